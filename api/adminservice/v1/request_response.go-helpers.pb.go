@@ -153,6 +153,80 @@ func (this *ImportWorkflowExecutionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RestoreArchivedWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionRequest:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RestoreArchivedWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RestoreArchivedWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *RestoreArchivedWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RestoreArchivedWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RestoreArchivedWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RestoreArchivedWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RestoreArchivedWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *RestoreArchivedWorkflowExecutionResponse:
+		that1 = t
+	case RestoreArchivedWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DescribeMutableStateRequest to the protobuf v3 wire format
 func (val *DescribeMutableStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2114,6 +2188,265 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type StreamTaskQueueStatsRequest to the protobuf v3 wire format
+func (val *StreamTaskQueueStatsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamTaskQueueStatsRequest from the protobuf v3 wire format
+func (val *StreamTaskQueueStatsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamTaskQueueStatsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamTaskQueueStatsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamTaskQueueStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamTaskQueueStatsRequest
+	switch t := that.(type) {
+	case *StreamTaskQueueStatsRequest:
+		that1 = t
+	case StreamTaskQueueStatsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StreamTaskQueueStatsResponse to the protobuf v3 wire format
+func (val *StreamTaskQueueStatsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StreamTaskQueueStatsResponse from the protobuf v3 wire format
+func (val *StreamTaskQueueStatsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StreamTaskQueueStatsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StreamTaskQueueStatsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StreamTaskQueueStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StreamTaskQueueStatsResponse
+	switch t := that.(type) {
+	case *StreamTaskQueueStatsResponse:
+		that1 = t
+	case StreamTaskQueueStatsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogRequest:
+		that1 = t
+	case ListTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogTaskFilter to the protobuf v3 wire format
+func (val *BacklogTaskFilter) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTaskFilter from the protobuf v3 wire format
+func (val *BacklogTaskFilter) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTaskFilter) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTaskFilter values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTaskFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTaskFilter
+	switch t := that.(type) {
+	case *BacklogTaskFilter:
+		that1 = t
+	case BacklogTaskFilter:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *ListTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *ListTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *ListTaskQueueBacklogResponse:
+		that1 = t
+	case ListTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogTask to the protobuf v3 wire format
+func (val *BacklogTask) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTask from the protobuf v3 wire format
+func (val *BacklogTask) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTask) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTask values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTask
+	switch t := that.(type) {
+	case *BacklogTask:
+		that1 = t
+	case BacklogTask:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogSummary to the protobuf v3 wire format
+func (val *BacklogSummary) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogSummary from the protobuf v3 wire format
+func (val *BacklogSummary) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogSummary) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogSummary values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogSummary
+	switch t := that.(type) {
+	case *BacklogSummary:
+		that1 = t
+	case BacklogSummary:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2743,35 +3076,35 @@ func (this *CancelDLQJobResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
-func (val *AddTasksRequest) Marshal() ([]byte, error) {
+// Marshal an object of type StartVisibilityConsistencyCheckRequest to the protobuf v3 wire format
+func (val *StartVisibilityConsistencyCheckRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
-func (val *AddTasksRequest) Unmarshal(buf []byte) error {
+// Unmarshal an object of type StartVisibilityConsistencyCheckRequest from the protobuf v3 wire format
+func (val *StartVisibilityConsistencyCheckRequest) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
 
 // Size returns the size of the object, in bytes, once serialized
-func (val *AddTasksRequest) Size() int {
+func (val *StartVisibilityConsistencyCheckRequest) Size() int {
 	return proto.Size(val)
 }
 
-// Equal returns whether two AddTasksRequest values are equivalent by recursively
+// Equal returns whether two StartVisibilityConsistencyCheckRequest values are equivalent by recursively
 // comparing the message's fields.
 // For more information see the documentation for
 // https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
-func (this *AddTasksRequest) Equal(that interface{}) bool {
+func (this *StartVisibilityConsistencyCheckRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	var that1 *AddTasksRequest
+	var that1 *StartVisibilityConsistencyCheckRequest
 	switch t := that.(type) {
-	case *AddTasksRequest:
+	case *StartVisibilityConsistencyCheckRequest:
 		that1 = t
-	case AddTasksRequest:
+	case StartVisibilityConsistencyCheckRequest:
 		that1 = &t
 	default:
 		return false
@@ -2780,12 +3113,197 @@ func (this *AddTasksRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
-// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
-func (val *AddTasksResponse) Marshal() ([]byte, error) {
+// Marshal an object of type StartVisibilityConsistencyCheckResponse to the protobuf v3 wire format
+func (val *StartVisibilityConsistencyCheckResponse) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
 }
 
-// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
+// Unmarshal an object of type StartVisibilityConsistencyCheckResponse from the protobuf v3 wire format
+func (val *StartVisibilityConsistencyCheckResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityConsistencyCheckResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityConsistencyCheckResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityConsistencyCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityConsistencyCheckResponse
+	switch t := that.(type) {
+	case *StartVisibilityConsistencyCheckResponse:
+		that1 = t
+	case StartVisibilityConsistencyCheckResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type VisibilityConsistencyCheckJobToken to the protobuf v3 wire format
+func (val *VisibilityConsistencyCheckJobToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type VisibilityConsistencyCheckJobToken from the protobuf v3 wire format
+func (val *VisibilityConsistencyCheckJobToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *VisibilityConsistencyCheckJobToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two VisibilityConsistencyCheckJobToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *VisibilityConsistencyCheckJobToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *VisibilityConsistencyCheckJobToken
+	switch t := that.(type) {
+	case *VisibilityConsistencyCheckJobToken:
+		that1 = t
+	case VisibilityConsistencyCheckJobToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityConsistencyCheckRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityConsistencyCheckRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityConsistencyCheckRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityConsistencyCheckRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityConsistencyCheckRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityConsistencyCheckRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityConsistencyCheckRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityConsistencyCheckRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityConsistencyCheckRequest:
+		that1 = t
+	case DescribeVisibilityConsistencyCheckRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityConsistencyCheckResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityConsistencyCheckResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityConsistencyCheckResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityConsistencyCheckResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityConsistencyCheckResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityConsistencyCheckResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityConsistencyCheckResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityConsistencyCheckResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityConsistencyCheckResponse:
+		that1 = t
+	case DescribeVisibilityConsistencyCheckResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddTasksRequest to the protobuf v3 wire format
+func (val *AddTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddTasksRequest from the protobuf v3 wire format
+func (val *AddTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AddTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AddTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AddTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AddTasksRequest
+	switch t := that.(type) {
+	case *AddTasksRequest:
+		that1 = t
+	case AddTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AddTasksResponse to the protobuf v3 wire format
+func (val *AddTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AddTasksResponse from the protobuf v3 wire format
 func (val *AddTasksResponse) Unmarshal(buf []byte) error {
 	return proto.Unmarshal(buf, val)
 }
@@ -3261,6 +3779,339 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueueConcurrencyLimitRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueConcurrencyLimitRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueConcurrencyLimitRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueConcurrencyLimitRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueConcurrencyLimitRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueConcurrencyLimitRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueConcurrencyLimitRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueConcurrencyLimitRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueConcurrencyLimitRequest:
+		that1 = t
+	case UpdateTaskQueueConcurrencyLimitRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueConcurrencyLimitResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueConcurrencyLimitResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueConcurrencyLimitResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueConcurrencyLimitResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueConcurrencyLimitResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueConcurrencyLimitResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueConcurrencyLimitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueConcurrencyLimitResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueConcurrencyLimitResponse:
+		that1 = t
+	case UpdateTaskQueueConcurrencyLimitResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueBacklogRequest to the protobuf v3 wire format
+func (val *PurgeTaskQueueBacklogRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueBacklogRequest from the protobuf v3 wire format
+func (val *PurgeTaskQueueBacklogRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueBacklogRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueBacklogRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueBacklogRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueBacklogRequest
+	switch t := that.(type) {
+	case *PurgeTaskQueueBacklogRequest:
+		that1 = t
+	case PurgeTaskQueueBacklogRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PurgeTaskQueueBacklogResponse to the protobuf v3 wire format
+func (val *PurgeTaskQueueBacklogResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PurgeTaskQueueBacklogResponse from the protobuf v3 wire format
+func (val *PurgeTaskQueueBacklogResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PurgeTaskQueueBacklogResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PurgeTaskQueueBacklogResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PurgeTaskQueueBacklogResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PurgeTaskQueueBacklogResponse
+	switch t := that.(type) {
+	case *PurgeTaskQueueBacklogResponse:
+		that1 = t
+	case PurgeTaskQueueBacklogResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReshardHistoryRequest to the protobuf v3 wire format
+func (val *ReshardHistoryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReshardHistoryRequest from the protobuf v3 wire format
+func (val *ReshardHistoryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReshardHistoryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReshardHistoryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReshardHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReshardHistoryRequest
+	switch t := that.(type) {
+	case *ReshardHistoryRequest:
+		that1 = t
+	case ReshardHistoryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReshardHistoryResponse to the protobuf v3 wire format
+func (val *ReshardHistoryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReshardHistoryResponse from the protobuf v3 wire format
+func (val *ReshardHistoryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReshardHistoryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReshardHistoryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReshardHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReshardHistoryResponse
+	switch t := that.(type) {
+	case *ReshardHistoryResponse:
+		that1 = t
+	case ReshardHistoryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReshardHistoryPageToken to the protobuf v3 wire format
+func (val *ReshardHistoryPageToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReshardHistoryPageToken from the protobuf v3 wire format
+func (val *ReshardHistoryPageToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReshardHistoryPageToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReshardHistoryPageToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReshardHistoryPageToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReshardHistoryPageToken
+	switch t := that.(type) {
+	case *ReshardHistoryPageToken:
+		that1 = t
+	case ReshardHistoryPageToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunQueueActionRequest to the protobuf v3 wire format
+func (val *RunQueueActionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunQueueActionRequest from the protobuf v3 wire format
+func (val *RunQueueActionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunQueueActionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunQueueActionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunQueueActionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunQueueActionRequest
+	switch t := that.(type) {
+	case *RunQueueActionRequest:
+		that1 = t
+	case RunQueueActionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunQueueActionResponse to the protobuf v3 wire format
+func (val *RunQueueActionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunQueueActionResponse from the protobuf v3 wire format
+func (val *RunQueueActionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunQueueActionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunQueueActionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunQueueActionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunQueueActionResponse
+	switch t := that.(type) {
+	case *RunQueueActionResponse:
+		that1 = t
+	case RunQueueActionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartAdminBatchOperationRequest to the protobuf v3 wire format
 func (val *StartAdminBatchOperationRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	AdminService_RebuildMutableState_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/RebuildMutableState"
 	AdminService_ImportWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/ImportWorkflowExecution"
+	AdminService_RestoreArchivedWorkflowExecution_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/RestoreArchivedWorkflowExecution"
 	AdminService_DescribeMutableState_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DescribeMutableState"
 	AdminService_DescribeHistoryHost_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryHost"
 	AdminService_GetShard_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/GetShard"
@@ -49,14 +50,18 @@ const (
 	AdminService_StartAdminBatchOperation_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/StartAdminBatchOperation"
 	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
 	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_ListTaskQueueBacklog_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ListTaskQueueBacklog"
 	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
 	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
+	AdminService_StreamTaskQueueStats_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/StreamTaskQueueStats"
 	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
 	AdminService_GetDLQTasks_FullMethodName                         = "/temporal.server.api.adminservice.v1.AdminService/GetDLQTasks"
 	AdminService_PurgeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/PurgeDLQTasks"
 	AdminService_MergeDLQTasks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/MergeDLQTasks"
 	AdminService_DescribeDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/DescribeDLQJob"
 	AdminService_CancelDLQJob_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
+	AdminService_StartVisibilityConsistencyCheck_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityConsistencyCheck"
+	AdminService_DescribeVisibilityConsistencyCheck_FullMethodName  = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityConsistencyCheck"
 	AdminService_AddTasks_FullMethodName                            = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
 	AdminService_ListQueues_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
 	AdminService_DeepHealthCheck_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/DeepHealthCheck"
@@ -64,6 +69,10 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_UpdateTaskQueueConcurrencyLimit_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskQueueConcurrencyLimit"
+	AdminService_PurgeTaskQueueBacklog_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/PurgeTaskQueueBacklog"
+	AdminService_ReshardHistory_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/ReshardHistory"
+	AdminService_RunQueueAction_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/RunQueueAction"
 	AdminService_MigrateSchedule_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/MigrateSchedule"
 )
 
@@ -77,6 +86,10 @@ type AdminServiceClient interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(ctx context.Context, in *ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*ImportWorkflowExecutionResponse, error)
+	// RestoreArchivedWorkflowExecution reads the history of a closed workflow execution from the namespace's
+	// history archival store and imports it back into the history service.
+	// NOTE: this is experimental API
+	RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -138,9 +151,15 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// ListTaskQueueBacklog pages through the backlog of a physical task queue, decodes the tasks and
+	// aggregates counts of the tasks that match the filter.
+	ListTaskQueueBacklog(ctx context.Context, in *ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
+	// StreamTaskQueueStats pushes stats of a task queue every interval until the caller cancels the
+	// stream. The stats are aggregated across all partitions by the root partition of each task queue type.
+	StreamTaskQueueStats(ctx context.Context, in *StreamTaskQueueStatsRequest, opts ...grpc.CallOption) (AdminService_StreamTaskQueueStatsClient, error)
 	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error)
 	GetDLQTasks(ctx context.Context, in *GetDLQTasksRequest, opts ...grpc.CallOption) (*GetDLQTasksResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
@@ -150,6 +169,10 @@ type AdminServiceClient interface {
 	MergeDLQTasks(ctx context.Context, in *MergeDLQTasksRequest, opts ...grpc.CallOption) (*MergeDLQTasksResponse, error)
 	DescribeDLQJob(ctx context.Context, in *DescribeDLQJobRequest, opts ...grpc.CallOption) (*DescribeDLQJobResponse, error)
 	CancelDLQJob(ctx context.Context, in *CancelDLQJobRequest, opts ...grpc.CallOption) (*CancelDLQJobResponse, error)
+	// StartVisibilityConsistencyCheck starts a job that compares the primary and secondary visibility stores of a
+	// namespace, and optionally backfills the divergent records from mutable state.
+	StartVisibilityConsistencyCheck(ctx context.Context, in *StartVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*StartVisibilityConsistencyCheckResponse, error)
+	DescribeVisibilityConsistencyCheck(ctx context.Context, in *DescribeVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*DescribeVisibilityConsistencyCheckResponse, error)
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
 	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
@@ -157,6 +180,24 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// UpdateTaskQueueConcurrencyLimit sets the maximum number of in-flight tasks of an activity task queue,
+	// overall and per fairness key. Matching does not dispatch more tasks until history reports that
+	// in-flight attempts have completed, failed or timed out.
+	UpdateTaskQueueConcurrencyLimit(ctx context.Context, in *UpdateTaskQueueConcurrencyLimitRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConcurrencyLimitResponse, error)
+	// PurgeTaskQueueBacklog drops or moves the backlog tasks of a task queue that match a filter. The
+	// purge is stored with the task queue. Every partition scans its backlog for matching tasks when it
+	// sees the purge, and also checks the tasks it reads while the purge is active. Activities of dropped
+	// tasks are reset by history, which schedules them again. Purged tasks are logged for auditing.
+	PurgeTaskQueueBacklog(ctx context.Context, in *PurgeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*PurgeTaskQueueBacklogResponse, error)
+	// ReshardHistory splits the history shards of the cluster into more shards, one page at a time. Executions
+	// are copied to their new shard while the history service serves traffic. Cutover must then be requested
+	// while the history service is stopped, after which all services must be restarted. This is an offline
+	// migration: shards are never owned by two history hosts at once, and cutover fails while history hosts
+	// are running.
+	ReshardHistory(ctx context.Context, in *ReshardHistoryRequest, opts ...grpc.CallOption) (*ReshardHistoryResponse, error)
+	// RunQueueAction runs one of the mitigation actions of a history task queue on a given shard on demand,
+	// without waiting for the queue to raise the corresponding alert.
+	RunQueueAction(ctx context.Context, in *RunQueueActionRequest, opts ...grpc.CallOption) (*RunQueueActionResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error)
 }
@@ -187,6 +228,15 @@ func (c *adminServiceClient) ImportWorkflowExecution(ctx context.Context, in *Im
 	return out, nil
 }
 
+func (c *adminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	out := new(RestoreArchivedWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreArchivedWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DescribeMutableState(ctx context.Context, in *DescribeMutableStateRequest, opts ...grpc.CallOption) (*DescribeMutableStateResponse, error) {
 	out := new(DescribeMutableStateResponse)
 	err := c.cc.Invoke(ctx, AdminService_DescribeMutableState_FullMethodName, in, out, opts...)
@@ -430,6 +480,15 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) ListTaskQueueBacklog(ctx context.Context, in *ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*ListTaskQueueBacklogResponse, error) {
+	out := new(ListTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, AdminService_DeleteWorkflowExecution_FullMethodName, in, out, opts...)
@@ -470,6 +529,38 @@ func (x *adminServiceStreamWorkflowReplicationMessagesClient) Recv() (*StreamWor
 	return m, nil
 }
 
+func (c *adminServiceClient) StreamTaskQueueStats(ctx context.Context, in *StreamTaskQueueStatsRequest, opts ...grpc.CallOption) (AdminService_StreamTaskQueueStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[1], AdminService_StreamTaskQueueStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceStreamTaskQueueStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_StreamTaskQueueStatsClient interface {
+	Recv() (*StreamTaskQueueStatsResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceStreamTaskQueueStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceStreamTaskQueueStatsClient) Recv() (*StreamTaskQueueStatsResponse, error) {
+	m := new(StreamTaskQueueStatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminServiceClient) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error) {
 	out := new(GetNamespaceResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetNamespace_FullMethodName, in, out, opts...)
@@ -524,6 +615,24 @@ func (c *adminServiceClient) CancelDLQJob(ctx context.Context, in *CancelDLQJobR
 	return out, nil
 }
 
+func (c *adminServiceClient) StartVisibilityConsistencyCheck(ctx context.Context, in *StartVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*StartVisibilityConsistencyCheckResponse, error) {
+	out := new(StartVisibilityConsistencyCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityConsistencyCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityConsistencyCheck(ctx context.Context, in *DescribeVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*DescribeVisibilityConsistencyCheckResponse, error) {
+	out := new(DescribeVisibilityConsistencyCheckResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityConsistencyCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error) {
 	out := new(AddTasksResponse)
 	err := c.cc.Invoke(ctx, AdminService_AddTasks_FullMethodName, in, out, opts...)
@@ -587,6 +696,42 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) UpdateTaskQueueConcurrencyLimit(ctx context.Context, in *UpdateTaskQueueConcurrencyLimitRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConcurrencyLimitResponse, error) {
+	out := new(UpdateTaskQueueConcurrencyLimitResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskQueueConcurrencyLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeTaskQueueBacklog(ctx context.Context, in *PurgeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*PurgeTaskQueueBacklogResponse, error) {
+	out := new(PurgeTaskQueueBacklogResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeTaskQueueBacklog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReshardHistory(ctx context.Context, in *ReshardHistoryRequest, opts ...grpc.CallOption) (*ReshardHistoryResponse, error) {
+	out := new(ReshardHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReshardHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RunQueueAction(ctx context.Context, in *RunQueueActionRequest, opts ...grpc.CallOption) (*RunQueueActionResponse, error) {
+	out := new(RunQueueActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_RunQueueAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error) {
 	out := new(MigrateScheduleResponse)
 	err := c.cc.Invoke(ctx, AdminService_MigrateSchedule_FullMethodName, in, out, opts...)
@@ -606,6 +751,10 @@ type AdminServiceServer interface {
 	// ImportWorkflowExecution attempts to import workflow according to persisted history events.
 	// NOTE: this is experimental API
 	ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error)
+	// RestoreArchivedWorkflowExecution reads the history of a closed workflow execution from the namespace's
+	// history archival store and imports it back into the history service.
+	// NOTE: this is experimental API
+	RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error)
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
 	DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error)
 	// DescribeHistoryHost returns information about the internal states of a history host
@@ -667,9 +816,15 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// ListTaskQueueBacklog pages through the backlog of a physical task queue, decodes the tasks and
+	// aggregates counts of the tasks that match the filter.
+	ListTaskQueueBacklog(context.Context, *ListTaskQueueBacklogRequest) (*ListTaskQueueBacklogResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
+	// StreamTaskQueueStats pushes stats of a task queue every interval until the caller cancels the
+	// stream. The stats are aggregated across all partitions by the root partition of each task queue type.
+	StreamTaskQueueStats(*StreamTaskQueueStatsRequest, AdminService_StreamTaskQueueStatsServer) error
 	GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error)
 	GetDLQTasks(context.Context, *GetDLQTasksRequest) (*GetDLQTasksResponse, error)
 	// (-- api-linter: core::0165::response-message-name=disabled
@@ -679,6 +834,10 @@ type AdminServiceServer interface {
 	MergeDLQTasks(context.Context, *MergeDLQTasksRequest) (*MergeDLQTasksResponse, error)
 	DescribeDLQJob(context.Context, *DescribeDLQJobRequest) (*DescribeDLQJobResponse, error)
 	CancelDLQJob(context.Context, *CancelDLQJobRequest) (*CancelDLQJobResponse, error)
+	// StartVisibilityConsistencyCheck starts a job that compares the primary and secondary visibility stores of a
+	// namespace, and optionally backfills the divergent records from mutable state.
+	StartVisibilityConsistencyCheck(context.Context, *StartVisibilityConsistencyCheckRequest) (*StartVisibilityConsistencyCheckResponse, error)
+	DescribeVisibilityConsistencyCheck(context.Context, *DescribeVisibilityConsistencyCheckRequest) (*DescribeVisibilityConsistencyCheckResponse, error)
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
 	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
@@ -686,6 +845,24 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// UpdateTaskQueueConcurrencyLimit sets the maximum number of in-flight tasks of an activity task queue,
+	// overall and per fairness key. Matching does not dispatch more tasks until history reports that
+	// in-flight attempts have completed, failed or timed out.
+	UpdateTaskQueueConcurrencyLimit(context.Context, *UpdateTaskQueueConcurrencyLimitRequest) (*UpdateTaskQueueConcurrencyLimitResponse, error)
+	// PurgeTaskQueueBacklog drops or moves the backlog tasks of a task queue that match a filter. The
+	// purge is stored with the task queue. Every partition scans its backlog for matching tasks when it
+	// sees the purge, and also checks the tasks it reads while the purge is active. Activities of dropped
+	// tasks are reset by history, which schedules them again. Purged tasks are logged for auditing.
+	PurgeTaskQueueBacklog(context.Context, *PurgeTaskQueueBacklogRequest) (*PurgeTaskQueueBacklogResponse, error)
+	// ReshardHistory splits the history shards of the cluster into more shards, one page at a time. Executions
+	// are copied to their new shard while the history service serves traffic. Cutover must then be requested
+	// while the history service is stopped, after which all services must be restarted. This is an offline
+	// migration: shards are never owned by two history hosts at once, and cutover fails while history hosts
+	// are running.
+	ReshardHistory(context.Context, *ReshardHistoryRequest) (*ReshardHistoryResponse, error)
+	// RunQueueAction runs one of the mitigation actions of a history task queue on a given shard on demand,
+	// without waiting for the queue to raise the corresponding alert.
+	RunQueueAction(context.Context, *RunQueueActionRequest) (*RunQueueActionResponse, error)
 	// MigrateSchedule migrates a schedule between V1 (workflow-backed) and V2 (CHASM-backed) implementations.
 	MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
@@ -701,6 +878,9 @@ func (UnimplementedAdminServiceServer) RebuildMutableState(context.Context, *Reb
 func (UnimplementedAdminServiceServer) ImportWorkflowExecution(context.Context, *ImportWorkflowExecutionRequest) (*ImportWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowExecution not implemented")
 }
+func (UnimplementedAdminServiceServer) RestoreArchivedWorkflowExecution(context.Context, *RestoreArchivedWorkflowExecutionRequest) (*RestoreArchivedWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArchivedWorkflowExecution not implemented")
+}
 func (UnimplementedAdminServiceServer) DescribeMutableState(context.Context, *DescribeMutableStateRequest) (*DescribeMutableStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeMutableState not implemented")
 }
@@ -782,12 +962,18 @@ func (UnimplementedAdminServiceServer) ResendReplicationTasks(context.Context, *
 func (UnimplementedAdminServiceServer) GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) ListTaskQueueBacklog(context.Context, *ListTaskQueueBacklogRequest) (*ListTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskQueueBacklog not implemented")
+}
 func (UnimplementedAdminServiceServer) DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
 func (UnimplementedAdminServiceServer) StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWorkflowReplicationMessages not implemented")
 }
+func (UnimplementedAdminServiceServer) StreamTaskQueueStats(*StreamTaskQueueStatsRequest, AdminService_StreamTaskQueueStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTaskQueueStats not implemented")
+}
 func (UnimplementedAdminServiceServer) GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
 }
@@ -806,6 +992,12 @@ func (UnimplementedAdminServiceServer) DescribeDLQJob(context.Context, *Describe
 func (UnimplementedAdminServiceServer) CancelDLQJob(context.Context, *CancelDLQJobRequest) (*CancelDLQJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelDLQJob not implemented")
 }
+func (UnimplementedAdminServiceServer) StartVisibilityConsistencyCheck(context.Context, *StartVisibilityConsistencyCheckRequest) (*StartVisibilityConsistencyCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityConsistencyCheck not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityConsistencyCheck(context.Context, *DescribeVisibilityConsistencyCheckRequest) (*DescribeVisibilityConsistencyCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityConsistencyCheck not implemented")
+}
 func (UnimplementedAdminServiceServer) AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTasks not implemented")
 }
@@ -827,6 +1019,18 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) UpdateTaskQueueConcurrencyLimit(context.Context, *UpdateTaskQueueConcurrencyLimitRequest) (*UpdateTaskQueueConcurrencyLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConcurrencyLimit not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeTaskQueueBacklog(context.Context, *PurgeTaskQueueBacklogRequest) (*PurgeTaskQueueBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTaskQueueBacklog not implemented")
+}
+func (UnimplementedAdminServiceServer) ReshardHistory(context.Context, *ReshardHistoryRequest) (*ReshardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshardHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) RunQueueAction(context.Context, *RunQueueActionRequest) (*RunQueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunQueueAction not implemented")
+}
 func (UnimplementedAdminServiceServer) MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MigrateSchedule not implemented")
 }
@@ -879,6 +1083,24 @@ func _AdminService_ImportWorkflowExecution_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RestoreArchivedWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArchivedWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RestoreArchivedWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreArchivedWorkflowExecution(ctx, req.(*RestoreArchivedWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DescribeMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeMutableStateRequest)
 	if err := dec(in); err != nil {
@@ -1365,6 +1587,24 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTaskQueueBacklog(ctx, req.(*ListTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1409,6 +1649,27 @@ func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWor
 	return m, nil
 }
 
+func _AdminService_StreamTaskQueueStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTaskQueueStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).StreamTaskQueueStats(m, &adminServiceStreamTaskQueueStatsServer{stream})
+}
+
+type AdminService_StreamTaskQueueStatsServer interface {
+	Send(*StreamTaskQueueStatsResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceStreamTaskQueueStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamTaskQueueStatsServer) Send(m *StreamTaskQueueStatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AdminService_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -1517,6 +1778,42 @@ func _AdminService_CancelDLQJob_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_StartVisibilityConsistencyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityConsistencyCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityConsistencyCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityConsistencyCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityConsistencyCheck(ctx, req.(*StartVisibilityConsistencyCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityConsistencyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityConsistencyCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityConsistencyCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityConsistencyCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityConsistencyCheck(ctx, req.(*DescribeVisibilityConsistencyCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_AddTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddTasksRequest)
 	if err := dec(in); err != nil {
@@ -1643,6 +1940,78 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UpdateTaskQueueConcurrencyLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueConcurrencyLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskQueueConcurrencyLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskQueueConcurrencyLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskQueueConcurrencyLimit(ctx, req.(*UpdateTaskQueueConcurrencyLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeTaskQueueBacklog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskQueueBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeTaskQueueBacklog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeTaskQueueBacklog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeTaskQueueBacklog(ctx, req.(*PurgeTaskQueueBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReshardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReshardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReshardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReshardHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReshardHistory(ctx, req.(*ReshardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RunQueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunQueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RunQueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RunQueueAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RunQueueAction(ctx, req.(*RunQueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_MigrateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MigrateScheduleRequest)
 	if err := dec(in); err != nil {
@@ -1676,6 +2045,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportWorkflowExecution",
 			Handler:    _AdminService_ImportWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RestoreArchivedWorkflowExecution",
+			Handler:    _AdminService_RestoreArchivedWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "DescribeMutableState",
 			Handler:    _AdminService_DescribeMutableState_Handler,
@@ -1784,6 +2157,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "ListTaskQueueBacklog",
+			Handler:    _AdminService_ListTaskQueueBacklog_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
@@ -1812,6 +2189,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelDLQJob",
 			Handler:    _AdminService_CancelDLQJob_Handler,
 		},
+		{
+			MethodName: "StartVisibilityConsistencyCheck",
+			Handler:    _AdminService_StartVisibilityConsistencyCheck_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityConsistencyCheck",
+			Handler:    _AdminService_DescribeVisibilityConsistencyCheck_Handler,
+		},
 		{
 			MethodName: "AddTasks",
 			Handler:    _AdminService_AddTasks_Handler,
@@ -1840,6 +2225,22 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueueConcurrencyLimit",
+			Handler:    _AdminService_UpdateTaskQueueConcurrencyLimit_Handler,
+		},
+		{
+			MethodName: "PurgeTaskQueueBacklog",
+			Handler:    _AdminService_PurgeTaskQueueBacklog_Handler,
+		},
+		{
+			MethodName: "ReshardHistory",
+			Handler:    _AdminService_ReshardHistory_Handler,
+		},
+		{
+			MethodName: "RunQueueAction",
+			Handler:    _AdminService_RunQueueAction_Handler,
+		},
 		{
 			MethodName: "MigrateSchedule",
 			Handler:    _AdminService_MigrateSchedule_Handler,
@@ -1852,6 +2253,11 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamTaskQueueStats",
+			Handler:       _AdminService_StreamTaskQueueStats_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
 }
@@ -283,6 +283,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeVisibilityConsistencyCheck mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityConsistencyCheck(ctx context.Context, in *adminservice.DescribeVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityConsistencyCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityConsistencyCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityConsistencyCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityConsistencyCheck indicates an expected call of DescribeVisibilityConsistencyCheck.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityConsistencyCheck(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityConsistencyCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityConsistencyCheck), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +663,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) ListTaskQueueBacklog(ctx context.Context, in *adminservice.ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklog indicates an expected call of ListTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) ListTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).ListTaskQueueBacklog), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -743,6 +783,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQTasks), varargs...)
 }
 
+// PurgeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceClient) PurgeTaskQueueBacklog(ctx context.Context, in *adminservice.PurgeTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.PurgeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PurgeTaskQueueBacklog", varargs...)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueBacklog indicates an expected call of PurgeTaskQueueBacklog.
+func (mr *MockAdminServiceClientMockRecorder) PurgeTaskQueueBacklog(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeTaskQueueBacklog), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +943,66 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ReshardHistory mocks base method.
+func (m *MockAdminServiceClient) ReshardHistory(ctx context.Context, in *adminservice.ReshardHistoryRequest, opts ...grpc.CallOption) (*adminservice.ReshardHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReshardHistory", varargs...)
+	ret0, _ := ret[0].(*adminservice.ReshardHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReshardHistory indicates an expected call of ReshardHistory.
+func (mr *MockAdminServiceClientMockRecorder) ReshardHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReshardHistory", reflect.TypeOf((*MockAdminServiceClient)(nil).ReshardHistory), varargs...)
+}
+
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) RestoreArchivedWorkflowExecution(ctx context.Context, in *adminservice.RestoreArchivedWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) RestoreArchivedWorkflowExecution(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).RestoreArchivedWorkflowExecution), varargs...)
+}
+
+// RunQueueAction mocks base method.
+func (m *MockAdminServiceClient) RunQueueAction(ctx context.Context, in *adminservice.RunQueueActionRequest, opts ...grpc.CallOption) (*adminservice.RunQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunQueueAction", varargs...)
+	ret0, _ := ret[0].(*adminservice.RunQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunQueueAction indicates an expected call of RunQueueAction.
+func (mr *MockAdminServiceClientMockRecorder) RunQueueAction(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunQueueAction", reflect.TypeOf((*MockAdminServiceClient)(nil).RunQueueAction), varargs...)
+}
+
 // StartAdminBatchOperation mocks base method.
 func (m *MockAdminServiceClient) StartAdminBatchOperation(ctx context.Context, in *adminservice.StartAdminBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartAdminBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1023,46 @@ func (mr *MockAdminServiceClientMockRecorder) StartAdminBatchOperation(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartAdminBatchOperation), varargs...)
 }
 
+// StartVisibilityConsistencyCheck mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityConsistencyCheck(ctx context.Context, in *adminservice.StartVisibilityConsistencyCheckRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityConsistencyCheckResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityConsistencyCheck", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityConsistencyCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityConsistencyCheck indicates an expected call of StartVisibilityConsistencyCheck.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityConsistencyCheck(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityConsistencyCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityConsistencyCheck), varargs...)
+}
+
+// StreamTaskQueueStats mocks base method.
+func (m *MockAdminServiceClient) StreamTaskQueueStats(ctx context.Context, in *adminservice.StreamTaskQueueStatsRequest, opts ...grpc.CallOption) (adminservice.AdminService_StreamTaskQueueStatsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamTaskQueueStats", varargs...)
+	ret0, _ := ret[0].(adminservice.AdminService_StreamTaskQueueStatsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamTaskQueueStats indicates an expected call of StreamTaskQueueStats.
+func (mr *MockAdminServiceClientMockRecorder) StreamTaskQueueStats(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamTaskQueueStats", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamTaskQueueStats), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -943,6 +1103,26 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateTaskQueueConcurrencyLimit mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskQueueConcurrencyLimit(ctx context.Context, in *adminservice.UpdateTaskQueueConcurrencyLimitRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskQueueConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConcurrencyLimit", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConcurrencyLimit indicates an expected call of UpdateTaskQueueConcurrencyLimit.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskQueueConcurrencyLimit(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConcurrencyLimit", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskQueueConcurrencyLimit), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1081,6 +1261,130 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesClientMockRecorder)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesClient)(nil).Trailer))
 }
 
+// MockAdminService_StreamTaskQueueStatsClient is a mock of AdminService_StreamTaskQueueStatsClient interface.
+type MockAdminService_StreamTaskQueueStatsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamTaskQueueStatsClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_StreamTaskQueueStatsClientMockRecorder is the mock recorder for MockAdminService_StreamTaskQueueStatsClient.
+type MockAdminService_StreamTaskQueueStatsClientMockRecorder struct {
+	mock *MockAdminService_StreamTaskQueueStatsClient
+}
+
+// NewMockAdminService_StreamTaskQueueStatsClient creates a new mock instance.
+func NewMockAdminService_StreamTaskQueueStatsClient(ctrl *gomock.Controller) *MockAdminService_StreamTaskQueueStatsClient {
+	mock := &MockAdminService_StreamTaskQueueStatsClient{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamTaskQueueStatsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamTaskQueueStatsClient) EXPECT() *MockAdminService_StreamTaskQueueStatsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsClient) Recv() (*adminservice.StreamTaskQueueStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*adminservice.StreamTaskQueueStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamTaskQueueStatsClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamTaskQueueStatsClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAdminService_StreamTaskQueueStatsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsClient)(nil).Trailer))
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1285,6 +1589,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeVisibilityConsistencyCheck mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityConsistencyCheck(arg0 context.Context, arg1 *adminservice.DescribeVisibilityConsistencyCheckRequest) (*adminservice.DescribeVisibilityConsistencyCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityConsistencyCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityConsistencyCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityConsistencyCheck indicates an expected call of DescribeVisibilityConsistencyCheck.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityConsistencyCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityConsistencyCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityConsistencyCheck), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1555,6 +1874,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) ListTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskQueueBacklog indicates an expected call of ListTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) ListTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).ListTaskQueueBacklog), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1630,6 +1964,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQTasks), arg0, arg1)
 }
 
+// PurgeTaskQueueBacklog mocks base method.
+func (m *MockAdminServiceServer) PurgeTaskQueueBacklog(arg0 context.Context, arg1 *adminservice.PurgeTaskQueueBacklogRequest) (*adminservice.PurgeTaskQueueBacklogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTaskQueueBacklog", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PurgeTaskQueueBacklogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTaskQueueBacklog indicates an expected call of PurgeTaskQueueBacklog.
+func (mr *MockAdminServiceServerMockRecorder) PurgeTaskQueueBacklog(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTaskQueueBacklog", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeTaskQueueBacklog), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1735,6 +2084,51 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ReshardHistory mocks base method.
+func (m *MockAdminServiceServer) ReshardHistory(arg0 context.Context, arg1 *adminservice.ReshardHistoryRequest) (*adminservice.ReshardHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReshardHistory", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ReshardHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReshardHistory indicates an expected call of ReshardHistory.
+func (mr *MockAdminServiceServerMockRecorder) ReshardHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReshardHistory", reflect.TypeOf((*MockAdminServiceServer)(nil).ReshardHistory), arg0, arg1)
+}
+
+// RestoreArchivedWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) RestoreArchivedWorkflowExecution(arg0 context.Context, arg1 *adminservice.RestoreArchivedWorkflowExecutionRequest) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreArchivedWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RestoreArchivedWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreArchivedWorkflowExecution indicates an expected call of RestoreArchivedWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) RestoreArchivedWorkflowExecution(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreArchivedWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).RestoreArchivedWorkflowExecution), arg0, arg1)
+}
+
+// RunQueueAction mocks base method.
+func (m *MockAdminServiceServer) RunQueueAction(arg0 context.Context, arg1 *adminservice.RunQueueActionRequest) (*adminservice.RunQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunQueueAction", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RunQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunQueueAction indicates an expected call of RunQueueAction.
+func (mr *MockAdminServiceServerMockRecorder) RunQueueAction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunQueueAction", reflect.TypeOf((*MockAdminServiceServer)(nil).RunQueueAction), arg0, arg1)
+}
+
 // StartAdminBatchOperation mocks base method.
 func (m *MockAdminServiceServer) StartAdminBatchOperation(arg0 context.Context, arg1 *adminservice.StartAdminBatchOperationRequest) (*adminservice.StartAdminBatchOperationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1750,6 +2144,35 @@ func (mr *MockAdminServiceServerMockRecorder) StartAdminBatchOperation(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAdminBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartAdminBatchOperation), arg0, arg1)
 }
 
+// StartVisibilityConsistencyCheck mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityConsistencyCheck(arg0 context.Context, arg1 *adminservice.StartVisibilityConsistencyCheckRequest) (*adminservice.StartVisibilityConsistencyCheckResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityConsistencyCheck", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityConsistencyCheckResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityConsistencyCheck indicates an expected call of StartVisibilityConsistencyCheck.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityConsistencyCheck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityConsistencyCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityConsistencyCheck), arg0, arg1)
+}
+
+// StreamTaskQueueStats mocks base method.
+func (m *MockAdminServiceServer) StreamTaskQueueStats(arg0 *adminservice.StreamTaskQueueStatsRequest, arg1 adminservice.AdminService_StreamTaskQueueStatsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamTaskQueueStats", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamTaskQueueStats indicates an expected call of StreamTaskQueueStats.
+func (mr *MockAdminServiceServerMockRecorder) StreamTaskQueueStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamTaskQueueStats", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamTaskQueueStats), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1779,6 +2202,21 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateTaskQueueConcurrencyLimit mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskQueueConcurrencyLimit(arg0 context.Context, arg1 *adminservice.UpdateTaskQueueConcurrencyLimitRequest) (*adminservice.UpdateTaskQueueConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueConcurrencyLimit", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueConcurrencyLimit indicates an expected call of UpdateTaskQueueConcurrencyLimit.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskQueueConcurrencyLimit(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConcurrencyLimit", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskQueueConcurrencyLimit), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -1961,3 +2399,123 @@ func (mr *MockAdminService_StreamWorkflowReplicationMessagesServerMockRecorder)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamWorkflowReplicationMessagesServer)(nil).SetTrailer), arg0)
 }
+
+// MockAdminService_StreamTaskQueueStatsServer is a mock of AdminService_StreamTaskQueueStatsServer interface.
+type MockAdminService_StreamTaskQueueStatsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminService_StreamTaskQueueStatsServerMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminService_StreamTaskQueueStatsServerMockRecorder is the mock recorder for MockAdminService_StreamTaskQueueStatsServer.
+type MockAdminService_StreamTaskQueueStatsServerMockRecorder struct {
+	mock *MockAdminService_StreamTaskQueueStatsServer
+}
+
+// NewMockAdminService_StreamTaskQueueStatsServer creates a new mock instance.
+func NewMockAdminService_StreamTaskQueueStatsServer(ctrl *gomock.Controller) *MockAdminService_StreamTaskQueueStatsServer {
+	mock := &MockAdminService_StreamTaskQueueStatsServer{ctrl: ctrl}
+	mock.recorder = &MockAdminService_StreamTaskQueueStatsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService_StreamTaskQueueStatsServer) EXPECT() *MockAdminService_StreamTaskQueueStatsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAdminService_StreamTaskQueueStatsServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsServer) Send(arg0 *adminservice.StreamTaskQueueStatsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAdminService_StreamTaskQueueStatsServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAdminService_StreamTaskQueueStatsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAdminService_StreamTaskQueueStatsServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAdminService_StreamTaskQueueStatsServer)(nil).SetTrailer), arg0)
+}
@@ -337,3 +337,40 @@ func (this *ActivityStartDuringTransitionFailure) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type TaskQueueBacklogLimitExceededFailure to the protobuf v3 wire format
+func (val *TaskQueueBacklogLimitExceededFailure) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueBacklogLimitExceededFailure from the protobuf v3 wire format
+func (val *TaskQueueBacklogLimitExceededFailure) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueBacklogLimitExceededFailure) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueBacklogLimitExceededFailure values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueBacklogLimitExceededFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueBacklogLimitExceededFailure
+	switch t := that.(type) {
+	case *TaskQueueBacklogLimitExceededFailure:
+		that1 = t
+	case TaskQueueBacklogLimitExceededFailure:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
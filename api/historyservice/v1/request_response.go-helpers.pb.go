@@ -4889,6 +4889,80 @@ func (this *ListTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type RunQueueActionRequest to the protobuf v3 wire format
+func (val *RunQueueActionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunQueueActionRequest from the protobuf v3 wire format
+func (val *RunQueueActionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunQueueActionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunQueueActionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunQueueActionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunQueueActionRequest
+	switch t := that.(type) {
+	case *RunQueueActionRequest:
+		that1 = t
+	case RunQueueActionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunQueueActionResponse to the protobuf v3 wire format
+func (val *RunQueueActionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunQueueActionResponse from the protobuf v3 wire format
+func (val *RunQueueActionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunQueueActionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunQueueActionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunQueueActionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunQueueActionResponse
+	switch t := that.(type) {
+	case *RunQueueActionResponse:
+		that1 = t
+	case RunQueueActionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CompleteNexusOperationChasmRequest to the protobuf v3 wire format
 func (val *CompleteNexusOperationChasmRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -83,6 +83,7 @@ const (
 	HistoryService_ListQueues_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/ListQueues"
 	HistoryService_AddTasks_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/AddTasks"
 	HistoryService_ListTasks_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/ListTasks"
+	HistoryService_RunQueueAction_FullMethodName                         = "/temporal.server.api.historyservice.v1.HistoryService/RunQueueAction"
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 	HistoryService_CompleteNexusOperationChasm_FullMethodName            = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperationChasm"
 	HistoryService_InvokeStateMachineMethod_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod"
@@ -306,6 +307,8 @@ type HistoryServiceClient interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// RunQueueAction runs a mitigation action on the queue of the given task category of a shard.
+	RunQueueAction(ctx context.Context, in *RunQueueActionRequest, opts ...grpc.CallOption) (*RunQueueActionResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	//
@@ -980,6 +983,15 @@ func (c *historyServiceClient) ListTasks(ctx context.Context, in *ListTasksReque
 	return out, nil
 }
 
+func (c *historyServiceClient) RunQueueAction(ctx context.Context, in *RunQueueActionRequest, opts ...grpc.CallOption) (*RunQueueActionResponse, error) {
+	out := new(RunQueueActionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RunQueueAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) CompleteNexusOperation(ctx context.Context, in *CompleteNexusOperationRequest, opts ...grpc.CallOption) (*CompleteNexusOperationResponse, error) {
 	out := new(CompleteNexusOperationResponse)
 	err := c.cc.Invoke(ctx, HistoryService_CompleteNexusOperation_FullMethodName, in, out, opts...)
@@ -1289,6 +1301,8 @@ type HistoryServiceServer interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// RunQueueAction runs a mitigation action on the queue of the given task category of a shard.
+	RunQueueAction(context.Context, *RunQueueActionRequest) (*RunQueueActionResponse, error)
 	// Complete an async Nexus Operation using a completion token. The completion state could be successful, failed, or
 	// canceled.
 	//
@@ -1560,6 +1574,9 @@ func (UnimplementedHistoryServiceServer) AddTasks(context.Context, *AddTasksRequ
 func (UnimplementedHistoryServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) RunQueueAction(context.Context, *RunQueueActionRequest) (*RunQueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunQueueAction not implemented")
+}
 func (UnimplementedHistoryServiceServer) CompleteNexusOperation(context.Context, *CompleteNexusOperationRequest) (*CompleteNexusOperationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CompleteNexusOperation not implemented")
 }
@@ -2748,6 +2765,24 @@ func _HistoryService_ListTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RunQueueAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunQueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RunQueueAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RunQueueAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RunQueueAction(ctx, req.(*RunQueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_CompleteNexusOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CompleteNexusOperationRequest)
 	if err := dec(in); err != nil {
@@ -3201,6 +3236,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _HistoryService_ListTasks_Handler,
 		},
+		{
+			MethodName: "RunQueueAction",
+			Handler:    _HistoryService_RunQueueAction_Handler,
+		},
 		{
 			MethodName: "CompleteNexusOperation",
 			Handler:    _HistoryService_CompleteNexusOperation_Handler,
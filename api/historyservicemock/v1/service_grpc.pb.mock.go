@@ -1203,6 +1203,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RespondWorkflowTaskFailed(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceClient)(nil).RespondWorkflowTaskFailed), varargs...)
 }
 
+// RunQueueAction mocks base method.
+func (m *MockHistoryServiceClient) RunQueueAction(ctx context.Context, in *historyservice.RunQueueActionRequest, opts ...grpc.CallOption) (*historyservice.RunQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunQueueAction", varargs...)
+	ret0, _ := ret[0].(*historyservice.RunQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunQueueAction indicates an expected call of RunQueueAction.
+func (mr *MockHistoryServiceClientMockRecorder) RunQueueAction(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunQueueAction", reflect.TypeOf((*MockHistoryServiceClient)(nil).RunQueueAction), varargs...)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceClient) ScheduleWorkflowTask(ctx context.Context, in *historyservice.ScheduleWorkflowTaskRequest, opts ...grpc.CallOption) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -2555,6 +2575,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RespondWorkflowTaskFailed(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceServer)(nil).RespondWorkflowTaskFailed), arg0, arg1)
 }
 
+// RunQueueAction mocks base method.
+func (m *MockHistoryServiceServer) RunQueueAction(arg0 context.Context, arg1 *historyservice.RunQueueActionRequest) (*historyservice.RunQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunQueueAction", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RunQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunQueueAction indicates an expected call of RunQueueAction.
+func (mr *MockHistoryServiceServerMockRecorder) RunQueueAction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunQueueAction", reflect.TypeOf((*MockHistoryServiceServer)(nil).RunQueueAction), arg0, arg1)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceServer) ScheduleWorkflowTask(arg0 context.Context, arg1 *historyservice.ScheduleWorkflowTaskRequest) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
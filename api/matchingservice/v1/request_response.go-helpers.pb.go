@@ -782,6 +782,80 @@ func (this *DescribeTaskQueuePartitionResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type GetTaskQueueStatsRequest to the protobuf v3 wire format
+func (val *GetTaskQueueStatsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueStatsRequest from the protobuf v3 wire format
+func (val *GetTaskQueueStatsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueStatsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueStatsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueStatsRequest
+	switch t := that.(type) {
+	case *GetTaskQueueStatsRequest:
+		that1 = t
+	case GetTaskQueueStatsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetTaskQueueStatsResponse to the protobuf v3 wire format
+func (val *GetTaskQueueStatsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetTaskQueueStatsResponse from the protobuf v3 wire format
+func (val *GetTaskQueueStatsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetTaskQueueStatsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetTaskQueueStatsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetTaskQueueStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetTaskQueueStatsResponse
+	switch t := that.(type) {
+	case *GetTaskQueueStatsResponse:
+		that1 = t
+	case GetTaskQueueStatsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type ListTaskQueuePartitionsRequest to the protobuf v3 wire format
 func (val *ListTaskQueuePartitionsRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2854,6 +2928,154 @@ func (this *UpdateFairnessStateResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type UpdateTaskQueuePartitionCountsRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueuePartitionCountsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePartitionCountsRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueuePartitionCountsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePartitionCountsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePartitionCountsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePartitionCountsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePartitionCountsRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueuePartitionCountsRequest:
+		that1 = t
+	case UpdateTaskQueuePartitionCountsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePartitionCountsResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueuePartitionCountsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePartitionCountsResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueuePartitionCountsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePartitionCountsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePartitionCountsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePartitionCountsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePartitionCountsResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueuePartitionCountsResponse:
+		that1 = t
+	case UpdateTaskQueuePartitionCountsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseConcurrencySlotRequest to the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseConcurrencySlotRequest from the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseConcurrencySlotRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseConcurrencySlotRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseConcurrencySlotRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseConcurrencySlotRequest
+	switch t := that.(type) {
+	case *ReleaseConcurrencySlotRequest:
+		that1 = t
+	case ReleaseConcurrencySlotRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReleaseConcurrencySlotResponse to the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReleaseConcurrencySlotResponse from the protobuf v3 wire format
+func (val *ReleaseConcurrencySlotResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReleaseConcurrencySlotResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReleaseConcurrencySlotResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReleaseConcurrencySlotResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReleaseConcurrencySlotResponse
+	switch t := that.(type) {
+	case *ReleaseConcurrencySlotResponse:
+		that1 = t
+	case ReleaseConcurrencySlotResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CheckTaskQueueVersionMembershipRequest to the protobuf v3 wire format
 func (val *CheckTaskQueueVersionMembershipRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
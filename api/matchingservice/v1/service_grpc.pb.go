@@ -33,6 +33,7 @@ const (
 	MatchingService_CancelOutstandingPoll_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/CancelOutstandingPoll"
 	MatchingService_DescribeTaskQueue_FullMethodName                      = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueue"
 	MatchingService_DescribeTaskQueuePartition_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeTaskQueuePartition"
+	MatchingService_GetTaskQueueStats_FullMethodName                      = "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskQueueStats"
 	MatchingService_DescribeVersionedTaskQueues_FullMethodName            = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeVersionedTaskQueues"
 	MatchingService_ListTaskQueuePartitions_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/ListTaskQueuePartitions"
 	MatchingService_UpdateWorkerBuildIdCompatibility_FullMethodName       = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateWorkerBuildIdCompatibility"
@@ -58,6 +59,8 @@ const (
 	MatchingService_UpdateTaskQueueConfig_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig"
 	MatchingService_DescribeWorker_FullMethodName                         = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker"
 	MatchingService_UpdateFairnessState_FullMethodName                    = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState"
+	MatchingService_UpdateTaskQueuePartitionCounts_FullMethodName         = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePartitionCounts"
+	MatchingService_ReleaseConcurrencySlot_FullMethodName                 = "/temporal.server.api.matchingservice.v1.MatchingService/ReleaseConcurrencySlot"
 	MatchingService_CheckTaskQueueVersionMembership_FullMethodName        = "/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership"
 )
 
@@ -102,6 +105,9 @@ type MatchingServiceClient interface {
 	DescribeTaskQueue(ctx context.Context, in *DescribeTaskQueueRequest, opts ...grpc.CallOption) (*DescribeTaskQueueResponse, error)
 	// DescribeTaskQueuePartition returns information about the target task queue partition.
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
+	// GetTaskQueueStats returns backlog, rate and poller stats of a task queue aggregated across all
+	// of its partitions. The root partition caches the aggregated stats for a short time.
+	GetTaskQueueStats(ctx context.Context, in *GetTaskQueueStatsRequest, opts ...grpc.CallOption) (*GetTaskQueueStatsResponse, error)
 	// DescribeVersionedTaskQueues returns details about the requested versioned task queues.
 	// It is an internal API; there is no direct user-facing equivalent.
 	DescribeVersionedTaskQueues(ctx context.Context, in *DescribeVersionedTaskQueuesRequest, opts ...grpc.CallOption) (*DescribeVersionedTaskQueuesResponse, error)
@@ -226,17 +232,28 @@ type MatchingServiceClient interface {
 	// UpdateFairnessState changes the fairness_state stored in UserData for automatically enabling
 	// priority and fairness.
 	// (-- api-linter: core::0134::method-signature=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
-	//
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	// (-- api-linter: core::0134::response-message-name=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
-	//
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	// (-- api-linter: core::0134::request-resource-required=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	UpdateFairnessState(ctx context.Context, in *UpdateFairnessStateRequest, opts ...grpc.CallOption) (*UpdateFairnessStateResponse, error)
+	// UpdateTaskQueuePartitionCounts stores the partition counts chosen by the partition auto-scaler of a
+	// task queue root partition in the task queue user data.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	UpdateTaskQueuePartitionCounts(ctx context.Context, in *UpdateTaskQueuePartitionCountsRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePartitionCountsResponse, error)
+	// ReleaseConcurrencySlot is called by history when an activity attempt that was dispatched under a task
+	// queue concurrency limit ends, to free its slot in the partition that dispatched it.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0136::response-message-name=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(ctx context.Context, in *CheckTaskQueueVersionMembershipRequest, opts ...grpc.CallOption) (*CheckTaskQueueVersionMembershipResponse, error)
 }
@@ -366,6 +383,15 @@ func (c *matchingServiceClient) DescribeTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *matchingServiceClient) GetTaskQueueStats(ctx context.Context, in *GetTaskQueueStatsRequest, opts ...grpc.CallOption) (*GetTaskQueueStatsResponse, error) {
+	out := new(GetTaskQueueStatsResponse)
+	err := c.cc.Invoke(ctx, MatchingService_GetTaskQueueStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) DescribeVersionedTaskQueues(ctx context.Context, in *DescribeVersionedTaskQueuesRequest, opts ...grpc.CallOption) (*DescribeVersionedTaskQueuesResponse, error) {
 	out := new(DescribeVersionedTaskQueuesResponse)
 	err := c.cc.Invoke(ctx, MatchingService_DescribeVersionedTaskQueues_FullMethodName, in, out, opts...)
@@ -591,6 +617,24 @@ func (c *matchingServiceClient) UpdateFairnessState(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateTaskQueuePartitionCounts(ctx context.Context, in *UpdateTaskQueuePartitionCountsRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePartitionCountsResponse, error) {
+	out := new(UpdateTaskQueuePartitionCountsResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueuePartitionCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReleaseConcurrencySlot(ctx context.Context, in *ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*ReleaseConcurrencySlotResponse, error) {
+	out := new(ReleaseConcurrencySlotResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ReleaseConcurrencySlot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) CheckTaskQueueVersionMembership(ctx context.Context, in *CheckTaskQueueVersionMembershipRequest, opts ...grpc.CallOption) (*CheckTaskQueueVersionMembershipResponse, error) {
 	out := new(CheckTaskQueueVersionMembershipResponse)
 	err := c.cc.Invoke(ctx, MatchingService_CheckTaskQueueVersionMembership_FullMethodName, in, out, opts...)
@@ -641,6 +685,9 @@ type MatchingServiceServer interface {
 	DescribeTaskQueue(context.Context, *DescribeTaskQueueRequest) (*DescribeTaskQueueResponse, error)
 	// DescribeTaskQueuePartition returns information about the target task queue partition.
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
+	// GetTaskQueueStats returns backlog, rate and poller stats of a task queue aggregated across all
+	// of its partitions. The root partition caches the aggregated stats for a short time.
+	GetTaskQueueStats(context.Context, *GetTaskQueueStatsRequest) (*GetTaskQueueStatsResponse, error)
 	// DescribeVersionedTaskQueues returns details about the requested versioned task queues.
 	// It is an internal API; there is no direct user-facing equivalent.
 	DescribeVersionedTaskQueues(context.Context, *DescribeVersionedTaskQueuesRequest) (*DescribeVersionedTaskQueuesResponse, error)
@@ -765,17 +812,28 @@ type MatchingServiceServer interface {
 	// UpdateFairnessState changes the fairness_state stored in UserData for automatically enabling
 	// priority and fairness.
 	// (-- api-linter: core::0134::method-signature=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
-	//
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	// (-- api-linter: core::0134::response-message-name=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
-	//
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	// (-- api-linter: core::0134::request-resource-required=disabled
-	//
-	//	aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
+	//     aip.dev/not-precedent: UpdateFairnessState RPC doesn't follow Google API format. --)
 	UpdateFairnessState(context.Context, *UpdateFairnessStateRequest) (*UpdateFairnessStateResponse, error)
+	// UpdateTaskQueuePartitionCounts stores the partition counts chosen by the partition auto-scaler of a
+	// task queue root partition in the task queue user data.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//     aip.dev/not-precedent: UpdateTaskQueuePartitionCounts RPC doesn't follow Google API format. --)
+	UpdateTaskQueuePartitionCounts(context.Context, *UpdateTaskQueuePartitionCountsRequest) (*UpdateTaskQueuePartitionCountsResponse, error)
+	// ReleaseConcurrencySlot is called by history when an activity attempt that was dispatched under a task
+	// queue concurrency limit ends, to free its slot in the partition that dispatched it.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	// (-- api-linter: core::0136::response-message-name=disabled
+	//     aip.dev/not-precedent: ReleaseConcurrencySlot RPC doesn't follow Google API format. --)
+	ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error)
 	// CheckTaskQueueVersionMembership checks if a task queue is part of a specific deployment version.
 	CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
@@ -824,6 +882,9 @@ func (UnimplementedMatchingServiceServer) DescribeTaskQueue(context.Context, *De
 func (UnimplementedMatchingServiceServer) DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueuePartition not implemented")
 }
+func (UnimplementedMatchingServiceServer) GetTaskQueueStats(context.Context, *GetTaskQueueStatsRequest) (*GetTaskQueueStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueStats not implemented")
+}
 func (UnimplementedMatchingServiceServer) DescribeVersionedTaskQueues(context.Context, *DescribeVersionedTaskQueuesRequest) (*DescribeVersionedTaskQueuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeVersionedTaskQueues not implemented")
 }
@@ -899,6 +960,12 @@ func (UnimplementedMatchingServiceServer) DescribeWorker(context.Context, *Descr
 func (UnimplementedMatchingServiceServer) UpdateFairnessState(context.Context, *UpdateFairnessStateRequest) (*UpdateFairnessStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFairnessState not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateTaskQueuePartitionCounts(context.Context, *UpdateTaskQueuePartitionCountsRequest) (*UpdateTaskQueuePartitionCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueuePartitionCounts not implemented")
+}
+func (UnimplementedMatchingServiceServer) ReleaseConcurrencySlot(context.Context, *ReleaseConcurrencySlotRequest) (*ReleaseConcurrencySlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseConcurrencySlot not implemented")
+}
 func (UnimplementedMatchingServiceServer) CheckTaskQueueVersionMembership(context.Context, *CheckTaskQueueVersionMembershipRequest) (*CheckTaskQueueVersionMembershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTaskQueueVersionMembership not implemented")
 }
@@ -1149,6 +1216,24 @@ func _MatchingService_DescribeTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_GetTaskQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskQueueStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetTaskQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_GetTaskQueueStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetTaskQueueStats(ctx, req.(*GetTaskQueueStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_DescribeVersionedTaskQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeVersionedTaskQueuesRequest)
 	if err := dec(in); err != nil {
@@ -1599,6 +1684,42 @@ func _MatchingService_UpdateFairnessState_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateTaskQueuePartitionCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueuePartitionCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePartitionCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueuePartitionCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePartitionCounts(ctx, req.(*UpdateTaskQueuePartitionCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReleaseConcurrencySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseConcurrencySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReleaseConcurrencySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ReleaseConcurrencySlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReleaseConcurrencySlot(ctx, req.(*ReleaseConcurrencySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_CheckTaskQueueVersionMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckTaskQueueVersionMembershipRequest)
 	if err := dec(in); err != nil {
@@ -1676,6 +1797,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeTaskQueuePartition",
 			Handler:    _MatchingService_DescribeTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "GetTaskQueueStats",
+			Handler:    _MatchingService_GetTaskQueueStats_Handler,
+		},
 		{
 			MethodName: "DescribeVersionedTaskQueues",
 			Handler:    _MatchingService_DescribeVersionedTaskQueues_Handler,
@@ -1776,6 +1901,14 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFairnessState",
 			Handler:    _MatchingService_UpdateFairnessState_Handler,
 		},
+		{
+			MethodName: "UpdateTaskQueuePartitionCounts",
+			Handler:    _MatchingService_UpdateTaskQueuePartitionCounts_Handler,
+		},
+		{
+			MethodName: "ReleaseConcurrencySlot",
+			Handler:    _MatchingService_ReleaseConcurrencySlot_Handler,
+		},
 		{
 			MethodName: "CheckTaskQueueVersionMembership",
 			Handler:    _MatchingService_CheckTaskQueueVersionMembership_Handler,
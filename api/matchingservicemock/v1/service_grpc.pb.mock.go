@@ -382,6 +382,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetBuildIdTaskQueueMapping(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetBuildIdTaskQueueMapping), varargs...)
 }
 
+// GetTaskQueueStats mocks base method.
+func (m *MockMatchingServiceClient) GetTaskQueueStats(ctx context.Context, in *matchingservice.GetTaskQueueStatsRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskQueueStats", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetTaskQueueStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueStats indicates an expected call of GetTaskQueueStats.
+func (mr *MockMatchingServiceClientMockRecorder) GetTaskQueueStats(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueStats", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetTaskQueueStats), varargs...)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) GetTaskQueueUserData(ctx context.Context, in *matchingservice.GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -602,6 +622,26 @@ func (mr *MockMatchingServiceClientMockRecorder) RecordWorkerHeartbeat(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceClient)(nil).RecordWorkerHeartbeat), varargs...)
 }
 
+// ReleaseConcurrencySlot mocks base method.
+func (m *MockMatchingServiceClient) ReleaseConcurrencySlot(ctx context.Context, in *matchingservice.ReleaseConcurrencySlotRequest, opts ...grpc.CallOption) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseConcurrencySlot", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReleaseConcurrencySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencySlot indicates an expected call of ReleaseConcurrencySlot.
+func (mr *MockMatchingServiceClientMockRecorder) ReleaseConcurrencySlot(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencySlot", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReleaseConcurrencySlot), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -762,6 +802,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
 }
 
+// UpdateTaskQueuePartitionCounts mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueuePartitionCounts(ctx context.Context, in *matchingservice.UpdateTaskQueuePartitionCountsRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePartitionCounts", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePartitionCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePartitionCounts indicates an expected call of UpdateTaskQueuePartitionCounts.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueuePartitionCounts(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePartitionCounts", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueuePartitionCounts), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1101,6 +1161,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetBuildIdTaskQueueMapping(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetBuildIdTaskQueueMapping), arg0, arg1)
 }
 
+// GetTaskQueueStats mocks base method.
+func (m *MockMatchingServiceServer) GetTaskQueueStats(arg0 context.Context, arg1 *matchingservice.GetTaskQueueStatsRequest) (*matchingservice.GetTaskQueueStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskQueueStats", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetTaskQueueStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskQueueStats indicates an expected call of GetTaskQueueStats.
+func (mr *MockMatchingServiceServerMockRecorder) GetTaskQueueStats(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueStats", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetTaskQueueStats), arg0, arg1)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) GetTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.GetTaskQueueUserDataRequest) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1266,6 +1341,21 @@ func (mr *MockMatchingServiceServerMockRecorder) RecordWorkerHeartbeat(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceServer)(nil).RecordWorkerHeartbeat), arg0, arg1)
 }
 
+// ReleaseConcurrencySlot mocks base method.
+func (m *MockMatchingServiceServer) ReleaseConcurrencySlot(arg0 context.Context, arg1 *matchingservice.ReleaseConcurrencySlotRequest) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseConcurrencySlot", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReleaseConcurrencySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencySlot indicates an expected call of ReleaseConcurrencySlot.
+func (mr *MockMatchingServiceServerMockRecorder) ReleaseConcurrencySlot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencySlot", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReleaseConcurrencySlot), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1386,6 +1476,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
 }
 
+// UpdateTaskQueuePartitionCounts mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueuePartitionCounts(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueuePartitionCountsRequest) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePartitionCounts", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePartitionCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePartitionCounts indicates an expected call of UpdateTaskQueuePartitionCounts.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueuePartitionCounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePartitionCounts", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueuePartitionCounts), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
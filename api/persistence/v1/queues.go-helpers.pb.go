@@ -264,6 +264,80 @@ func (this *HistoryTask) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type HistoryDLQTaskState to the protobuf v3 wire format
+func (val *HistoryDLQTaskState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryDLQTaskState from the protobuf v3 wire format
+func (val *HistoryDLQTaskState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryDLQTaskState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryDLQTaskState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryDLQTaskState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryDLQTaskState
+	switch t := that.(type) {
+	case *HistoryDLQTaskState:
+		that1 = t
+	case HistoryDLQTaskState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryDLQTaskAttempt to the protobuf v3 wire format
+func (val *HistoryDLQTaskAttempt) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryDLQTaskAttempt from the protobuf v3 wire format
+func (val *HistoryDLQTaskAttempt) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryDLQTaskAttempt) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryDLQTaskAttempt values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryDLQTaskAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryDLQTaskAttempt
+	switch t := that.(type) {
+	case *HistoryDLQTaskAttempt:
+		that1 = t
+	case HistoryDLQTaskAttempt:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type QueuePartition to the protobuf v3 wire format
 func (val *QueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -14,6 +14,7 @@ import (
 	v1 "go.temporal.io/api/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -323,7 +324,10 @@ type HistoryTask struct {
 	// blob that contains the history task proto. There is a GoLang-specific generic deserializer for this blob, but
 	// there is no common proto for all task proto types, so deserializing in other languages will require a custom
 	// switch on the task category, which should be available from the metadata for the queue that this task came from.
-	Blob          *v1.DataBlob `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Blob *v1.DataBlob `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	// dlq_state is only set for tasks in a DLQ or in its re-drive log. It is used to automatically re-drive the task
+	// according to the DLQ re-drive policy of its category and namespace.
+	DlqState      *HistoryDLQTaskState `protobuf:"bytes,3,opt,name=dlq_state,json=dlqState,proto3" json:"dlq_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -372,6 +376,133 @@ func (x *HistoryTask) GetBlob() *v1.DataBlob {
 	return nil
 }
 
+func (x *HistoryTask) GetDlqState() *HistoryDLQTaskState {
+	if x != nil {
+		return x.DlqState
+	}
+	return nil
+}
+
+// HistoryDLQTaskState is the re-drive state of a task in a history task DLQ.
+type HistoryDLQTaskState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attempts contains one entry for each time the task was sent to the DLQ, oldest first. The number of times the
+	// task was re-driven is one less than the number of attempts.
+	Attempts []*HistoryDLQTaskAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// redrive_time is when the task was re-driven. It is only set in the re-drive log of a DLQ, which keeps the
+	// attempts of re-driven tasks so that the DLQ message of a re-driven task that fails again continues them.
+	RedriveTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=redrive_time,json=redriveTime,proto3" json:"redrive_time,omitempty"`
+	// parked is set when the task exhausted its re-drives. Parked tasks are never re-driven automatically.
+	Parked        bool `protobuf:"varint,3,opt,name=parked,proto3" json:"parked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryDLQTaskState) Reset() {
+	*x = HistoryDLQTaskState{}
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryDLQTaskState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryDLQTaskState) ProtoMessage() {}
+
+func (x *HistoryDLQTaskState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryDLQTaskState.ProtoReflect.Descriptor instead.
+func (*HistoryDLQTaskState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HistoryDLQTaskState) GetAttempts() []*HistoryDLQTaskAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *HistoryDLQTaskState) GetRedriveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RedriveTime
+	}
+	return nil
+}
+
+func (x *HistoryDLQTaskState) GetParked() bool {
+	if x != nil {
+		return x.Parked
+	}
+	return false
+}
+
+type HistoryDLQTaskAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enqueue_time is when the task was sent to the DLQ.
+	EnqueueTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=enqueue_time,json=enqueueTime,proto3" json:"enqueue_time,omitempty"`
+	// error that caused the task to be sent to the DLQ.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryDLQTaskAttempt) Reset() {
+	*x = HistoryDLQTaskAttempt{}
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryDLQTaskAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryDLQTaskAttempt) ProtoMessage() {}
+
+func (x *HistoryDLQTaskAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryDLQTaskAttempt.ProtoReflect.Descriptor instead.
+func (*HistoryDLQTaskAttempt) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HistoryDLQTaskAttempt) GetEnqueueTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EnqueueTime
+	}
+	return nil
+}
+
+func (x *HistoryDLQTaskAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type QueuePartition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// min_message_id is less than or equal to the id of every message in the queue. The min_message_id is mainly used to
@@ -392,7 +523,7 @@ type QueuePartition struct {
 
 func (x *QueuePartition) Reset() {
 	*x = QueuePartition{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +535,7 @@ func (x *QueuePartition) String() string {
 func (*QueuePartition) ProtoMessage() {}
 
 func (x *QueuePartition) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +548,7 @@ func (x *QueuePartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuePartition.ProtoReflect.Descriptor instead.
 func (*QueuePartition) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueuePartition) GetMinMessageId() int64 {
@@ -437,7 +568,7 @@ type Queue struct {
 
 func (x *Queue) Reset() {
 	*x = Queue{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +580,7 @@ func (x *Queue) String() string {
 func (*Queue) ProtoMessage() {}
 
 func (x *Queue) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +593,7 @@ func (x *Queue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Queue.ProtoReflect.Descriptor instead.
 func (*Queue) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Queue) GetPartitions() map[int32]*QueuePartition {
@@ -476,7 +607,7 @@ var File_temporal_server_api_persistence_v1_queues_proto protoreflect.FileDescri
 
 const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a$temporal/api/common/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
+	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
 	"\n" +
 	"QueueState\x12e\n" +
 	"\rreader_states\x18\x01 \x03(\v2@.temporal.server.api.persistence.v1.QueueState.ReaderStatesEntryR\freaderStates\x12r\n" +
@@ -495,10 +626,18 @@ const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\x1eReadQueueMessagesNextPageToken\x12/\n" +
 	"\x14last_read_message_id\x18\x01 \x01(\x03R\x11lastReadMessageId\"N\n" +
 	"\x17ListQueuesNextPageToken\x123\n" +
-	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"^\n" +
+	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"\xb4\x01\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
-	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"6\n" +
+	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\x12T\n" +
+	"\tdlq_state\x18\x03 \x01(\v27.temporal.server.api.persistence.v1.HistoryDLQTaskStateR\bdlqState\"\xc3\x01\n" +
+	"\x13HistoryDLQTaskState\x12U\n" +
+	"\battempts\x18\x01 \x03(\v29.temporal.server.api.persistence.v1.HistoryDLQTaskAttemptR\battempts\x12=\n" +
+	"\fredrive_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vredriveTime\x12\x16\n" +
+	"\x06parked\x18\x03 \x01(\bR\x06parked\"l\n" +
+	"\x15HistoryDLQTaskAttempt\x12=\n" +
+	"\fenqueue_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\venqueueTime\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"6\n" +
 	"\x0eQueuePartition\x12$\n" +
 	"\x0emin_message_id\x18\x01 \x01(\x03R\fminMessageId\"\xd5\x01\n" +
 	"\x05Queue\x12Y\n" +
@@ -521,7 +660,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_queues_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*QueueState)(nil),                     // 0: temporal.server.api.persistence.v1.QueueState
 	(*QueueReaderState)(nil),               // 1: temporal.server.api.persistence.v1.QueueReaderState
@@ -530,31 +669,38 @@ var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*ReadQueueMessagesNextPageToken)(nil), // 4: temporal.server.api.persistence.v1.ReadQueueMessagesNextPageToken
 	(*ListQueuesNextPageToken)(nil),        // 5: temporal.server.api.persistence.v1.ListQueuesNextPageToken
 	(*HistoryTask)(nil),                    // 6: temporal.server.api.persistence.v1.HistoryTask
-	(*QueuePartition)(nil),                 // 7: temporal.server.api.persistence.v1.QueuePartition
-	(*Queue)(nil),                          // 8: temporal.server.api.persistence.v1.Queue
-	nil,                                    // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	nil,                                    // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	(*TaskKey)(nil),                        // 11: temporal.server.api.persistence.v1.TaskKey
-	(*Predicate)(nil),                      // 12: temporal.server.api.persistence.v1.Predicate
-	(*v1.DataBlob)(nil),                    // 13: temporal.api.common.v1.DataBlob
+	(*HistoryDLQTaskState)(nil),            // 7: temporal.server.api.persistence.v1.HistoryDLQTaskState
+	(*HistoryDLQTaskAttempt)(nil),          // 8: temporal.server.api.persistence.v1.HistoryDLQTaskAttempt
+	(*QueuePartition)(nil),                 // 9: temporal.server.api.persistence.v1.QueuePartition
+	(*Queue)(nil),                          // 10: temporal.server.api.persistence.v1.Queue
+	nil,                                    // 11: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	nil,                                    // 12: temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	(*TaskKey)(nil),                        // 13: temporal.server.api.persistence.v1.TaskKey
+	(*Predicate)(nil),                      // 14: temporal.server.api.persistence.v1.Predicate
+	(*v1.DataBlob)(nil),                    // 15: temporal.api.common.v1.DataBlob
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
 }
 var file_temporal_server_api_persistence_v1_queues_proto_depIdxs = []int32{
-	9,  // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	11, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
+	11, // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	13, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
 	2,  // 2: temporal.server.api.persistence.v1.QueueReaderState.scopes:type_name -> temporal.server.api.persistence.v1.QueueSliceScope
 	3,  // 3: temporal.server.api.persistence.v1.QueueSliceScope.range:type_name -> temporal.server.api.persistence.v1.QueueSliceRange
-	12, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
-	11, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
-	11, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
-	13, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
-	10, // 8: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	1,  // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
-	7,  // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	14, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
+	13, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
+	13, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
+	15, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	7,  // 8: temporal.server.api.persistence.v1.HistoryTask.dlq_state:type_name -> temporal.server.api.persistence.v1.HistoryDLQTaskState
+	8,  // 9: temporal.server.api.persistence.v1.HistoryDLQTaskState.attempts:type_name -> temporal.server.api.persistence.v1.HistoryDLQTaskAttempt
+	16, // 10: temporal.server.api.persistence.v1.HistoryDLQTaskState.redrive_time:type_name -> google.protobuf.Timestamp
+	16, // 11: temporal.server.api.persistence.v1.HistoryDLQTaskAttempt.enqueue_time:type_name -> google.protobuf.Timestamp
+	12, // 12: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	1,  // 13: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
+	9,  // 14: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_queues_proto_init() }
@@ -570,7 +716,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_queues_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -301,6 +301,117 @@ func (this *TaskQueueTypeUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueueBacklogPurge to the protobuf v3 wire format
+func (val *TaskQueueBacklogPurge) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueBacklogPurge from the protobuf v3 wire format
+func (val *TaskQueueBacklogPurge) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueBacklogPurge) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueBacklogPurge values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueBacklogPurge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueBacklogPurge
+	switch t := that.(type) {
+	case *TaskQueueBacklogPurge:
+		that1 = t
+	case TaskQueueBacklogPurge:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueueConcurrencyLimit to the protobuf v3 wire format
+func (val *TaskQueueConcurrencyLimit) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueConcurrencyLimit from the protobuf v3 wire format
+func (val *TaskQueueConcurrencyLimit) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueConcurrencyLimit) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueConcurrencyLimit values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueConcurrencyLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueConcurrencyLimit
+	switch t := that.(type) {
+	case *TaskQueueConcurrencyLimit:
+		that1 = t
+	case TaskQueueConcurrencyLimit:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePartitionCounts to the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePartitionCounts from the protobuf v3 wire format
+func (val *TaskQueuePartitionCounts) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePartitionCounts) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePartitionCounts values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePartitionCounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePartitionCounts
+	switch t := that.(type) {
+	case *TaskQueuePartitionCounts:
+		that1 = t
+	case TaskQueuePartitionCounts:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueUserData to the protobuf v3 wire format
 func (val *TaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -104,7 +104,11 @@ type TaskInfo struct {
 	Stamp    int32         `protobuf:"varint,9,opt,name=stamp,proto3" json:"stamp,omitempty"`
 	Priority *v12.Priority `protobuf:"bytes,10,opt,name=priority,proto3" json:"priority,omitempty"`
 	// Reference to any chasm component associated with this task
-	ComponentRef  []byte `protobuf:"bytes,11,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
+	ComponentRef []byte `protobuf:"bytes,11,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
+	// Worker labels a poller must have to receive this task. Sorted and de-duplicated.
+	RequiredLabels []string `protobuf:"bytes,12,rep,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty"`
+	// Tasks with the same affinity key are preferably dispatched to the same worker.
+	AffinityKey   string `protobuf:"bytes,13,opt,name=affinity_key,json=affinityKey,proto3" json:"affinity_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -216,6 +220,20 @@ func (x *TaskInfo) GetComponentRef() []byte {
 	return nil
 }
 
+func (x *TaskInfo) GetRequiredLabels() []string {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
+func (x *TaskInfo) GetAffinityKey() string {
+	if x != nil {
+		return x.AffinityKey
+	}
+	return ""
+}
+
 // task_queue column
 type TaskQueueInfo struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -440,9 +458,13 @@ func (x *SubqueueInfo) GetFairMaxReadLevel() *v11.FairLevel {
 type SubqueueKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Each subqueue contains tasks from only one priority level.
-	Priority      int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Priority int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Each subqueue also contains tasks with only one set of required labels (sorted and
+	// de-duplicated), unless the queue has too many distinct label sets, in which case the
+	// overflow shares the subqueue with no required labels.
+	RequiredLabels []string `protobuf:"bytes,2,rep,name=required_labels,json=requiredLabels,proto3" json:"required_labels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SubqueueKey) Reset() {
@@ -482,6 +504,13 @@ func (x *SubqueueKey) GetPriority() int32 {
 	return 0
 }
 
+func (x *SubqueueKey) GetRequiredLabels() []string {
+	if x != nil {
+		return x.RequiredLabels
+	}
+	return nil
+}
+
 type TaskKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FireTime      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=fire_time,json=fireTime,proto3" json:"fire_time,omitempty"`
@@ -542,7 +571,7 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"\x11AllocatedTaskInfo\x12@\n" +
 	"\x04data\x18\x01 \x01(\v2,.temporal.server.api.persistence.v1.TaskInfoR\x04data\x12\x1b\n" +
 	"\ttask_pass\x18\x03 \x01(\x03R\btaskPass\x12\x17\n" +
-	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xac\x04\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\"\xf8\x04\n" +
 	"\bTaskInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -558,7 +587,9 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"\x05stamp\x18\t \x01(\x05R\x05stamp\x12<\n" +
 	"\bpriority\x18\n" +
 	" \x01(\v2 .temporal.api.common.v1.PriorityR\bpriority\x12#\n" +
-	"\rcomponent_ref\x18\v \x01(\fR\fcomponentRef\"\x97\x04\n" +
+	"\rcomponent_ref\x18\v \x01(\fR\fcomponentRef\x12'\n" +
+	"\x0frequired_labels\x18\f \x03(\tR\x0erequiredLabels\x12!\n" +
+	"\faffinity_key\x18\r \x01(\tR\vaffinityKey\"\x97\x04\n" +
 	"\rTaskQueueInfo\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
@@ -577,9 +608,10 @@ const file_temporal_server_api_persistence_v1_tasks_proto_rawDesc = "" +
 	"\tack_level\x18\x02 \x01(\x03R\backLevel\x12Q\n" +
 	"\x0efair_ack_level\x18\x04 \x01(\v2+.temporal.server.api.taskqueue.v1.FairLevelR\ffairAckLevel\x12:\n" +
 	"\x19approximate_backlog_count\x18\x03 \x01(\x03R\x17approximateBacklogCount\x12Z\n" +
-	"\x13fair_max_read_level\x18\x05 \x01(\v2+.temporal.server.api.taskqueue.v1.FairLevelR\x10fairMaxReadLevel\")\n" +
+	"\x13fair_max_read_level\x18\x05 \x01(\v2+.temporal.server.api.taskqueue.v1.FairLevelR\x10fairMaxReadLevel\"R\n" +
 	"\vSubqueueKey\x12\x1a\n" +
-	"\bpriority\x18\x01 \x01(\x05R\bpriority\"[\n" +
+	"\bpriority\x18\x01 \x01(\x05R\bpriority\x12'\n" +
+	"\x0frequired_labels\x18\x02 \x03(\tR\x0erequiredLabels\"[\n" +
 	"\aTaskKey\x127\n" +
 	"\tfire_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bfireTime\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\x03R\x06taskIdB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
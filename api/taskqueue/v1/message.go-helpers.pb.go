@@ -190,6 +190,80 @@ func (this *PhysicalTaskQueueInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type BacklogLimitStatus to the protobuf v3 wire format
+func (val *BacklogLimitStatus) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogLimitStatus from the protobuf v3 wire format
+func (val *BacklogLimitStatus) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogLimitStatus) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogLimitStatus values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogLimitStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogLimitStatus
+	switch t := that.(type) {
+	case *BacklogLimitStatus:
+		that1 = t
+	case BacklogLimitStatus:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueueStatsSnapshot to the protobuf v3 wire format
+func (val *TaskQueueStatsSnapshot) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueStatsSnapshot from the protobuf v3 wire format
+func (val *TaskQueueStatsSnapshot) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueStatsSnapshot) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueStatsSnapshot values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueStatsSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueStatsSnapshot
+	switch t := that.(type) {
+	case *TaskQueueStatsSnapshot:
+		that1 = t
+	case TaskQueueStatsSnapshot:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueuePartition to the protobuf v3 wire format
 func (val *TaskQueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
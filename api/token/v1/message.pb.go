@@ -258,9 +258,13 @@ type Task struct {
 	StartedTime      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=started_time,json=startedTime,proto3" json:"started_time,omitempty"`
 	StartVersion     int64                  `protobuf:"varint,13,opt,name=start_version,json=startVersion,proto3" json:"start_version,omitempty"`
 	// Reference to the associated Chasm component, if provided.
-	ComponentRef  []byte `protobuf:"bytes,14,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ComponentRef []byte `protobuf:"bytes,14,opt,name=component_ref,json=componentRef,proto3" json:"component_ref,omitempty"`
+	// Shared task queue the activity task was dispatched from, when the task queue of the activity
+	// is bound to a shared task queue of another namespace. Workers of the shared task queue complete
+	// the task with requests of the shared namespace.
+	SharedTaskQueue string `protobuf:"bytes,15,opt,name=shared_task_queue,json=sharedTaskQueue,proto3" json:"shared_task_queue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -391,6 +395,13 @@ func (x *Task) GetComponentRef() []byte {
 	return nil
 }
 
+func (x *Task) GetSharedTaskQueue() string {
+	if x != nil {
+		return x.SharedTaskQueue
+	}
+	return ""
+}
+
 type QueryTask struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -688,7 +699,7 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"endEventId\x12*\n" +
 	"\x11end_event_version\x18\a \x01(\x03R\x0fendEventVersion\x12+\n" +
 	"\x11persistence_token\x18\b \x01(\fR\x10persistenceToken\x12]\n" +
-	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\xa2\x04\n" +
+	"\x11version_histories\x18\t \x01(\v20.temporal.server.api.history.v1.VersionHistoriesR\x10versionHistoriesJ\x04\b\x01\x10\x02\"\xce\x04\n" +
 	"\x04Task\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vworkflow_id\x18\x02 \x01(\tR\n" +
@@ -706,7 +717,8 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\aversion\x18\v \x01(\x03R\aversion\x12=\n" +
 	"\fstarted_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vstartedTime\x12#\n" +
 	"\rstart_version\x18\r \x01(\x03R\fstartVersion\x12#\n" +
-	"\rcomponent_ref\x18\x0e \x01(\fR\fcomponentRef\"f\n" +
+	"\rcomponent_ref\x18\x0e \x01(\fR\fcomponentRef\x12*\n" +
+	"\x11shared_task_queue\x18\x0f \x01(\tR\x0fsharedTaskQueue\"f\n" +
 	"\tQueryTask\x12!\n" +
 	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1d\n" +
 	"\n" +
@@ -58,3 +58,12 @@ func (c *clientImpl) StreamWorkflowReplicationMessages(
 	// do not use createContext function, let caller manage stream API lifecycle
 	return c.client.StreamWorkflowReplicationMessages(ctx, opts...)
 }
+
+func (c *clientImpl) StreamTaskQueueStats(
+	ctx context.Context,
+	request *adminservice.StreamTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamTaskQueueStatsClient, error) {
+	// do not use createContext function, let caller manage stream API lifecycle
+	return c.client.StreamTaskQueueStats(ctx, request, opts...)
+}
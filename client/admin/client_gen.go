@@ -129,6 +129,16 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityConsistencyCheckResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityConsistencyCheck(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -309,6 +319,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -359,6 +379,16 @@ func (c *clientImpl) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PurgeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueBacklogResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PurgeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -429,6 +459,36 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ReshardHistory(
+	ctx context.Context,
+	request *adminservice.ReshardHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReshardHistoryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReshardHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) RunQueueAction(
+	ctx context.Context,
+	request *adminservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RunQueueActionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RunQueueAction(ctx, request, opts...)
+}
+
 func (c *clientImpl) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -439,6 +499,16 @@ func (c *clientImpl) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *clientImpl) StartVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityConsistencyCheckResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityConsistencyCheck(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -448,3 +518,13 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateTaskQueueConcurrencyLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueConcurrencyLimitResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskQueueConcurrencyLimit(ctx, request, opts...)
+}
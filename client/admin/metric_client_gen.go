@@ -177,6 +177,20 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityConsistencyCheckResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityConsistencyCheck")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityConsistencyCheck(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -429,6 +443,20 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListTaskQueueBacklog")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -499,6 +527,20 @@ func (c *metricClient) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PurgeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PurgeTaskQueueBacklogResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPurgeTaskQueueBacklog")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PurgeTaskQueueBacklog(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -597,6 +639,48 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ReshardHistory(
+	ctx context.Context,
+	request *adminservice.ReshardHistoryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ReshardHistoryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientReshardHistory")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReshardHistory(ctx, request, opts...)
+}
+
+func (c *metricClient) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RestoreArchivedWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRestoreArchivedWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) RunQueueAction(
+	ctx context.Context,
+	request *adminservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RunQueueActionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRunQueueAction")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RunQueueAction(ctx, request, opts...)
+}
+
 func (c *metricClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -611,6 +695,34 @@ func (c *metricClient) StartAdminBatchOperation(
 	return c.client.StartAdminBatchOperation(ctx, request, opts...)
 }
 
+func (c *metricClient) StartVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityConsistencyCheckResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityConsistencyCheck")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityConsistencyCheck(ctx, request, opts...)
+}
+
+func (c *metricClient) StreamTaskQueueStats(
+	ctx context.Context,
+	request *adminservice.StreamTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (_ adminservice.AdminService_StreamTaskQueueStatsClient, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStreamTaskQueueStats")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StreamTaskQueueStats(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -624,3 +736,17 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateTaskQueueConcurrencyLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskQueueConcurrencyLimitResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskQueueConcurrencyLimit")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueConcurrencyLimit(ctx, request, opts...)
+}
@@ -191,6 +191,21 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityConsistencyCheckResponse, error) {
+	var resp *adminservice.DescribeVisibilityConsistencyCheckResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityConsistencyCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -461,6 +476,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	var resp *adminservice.ListTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -536,6 +566,21 @@ func (c *retryableClient) PurgeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PurgeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueBacklogRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PurgeTaskQueueBacklogResponse, error) {
+	var resp *adminservice.PurgeTaskQueueBacklogResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PurgeTaskQueueBacklog(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -641,6 +686,51 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ReshardHistory(
+	ctx context.Context,
+	request *adminservice.ReshardHistoryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ReshardHistoryResponse, error) {
+	var resp *adminservice.ReshardHistoryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReshardHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RestoreArchivedWorkflowExecutionResponse, error) {
+	var resp *adminservice.RestoreArchivedWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RestoreArchivedWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RunQueueAction(
+	ctx context.Context,
+	request *adminservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RunQueueActionResponse, error) {
+	var resp *adminservice.RunQueueActionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RunQueueAction(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) StartAdminBatchOperation(
 	ctx context.Context,
 	request *adminservice.StartAdminBatchOperationRequest,
@@ -656,6 +746,36 @@ func (c *retryableClient) StartAdminBatchOperation(
 	return resp, err
 }
 
+func (c *retryableClient) StartVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityConsistencyCheckRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityConsistencyCheckResponse, error) {
+	var resp *adminservice.StartVisibilityConsistencyCheckResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityConsistencyCheck(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StreamTaskQueueStats(
+	ctx context.Context,
+	request *adminservice.StreamTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamTaskQueueStatsClient, error) {
+	var resp adminservice.AdminService_StreamTaskQueueStatsClient
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StreamTaskQueueStats(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -670,3 +790,18 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateTaskQueueConcurrencyLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueConcurrencyLimitResponse, error) {
+	var resp *adminservice.UpdateTaskQueueConcurrencyLimitResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueConcurrencyLimit(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -1187,6 +1187,26 @@ func (c *clientImpl) RespondWorkflowTaskFailed(
 	return response, nil
 }
 
+func (c *clientImpl) RunQueueAction(
+	ctx context.Context,
+	request *historyservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RunQueueActionResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.RunQueueActionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RunQueueAction(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
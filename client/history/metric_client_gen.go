@@ -821,6 +821,20 @@ func (c *metricClient) RespondWorkflowTaskFailed(
 	return c.client.RespondWorkflowTaskFailed(ctx, request, opts...)
 }
 
+func (c *metricClient) RunQueueAction(
+	ctx context.Context,
+	request *historyservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RunQueueActionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRunQueueAction")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RunQueueAction(ctx, request, opts...)
+}
+
 func (c *metricClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
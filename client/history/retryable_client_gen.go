@@ -881,6 +881,21 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 	return resp, err
 }
 
+func (c *retryableClient) RunQueueAction(
+	ctx context.Context,
+	request *historyservice.RunQueueActionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RunQueueActionResponse, error) {
+	var resp *historyservice.RunQueueActionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RunQueueAction(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
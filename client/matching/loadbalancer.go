@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"sync"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/testing/testhooks"
@@ -31,6 +32,14 @@ type (
 		PickReadPartition(
 			taskQueue *tqid.TaskQueue,
 		) *pollToken
+
+		// UpdatePartitionCounts records the partition counts returned by matching for a task queue
+		// that uses partition auto-scaling. Subsequent picks use them instead of dynamic config.
+		// A nil value reverts to dynamic config.
+		UpdatePartitionCounts(
+			taskQueue *tqid.TaskQueue,
+			counts *persistencespb.TaskQueuePartitionCounts,
+		)
 	}
 
 	defaultLoadBalancer struct {
@@ -47,7 +56,10 @@ type (
 	tqLoadBalancer struct {
 		taskQueue    *tqid.TaskQueue
 		pollerCounts []int // keep track of poller count of each partition
-		lock         sync.Mutex
+		// partition counts last returned by matching, zero if unknown
+		readPartitions  int
+		writePartitions int
+		lock            sync.Mutex
 	}
 
 	pollToken struct {
@@ -85,7 +97,11 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 		return taskQueue.RootPartition()
 	}
 
-	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	n := lb.getTaskQueueLoadBalancer(taskQueue).getWritePartitions()
+	if n == 0 {
+		n = lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType())
+	}
+	n = max(1, n)
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -100,8 +116,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	// map namespace ID to name.
 	var partitionCount = dynamicconfig.GlobalDefaultNumTaskQueuePartitions
 
-	namespaceName, err := lb.namespaceIDToName(namespace.ID(taskQueue.NamespaceId()))
-	if err == nil {
+	if n := tqlb.getReadPartitions(); n > 0 {
+		partitionCount = n
+	} else if namespaceName, err := lb.namespaceIDToName(namespace.ID(taskQueue.NamespaceId())); err == nil {
 		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
 	}
 
@@ -112,6 +129,17 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	return tqlb.pickReadPartition(partitionCount)
 }
 
+func (lb *defaultLoadBalancer) UpdatePartitionCounts(
+	taskQueue *tqid.TaskQueue,
+	counts *persistencespb.TaskQueuePartitionCounts,
+) {
+	tqlb := lb.getTaskQueueLoadBalancer(taskQueue)
+	tqlb.lock.Lock()
+	defer tqlb.lock.Unlock()
+	tqlb.writePartitions = int(counts.GetWritePartitions())
+	tqlb.readPartitions = int(max(counts.GetReadPartitions(), counts.GetWritePartitions()))
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -136,6 +164,18 @@ func newTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	}
 }
 
+func (b *tqLoadBalancer) getReadPartitions() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.readPartitions
+}
+
+func (b *tqLoadBalancer) getWritePartitions() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.writePartitions
+}
+
 func (b *tqLoadBalancer) pickReadPartition(partitionCount int) *pollToken {
 	b.lock.Lock()
 	defer b.lock.Unlock()
@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
 
@@ -154,6 +157,46 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancer_PartitionCountsHint(t *testing.T) {
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) {
+			return "fake-namespace", nil
+		},
+		nReadPartitions:  dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(1),
+		nWritePartitions: dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(1),
+		taskQueueLBs:     make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	tq := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	// without a hint, dynamic config is used
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, 0, lb.PickWritePartition(tq).PartitionId())
+		p := lb.PickReadPartition(tq)
+		assert.Equal(t, 0, p.TQPartition.PartitionId())
+		p.Release()
+	}
+
+	// read partitions cover write partitions while a scale-down is in progress
+	lb.UpdatePartitionCounts(tq, &persistencespb.TaskQueuePartitionCounts{WritePartitions: 2, ReadPartitions: 4})
+	seen := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		id := lb.PickWritePartition(tq).PartitionId()
+		assert.Less(t, id, 2)
+	}
+	for i := 0; i < 4; i++ {
+		seen[lb.PickReadPartition(tq).TQPartition.PartitionId()] = true
+	}
+	assert.Len(t, seen, 4)
+
+	// clearing the hint reverts to dynamic config
+	lb.UpdatePartitionCounts(tq, nil)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, 0, lb.PickWritePartition(tq).PartitionId())
+	}
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
@@ -219,6 +219,20 @@ func (c *metricClient) GetBuildIdTaskQueueMapping(
 	return c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
 }
 
+func (c *metricClient) GetTaskQueueStats(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetTaskQueueStatsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientGetTaskQueueStats")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetTaskQueueStats(ctx, request, opts...)
+}
+
 func (c *metricClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -331,6 +345,20 @@ func (c *metricClient) RecordWorkerHeartbeat(
 	return c.client.RecordWorkerHeartbeat(ctx, request, opts...)
 }
 
+func (c *metricClient) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReleaseConcurrencySlotResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientReleaseConcurrencySlot")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReleaseConcurrencySlot(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -443,6 +471,20 @@ func (c *metricClient) UpdateTaskQueueConfig(
 	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskQueuePartitionCounts(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePartitionCountsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateTaskQueuePartitionCountsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateTaskQueuePartitionCounts")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueuePartitionCounts(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -266,6 +266,21 @@ func (c *retryableClient) GetBuildIdTaskQueueMapping(
 	return resp, err
 }
 
+func (c *retryableClient) GetTaskQueueStats(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueStatsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetTaskQueueStatsResponse, error) {
+	var resp *matchingservice.GetTaskQueueStatsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskQueueStats(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -431,6 +446,21 @@ func (c *retryableClient) RecordWorkerHeartbeat(
 	return resp, err
 }
 
+func (c *retryableClient) ReleaseConcurrencySlot(
+	ctx context.Context,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	var resp *matchingservice.ReleaseConcurrencySlotResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReleaseConcurrencySlot(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -551,6 +581,21 @@ func (c *retryableClient) UpdateTaskQueueConfig(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueuePartitionCounts(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePartitionCountsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+	var resp *matchingservice.UpdateTaskQueuePartitionCountsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueuePartitionCounts(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
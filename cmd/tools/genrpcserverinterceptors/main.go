@@ -93,7 +93,8 @@ func getGrpcServerData(grpcServerT reflect.Type) grpcServerData {
 
 	for i := 0; i < grpcServerT.NumMethod(); i++ {
 		rpcT := grpcServerT.Method(i).Type
-		if rpcT.NumIn() < 2 {
+		// Streaming RPCs take a stream instead of a request and only return an error.
+		if rpcT.NumIn() < 2 || rpcT.NumOut() < 2 {
 			continue
 		}
 
@@ -87,6 +87,8 @@ var (
 		"client.history.StreamWorkflowReplicationMessages":          true,
 		"metricsClient.history.StreamWorkflowReplicationMessages":   true,
 		"retryableClient.history.StreamWorkflowReplicationMessages": true,
+		// the generated client would cancel the stream context when it returns.
+		"client.admin.StreamTaskQueueStats": true,
 
 		// these need to pick a partition. too complicated.
 		"client.matching.AddActivityTask":       true,
@@ -335,6 +337,7 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"GetWorkerVersioningRulesRequest",
 		"UpdateWorkerVersioningRulesRequest",
 		"UpdateFairnessStateRequest",
+		"UpdateTaskQueuePartitionCountsRequest",
 		"UpdateTaskQueueConfigRequest":
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_WORKFLOW"}
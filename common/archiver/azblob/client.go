@@ -0,0 +1,300 @@
+package azblob
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// apiVersion is the Blob service REST API version sent with every request.
+	apiVersion = "2021-08-06"
+	// listMaxResults is the page size used when listing blobs.
+	listMaxResults = 1000
+
+	defaultRequestTimeout = time.Minute
+	defaultConnectTimeout = 10 * time.Second
+)
+
+var (
+	// ErrContainerNotFound is returned when the container named in the URI does not exist.
+	ErrContainerNotFound = errors.New("azure blob container not found")
+	// ErrMissingAccount is returned when neither an account name nor an endpoint is configured.
+	ErrMissingAccount = errors.New("azure blob archiver requires accountName or endpoint")
+
+	errBlobNotFound = errors.New("azure blob not found")
+)
+
+type (
+	// Client is a minimal Azure Blob Storage client covering the operations needed by the archivers.
+	// It talks to the Blob service REST API directly and authenticates with a SAS token, which
+	// also makes it usable against Azurite and other Blob-compatible object stores.
+	Client interface {
+		Upload(ctx context.Context, container, blobName string, data []byte) error
+		Get(ctx context.Context, container, blobName string) ([]byte, error)
+		Exist(ctx context.Context, container, blobName string) (bool, error)
+		ContainerExist(ctx context.Context, container string) (bool, error)
+		List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error)
+	}
+
+	restClient struct {
+		httpClient *http.Client
+		endpoint   *url.URL
+		sasQuery   url.Values
+	}
+
+	// requestError is returned for non-2xx responses from the Blob service.
+	requestError struct {
+		StatusCode int
+		Code       string
+		Message    string
+	}
+
+	listBlobsResult struct {
+		XMLName    xml.Name `xml:"EnumerationResults"`
+		Blobs      []string `xml:"Blobs>Blob>Name"`
+		NextMarker string   `xml:"NextMarker"`
+	}
+
+	errorResponse struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+)
+
+// NewClient creates a new Client for the given config
+func NewClient(cfg *config.AzblobArchiver) (Client, error) {
+	if cfg == nil {
+		return nil, ErrMissingAccount
+	}
+	return newClient(cfg, newHTTPClient(cfg))
+}
+
+// newHTTPClient returns an HTTP client with the timeouts of the config, so that a blob service that stops responding
+// fails the request instead of blocking archival.
+func newHTTPClient(cfg *config.AzblobArchiver) *http.Client {
+	requestTimeout := cfg.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+	connectTimeout := cfg.ConnectTimeout
+	if connectTimeout <= 0 {
+		connectTimeout = defaultConnectTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = connectTimeout
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}
+}
+
+func newClient(cfg *config.AzblobArchiver, httpClient *http.Client) (Client, error) {
+	if cfg == nil {
+		return nil, ErrMissingAccount
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		if cfg.AccountName == "" {
+			return nil, ErrMissingAccount
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName)
+	}
+	endpointURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure blob endpoint: %w", err)
+	}
+	sasQuery, err := url.ParseQuery(strings.TrimPrefix(cfg.SASToken, "?"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure blob SAS token: %w", err)
+	}
+	return &restClient{
+		httpClient: httpClient,
+		endpoint:   endpointURL,
+		sasQuery:   sasQuery,
+	}, nil
+}
+
+// Upload writes data to a block blob, overwriting any existing blob with the same name
+func (c *restClient) Upload(ctx context.Context, container, blobName string, data []byte) error {
+	req, err := c.newRequest(ctx, http.MethodPut, container, blobName, nil, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Get reads a blob. It returns errBlobNotFound if the blob does not exist.
+func (c *restClient) Get(ctx context.Context, container, blobName string) ([]byte, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, container, blobName, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return io.ReadAll(resp.Body)
+}
+
+// Exist checks whether a blob exists
+func (c *restClient) Exist(ctx context.Context, container, blobName string) (bool, error) {
+	req, err := c.newRequest(ctx, http.MethodHead, container, blobName, nil, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		if errors.Is(err, errBlobNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, resp.Body.Close()
+}
+
+// ContainerExist checks whether a container exists
+func (c *restClient) ContainerExist(ctx context.Context, container string) (bool, error) {
+	query := url.Values{"restype": []string{"container"}}
+	req, err := c.newRequest(ctx, http.MethodHead, container, "", query, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		if errors.Is(err, ErrContainerNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, resp.Body.Close()
+}
+
+// List returns the names of the blobs whose name starts with prefix, in lexicographical order.
+// An empty marker starts from the beginning; the returned marker is empty once the listing is complete.
+func (c *restClient) List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error) {
+	query := url.Values{
+		"restype": []string{"container"},
+		"comp":    []string{"list"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if maxResults > 0 {
+		query.Set("maxresults", strconv.Itoa(maxResults))
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, container, "", query, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result listBlobsResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("failed to decode azure blob list response: %w", err)
+	}
+	return result.Blobs, result.NextMarker, nil
+}
+
+func (c *restClient) newRequest(
+	ctx context.Context,
+	method string,
+	container string,
+	blobName string,
+	query url.Values,
+	body io.Reader,
+) (*http.Request, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + container
+	if blobName != "" {
+		u.Path += "/" + blobName
+	}
+	values := url.Values{}
+	for k, v := range c.sasQuery {
+		values[k] = v
+	}
+	for k, v := range query {
+		values[k] = v
+	}
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-version", apiVersion)
+	return req, nil
+}
+
+func (c *restClient) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	reqErr := &requestError{StatusCode: resp.StatusCode, Code: resp.Header.Get("x-ms-error-code")}
+	var errResp errorResponse
+	if body, err := io.ReadAll(resp.Body); err == nil && len(body) > 0 {
+		if xml.Unmarshal(body, &errResp) == nil {
+			if reqErr.Code == "" {
+				reqErr.Code = errResp.Code
+			}
+			reqErr.Message = errResp.Message
+		}
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		switch reqErr.Code {
+		case "ContainerNotFound":
+			return nil, ErrContainerNotFound
+		case "", "BlobNotFound", "ResourceNotFound":
+			if req.URL.Query().Get("restype") == "container" {
+				return nil, ErrContainerNotFound
+			}
+			return nil, errBlobNotFound
+		}
+	}
+	return nil, reqErr
+}
+
+func (e *requestError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("azure blob request failed with status %d (%s): %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("azure blob request failed with status %d (%s)", e.StatusCode, e.Code)
+}
@@ -0,0 +1,251 @@
+package azblob
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	testContainer = "test-container"
+	testSASToken  = "sv=2021-08-06&sig=test-signature"
+)
+
+// fakeBlobService is an in-memory stand-in for the subset of the Blob service REST API used by Client.
+type fakeBlobService struct {
+	sync.Mutex
+	containers map[string]map[string][]byte
+	requests   int
+}
+
+func newFakeBlobService(t *testing.T, containers ...string) (*fakeBlobService, *httptest.Server) {
+	service := &fakeBlobService{containers: make(map[string]map[string][]byte)}
+	for _, container := range containers {
+		service.containers[container] = make(map[string][]byte)
+	}
+	server := httptest.NewServer(service)
+	t.Cleanup(server.Close)
+	return service, server
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) Client {
+	client, err := newClient(&config.AzblobArchiver{
+		Endpoint: server.URL + "/devstoreaccount1",
+		SASToken: "?" + testSASToken,
+	}, server.Client())
+	require.NoError(t, err)
+	return client
+}
+
+func (f *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	f.requests++
+
+	query := r.URL.Query()
+	if query.Get("sig") != "test-signature" || r.Header.Get("x-ms-version") != apiVersion {
+		writeError(w, r, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+	// path is /<account>/<container>[/<blob>]
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != "devstoreaccount1" {
+		writeError(w, r, http.StatusBadRequest, "InvalidUri")
+		return
+	}
+	blobs, ok := f.containers[parts[1]]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	if len(parts) == 2 {
+		switch {
+		case query.Get("restype") == "container" && r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusOK)
+		case query.Get("restype") == "container" && query.Get("comp") == "list" && r.Method == http.MethodGet:
+			f.list(w, blobs, query)
+		default:
+			writeError(w, r, http.StatusBadRequest, "UnsupportedHttpVerb")
+		}
+		return
+	}
+
+	name := parts[2]
+	switch r.Method {
+	case http.MethodPut:
+		if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			writeError(w, r, http.StatusBadRequest, "MissingRequiredHeader")
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet, http.MethodHead:
+		data, ok := blobs[name]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		writeError(w, r, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func (f *fakeBlobService) list(w http.ResponseWriter, blobs map[string][]byte, query map[string][]string) {
+	get := func(key string) string {
+		if v := query[key]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	var names []string
+	for name := range blobs {
+		if strings.HasPrefix(name, get("prefix")) && name >= get("marker") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	result := listBlobsResult{}
+	maxResults, err := strconv.Atoi(get("maxresults"))
+	if err != nil || maxResults <= 0 {
+		maxResults = 5000
+	}
+	if len(names) > maxResults {
+		result.NextMarker = names[maxResults]
+		names = names[:maxResults]
+	}
+	result.Blobs = names
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeBlobService) put(container, name string, data []byte) {
+	f.Lock()
+	defer f.Unlock()
+	f.containers[container][name] = data
+}
+
+func (f *fakeBlobService) blobNames(container string) []string {
+	f.Lock()
+	defer f.Unlock()
+	var names []string
+	for name := range f.containers[container] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_ = xml.NewEncoder(w).Encode(errorResponse{Code: code, Message: code})
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	_, server := newFakeBlobService(t, testContainer)
+	client := newTestClient(t, server)
+
+	exist, err := client.ContainerExist(ctx, testContainer)
+	require.NoError(t, err)
+	require.True(t, exist)
+	exist, err = client.ContainerExist(ctx, "missing")
+	require.NoError(t, err)
+	require.False(t, exist)
+
+	exist, err = client.Exist(ctx, testContainer, "a/b/1")
+	require.NoError(t, err)
+	require.False(t, exist)
+	_, err = client.Get(ctx, testContainer, "a/b/1")
+	require.ErrorIs(t, err, errBlobNotFound)
+
+	for _, name := range []string{"a/b/1", "a/b/2", "a/b/3", "a/c/1"} {
+		require.NoError(t, client.Upload(ctx, testContainer, name, []byte(name)))
+	}
+	exist, err = client.Exist(ctx, testContainer, "a/b/1")
+	require.NoError(t, err)
+	require.True(t, exist)
+	data, err := client.Get(ctx, testContainer, "a/b/2")
+	require.NoError(t, err)
+	require.Equal(t, "a/b/2", string(data))
+
+	names, marker, err := client.List(ctx, testContainer, "a/b/", "", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/b/1", "a/b/2"}, names)
+	require.NotEmpty(t, marker)
+	names, marker, err = client.List(ctx, testContainer, "a/b/", marker, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a/b/3"}, names)
+	require.Empty(t, marker)
+
+	err = client.Upload(ctx, "missing", "a", nil)
+	require.ErrorIs(t, err, ErrContainerNotFound)
+	_, _, err = client.List(ctx, "missing", "", "", 0)
+	require.ErrorIs(t, err, ErrContainerNotFound)
+}
+
+func TestClient_AuthenticationFailed(t *testing.T) {
+	_, server := newFakeBlobService(t, testContainer)
+	client, err := newClient(&config.AzblobArchiver{Endpoint: server.URL + "/devstoreaccount1"}, server.Client())
+	require.NoError(t, err)
+
+	_, err = client.Get(context.Background(), testContainer, "a")
+	var reqErr *requestError
+	require.ErrorAs(t, err, &reqErr)
+	require.Equal(t, http.StatusForbidden, reqErr.StatusCode)
+	require.Equal(t, "AuthenticationFailed", reqErr.Code)
+	require.True(t, isRetryableError(err))
+}
+
+func TestNewClient_Config(t *testing.T) {
+	_, err := NewClient(&config.AzblobArchiver{})
+	require.ErrorIs(t, err, ErrMissingAccount)
+
+	client, err := NewClient(&config.AzblobArchiver{AccountName: "myaccount", SASToken: testSASToken})
+	require.NoError(t, err)
+	require.Equal(t, "https://myaccount.blob.core.windows.net", client.(*restClient).endpoint.String())
+	require.Equal(t, defaultRequestTimeout, client.(*restClient).httpClient.Timeout)
+
+	client, err = NewClient(&config.AzblobArchiver{AccountName: "myaccount", RequestTimeout: time.Second})
+	require.NoError(t, err)
+	require.Equal(t, time.Second, client.(*restClient).httpClient.Timeout)
+}
+
+func TestClient_RequestTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+	client, err := NewClient(&config.AzblobArchiver{
+		Endpoint:       server.URL + "/devstoreaccount1",
+		RequestTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	_, err = client.Get(context.Background(), testContainer, "a")
+	require.Error(t, err)
+	require.True(t, isRetryableError(err))
+}
@@ -0,0 +1,400 @@
+package azblob
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// URIScheme is the scheme for the Azure Blob Storage implementation
+	URIScheme = "azblob"
+
+	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
+	errEncodeHistory      = "failed to encode history batches"
+	errWriteBlob          = "failed to write history to azure blob storage"
+)
+
+var (
+	errUploadNonRetryable = errors.New("upload non-retryable error")
+)
+
+type (
+	historyArchiver struct {
+		executionManager persistence.ExecutionManager
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+		client           Client
+
+		// only set in test code
+		historyIterator archiver.HistoryIterator
+	}
+
+	progress struct {
+		CurrentPageNumber int
+		IteratorState     []byte
+	}
+
+	getHistoryToken struct {
+		CloseFailoverVersion int64
+		HighestPart          int
+		CurrentPart          int
+		BatchIdxOffset       int
+	}
+)
+
+// NewHistoryArchiver creates a new Azure Blob Storage HistoryArchiver
+func NewHistoryArchiver(
+	executionManager persistence.ExecutionManager,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	config *config.AzblobArchiver,
+) (archiver.HistoryArchiver, error) {
+	client, err := NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryArchiver(executionManager, logger, metricsHandler, nil, client), nil
+}
+
+func newHistoryArchiver(
+	executionManager persistence.ExecutionManager,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	historyIterator archiver.HistoryIterator,
+	client Client,
+) *historyArchiver {
+	return &historyArchiver{
+		executionManager: executionManager,
+		logger:           logger,
+		metricsHandler:   metricsHandler,
+		client:           client,
+		historyIterator:  historyIterator,
+	}
+}
+
+// Archive is used to archive a workflow history. History is split into parts of roughly
+// targetHistoryBlobSize and each part is written to its own blob so that archival can resume
+// from the last uploaded part when progress is recorded.
+func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request *archiver.ArchiveHistoryRequest, opts ...archiver.ArchiveOption) (err error) {
+	handler := h.metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	defer func() {
+		metrics.ServiceLatency.With(handler).Record(time.Since(startTime))
+		if err != nil {
+			if !errors.Is(err, errUploadNonRetryable) {
+				metrics.HistoryArchiverArchiveTransientErrorCount.With(handler).Record(1)
+				return
+			}
+
+			metrics.HistoryArchiverArchiveNonRetryableErrorCount.With(handler).Record(1)
+			if featureCatalog.NonRetryableError != nil {
+				err = featureCatalog.NonRetryableError()
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveHistoryRequestAndURI(h.logger, request, URI.String())
+
+	if err := h.ValidateURI(URI); err != nil {
+		if isRetryableError(err) {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+			return err
+		}
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	if err := archiver.ValidateHistoryArchiveRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	var totalUploadSize int64
+	historyIterator := h.historyIterator
+	var archiveProgress progress
+	if historyIterator == nil { // will only be set by testing code
+		historyIterator = loadHistoryIterator(ctx, request, h.executionManager, featureCatalog, &archiveProgress)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+
+	for historyIterator.HasNext() {
+		part := archiveProgress.CurrentPageNumber
+		historyBlob, err := historyIterator.Next(ctx)
+		if err != nil {
+			if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+				// workflow history no longer exists, may due to duplicated archival signal
+				// this may happen even in the middle of iterating history as two archival signals
+				// can be processed concurrently.
+				logger.Info(archiver.ArchiveSkippedInfoMsg)
+				metrics.HistoryArchiverDuplicateArchivalsCount.With(handler).Record(1)
+				return nil
+			}
+
+			logger = log.With(logger, tag.ArchivalArchiveFailReason(archiver.ErrReasonReadHistory), tag.Error(err))
+			if !common.IsPersistenceTransientError(err) {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg)
+				return errUploadNonRetryable
+			}
+			logger.Error(archiver.ArchiveTransientErrorMsg)
+			return err
+		}
+
+		if historyMutated(request, historyBlob.Body, historyBlob.Header.IsLast) {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonHistoryMutated))
+			return archiver.ErrHistoryMutated
+		}
+
+		encodedHistoryPart, err := encoder.EncodeHistories(historyBlob.Body)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return errUploadNonRetryable
+		}
+
+		blobName := blobPath(URI, constructHistoryBlobName(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part))
+		exist, err := h.client.Exist(ctx, URI.Hostname(), blobName)
+		if err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteBlob), tag.Error(err))
+			return err
+		}
+		if !exist {
+			if err := h.client.Upload(ctx, URI.Hostname(), blobName, encodedHistoryPart); err != nil {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteBlob), tag.Error(err))
+				return err
+			}
+			totalUploadSize += int64(len(encodedHistoryPart))
+		}
+
+		if err := saveHistoryIteratorState(ctx, featureCatalog, historyIterator, part, &archiveProgress); err != nil {
+			return err
+		}
+	}
+
+	metrics.HistoryArchiverTotalUploadSize.With(handler).Record(totalUploadSize)
+	metrics.HistoryArchiverHistorySize.With(handler).Record(totalUploadSize)
+	metrics.HistoryArchiverArchiveSuccessCount.With(handler).Record(1)
+	return nil
+}
+
+// Get is used to access an archived history. The first call locates the highest (or requested)
+// close failover version by listing blobs; the page token then tracks the part and batch offset.
+func (h *historyArchiver) Get(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+	if err := h.validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetHistoryRequest.Error())
+	}
+
+	var token *getHistoryToken
+	if request.NextPageToken != nil {
+		var err error
+		token, err = deserializeGetHistoryToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	} else {
+		var err error
+		token, err = h.getHighestVersion(ctx, URI, request)
+		if err != nil {
+			if errors.Is(err, ErrContainerNotFound) {
+				return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+			}
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+		if token == nil {
+			return nil, serviceerror.NewNotFound(archiver.ErrHistoryNotExist.Error())
+		}
+	}
+
+	response := &archiver.GetHistoryResponse{}
+	numOfEvents := 0
+	encoder := codec.NewJSONPBEncoder()
+
+outer:
+	for token.CurrentPart <= token.HighestPart {
+		blobName := blobPath(URI, constructHistoryBlobName(request.NamespaceID, request.WorkflowID, request.RunID, token.CloseFailoverVersion, token.CurrentPart))
+		encodedHistoryBatches, err := h.client.Get(ctx, URI.Hostname(), blobName)
+		if err != nil {
+			if errors.Is(err, errBlobNotFound) {
+				return nil, serviceerror.NewInternal("history blob is missing: " + URI.String() + "/" + blobName)
+			}
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+
+		batches, err := encoder.DecodeHistories(encodedHistoryBatches)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		if token.BatchIdxOffset > len(batches) {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+		batches = batches[token.BatchIdxOffset:]
+
+		for idx, batch := range batches {
+			response.HistoryBatches = append(response.HistoryBatches, batch)
+			token.BatchIdxOffset++
+			numOfEvents += len(batch.Events)
+
+			if numOfEvents >= request.PageSize {
+				if idx == len(batches)-1 {
+					// page size is reached exactly at the end of the part
+					token.BatchIdxOffset = 0
+					token.CurrentPart++
+				}
+				break outer
+			}
+		}
+
+		token.BatchIdxOffset = 0
+		token.CurrentPart++
+	}
+
+	if token.CurrentPart <= token.HighestPart {
+		nextToken, err := serializeToken(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.NextPageToken = nextToken
+	}
+
+	return response, nil
+}
+
+// ValidateURI is used to define what a valid URI for an implementation is.
+func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
+	if err := h.validateURI(URI); err != nil {
+		return err
+	}
+	return validateContainer(h.client, URI)
+}
+
+func (h *historyArchiver) validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+	return nil
+}
+
+func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.URI, request *archiver.GetHistoryRequest) (*getHistoryToken, error) {
+	prefix := blobPath(URI, constructHistoryBlobNamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
+
+	var token *getHistoryToken
+	marker := ""
+	for {
+		blobNames, nextMarker, err := h.client.List(ctx, URI.Hostname(), prefix, marker, listMaxResults)
+		if err != nil {
+			return nil, err
+		}
+		for _, blobName := range blobNames {
+			version, part, err := extractCloseFailoverVersion(blobName)
+			if err != nil || (request.CloseFailoverVersion != nil && version != *request.CloseFailoverVersion) {
+				continue
+			}
+			if token == nil || version > token.CloseFailoverVersion {
+				token = &getHistoryToken{
+					CloseFailoverVersion: version,
+					HighestPart:          part,
+					CurrentPart:          part,
+				}
+				continue
+			}
+			if version == token.CloseFailoverVersion {
+				token.HighestPart = max(token.HighestPart, part)
+				token.CurrentPart = min(token.CurrentPart, part)
+			}
+		}
+		if nextMarker == "" {
+			return token, nil
+		}
+		marker = nextMarker
+	}
+}
+
+func validateContainer(client Client, URI archiver.URI) error {
+	ctx, cancel := context.WithTimeout(context.Background(), validateURITimeout)
+	defer cancel()
+
+	exist, err := client.ContainerExist(ctx, URI.Hostname())
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return ErrContainerNotFound
+	}
+	return nil
+}
+
+func historyMutated(request *archiver.ArchiveHistoryRequest, historyBatches []*historypb.History, isLast bool) bool {
+	lastBatch := historyBatches[len(historyBatches)-1].Events
+	lastEvent := lastBatch[len(lastBatch)-1]
+	lastFailoverVersion := lastEvent.GetVersion()
+	if lastFailoverVersion > request.CloseFailoverVersion {
+		return true
+	}
+
+	if !isLast {
+		return false
+	}
+	lastEventID := lastEvent.GetEventId()
+	return lastFailoverVersion != request.CloseFailoverVersion || lastEventID+1 != request.NextEventID
+}
+
+func loadHistoryIterator(
+	ctx context.Context,
+	request *archiver.ArchiveHistoryRequest,
+	executionManager persistence.ExecutionManager,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	archiveProgress *progress,
+) archiver.HistoryIterator {
+	if featureCatalog.ProgressManager != nil && featureCatalog.ProgressManager.HasProgress(ctx) {
+		if err := featureCatalog.ProgressManager.LoadProgress(ctx, archiveProgress); err == nil {
+			historyIterator, err := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, archiveProgress.IteratorState)
+			if err == nil {
+				return historyIterator
+			}
+		}
+		// start over if the recorded progress can't be used
+		*archiveProgress = progress{}
+	}
+	historyIterator, _ := archiver.NewHistoryIteratorFromState(request, executionManager, targetHistoryBlobSize, nil)
+	return historyIterator
+}
+
+func saveHistoryIteratorState(
+	ctx context.Context,
+	featureCatalog *archiver.ArchiveFeatureCatalog,
+	historyIterator archiver.HistoryIterator,
+	currentPartNum int,
+	archiveProgress *progress,
+) error {
+	// the part number must advance even when progress isn't persisted, otherwise every part
+	// would be written to the same blob
+	archiveProgress.CurrentPageNumber = currentPartNum + 1
+	if featureCatalog.ProgressManager == nil {
+		return nil
+	}
+	state, err := historyIterator.GetState()
+	if err != nil {
+		return err
+	}
+	archiveProgress.IteratorState = state
+	return featureCatalog.ProgressManager.RecordProgress(ctx, archiveProgress)
+}
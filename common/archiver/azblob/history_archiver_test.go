@@ -0,0 +1,305 @@
+package azblob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/util"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespaceID          = "test-namespace-id"
+	testNamespace            = "test-namespace"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = 100
+	testPageSize             = 100
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type historyArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	controller *gomock.Controller
+
+	logger          log.Logger
+	metricsHandler  metrics.Handler
+	service         *fakeBlobService
+	client          Client
+	testArchivalURI archiver.URI
+}
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (h *historyArchiverSuite) SetupTest() {
+	h.Assertions = require.New(h.T())
+	h.controller = gomock.NewController(h.T())
+	h.logger = log.NewNoopLogger()
+	h.metricsHandler = metrics.NoopMetricsHandler
+	service, server := newFakeBlobService(h.T(), testContainer)
+	h.service = service
+	h.client = newTestClient(h.T(), server)
+	h.testArchivalURI, _ = archiver.NewURI("azblob://" + testContainer + "/temporal_archival/development")
+}
+
+func (h *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob://",
+			expectedErr: archiver.ErrInvalidURI,
+		},
+		{
+			URI:         "azblob:///temporal_archival/development",
+			expectedErr: archiver.ErrInvalidURI,
+		},
+		{
+			URI:         "azblob://missing-container/temporal_archival/development",
+			expectedErr: ErrContainerNotFound,
+		},
+		{
+			URI:         "azblob://" + testContainer,
+			expectedErr: nil,
+		},
+		{
+			URI:         "azblob://" + testContainer + "/temporal_archival/development",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, nil, h.client)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		h.NoError(err)
+		h.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI), tc.URI)
+	}
+}
+
+func (h *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	URI, err := archiver.NewURI("azblob://missing-container/path")
+	h.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, h.archiveRequest(), archiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+	h.Equal(errUploadNonRetryable, err)
+}
+
+func (h *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	request := h.archiveRequest()
+	request.WorkflowID = ""
+	err := historyArchiver.Archive(context.Background(), h.testArchivalURI, request)
+	h.Error(err)
+	h.Empty(h.service.blobNames(testContainer))
+}
+
+func (h *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	err := historyArchiver.Archive(context.Background(), h.testArchivalURI, h.archiveRequest())
+	h.Error(err)
+}
+
+func (h *historyArchiverSuite) TestArchive_Fail_HistoryMutated() {
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   common.FirstEventID + 1,
+						EventTime: timestamppb.New(time.Now().UTC()),
+						Version:   testCloseFailoverVersion + 1,
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+	)
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	err := historyArchiver.Archive(context.Background(), h.testArchivalURI, h.archiveRequest())
+	h.Equal(archiver.ErrHistoryMutated, err)
+}
+
+func (h *historyArchiverSuite) TestArchive_Skip() {
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(h.historyBlob(common.FirstEventID, false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found")),
+	)
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	err := historyArchiver.Archive(context.Background(), h.testArchivalURI, h.archiveRequest())
+	h.NoError(err)
+	h.Len(h.service.blobNames(testContainer), 1)
+}
+
+func (h *historyArchiverSuite) TestArchiveAndGet() {
+	ctx := context.Background()
+	historyIterator := archiver.NewMockHistoryIterator(h.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(h.historyBlob(common.FirstEventID, false), nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(h.historyBlob(testNextEventID-1, true), nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, historyIterator, h.client)
+	h.NoError(historyArchiver.Archive(ctx, h.testArchivalURI, h.archiveRequest()))
+
+	prefix := "temporal_archival/development/" + constructHistoryBlobNamePrefix(testNamespaceID, testWorkflowID, testRunID)
+	h.Equal([]string{
+		prefix + "_100_0.history",
+		prefix + "_100_1.history",
+	}, h.service.blobNames(testContainer))
+
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    1,
+	}
+	response, err := historyArchiver.Get(ctx, h.testArchivalURI, request)
+	h.NoError(err)
+	h.Len(response.HistoryBatches, 1)
+	h.Equal(common.FirstEventID, response.HistoryBatches[0].Events[0].EventId)
+	h.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(ctx, h.testArchivalURI, request)
+	h.NoError(err)
+	h.Len(response.HistoryBatches, 1)
+	h.Equal(int64(testNextEventID-1), response.HistoryBatches[0].Events[0].EventId)
+	h.Nil(response.NextPageToken)
+}
+
+func (h *historyArchiverSuite) TestGet_PickVersion() {
+	ctx := context.Background()
+	encoder := codec.NewJSONPBEncoder()
+	encoded, err := encoder.EncodeHistories(h.historyBlob(common.FirstEventID, true).Body)
+	h.NoError(err)
+	for _, name := range []string{
+		constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, -24, 0),
+		constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, -25, 0),
+		constructHistoryBlobName(testNamespaceID, testWorkflowID, testRunID, -25, 1),
+	} {
+		h.service.put(testContainer, "temporal_archival/development/"+name, encoded)
+	}
+
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, nil, h.client)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(ctx, h.testArchivalURI, request)
+	h.NoError(err)
+	h.Len(response.HistoryBatches, 1)
+	h.Nil(response.NextPageToken)
+
+	request.CloseFailoverVersion = util.Ptr(int64(-25))
+	response, err = historyArchiver.Get(ctx, h.testArchivalURI, request)
+	h.NoError(err)
+	h.Len(response.HistoryBatches, 2)
+	h.Nil(response.NextPageToken)
+}
+
+func (h *historyArchiverSuite) TestGet_Fail_InvalidToken() {
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, nil, h.client)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID:   testNamespaceID,
+		WorkflowID:    testWorkflowID,
+		RunID:         testRunID,
+		PageSize:      testPageSize,
+		NextPageToken: []byte{'r', 'a', 'n', 'd', 'o', 'm'},
+	}
+	_, err := historyArchiver.Get(context.Background(), h.testArchivalURI, request)
+	h.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (h *historyArchiverSuite) TestGet_NoHistory() {
+	historyArchiver := newHistoryArchiver(nil, h.logger, h.metricsHandler, nil, h.client)
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	_, err := historyArchiver.Get(context.Background(), h.testArchivalURI, request)
+	h.IsType(&serviceerror.NotFound{}, err)
+}
+
+func (h *historyArchiverSuite) archiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (h *historyArchiverSuite) historyBlob(eventID int64, isLast bool) *archiverspb.HistoryBlob {
+	return &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: isLast,
+		},
+		Body: []*historypb.History{
+			{
+				Events: []*historypb.HistoryEvent{
+					{
+						EventId:   eventID,
+						EventTime: timestamppb.New(time.Now().UTC()),
+						Version:   testCloseFailoverVersion,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package azblob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/util"
+)
+
+type queryParserSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser QueryParser
+}
+
+func TestQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(queryParserSuite))
+}
+
+func (s *queryParserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewQueryParser()
+}
+
+func (s *queryParserSuite) TestParse() {
+	closeTime := time.Date(2020, 1, 21, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *parsedQuery
+	}{
+		{
+			query: "WorkflowId = 'wid' and RunId = 'rid' and WorkflowType = 'type'",
+			parsedQuery: &parsedQuery{
+				workflowID:       util.Ptr("wid"),
+				runID:            util.Ptr("rid"),
+				workflowTypeName: util.Ptr("type"),
+			},
+		},
+		{
+			query: "WorkflowId = 'wid' and WorkflowId = 'other'",
+			parsedQuery: &parsedQuery{
+				workflowID:  util.Ptr("wid"),
+				emptyResult: true,
+			},
+		},
+		{
+			query: "ExecutionStatus = 'Failed'",
+			parsedQuery: &parsedQuery{
+				status: util.Ptr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+			},
+		},
+		{
+			query: "CloseTime >= '2020-01-21T00:00:00Z' and CloseTime <= '2020-01-21T00:00:00Z'",
+			parsedQuery: &parsedQuery{
+				earliestCloseTime: closeTime,
+				latestCloseTime:   closeTime,
+			},
+		},
+		{
+			query:     "WorkflowId > 'wid'",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowId = 'wid' or RunId = 'rid'",
+			expectErr: true,
+		},
+		{
+			query:     "StartTime > '2020-01-21T00:00:00Z'",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID)
+		s.Equal(tc.parsedQuery.runID, parsedQuery.runID)
+		s.Equal(tc.parsedQuery.workflowTypeName, parsedQuery.workflowTypeName)
+		s.Equal(tc.parsedQuery.status, parsedQuery.status)
+		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
+		if !tc.parsedQuery.earliestCloseTime.IsZero() {
+			s.True(tc.parsedQuery.earliestCloseTime.Equal(parsedQuery.earliestCloseTime))
+			s.True(tc.parsedQuery.latestCloseTime.Equal(parsedQuery.latestCloseTime))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package azblob
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/proto"
+)
+
+// closeTimeLayout is a fixed width UTC layout so that lexicographical order of visibility blob names
+// matches chronological order of close times.
+const closeTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+func encode(message proto.Message) ([]byte, error) {
+	encoder := codec.NewJSONPBEncoder()
+	return encoder.Encode(message)
+}
+
+func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
+	record := &archiverspb.VisibilityRecord{}
+	encoder := codec.NewJSONPBEncoder()
+	err := encoder.Decode(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func hash(s string) string {
+	return strconv.FormatUint(farm.Fingerprint64([]byte(s)), 10)
+}
+
+// blobPath joins the URI path with a blob name relative to it.
+func blobPath(URI archiver.URI, name string) string {
+	prefix := strings.Trim(URI.Path(), "/")
+	if prefix == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
+
+func constructHistoryBlobNamePrefix(namespaceID, workflowID, runID string) string {
+	return strings.Join([]string{hash(namespaceID), hash(workflowID), hash(runID)}, "")
+}
+
+func constructHistoryBlobName(namespaceID, workflowID, runID string, version int64, partNumber int) string {
+	return fmt.Sprintf("%s_%v_%v.history", constructHistoryBlobNamePrefix(namespaceID, workflowID, runID), version, partNumber)
+}
+
+func extractCloseFailoverVersion(blobName string) (int64, int, error) {
+	parts := strings.FieldsFunc(path.Base(blobName), func(r rune) bool {
+		return r == '_' || r == '.'
+	})
+	if len(parts) != 4 || parts[3] != "history" {
+		return 0, 0, errors.New("unknown history blob name structure")
+	}
+	version, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	part, err := strconv.Atoi(parts[2])
+	return version, part, err
+}
+
+func constructVisibilityBlobNamePrefix(namespaceID string) string {
+	return namespaceID + "/"
+}
+
+func constructVisibilityBlobName(namespaceID string, closeTime time.Time, runID string) string {
+	return fmt.Sprintf("%s%s_%s.visibility", constructVisibilityBlobNamePrefix(namespaceID), closeTime.UTC().Format(closeTimeLayout), hash(runID))
+}
+
+// extractCloseTime parses the close time encoded in a visibility blob name.
+func extractCloseTime(blobName string) (time.Time, error) {
+	base := path.Base(blobName)
+	idx := strings.LastIndex(base, "_")
+	if idx < 0 || !strings.HasSuffix(base, ".visibility") {
+		return time.Time{}, errors.New("unknown visibility blob name structure")
+	}
+	return time.Parse(closeTimeLayout, base[:idx])
+}
+
+// closeTimeSearchPrefix returns the longest blob name prefix shared by every visibility blob
+// closed between earliest and latest, so that listing can skip everything outside the range.
+func closeTimeSearchPrefix(namespaceID string, earliest, latest time.Time) string {
+	prefix := constructVisibilityBlobNamePrefix(namespaceID)
+	if earliest.IsZero() || latest.Before(earliest) {
+		return prefix
+	}
+	from := earliest.UTC().Format(closeTimeLayout)
+	to := latest.UTC().Format(closeTimeLayout)
+	i := 0
+	for i < len(from) && from[i] == to[i] {
+		i++
+	}
+	return prefix + from[:i]
+}
+
+func serializeToken(token any) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error) {
+	token := &queryVisibilityToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+func convertToExecutionInfo(record *archiverspb.VisibilityRecord, saTypeMap searchattribute.NameTypeMap) (*workflowpb.WorkflowExecutionInfo, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: record.GetWorkflowId(),
+			RunId:      record.GetRunId(),
+		},
+		Type: &commonpb.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:         record.StartTime,
+		ExecutionTime:     record.ExecutionTime,
+		CloseTime:         record.CloseTime,
+		ExecutionDuration: record.ExecutionDuration,
+		Status:            record.Status,
+		HistoryLength:     record.HistoryLength,
+		Memo:              record.Memo,
+		SearchAttributes:  searchAttributes,
+	}, nil
+}
+
+func matchQuery(record *archiverspb.VisibilityRecord, query *parsedQuery) bool {
+	closeTime := record.CloseTime.AsTime()
+	if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
+		return false
+	}
+	if query.workflowID != nil && record.GetWorkflowId() != *query.workflowID {
+		return false
+	}
+	if query.runID != nil && record.GetRunId() != *query.runID {
+		return false
+	}
+	if query.workflowTypeName != nil && record.WorkflowTypeName != *query.workflowTypeName {
+		return false
+	}
+	if query.status != nil && record.Status != *query.status {
+		return false
+	}
+	return true
+}
+
+func isRetryableError(err error) bool {
+	return !errors.Is(err, errUploadNonRetryable) &&
+		!errors.Is(err, ErrContainerNotFound) &&
+		!errors.Is(err, archiver.ErrURISchemeMismatch) &&
+		!errors.Is(err, archiver.ErrInvalidURI)
+}
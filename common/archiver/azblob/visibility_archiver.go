@@ -0,0 +1,227 @@
+package azblob
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+	errWriteVisibilityBlob    = "failed to write visibility record to azure blob storage"
+
+	validateURITimeout = 5 * time.Second
+)
+
+type (
+	visibilityArchiver struct {
+		logger         log.Logger
+		metricsHandler metrics.Handler
+		client         Client
+		queryParser    QueryParser
+	}
+
+	// queryVisibilityToken resumes a listing at Marker, skipping the first Offset blobs of that
+	// listing page which were already returned.
+	queryVisibilityToken struct {
+		Marker string
+		Offset int
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on Azure Blob Storage
+func NewVisibilityArchiver(logger log.Logger, metricsHandler metrics.Handler, cfg *config.AzblobArchiver) (archiver.VisibilityArchiver, error) {
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(logger, metricsHandler, client), nil
+}
+
+func newVisibilityArchiver(logger log.Logger, metricsHandler metrics.Handler, client Client) *visibilityArchiver {
+	return &visibilityArchiver{
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		client:         client,
+		queryParser:    NewQueryParser(),
+	}
+}
+
+// Archive is used to archive one workflow visibility record. Each record is stored in its own
+// blob named <namespaceID>/<closeTime>_<hash(runID)>.visibility so that records can be listed in
+// close time order without reading their contents.
+func (v *visibilityArchiver) Archive(ctx context.Context, URI archiver.URI, request *archiverspb.VisibilityRecord, opts ...archiver.ArchiveOption) (err error) {
+	handler := v.metricsHandler.WithTags(metrics.OperationTag(metrics.VisibilityArchiverScope), metrics.NamespaceTag(request.Namespace))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	startTime := time.Now().UTC()
+	defer func() {
+		metrics.ServiceLatency.With(handler).Record(time.Since(startTime))
+		if err != nil {
+			if isRetryableError(err) {
+				metrics.VisibilityArchiverArchiveTransientErrorCount.With(handler).Record(1)
+			} else {
+				metrics.VisibilityArchiverArchiveNonRetryableErrorCount.With(handler).Record(1)
+				if featureCatalog.NonRetryableError != nil {
+					err = featureCatalog.NonRetryableError()
+				}
+			}
+		}
+	}()
+
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		if isRetryableError(err) {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+			return err
+		}
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
+		return errUploadNonRetryable
+	}
+
+	blobName := blobPath(URI, constructVisibilityBlobName(request.GetNamespaceId(), request.CloseTime.AsTime(), request.GetRunId()))
+	if err := v.client.Upload(ctx, URI.Hostname(), blobName, encodedVisibilityRecord); err != nil {
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteVisibilityBlob), tag.Error(err))
+		return err
+	}
+
+	metrics.VisibilityArchiveSuccessCount.With(handler).Record(1)
+	return nil
+}
+
+// Query is used to retrieve archived visibility records. Results are returned in ascending close
+// time order. Only blobs whose name falls into the queried close time range are read.
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.validateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	token := &queryVisibilityToken{}
+	if request.NextPageToken != nil {
+		token, err = deserializeQueryVisibilityToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	container := URI.Hostname()
+	prefix := blobPath(URI, closeTimeSearchPrefix(request.NamespaceID, parsedQuery.earliestCloseTime, parsedQuery.latestCloseTime))
+	response := &archiver.QueryVisibilityResponse{}
+	for {
+		blobNames, nextMarker, err := v.client.List(ctx, container, prefix, token.Marker, listMaxResults)
+		if err != nil {
+			if errors.Is(err, ErrContainerNotFound) {
+				return response, nil
+			}
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+
+		for idx := token.Offset; idx < len(blobNames); idx++ {
+			closeTime, err := extractCloseTime(blobNames[idx])
+			if err != nil || closeTime.Before(parsedQuery.earliestCloseTime) {
+				continue
+			}
+			if closeTime.After(parsedQuery.latestCloseTime) {
+				// blobs are listed in close time order, nothing after this one can match
+				return response, nil
+			}
+
+			encodedRecord, err := v.client.Get(ctx, container, blobNames[idx])
+			if err != nil {
+				if errors.Is(err, errBlobNotFound) {
+					continue
+				}
+				return nil, serviceerror.NewUnavailable(err.Error())
+			}
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			if !matchQuery(record, parsedQuery) {
+				continue
+			}
+
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			response.Executions = append(response.Executions, executionInfo)
+
+			if len(response.Executions) == request.PageSize {
+				next := &queryVisibilityToken{Marker: token.Marker, Offset: idx + 1}
+				if next.Offset == len(blobNames) {
+					if nextMarker == "" {
+						return response, nil
+					}
+					next = &queryVisibilityToken{Marker: nextMarker}
+				}
+				response.NextPageToken, err = serializeToken(next)
+				if err != nil {
+					return nil, serviceerror.NewInternal(err.Error())
+				}
+				return response, nil
+			}
+		}
+
+		if nextMarker == "" {
+			return response, nil
+		}
+		token = &queryVisibilityToken{Marker: nextMarker}
+	}
+}
+
+// ValidateURI is used to define what a valid URI for an implementation is.
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	if err := v.validateURI(URI); err != nil {
+		return err
+	}
+	return validateContainer(v.client, URI)
+}
+
+func (v *visibilityArchiver) validateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if URI.Hostname() == "" {
+		return archiver.ErrInvalidURI
+	}
+	return nil
+}
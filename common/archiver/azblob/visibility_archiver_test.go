@@ -0,0 +1,237 @@
+package azblob
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testWorkflowTypeName = "test-workflow-type"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	logger          log.Logger
+	metricsHandler  metrics.Handler
+	service         *fakeBlobService
+	client          Client
+	testArchivalURI archiver.URI
+	baseTime        time.Time
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = log.NewNoopLogger()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	service, server := newFakeBlobService(s.T(), testContainer)
+	s.service = service
+	s.client = newTestClient(s.T(), server)
+	s.testArchivalURI, _ = archiver.NewURI("azblob://" + testContainer + "/temporal_archival/visibility")
+	s.baseTime = time.Date(2020, 1, 21, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob://",
+			expectedErr: archiver.ErrInvalidURI,
+		},
+		{
+			URI:         "azblob://missing-container/temporal_archival/visibility",
+			expectedErr: ErrContainerNotFound,
+		},
+		{
+			URI:         "azblob://" + testContainer + "/temporal_archival/visibility",
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI), tc.URI)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	URI, err := archiver.NewURI("azblob://missing-container/temporal_archival/visibility")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.record(0, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED))
+	s.Equal(ErrContainerNotFound, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	record := s.record(0, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	record.WorkflowId = ""
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record, archiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+	s.Equal(errUploadNonRetryable, err)
+	s.Empty(s.service.blobNames(testContainer))
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	record := s.record(0, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, record))
+	s.Equal([]string{
+		"temporal_archival/visibility/" + testNamespaceID + "/2020-01-21T00:00:00.000000000Z_" + hash(record.RunId) + ".visibility",
+	}, s.service.blobNames(testContainer))
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "StartTime > 0",
+	}, searchattribute.TestNameTypeMap())
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      10,
+		NextPageToken: []byte{1, 2, 3},
+	}, searchattribute.TestNameTypeMap())
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success() {
+	ctx := context.Background()
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	for i := 0; i < 10; i++ {
+		status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+		if i%2 == 1 {
+			status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+		}
+		s.NoError(visibilityArchiver.Archive(ctx, s.testArchivalURI, s.record(i, status)))
+	}
+	// a record from another namespace must never be returned
+	other := s.record(3, enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+	other.NamespaceId = "other-namespace-id"
+	s.NoError(visibilityArchiver.Archive(ctx, s.testArchivalURI, other))
+
+	response, err := visibilityArchiver.Query(ctx, s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    100,
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Len(response.Executions, 10)
+	s.Nil(response.NextPageToken)
+	s.Equal("run-0", response.Executions[0].Execution.RunId)
+
+	query := fmt.Sprintf("ExecutionStatus = 'Failed' and CloseTime >= '%s' and CloseTime < '%s'",
+		s.baseTime.Add(2*time.Hour).Format(time.RFC3339), s.baseTime.Add(8*time.Hour).Format(time.RFC3339))
+	var runIDs []string
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    2,
+		Query:       query,
+	}
+	for {
+		response, err := visibilityArchiver.Query(ctx, s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		s.LessOrEqual(len(response.Executions), 2)
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.Execution.RunId)
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-3", "run-5", "run-7"}, runIDs)
+
+	response, err = visibilityArchiver.Query(ctx, s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "WorkflowId = 'workflow-4' and WorkflowId = 'workflow-5'",
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_PaginationAcrossListPages() {
+	ctx := context.Background()
+	visibilityArchiver := newVisibilityArchiver(s.logger, s.metricsHandler, s.client)
+	total := listMaxResults + 5
+	for i := 0; i < total; i++ {
+		record := s.record(i, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+		record.CloseTime = timestamppb.New(s.baseTime.Add(time.Duration(i) * time.Second))
+		encoded, err := encode(record)
+		s.NoError(err)
+		s.service.put(testContainer, blobPath(s.testArchivalURI, constructVisibilityBlobName(testNamespaceID, record.CloseTime.AsTime(), record.RunId)), encoded)
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    300,
+	}
+	seen := 0
+	for {
+		response, err := visibilityArchiver.Query(ctx, s.testArchivalURI, request, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		for _, execution := range response.Executions {
+			s.Equal(fmt.Sprintf("run-%d", seen), execution.Execution.RunId)
+			seen++
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal(total, seen)
+}
+
+func (s *visibilityArchiverSuite) TestCloseTimeSearchPrefix() {
+	namespacePrefix := testNamespaceID + "/"
+	s.Equal(namespacePrefix, closeTimeSearchPrefix(testNamespaceID, time.Time{}, s.baseTime))
+	s.Equal(namespacePrefix+"2020-01-21T0", closeTimeSearchPrefix(testNamespaceID, s.baseTime, s.baseTime.Add(5*time.Hour)))
+	s.Equal(namespacePrefix+"2020-01-2", closeTimeSearchPrefix(testNamespaceID, s.baseTime, s.baseTime.Add(48*time.Hour)))
+	s.Equal(namespacePrefix, closeTimeSearchPrefix(testNamespaceID, s.baseTime, s.baseTime.Add(-time.Hour)))
+}
+
+func (s *visibilityArchiverSuite) record(i int, status enumspb.WorkflowExecutionStatus) *archiverspb.VisibilityRecord {
+	closeTime := s.baseTime.Add(time.Duration(i) * time.Hour)
+	return &archiverspb.VisibilityRecord{
+		NamespaceId:      testNamespaceID,
+		Namespace:        testNamespace,
+		WorkflowId:       fmt.Sprintf("workflow-%d", i),
+		RunId:            fmt.Sprintf("run-%d", i),
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTime:        timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:        timestamppb.New(closeTime),
+		Status:           status,
+		HistoryLength:    36,
+	}
+}
@@ -170,7 +170,7 @@ func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) strin
 }
 
 func constructVisibilityFilename(closeTimestamp time.Time, runID string) string {
-	return fmt.Sprintf("%v_%s.visibility", closeTimestamp.UnixNano(), hash(runID))
+	return fmt.Sprintf("%v_%s%s", closeTimestamp.UnixNano(), hash(runID), visibilityFileSuffix)
 }
 
 func hash(s string) string {
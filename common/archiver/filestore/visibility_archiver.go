@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -15,6 +17,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -33,7 +36,13 @@ type (
 		metricsHandler metrics.Handler
 		fileMode       os.FileMode
 		dirMode        os.FileMode
+		format         string
 		queryParser    QueryParser
+		timeSource     clock.TimeSource
+
+		sealLock      sync.Mutex
+		lastSealCheck map[string]time.Time
+		sealing       map[string]struct{}
 	}
 
 	queryVisibilityToken struct {
@@ -63,12 +72,24 @@ func NewVisibilityArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	format := strings.ToLower(config.VisibilityFormat)
+	switch format {
+	case "":
+		format = visibilityFormatJSON
+	case visibilityFormatJSON, visibilityFormatParquet:
+	default:
+		return nil, errInvalidVisibilityFormat
+	}
 	return &visibilityArchiver{
 		logger:         logger,
 		metricsHandler: metricsHandler,
 		fileMode:       os.FileMode(fileMode),
 		dirMode:        os.FileMode(dirMode),
+		format:         format,
 		queryParser:    NewQueryParser(),
+		timeSource:     clock.NewRealTimeSource(),
+		lastSealCheck:  make(map[string]time.Time),
+		sealing:        make(map[string]struct{}),
 	}, nil
 }
 
@@ -97,7 +118,13 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
-	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
+	namespaceDirPath := path.Join(URI.Path(), request.GetNamespaceId())
+	dirPath := namespaceDirPath
+	if v.format == visibilityFormatParquet {
+		// Records are staged in their close date partition and merged into a parquet file
+		// once the day is over, see scheduleSeal.
+		dirPath = path.Join(namespaceDirPath, constructPartitionDirName(request.CloseTime.AsTime()))
+	}
 	if err = mkdirAll(dirPath, v.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
@@ -117,6 +144,9 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	if v.format == visibilityFormatParquet {
+		v.scheduleSeal(namespaceDirPath)
+	}
 	return nil
 }
 
@@ -143,7 +173,11 @@ func (v *visibilityArchiver) Query(
 		return &archiver.QueryVisibilityResponse{}, nil
 	}
 
-	return v.query(
+	query := v.query
+	if v.format == visibilityFormatParquet {
+		query = v.queryPartitions
+	}
+	return query(
 		ctx,
 		URI,
 		&queryVisibilityRequest{
@@ -184,6 +218,10 @@ func (v *visibilityArchiver) query(
 	if err != nil {
 		return nil, serviceerror.NewInternal(err.Error())
 	}
+	// skip close date partitions written in parquet format
+	files = slices.DeleteFunc(files, func(name string) bool {
+		return !strings.HasSuffix(name, visibilityFileSuffix)
+	})
 
 	files, err = sortAndFilterFiles(files, token)
 	if err != nil {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"os"
 	"path"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
@@ -23,9 +26,11 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/tests/testutils"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -35,6 +40,7 @@ const (
 
 type visibilityArchiverSuite struct {
 	*require.Assertions
+	protorequire.ProtoAssertions
 	suite.Suite
 
 	logger             log.Logger
@@ -67,6 +73,7 @@ func (s *visibilityArchiverSuite) TearDownSuite() {
 
 func (s *visibilityArchiverSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
 	s.logger = log.NewNoopLogger()
 	s.metricsHandler = metrics.NoopMetricsHandler
 	s.controller = gomock.NewController(s.T())
@@ -554,6 +561,190 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_Pagination() {
 	s.Len(executions, 4)
 }
 
+func (s *visibilityArchiverSuite) TestNewVisibilityArchiver_InvalidFormat() {
+	_, err := NewVisibilityArchiver(s.logger, s.metricsHandler, &config.FilestoreArchiver{
+		FileMode:         testFileModeStr,
+		DirMode:          testDirModeStr,
+		VisibilityFormat: "csv",
+	})
+	s.Equal(errInvalidVisibilityFormat, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Parquet() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndQuery_Parquet")
+
+	visibilityArchiver := s.newTestParquetVisibilityArchiver()
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(0, 10000).Add(time.Hour))
+	visibilityArchiver.timeSource = timeSource
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+	partitionDir := path.Join(dir, testNamespaceID, "closeDate=1970-01-01")
+	s.assertFileExists(path.Join(partitionDir, constructVisibilityFilename(s.visibilityRecords[0].CloseTime.AsTime(), testRunID)))
+
+	// records are merged into a parquet file once their day is over
+	s.waitForSeals(visibilityArchiver)
+	timeSource.Advance(24 * time.Hour)
+	record := proto.Clone(s.visibilityRecords[1]).(*archiverspb.VisibilityRecord)
+	record.RunId = "run ID archived the next day"
+	record.CloseTime = timestamppb.New(timeSource.Now())
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	// sealing happens in the background
+	s.Eventually(func() bool {
+		files, err := listFiles(partitionDir)
+		return err == nil && len(files) == 1 && strings.HasSuffix(files[0], parquetFileSuffix)
+	}, 10*time.Second, 10*time.Millisecond)
+	s.assertFileExists(path.Join(dir, testNamespaceID, constructPartitionDirName(timeSource.Now()), constructVisibilityFilename(timeSource.Now(), record.RunId)))
+
+	// a record archived again after sealing is returned only once
+	s.NoError(visibilityArchiver.Archive(context.Background(), URI, s.visibilityRecords[0]))
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+	}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for len(executions) == 0 || request.NextPageToken != nil {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		executions = append(executions, response.Executions...)
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(executions, 5)
+	s.Equal(record.RunId, executions[0].Execution.RunId)
+	for i, execution := range executions[1:] {
+		ei, err := convertToExecutionInfo(s.visibilityRecords[i], searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		s.ProtoEqual(ei, execution)
+	}
+
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: time.Unix(0, 10),
+		latestCloseTime:   time.Unix(0, 10001),
+		status:            toWorkflowExecutionStatusPtr(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "parsed by mockParser",
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal(testRunID, response.Executions[0].Execution.RunId)
+	s.Equal("some random run ID", response.Executions[1].Execution.RunId)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Parquet_LegacyRecords() {
+	visibilityArchiver := s.newTestParquetVisibilityArchiver()
+	URI, err := archiver.NewURI("file://" + s.testQueryDirectory)
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 4)
+	for i, execution := range response.Executions {
+		ei, err := convertToExecutionInfo(s.visibilityRecords[i], searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		s.Equal(ei, execution)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestSealPartition_Idempotent() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestSealPartition_Idempotent")
+
+	visibilityArchiver := s.newTestParquetVisibilityArchiver()
+	visibilityArchiver.timeSource = clock.NewEventTimeSource().Update(time.Unix(0, 10000))
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	var records []*archiverspb.VisibilityRecord
+	for _, record := range s.visibilityRecords {
+		if record.NamespaceId == testNamespaceID {
+			records = append(records, record)
+			s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+		}
+	}
+	s.waitForSeals(visibilityArchiver)
+	partitionDir := path.Join(dir, testNamespaceID, "closeDate=1970-01-01")
+	stagedFiles, err := listFiles(partitionDir)
+	s.NoError(err)
+	restoreStagedFiles := func(names []string) {
+		for _, record := range records {
+			name := constructVisibilityFilename(record.CloseTime.AsTime(), record.RunId)
+			if slices.Contains(names, name) {
+				data, err := encode(record)
+				s.NoError(err)
+				s.NoError(writeFile(path.Join(partitionDir, name), data, visibilityArchiver.fileMode))
+			}
+		}
+	}
+	// left behind by a seal that crashed
+	s.NoError(writeFile(path.Join(partitionDir, ".part-crashed.parquet.1.tmp"), []byte("partial"), visibilityArchiver.fileMode))
+
+	s.NoError(visibilityArchiver.sealPartition(partitionDir))
+	sealedFiles, err := listFilesByPrefix(partitionDir, "part-")
+	s.NoError(err)
+	s.Len(sealedFiles, 1)
+
+	// staged records that were not removed are only removed when sealed again
+	restoreStagedFiles(stagedFiles)
+	s.NoError(visibilityArchiver.sealPartition(partitionDir))
+	files, err := listFilesByPrefix(partitionDir, "part-")
+	s.NoError(err)
+	s.Equal(sealedFiles, files)
+	files, err = listFiles(partitionDir)
+	s.NoError(err)
+	s.Len(files, 2)
+
+	// records both staged and sealed, or sealed twice, are returned once
+	restoreStagedFiles(stagedFiles[:2])
+	s.NoError(visibilityArchiver.sealPartition(partitionDir))
+	restoreStagedFiles(stagedFiles[:1])
+	files, err = listFilesByPrefix(partitionDir, "part-")
+	s.NoError(err)
+	s.Len(files, 2)
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, len(records))
+	for i, execution := range response.Executions {
+		ei, err := convertToExecutionInfo(records[i], searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		s.ProtoEqual(ei, execution)
+	}
+}
+
+func (s *visibilityArchiverSuite) newTestParquetVisibilityArchiver() *visibilityArchiver {
+	config := &config.FilestoreArchiver{
+		FileMode:         testFileModeStr,
+		DirMode:          testDirModeStr,
+		VisibilityFormat: visibilityFormatParquet,
+	}
+	a, err := NewVisibilityArchiver(s.logger, s.metricsHandler, config)
+	s.NoError(err)
+	return a.(*visibilityArchiver)
+}
+
+// waitForSeals waits for the background seals started by Archive, a seal that is still running
+// when Archive is called again isn't started twice.
+func (s *visibilityArchiverSuite) waitForSeals(visibilityArchiver *visibilityArchiver) {
+	s.Eventually(func() bool {
+		visibilityArchiver.sealLock.Lock()
+		defer visibilityArchiver.sealLock.Unlock()
+		return len(visibilityArchiver.sealing) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -0,0 +1,226 @@
+package filestore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/codec"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// visibilityParquetRowGroupSize bounds the number of rows the writer buffers in memory.
+	visibilityParquetRowGroupSize = 10000
+	// visibilityParquetReadBatchSize is the number of rows decoded at a time when reading.
+	visibilityParquetReadBatchSize = 100
+)
+
+type (
+	// visibilityParquetRow is the schema of visibility parquet files. Columns may be appended
+	// but never removed or changed, since readers match columns by name. Memo and search
+	// attributes are stored as JSON strings so that they can be inspected by analytics tools.
+	visibilityParquetRow struct {
+		NamespaceID         string  `parquet:"namespace_id"`
+		Namespace           string  `parquet:"namespace"`
+		WorkflowID          string  `parquet:"workflow_id"`
+		RunID               string  `parquet:"run_id"`
+		WorkflowTypeName    string  `parquet:"workflow_type_name"`
+		StartTime           *int64  `parquet:"start_time,optional,timestamp(nanosecond)"`
+		ExecutionTime       *int64  `parquet:"execution_time,optional,timestamp(nanosecond)"`
+		CloseTime           int64   `parquet:"close_time,timestamp(nanosecond)"`
+		ExecutionDurationNs *int64  `parquet:"execution_duration_ns,optional"`
+		Status              string  `parquet:"status"`
+		HistoryLength       int64   `parquet:"history_length"`
+		Memo                *string `parquet:"memo,optional"`
+		SearchAttributes    *string `parquet:"search_attributes,optional"`
+		HistoryArchivalURI  string  `parquet:"history_archival_uri"`
+	}
+
+	// visibilityParquetWriter writes visibility records to a snappy compressed parquet file.
+	// Rows are flushed to the file every visibilityParquetRowGroupSize records.
+	visibilityParquetWriter struct {
+		writer  *parquet.GenericWriter[visibilityParquetRow]
+		encoder codec.JSONPBEncoder
+	}
+
+	// visibilityParquetReader reads the records of a parquet file in the order they were written,
+	// a batch at a time.
+	visibilityParquetReader struct {
+		file    *os.File
+		reader  *parquet.GenericReader[visibilityParquetRow]
+		encoder codec.JSONPBEncoder
+		batch   []visibilityParquetRow
+		pos     int
+		eof     bool
+	}
+)
+
+func newVisibilityParquetWriter(w io.Writer) *visibilityParquetWriter {
+	return &visibilityParquetWriter{
+		writer: parquet.NewGenericWriter[visibilityParquetRow](
+			w,
+			parquet.Compression(&parquet.Snappy),
+			parquet.MaxRowsPerRowGroup(visibilityParquetRowGroupSize),
+			parquet.CreatedBy("temporal filestore archiver", "", ""),
+		),
+		encoder: codec.NewJSONPBEncoder(),
+	}
+}
+
+func (w *visibilityParquetWriter) Write(record *archiverspb.VisibilityRecord) error {
+	row := visibilityParquetRow{
+		NamespaceID:        record.GetNamespaceId(),
+		Namespace:          record.GetNamespace(),
+		WorkflowID:         record.GetWorkflowId(),
+		RunID:              record.GetRunId(),
+		WorkflowTypeName:   record.GetWorkflowTypeName(),
+		StartTime:          timestampToParquet(record.StartTime),
+		ExecutionTime:      timestampToParquet(record.ExecutionTime),
+		CloseTime:          record.CloseTime.AsTime().UnixNano(),
+		Status:             record.Status.String(),
+		HistoryLength:      record.GetHistoryLength(),
+		HistoryArchivalURI: record.GetHistoryArchivalUri(),
+	}
+	if record.ExecutionDuration != nil {
+		d := record.ExecutionDuration.AsDuration().Nanoseconds()
+		row.ExecutionDurationNs = &d
+	}
+	if record.Memo != nil {
+		data, err := w.encoder.Encode(record.Memo)
+		if err != nil {
+			return err
+		}
+		memo := string(data)
+		row.Memo = &memo
+	}
+	if len(record.SearchAttributes) > 0 {
+		data, err := json.Marshal(record.SearchAttributes)
+		if err != nil {
+			return err
+		}
+		searchAttributes := string(data)
+		row.SearchAttributes = &searchAttributes
+	}
+	_, err := w.writer.Write([]visibilityParquetRow{row})
+	return err
+}
+
+// Close flushes the buffered rows and writes the file footer. It doesn't close the underlying
+// writer.
+func (w *visibilityParquetWriter) Close() error {
+	return w.writer.Close()
+}
+
+func openVisibilityParquetReader(filePath string) (_ *visibilityParquetReader, retErr error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = f.Close()
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	file, err := parquet.OpenFile(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	return &visibilityParquetReader{
+		file:    f,
+		reader:  parquet.NewGenericReader[visibilityParquetRow](file),
+		encoder: codec.NewJSONPBEncoder(),
+	}, nil
+}
+
+// Next returns the next record, or nil after the last one.
+func (r *visibilityParquetReader) Next() (*archiverspb.VisibilityRecord, error) {
+	if r.pos == len(r.batch) {
+		if r.eof {
+			return nil, nil
+		}
+		r.batch = r.batch[:cap(r.batch)]
+		if len(r.batch) == 0 {
+			r.batch = make([]visibilityParquetRow, visibilityParquetReadBatchSize)
+		}
+		n, err := r.reader.Read(r.batch)
+		if errors.Is(err, io.EOF) {
+			r.eof = true
+		} else if err != nil {
+			return nil, err
+		}
+		r.batch, r.pos = r.batch[:n], 0
+		if n == 0 {
+			return nil, nil
+		}
+	}
+	row := &r.batch[r.pos]
+	r.pos++
+	return r.toRecord(row)
+}
+
+func (r *visibilityParquetReader) toRecord(row *visibilityParquetRow) (*archiverspb.VisibilityRecord, error) {
+	status, err := enumspb.WorkflowExecutionStatusFromString(row.Status)
+	if err != nil {
+		return nil, err
+	}
+	record := &archiverspb.VisibilityRecord{
+		NamespaceId:        row.NamespaceID,
+		Namespace:          row.Namespace,
+		WorkflowId:         row.WorkflowID,
+		RunId:              row.RunID,
+		WorkflowTypeName:   row.WorkflowTypeName,
+		StartTime:          timestampFromParquet(row.StartTime),
+		ExecutionTime:      timestampFromParquet(row.ExecutionTime),
+		CloseTime:          timestampFromParquet(&row.CloseTime),
+		Status:             status,
+		HistoryLength:      row.HistoryLength,
+		HistoryArchivalUri: row.HistoryArchivalURI,
+	}
+	if row.ExecutionDurationNs != nil {
+		record.ExecutionDuration = durationpb.New(time.Duration(*row.ExecutionDurationNs))
+	}
+	if row.Memo != nil {
+		record.Memo = &commonpb.Memo{}
+		if err := r.encoder.Decode([]byte(*row.Memo), record.Memo); err != nil {
+			return nil, fmt.Errorf("failed to decode memo: %w", err)
+		}
+	}
+	if row.SearchAttributes != nil {
+		if err := json.Unmarshal([]byte(*row.SearchAttributes), &record.SearchAttributes); err != nil {
+			return nil, fmt.Errorf("failed to decode search attributes: %w", err)
+		}
+	}
+	return record, nil
+}
+
+func (r *visibilityParquetReader) Close() error {
+	return errors.Join(r.reader.Close(), r.file.Close())
+}
+
+func timestampToParquet(ts *timestamppb.Timestamp) *int64 {
+	if ts == nil {
+		return nil
+	}
+	v := ts.AsTime().UnixNano()
+	return &v
+}
+
+func timestampFromParquet(v *int64) *timestamppb.Timestamp {
+	if v == nil {
+		return nil
+	}
+	return timestamppb.New(time.Unix(0, *v).UTC())
+}
@@ -0,0 +1,149 @@
+package filestore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVisibilityParquet_RoundTrip(t *testing.T) {
+	closeTime := time.Date(2020, 1, 21, 10, 0, 0, 123, time.UTC)
+	records := []*archiverspb.VisibilityRecord{
+		{
+			NamespaceId:       testNamespaceID,
+			Namespace:         testNamespace,
+			WorkflowId:        testWorkflowID,
+			RunId:             testRunID,
+			WorkflowTypeName:  testWorkflowTypeName,
+			StartTime:         timestamppb.New(closeTime.Add(-time.Hour)),
+			ExecutionTime:     timestamppb.New(closeTime.Add(-time.Hour)),
+			CloseTime:         timestamppb.New(closeTime),
+			ExecutionDuration: durationpb.New(time.Hour),
+			Status:            enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			HistoryLength:     101,
+			Memo: &commonpb.Memo{
+				Fields: map[string]*commonpb.Payload{
+					"key": payload.EncodeString("value"),
+				},
+			},
+			SearchAttributes: map[string]string{
+				"CustomKeywordField": `"keyword"`,
+			},
+			HistoryArchivalUri: "file:///history",
+		},
+		{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       "other workflow ID",
+			RunId:            "other run ID",
+			WorkflowTypeName: testWorkflowTypeName,
+			CloseTime:        timestamppb.New(closeTime.Add(time.Minute)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}
+	// more than one batch and row group
+	for i := range visibilityParquetRowGroupSize + 1 {
+		records = append(records, &archiverspb.VisibilityRecord{
+			RunId:     fmt.Sprintf("run %d", i),
+			CloseTime: timestamppb.New(closeTime),
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		})
+	}
+
+	filePath := writeTestParquetFile(t, records)
+	reader, err := openVisibilityParquetReader(filePath)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reader.Close()) }()
+	for i := range records {
+		record, err := reader.Next()
+		require.NoError(t, err)
+		protorequire.ProtoEqual(t, records[i], record)
+	}
+	record, err := reader.Next()
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
+
+func TestVisibilityParquet_Empty(t *testing.T) {
+	reader, err := openVisibilityParquetReader(writeTestParquetFile(t, nil))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, reader.Close()) }()
+	record, err := reader.Next()
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
+
+func TestVisibilityParquet_InvalidFile(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "invalid.parquet")
+	require.NoError(t, os.WriteFile(filePath, []byte("not a parquet file"), 0o644))
+	_, err := openVisibilityParquetReader(filePath)
+	require.Error(t, err)
+}
+
+// Analytics tools read the files without knowing about visibilityParquetRow, check the schema
+// they see.
+func TestVisibilityParquet_Schema(t *testing.T) {
+	filePath := writeTestParquetFile(t, []*archiverspb.VisibilityRecord{{
+		RunId:     testRunID,
+		CloseTime: timestamppb.New(time.Unix(0, 10)),
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}})
+	f, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+	info, err := f.Stat()
+	require.NoError(t, err)
+	file, err := parquet.OpenFile(f, info.Size())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, file.NumRows())
+
+	schema := file.Schema()
+	closeTime, ok := schema.Lookup("close_time")
+	require.True(t, ok)
+	require.Equal(t, parquet.Int64, closeTime.Node.Type().Kind())
+	require.IsType(t, &format.TimestampType{}, closeTime.Node.Type().LogicalType().Value)
+	require.False(t, closeTime.Node.Optional())
+	startTime, ok := schema.Lookup("start_time")
+	require.True(t, ok)
+	require.True(t, startTime.Node.Optional())
+	runID, ok := schema.Lookup("run_id")
+	require.True(t, ok)
+	require.IsType(t, &format.StringType{}, runID.Node.Type().LogicalType().Value)
+
+	rows := make([]parquet.Row, 1)
+	n, err := parquet.NewReader(file).ReadRows(rows)
+	require.Equal(t, 1, n)
+	if !errors.Is(err, io.EOF) {
+		require.NoError(t, err)
+	}
+	require.Equal(t, testRunID, rows[0][runID.ColumnIndex].String())
+	require.Equal(t, int64(10), rows[0][closeTime.ColumnIndex].Int64())
+}
+
+func writeTestParquetFile(t *testing.T, records []*archiverspb.VisibilityRecord) string {
+	filePath := path.Join(t.TempDir(), "test.parquet")
+	f, err := os.Create(filePath)
+	require.NoError(t, err)
+	writer := newVisibilityParquetWriter(f)
+	for _, record := range records {
+		require.NoError(t, writer.Write(record))
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, f.Close())
+	return filePath
+}
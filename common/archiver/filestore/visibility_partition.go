@@ -0,0 +1,545 @@
+package filestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+// In parquet format, visibility records of a namespace are laid out in hive style close date
+// partitions so that analytics tools can read the archive directly:
+//
+//	<URI path>/<namespaceID>/closeDate=2006-01-02/part-<hash>.parquet
+//
+// Archive stages each record as a JSON file in its partition, which keeps archival lossless.
+// Once a day is over, the staged records of its partition are merged into a parquet file in the
+// background. Parquet files hold their records in query order, so Query merges the parquet files
+// and staged records of the partitions overlapping the queried close time range as streams.
+
+const (
+	visibilityFormatJSON    = "json"
+	visibilityFormatParquet = "parquet"
+
+	visibilityFileSuffix = ".visibility"
+	parquetFileSuffix    = ".parquet"
+	partitionDirPrefix   = "closeDate="
+	partitionDateLayout  = "2006-01-02"
+
+	// sealCheckInterval limits how often a namespace directory is scanned for partitions to seal.
+	sealCheckInterval = time.Minute
+)
+
+var errInvalidVisibilityFormat = errors.New("invalid visibility format")
+
+type (
+	visibilityPartition struct {
+		// dir is the partition directory, empty if the date only has records in the legacy layout
+		dir string
+		// legacyFiles are records of this date archived in json format before switching to
+		// parquet, in the namespace directory legacyDir
+		legacyDir   string
+		legacyFiles []string
+	}
+
+	partitionRecord struct {
+		record      *archiverspb.VisibilityRecord
+		closeTime   time.Time
+		hashedRunID string
+	}
+
+	// partitionRecordIterator returns the records of a source in query order, and nil after the
+	// last one.
+	partitionRecordIterator interface {
+		Next() (*partitionRecord, error)
+		Close() error
+	}
+
+	// visibilityFilesIterator reads visibility record files, which are sorted by name in query
+	// order.
+	visibilityFilesIterator struct {
+		paths []string
+	}
+
+	parquetFileIterator struct {
+		reader *visibilityParquetReader
+	}
+
+	// mergedPartitionIterator merges the records of several sources in query order and drops
+	// duplicates, which are records both staged and sealed after a failed seal or records that
+	// were archived again.
+	mergedPartitionIterator struct {
+		sources []partitionRecordIterator
+		heads   []*partitionRecord
+		// run IDs returned at the close time and hashed run ID of the last record
+		last     *partitionRecord
+		lastRuns map[string]struct{}
+	}
+)
+
+func constructPartitionDirName(closeTime time.Time) string {
+	return partitionDirPrefix + closeTime.UTC().Format(partitionDateLayout)
+}
+
+// scheduleSeal starts sealing the partitions of a namespace in the background, unless that was
+// done recently or is still in progress.
+func (v *visibilityArchiver) scheduleSeal(namespaceDirPath string) {
+	now := v.timeSource.Now().UTC()
+
+	v.sealLock.Lock()
+	defer v.sealLock.Unlock()
+
+	if lastCheck, ok := v.lastSealCheck[namespaceDirPath]; ok && now.Sub(lastCheck) < sealCheckInterval {
+		return
+	}
+	if _, ok := v.sealing[namespaceDirPath]; ok {
+		return
+	}
+	v.lastSealCheck[namespaceDirPath] = now
+	v.sealing[namespaceDirPath] = struct{}{}
+
+	go func() {
+		defer func() {
+			v.sealLock.Lock()
+			defer v.sealLock.Unlock()
+			delete(v.sealing, namespaceDirPath)
+		}()
+		v.sealPartitions(namespaceDirPath, now)
+	}()
+}
+
+// sealPartitions merges the staged records of every partition whose day was over at now into
+// parquet files. Failures are only logged: staged records are durable and sealing is retried
+// later.
+func (v *visibilityArchiver) sealPartitions(namespaceDirPath string, now time.Time) {
+	logger := log.With(v.logger, tag.Value(namespaceDirPath))
+	names, err := listFiles(namespaceDirPath)
+	if err != nil {
+		logger.Warn("Failed to list archived visibility partitions", tag.Error(err))
+		return
+	}
+	today := now.Format(partitionDateLayout)
+	for _, name := range names {
+		date, ok := strings.CutPrefix(name, partitionDirPrefix)
+		if !ok || date >= today {
+			continue
+		}
+		if err := v.sealPartition(path.Join(namespaceDirPath, name)); err != nil {
+			logger.Warn("Failed to seal archived visibility partition", tag.Key(name), tag.Error(err))
+		}
+	}
+}
+
+// sealPartition writes the staged records of a partition to a parquet file in query order,
+// reading one record at a time, and then removes them. The file is named after the set of staged
+// records and written to a temporary file that is renamed when complete. That makes sealing
+// idempotent: if the staged records were not all removed, sealing the same set again only
+// removes them, and sealing an overlapping set writes duplicates that Query ignores.
+func (v *visibilityArchiver) sealPartition(dirPath string) error {
+	names, err := listFiles(dirPath)
+	if err != nil {
+		return err
+	}
+	names = slices.DeleteFunc(names, func(name string) bool {
+		return strings.HasPrefix(name, ".") || !strings.HasSuffix(name, visibilityFileSuffix)
+	})
+	if len(names) == 0 {
+		return nil
+	}
+	stagedFiles, err := sortAndFilterFiles(names, nil)
+	if err != nil {
+		return err
+	}
+
+	filename := "part-" + hash(strings.Join(stagedFiles, ",")) + parquetFileSuffix
+	filePath := path.Join(dirPath, filename)
+	exists, err := fileExists(filePath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := v.writePartitionParquet(dirPath, filePath, stagedFiles); err != nil {
+			return err
+		}
+	}
+	for _, name := range stagedFiles {
+		if err := os.Remove(path.Join(dirPath, name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) writePartitionParquet(dirPath string, filePath string, stagedFiles []string) error {
+	// The temporary file name is unique so that concurrent seals don't write to the same file.
+	tmpPath := path.Join(dirPath, fmt.Sprintf(".%s.%d.tmp", path.Base(filePath), v.timeSource.Now().UnixNano()))
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, v.fileMode)
+	if err != nil {
+		return err
+	}
+	err = writeStagedRecords(f, dirPath, stagedFiles)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
+
+func writeStagedRecords(f *os.File, dirPath string, stagedFiles []string) error {
+	writer := newVisibilityParquetWriter(f)
+	for _, name := range stagedFiles {
+		record, err := readVisibilityRecordFile(path.Join(dirPath, name))
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// queryPartitions serves queries for the parquet format. Partitions are visited from the most
+// recent close date to the oldest and skipped entirely when outside the queried close time range.
+func (v *visibilityArchiver) queryPartitions(
+	_ context.Context,
+	URI archiver.URI,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var token *queryVisibilityToken
+	if request.nextPageToken != nil {
+		var err error
+		token, err = deserializeQueryVisibilityToken(request.nextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+	}
+
+	dirPath := path.Join(URI.Path(), request.namespaceID)
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	partitions, err := listPartitions(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	dates := make([]string, 0, len(partitions))
+	for date := range partitions {
+		dates = append(dates, date)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(dates)))
+
+	earliestDate := request.parsedQuery.earliestCloseTime.UTC().Format(partitionDateLayout)
+	latestDate := request.parsedQuery.latestCloseTime.UTC().Format(partitionDateLayout)
+	var tokenHashedRunID string
+	if token != nil {
+		tokenHashedRunID = hash(token.LastRunID)
+		latestDate = min(latestDate, token.LastCloseTime.UTC().Format(partitionDateLayout))
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, date := range dates {
+		if date > latestDate {
+			continue
+		}
+		if date < earliestDate {
+			break
+		}
+
+		done, err := v.queryPartition(partitions[date], token, tokenHashedRunID, request, saTypeMap, response)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		if done {
+			return response, nil
+		}
+	}
+	return response, nil
+}
+
+// queryPartition appends the records of a partition that match the query to response. It returns
+// true if the page is full or there are no more records to return.
+func (v *visibilityArchiver) queryPartition(
+	partition *visibilityPartition,
+	token *queryVisibilityToken,
+	tokenHashedRunID string,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+	response *archiver.QueryVisibilityResponse,
+) (_ bool, retErr error) {
+	records, err := openPartition(partition, token)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := records.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+
+	for {
+		r, err := records.Next()
+		if err != nil {
+			return false, err
+		}
+		if r == nil {
+			return false, nil
+		}
+		if token != nil && !partitionRecordBefore(r, token.LastCloseTime, tokenHashedRunID) {
+			continue
+		}
+		if r.closeTime.Before(request.parsedQuery.earliestCloseTime) {
+			return true, nil
+		}
+		if !matchQuery(r.record, request.parsedQuery) {
+			continue
+		}
+
+		executionInfo, err := convertToExecutionInfo(r.record, saTypeMap)
+		if err != nil {
+			return false, err
+		}
+		response.Executions = append(response.Executions, executionInfo)
+		if len(response.Executions) == request.pageSize {
+			encodedToken, err := serializeToken(&queryVisibilityToken{
+				LastCloseTime: r.closeTime,
+				LastRunID:     r.record.GetRunId(),
+			})
+			if err != nil {
+				return false, err
+			}
+			response.NextPageToken = encodedToken
+			return true, nil
+		}
+	}
+}
+
+// listPartitions groups the content of a namespace directory by close date.
+func listPartitions(dirPath string) (map[string]*visibilityPartition, error) {
+	names, err := listFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	partitions := make(map[string]*visibilityPartition)
+	getPartition := func(date string) *visibilityPartition {
+		if _, ok := partitions[date]; !ok {
+			partitions[date] = &visibilityPartition{}
+		}
+		return partitions[date]
+	}
+	for _, name := range names {
+		if date, ok := strings.CutPrefix(name, partitionDirPrefix); ok {
+			if _, err := time.Parse(partitionDateLayout, date); err != nil {
+				continue
+			}
+			getPartition(date).dir = path.Join(dirPath, name)
+			continue
+		}
+		if !strings.HasSuffix(name, visibilityFileSuffix) {
+			continue
+		}
+		closeTimeNanos, err := strconv.ParseInt(strings.SplitN(name, "_", 2)[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		date := timestamp.UnixOrZeroTime(closeTimeNanos).UTC().Format(partitionDateLayout)
+		partition := getPartition(date)
+		partition.legacyDir = dirPath
+		partition.legacyFiles = append(partition.legacyFiles, name)
+	}
+	return partitions, nil
+}
+
+// openPartition returns the records of a partition in query order, starting after token. That
+// is close time (desc) and hashed run ID (desc), the same order used for the json format.
+func openPartition(partition *visibilityPartition, token *queryVisibilityToken) (_ partitionRecordIterator, retErr error) {
+	var sources []partitionRecordIterator
+	defer func() {
+		if retErr != nil {
+			for _, source := range sources {
+				_ = source.Close()
+			}
+		}
+	}()
+
+	legacyFiles, err := newVisibilityFilesIterator(partition.legacyDir, partition.legacyFiles, token)
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, legacyFiles)
+	if partition.dir != "" {
+		names, err := listFiles(partition.dir)
+		if err != nil {
+			return nil, err
+		}
+		var stagedFiles []string
+		for _, name := range names {
+			switch {
+			case strings.HasPrefix(name, "."):
+			case strings.HasSuffix(name, visibilityFileSuffix):
+				stagedFiles = append(stagedFiles, name)
+			case strings.HasSuffix(name, parquetFileSuffix):
+				filePath := path.Join(partition.dir, name)
+				reader, err := openVisibilityParquetReader(filePath)
+				if err != nil {
+					return nil, fmt.Errorf("failed to open %s: %w", filePath, err)
+				}
+				sources = append(sources, &parquetFileIterator{reader: reader})
+			}
+		}
+		staged, err := newVisibilityFilesIterator(partition.dir, stagedFiles, token)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, staged)
+	}
+	return newMergedPartitionIterator(sources)
+}
+
+func newVisibilityFilesIterator(dirPath string, names []string, token *queryVisibilityToken) (*visibilityFilesIterator, error) {
+	names, err := sortAndFilterFiles(names, token)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = path.Join(dirPath, name)
+	}
+	return &visibilityFilesIterator{paths: paths}, nil
+}
+
+func (it *visibilityFilesIterator) Next() (*partitionRecord, error) {
+	if len(it.paths) == 0 {
+		return nil, nil
+	}
+	record, err := readVisibilityRecordFile(it.paths[0])
+	if err != nil {
+		return nil, err
+	}
+	it.paths = it.paths[1:]
+	return newPartitionRecord(record), nil
+}
+
+func (it *visibilityFilesIterator) Close() error {
+	return nil
+}
+
+func (it *parquetFileIterator) Next() (*partitionRecord, error) {
+	record, err := it.reader.Next()
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return newPartitionRecord(record), nil
+}
+
+func (it *parquetFileIterator) Close() error {
+	return it.reader.Close()
+}
+
+func newMergedPartitionIterator(sources []partitionRecordIterator) (*mergedPartitionIterator, error) {
+	it := &mergedPartitionIterator{
+		sources:  sources,
+		heads:    make([]*partitionRecord, len(sources)),
+		lastRuns: make(map[string]struct{}),
+	}
+	for i, source := range sources {
+		head, err := source.Next()
+		if err != nil {
+			return nil, err
+		}
+		it.heads[i] = head
+	}
+	return it, nil
+}
+
+func (it *mergedPartitionIterator) Next() (*partitionRecord, error) {
+	for {
+		next := -1
+		for i, head := range it.heads {
+			if head != nil && (next < 0 || partitionRecordBefore(it.heads[next], head.closeTime, head.hashedRunID)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			return nil, nil
+		}
+		r := it.heads[next]
+		head, err := it.sources[next].Next()
+		if err != nil {
+			return nil, err
+		}
+		it.heads[next] = head
+
+		// Copies of a record have the same position in query order, so they come one after
+		// another.
+		if it.last == nil || !it.last.closeTime.Equal(r.closeTime) || it.last.hashedRunID != r.hashedRunID {
+			clear(it.lastRuns)
+		}
+		it.last = r
+		if _, ok := it.lastRuns[r.record.GetRunId()]; ok {
+			continue
+		}
+		it.lastRuns[r.record.GetRunId()] = struct{}{}
+		return r, nil
+	}
+}
+
+func (it *mergedPartitionIterator) Close() error {
+	var errs []error
+	for _, source := range it.sources {
+		errs = append(errs, source.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func newPartitionRecord(record *archiverspb.VisibilityRecord) *partitionRecord {
+	return &partitionRecord{
+		record:      record,
+		closeTime:   record.CloseTime.AsTime(),
+		hashedRunID: hash(record.GetRunId()),
+	}
+}
+
+// partitionRecordBefore reports whether r comes after the given position in query order.
+func partitionRecordBefore(r *partitionRecord, closeTime time.Time, hashedRunID string) bool {
+	if r.closeTime.Equal(closeTime) {
+		return r.hashedRunID < hashedRunID
+	}
+	return r.closeTime.Before(closeTime)
+}
+
+func readVisibilityRecordFile(filePath string) (*archiverspb.VisibilityRecord, error) {
+	data, err := readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return decodeVisibilityRecord(data)
+}
@@ -5,17 +5,20 @@ package gcloud
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/sqlquery"
 	"go.temporal.io/server/common/util"
 )
 
 type (
-	// QueryParser parses a limited SQL where clause into a struct
+	// QueryParser parses a List filter where clause into index hints and an in-memory filter
 	QueryParser interface {
-		Parse(query string) (*parsedQuery, error)
+		Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error)
 	}
 
 	queryParser struct{}
@@ -28,10 +31,12 @@ type (
 		searchPrecision *string
 		runID           *string
 		emptyResult     bool
+		// filter is applied to every record read from the index prefix selected by the fields above.
+		filter *archiver.VisibilityQueryFilter
 	}
 )
 
-// All allowed fields for filtering
+// All allowed fields for index-based filtering
 const (
 	WorkflowID      = "WorkflowId"
 	RunID           = "RunId"
@@ -54,151 +59,177 @@ func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
-func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+// Parse accepts the same query grammar as ListWorkflowExecutions. Top level "and" conditions on WorkflowId,
+// RunId and WorkflowType, and a StartTime or CloseTime combined with a SearchPrecision, are used to narrow down
+// the archived records to read. The whole query, except for SearchPrecision and the time it applies to, is then
+// evaluated in memory against each record.
+func (p *queryParser) Parse(query string, saTypeMap searchattribute.NameTypeMap) (*parsedQuery, error) {
 	stmt, err := sqlparser.Parse(fmt.Sprintf(sqlquery.QueryTemplate, query))
 	if err != nil {
 		return nil, err
 	}
 	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
-		return nil, err
-	}
-
-	if (parsedQuery.closeTime.IsZero() && parsedQuery.startTime.IsZero()) || (!parsedQuery.closeTime.IsZero() && !parsedQuery.startTime.IsZero()) {
-		return nil, errors.New("requires a StartTime or CloseTime")
+	if whereExpr == nil {
+		return nil, errors.New("where expression is nil")
 	}
 
-	if parsedQuery.searchPrecision == nil {
-		return nil, errors.New("SearchPrecision is required when searching for a StartTime or CloseTime")
+	parsedQuery := &parsedQuery{}
+	var filterExprs, timeExprs []sqlparser.Expr
+	for _, expr := range splitAndExpr(whereExpr) {
+		isTimeExpr, err := p.convertIndexExpr(expr, parsedQuery)
+		if err != nil {
+			return nil, err
+		}
+		if isTimeExpr {
+			timeExprs = append(timeExprs, expr)
+		} else if !isSearchPrecisionExpr(expr) {
+			filterExprs = append(filterExprs, expr)
+		}
 	}
 
-	return parsedQuery, nil
-}
-
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
+	if parsedQuery.searchPrecision != nil {
+		if (parsedQuery.closeTime.IsZero() && parsedQuery.startTime.IsZero()) || (!parsedQuery.closeTime.IsZero() && !parsedQuery.startTime.IsZero()) {
+			return nil, errors.New("requires a StartTime or CloseTime when searching with a SearchPrecision")
+		}
+	} else {
+		// Without a SearchPrecision, StartTime and CloseTime are regular filters.
+		parsedQuery.startTime = time.Time{}
+		parsedQuery.closeTime = time.Time{}
+		filterExprs = append(filterExprs, timeExprs...)
 	}
 
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
+	parsedQuery.filter, err = archiver.NewVisibilityQueryFilter(joinAndExpr(filterExprs), saTypeMap)
+	if err != nil {
+		return nil, err
 	}
+	return parsedQuery, nil
 }
 
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
+// convertIndexExpr extracts the index hints from a top level condition. It returns true if the condition is a
+// StartTime or CloseTime equality, which is only used as an index hint when a SearchPrecision is present.
+func (p *queryParser) convertIndexExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) (bool, error) {
+	compExpr, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return false, nil
 	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
 	colName, ok := compExpr.Left.(*sqlparser.ColName)
 	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
+		return false, nil
 	}
 	colNameStr := sqlparser.String(colName)
 	op := compExpr.Operator
 	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
 	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		if colNameStr == SearchPrecision {
+			return false, fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
+		}
+		return false, nil
 	}
 	valStr := sqlparser.String(valExpr)
 
-	switch colNameStr {
-	case WorkflowID:
+	if colNameStr == SearchPrecision {
 		val, err := sqlquery.ExtractStringValue(valStr)
 		if err != nil {
-			return err
+			return false, err
 		}
 		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowID)
+			return false, fmt.Errorf("only operation = is support for %s", SearchPrecision)
+		}
+		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
+			return false, fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+		}
+		switch val {
+		case PrecisionDay:
+		case PrecisionHour:
+		case PrecisionMinute:
+		case PrecisionSecond:
+		default:
+			return false, fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+		}
+		parsedQuery.searchPrecision = util.Ptr(val)
+		return false, nil
+	}
+
+	if op != "=" {
+		return false, nil
+	}
+
+	switch colNameStr {
+	case WorkflowID:
+		val, err := sqlquery.ExtractStringValue(valStr)
+		if err != nil {
+			return false, err
 		}
 		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
 			parsedQuery.emptyResult = true
-			return nil
+			return false, nil
 		}
 		parsedQuery.workflowID = util.Ptr(val)
 	case RunID:
 		val, err := sqlquery.ExtractStringValue(valStr)
 		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", RunID)
+			return false, err
 		}
 		if parsedQuery.runID != nil && *parsedQuery.runID != val {
 			parsedQuery.emptyResult = true
-			return nil
+			return false, nil
 		}
 		parsedQuery.runID = util.Ptr(val)
-	case CloseTime:
-		closeTime, err := sqlquery.ConvertToTime(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
-		parsedQuery.closeTime = closeTime
-
-	case StartTime:
-		startTime, err := sqlquery.ConvertToTime(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", CloseTime)
-		}
-		parsedQuery.startTime = startTime
 	case WorkflowType:
 		val, err := sqlquery.ExtractStringValue(valStr)
 		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", WorkflowType)
+			return false, err
 		}
 		if parsedQuery.workflowType != nil && *parsedQuery.workflowType != val {
 			parsedQuery.emptyResult = true
-			return nil
+			return false, nil
 		}
 		parsedQuery.workflowType = util.Ptr(val)
-	case SearchPrecision:
-		val, err := sqlquery.ExtractStringValue(valStr)
+	case CloseTime:
+		closeTime, err := sqlquery.ConvertToTime(valStr)
 		if err != nil {
-			return err
+			return false, err
 		}
-		if op != "=" {
-			return fmt.Errorf("only operation = is support for %s", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
-		}
-		switch val {
-		case PrecisionDay:
-		case PrecisionHour:
-		case PrecisionMinute:
-		case PrecisionSecond:
-		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+		parsedQuery.closeTime = closeTime
+		return true, nil
+	case StartTime:
+		startTime, err := sqlquery.ConvertToTime(valStr)
+		if err != nil {
+			return false, err
 		}
-		parsedQuery.searchPrecision = util.Ptr(val)
+		parsedQuery.startTime = startTime
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func isSearchPrecisionExpr(expr sqlparser.Expr) bool {
+	compExpr, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return false
+	}
+	colName, ok := compExpr.Left.(*sqlparser.ColName)
+	return ok && sqlparser.String(colName) == SearchPrecision
+}
+
+// splitAndExpr returns the top level conditions of an "and" expression.
+func splitAndExpr(expr sqlparser.Expr) []sqlparser.Expr {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return append(splitAndExpr(expr.Left), splitAndExpr(expr.Right)...)
+	case *sqlparser.ParenExpr:
+		return splitAndExpr(expr.Expr)
 	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		return []sqlparser.Expr{expr}
 	}
+}
 
-	return nil
+// joinAndExpr builds a where clause that is the conjunction of the given conditions.
+func joinAndExpr(exprs []sqlparser.Expr) string {
+	conditions := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		conditions = append(conditions, "("+sqlparser.String(expr)+")")
+	}
+	return strings.Join(conditions, " and ")
 }
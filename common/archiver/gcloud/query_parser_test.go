@@ -0,0 +1,129 @@
+package gcloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+type queryParserSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	parser QueryParser
+}
+
+func TestQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(queryParserSuite))
+}
+
+func (s *queryParserSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.parser = NewQueryParser()
+}
+
+func (s *queryParserSuite) TestParseIndexFields() {
+	testCases := []struct {
+		query       string
+		expectErr   bool
+		parsedQuery *parsedQuery
+	}{
+		{
+			query: "WorkflowId = 'random workflowID' and RunId = 'random runID' and WorkflowType = 'random type'",
+			parsedQuery: &parsedQuery{
+				workflowID:   util.Ptr("random workflowID"),
+				runID:        util.Ptr("random runID"),
+				workflowType: util.Ptr("random type"),
+			},
+		},
+		{
+			query: "WorkflowId = 'random workflowID' and WorkflowId = 'another workflowID'",
+			parsedQuery: &parsedQuery{
+				workflowID:  util.Ptr("random workflowID"),
+				emptyResult: true,
+			},
+		},
+		{
+			query: "CloseTime = '2019-01-01T11:11:11Z' and SearchPrecision = 'Day'",
+			parsedQuery: &parsedQuery{
+				closeTime:       time.Date(2019, 1, 1, 11, 11, 11, 0, time.UTC),
+				searchPrecision: util.Ptr(PrecisionDay),
+			},
+		},
+		{
+			query: "(StartTime = 1000 and SearchPrecision = 'Hour')",
+			parsedQuery: &parsedQuery{
+				startTime:       time.Unix(0, 1000),
+				searchPrecision: util.Ptr(PrecisionHour),
+			},
+		},
+		{
+			query:       "CloseTime = '2019-01-01T11:11:11Z'",
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:       "WorkflowId = 'random workflowID' or WorkflowType = 'random type'",
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:     "SearchPrecision = 'Day'",
+			expectErr: true,
+		},
+		{
+			query:     "CloseTime = 1000 and StartTime = 1000 and SearchPrecision = 'Day'",
+			expectErr: true,
+		},
+		{
+			query:     "CloseTime = 1000 and SearchPrecision = 'Week'",
+			expectErr: true,
+		},
+		{
+			query:     "UnknownField = 'value'",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.parsedQuery.workflowID, parsedQuery.workflowID, tc.query)
+		s.Equal(tc.parsedQuery.runID, parsedQuery.runID, tc.query)
+		s.Equal(tc.parsedQuery.workflowType, parsedQuery.workflowType, tc.query)
+		s.True(tc.parsedQuery.closeTime.Equal(parsedQuery.closeTime), tc.query)
+		s.True(tc.parsedQuery.startTime.Equal(parsedQuery.startTime), tc.query)
+		s.Equal(tc.parsedQuery.searchPrecision, parsedQuery.searchPrecision, tc.query)
+		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult, tc.query)
+	}
+}
+
+func (s *queryParserSuite) TestParseFilter() {
+	execution := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "order-1", RunId: "run-1"},
+		Type:      &commonpb.WorkflowType{Name: "OrderWorkflow"},
+	}
+	testCases := []struct {
+		query       string
+		expectMatch bool
+	}{
+		{query: "WorkflowType = 'OrderWorkflow' and WorkflowId STARTS_WITH 'order-'", expectMatch: true},
+		{query: "WorkflowType = 'OrderWorkflow' and RunId != 'run-1'", expectMatch: false},
+		{query: "WorkflowId IN ('order-1', 'order-2') or RunId = 'run-2'", expectMatch: true},
+		{query: "NOT (WorkflowId = 'order-1')", expectMatch: false},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
+		s.NoError(err, tc.query)
+		s.Equal(tc.expectMatch, parsedQuery.filter.Match(execution), tc.query)
+	}
+}
@@ -152,7 +152,7 @@ func (v *visibilityArchiver) Query(
 		return v.queryAll(ctx, URI, request, saTypeMap)
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 	}
@@ -236,41 +236,49 @@ func (v *visibilityArchiver) queryPrefix(ctx context.Context, uri archiver.URI,
 		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
 	}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, uri, prefix, request.pageSize, token.Offset, filters)
-	if err != nil {
-		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
-	}
-
+	// Records that don't match the in-memory filter are skipped, so keep reading until the page is full.
 	response := &archiver.QueryVisibilityResponse{}
-	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, uri, fmt.Sprintf("%s/%s", request.namespaceID, filepath.Base(file)))
+	for {
+		filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, uri, prefix, request.pageSize-len(response.Executions), token.Offset, filters)
 		if err != nil {
 			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
-		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
-		}
+		for _, file := range filenames {
+			encodedRecord, err := v.gcloudStorage.Get(ctx, uri, fmt.Sprintf("%s/%s", request.namespaceID, filepath.Base(file)))
+			if err != nil {
+				return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			}
 
-		executionInfo, err := convertToExecutionInfo(record, saTypeMap)
-		if err != nil {
-			return nil, serviceerror.NewInternal(err.Error())
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+			}
+
+			executionInfo, err := convertToExecutionInfo(record, saTypeMap)
+			if err != nil {
+				return nil, serviceerror.NewInternal(err.Error())
+			}
+			if !request.parsedQuery.filter.Match(executionInfo) {
+				continue
+			}
+			response.Executions = append(response.Executions, executionInfo)
 		}
-		response.Executions = append(response.Executions, executionInfo)
-	}
 
-	if !completed {
-		newToken := &queryVisibilityToken{
-			Offset: currentCursorPos,
+		if completed {
+			return response, nil
 		}
-		encodedToken, err := serializeToken(newToken)
-		if err != nil {
-			return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+		token.Offset = currentCursorPos
+		if len(response.Executions) >= request.pageSize {
+			break
 		}
-		response.NextPageToken = encodedToken
 	}
 
+	encodedToken, err := serializeToken(token)
+	if err != nil {
+		return nil, &serviceerror.InvalidArgument{Message: err.Error()}
+	}
+	response.NextPageToken = encodedToken
 	return response, nil
 }
 
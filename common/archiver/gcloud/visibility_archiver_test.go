@@ -186,7 +186,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	s.NoError(err)
 
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(ctx, URI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -209,7 +209,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
 	startTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
 	closeTime := startTime.Add(time.Hour)
 	precision := PrecisionDay
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		startTime:       startTime,
 		searchPrecision: &precision,
@@ -241,7 +241,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	mockParser := NewMockQueryParser(s.controller)
 	dayPrecision := "Day"
 	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		searchPrecision: &dayPrecision,
 		workflowType:    util.Ptr("MobileOnlyWorkflow::processMobileOnly"),
@@ -284,7 +284,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	mockParser := NewMockQueryParser(s.controller)
 	dayPrecision := "Day"
 	closeTime, _ := time.Parse(time.RFC3339, "2019-10-04T11:00:00+00:00")
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       closeTime,
 		searchPrecision: &dayPrecision,
 		workflowType:    util.Ptr("MobileOnlyWorkflow::processMobileOnly"),
@@ -439,3 +439,39 @@ func (s *visibilityArchiverSuite) TestQuery_EmptyQuery_Pagination() {
 	}
 	s.Len(executions, 2, "there should be exactly 2 unique executions")
 }
+
+func (s *visibilityArchiverSuite) TestQuery_Success_InMemoryFilter() {
+	URI, err := archiver.NewURI("gs://my-bucket-cad/temporal_archival/visibility")
+	s.NoError(err)
+	storageWrapper := connector.NewMockClient(s.controller)
+	storageWrapper.EXPECT().Exist(gomock.Any(), URI, gomock.Any()).Return(true, nil)
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, gomock.Any(), 1, 0, gomock.Any()).Return(
+		[]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"},
+		false,
+		1,
+		nil,
+	)
+	storageWrapper.EXPECT().QueryWithFilters(gomock.Any(), URI, gomock.Any(), 1, 1, gomock.Any()).Return(
+		[]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id2_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"},
+		true,
+		2,
+		nil,
+	)
+	storageWrapper.EXPECT().Get(gomock.Any(), URI,
+		"test-namespace-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility",
+	).Return([]byte(exampleVisibilityRecord), nil)
+	storageWrapper.EXPECT().Get(gomock.Any(), URI,
+		"test-namespace-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id2_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility",
+	).Return([]byte(exampleVisibilityRecord2), nil)
+
+	arc := newVisibilityArchiver(s.logger, s.metricsHandler, storageWrapper)
+	response, err := arc.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    1,
+		Query:       "WorkflowId IN ('test-workflow-id2', 'test-workflow-id3') AND ExecutionStatus = 'Completed'",
+	}, searchattribute.TestNameTypeMap())
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal("test-workflow-id2", response.Executions[0].GetExecution().GetWorkflowId())
+}
@@ -4,9 +4,11 @@ package provider
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/azblob"
 	"go.temporal.io/server/common/archiver/filestore"
 	"go.temporal.io/server/common/archiver/gcloud"
 	"go.temporal.io/server/common/archiver/s3store"
@@ -21,6 +23,10 @@ var (
 	ErrUnknownScheme = errors.New("unknown archiver scheme")
 	// ErrArchiverConfigNotFound is the error for unable to find the config for an archiver given scheme
 	ErrArchiverConfigNotFound = errors.New("unable to find archiver config for the given scheme")
+	// ErrBuiltInScheme is the error for registering a custom archiver for a scheme that is handled by a built-in archiver
+	ErrBuiltInScheme = errors.New("scheme is reserved for a built-in archiver")
+
+	builtInSchemes = []string{filestore.URIScheme, gcloud.URIScheme, s3store.URIScheme, azblob.URIScheme}
 )
 
 type (
@@ -31,11 +37,32 @@ type (
 		GetVisibilityArchiver(scheme string) (archiver.VisibilityArchiver, error)
 	}
 
+	// ArchiverFactoryParams are the dependencies passed to custom archiver factories.
+	ArchiverFactoryParams struct {
+		ExecutionManager persistence.ExecutionManager
+		Logger           log.Logger
+		MetricsHandler   metrics.Handler
+	}
+
+	// HistoryArchiverFactory creates the HistoryArchiver for a custom URI scheme.
+	HistoryArchiverFactory func(params ArchiverFactoryParams) (archiver.HistoryArchiver, error)
+
+	// VisibilityArchiverFactory creates the VisibilityArchiver for a custom URI scheme.
+	VisibilityArchiverFactory func(params ArchiverFactoryParams) (archiver.VisibilityArchiver, error)
+
+	// ArchiverFactories holds the factories of custom archivers keyed by URI scheme. Custom archivers
+	// are only consulted for schemes that are not handled by a built-in archiver.
+	ArchiverFactories struct {
+		History    map[string]HistoryArchiverFactory
+		Visibility map[string]VisibilityArchiverFactory
+	}
+
 	archiverProvider struct {
 		sync.RWMutex
 
 		historyArchiverConfigs    *config.HistoryArchiverProvider
 		visibilityArchiverConfigs *config.VisibilityArchiverProvider
+		customArchiverFactories   ArchiverFactories
 
 		executionManager persistence.ExecutionManager
 		logger           log.Logger
@@ -51,6 +78,7 @@ type (
 func NewArchiverProvider(
 	historyArchiverConfigs *config.HistoryArchiverProvider,
 	visibilityArchiverConfigs *config.VisibilityArchiverProvider,
+	customArchiverFactories ArchiverFactories,
 	executionManager persistence.ExecutionManager,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
@@ -58,6 +86,7 @@ func NewArchiverProvider(
 	return &archiverProvider{
 		historyArchiverConfigs:    historyArchiverConfigs,
 		visibilityArchiverConfigs: visibilityArchiverConfigs,
+		customArchiverFactories:   customArchiverFactories,
 		executionManager:          executionManager,
 		logger:                    logger,
 		metricsHandler:            metricsHandler,
@@ -93,8 +122,17 @@ func (p *archiverProvider) GetHistoryArchiver(scheme string) (historyArchiver ar
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(p.executionManager, p.logger, p.metricsHandler, p.historyArchiverConfigs.S3store)
+	case azblob.URIScheme:
+		if p.historyArchiverConfigs.Azblob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = azblob.NewHistoryArchiver(p.executionManager, p.logger, p.metricsHandler, p.historyArchiverConfigs.Azblob)
 	default:
-		return nil, ErrUnknownScheme
+		factory, ok := p.customArchiverFactories.History[scheme]
+		if !ok {
+			return nil, ErrUnknownScheme
+		}
+		historyArchiver, err = factory(p.factoryParams())
 	}
 
 	if err != nil {
@@ -137,9 +175,18 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.Visibi
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Gstorage)
+	case azblob.URIScheme:
+		if p.visibilityArchiverConfigs.Azblob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = azblob.NewVisibilityArchiver(p.logger, p.metricsHandler, p.visibilityArchiverConfigs.Azblob)
 
 	default:
-		return nil, ErrUnknownScheme
+		factory, ok := p.customArchiverFactories.Visibility[scheme]
+		if !ok {
+			return nil, ErrUnknownScheme
+		}
+		visibilityArchiver, err = factory(p.factoryParams())
 	}
 	if err != nil {
 		return nil, err
@@ -154,3 +201,24 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme string) (archiver.Visibi
 	return visibilityArchiver, nil
 
 }
+
+func (p *archiverProvider) factoryParams() ArchiverFactoryParams {
+	return ArchiverFactoryParams{
+		ExecutionManager: p.executionManager,
+		Logger:           p.logger,
+		MetricsHandler:   p.metricsHandler,
+	}
+}
+
+// Validate returns an error if a custom archiver is registered for a built-in scheme.
+func (f ArchiverFactories) Validate() error {
+	for _, scheme := range builtInSchemes {
+		if _, ok := f.History[scheme]; ok {
+			return fmt.Errorf("history archiver for %q: %w", scheme, ErrBuiltInScheme)
+		}
+		if _, ok := f.Visibility[scheme]; ok {
+			return fmt.Errorf("visibility archiver for %q: %w", scheme, ErrBuiltInScheme)
+		}
+	}
+	return nil
+}
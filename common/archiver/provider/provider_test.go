@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/filestore"
+	"go.temporal.io/server/common/archiver/s3store"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.uber.org/mock/gomock"
+)
+
+func TestArchiverProvider_CustomArchivers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+	visibilityArchiver := archiver.NewMockVisibilityArchiver(ctrl)
+
+	historyCalls := 0
+	logger := log.NewTestLogger()
+	p := NewArchiverProvider(
+		&config.HistoryArchiverProvider{},
+		&config.VisibilityArchiverProvider{},
+		ArchiverFactories{
+			History: map[string]HistoryArchiverFactory{
+				"custom": func(params ArchiverFactoryParams) (archiver.HistoryArchiver, error) {
+					historyCalls++
+					require.Equal(t, logger, params.Logger)
+					return historyArchiver, nil
+				},
+				"broken": func(ArchiverFactoryParams) (archiver.HistoryArchiver, error) {
+					return nil, errors.New("boom")
+				},
+			},
+			Visibility: map[string]VisibilityArchiverFactory{
+				"custom": func(ArchiverFactoryParams) (archiver.VisibilityArchiver, error) {
+					return visibilityArchiver, nil
+				},
+			},
+		},
+		nil,
+		logger,
+		metrics.NoopMetricsHandler,
+	)
+
+	h, err := p.GetHistoryArchiver("custom")
+	require.NoError(t, err)
+	require.Equal(t, historyArchiver, h)
+	_, err = p.GetHistoryArchiver("custom")
+	require.NoError(t, err)
+	require.Equal(t, 1, historyCalls, "archiver should be created once and cached")
+
+	v, err := p.GetVisibilityArchiver("custom")
+	require.NoError(t, err)
+	require.Equal(t, visibilityArchiver, v)
+
+	_, err = p.GetHistoryArchiver("broken")
+	require.EqualError(t, err, "boom")
+	_, err = p.GetVisibilityArchiver("broken")
+	require.ErrorIs(t, err, ErrUnknownScheme)
+	_, err = p.GetHistoryArchiver(filestore.URIScheme)
+	require.ErrorIs(t, err, ErrArchiverConfigNotFound)
+}
+
+func TestArchiverFactories_Validate(t *testing.T) {
+	require.NoError(t, ArchiverFactories{}.Validate())
+	require.NoError(t, ArchiverFactories{
+		History: map[string]HistoryArchiverFactory{"custom": nil},
+	}.Validate())
+	require.ErrorIs(t, ArchiverFactories{
+		History: map[string]HistoryArchiverFactory{s3store.URIScheme: nil},
+	}.Validate(), ErrBuiltInScheme)
+	require.ErrorIs(t, ArchiverFactories{
+		Visibility: map[string]VisibilityArchiverFactory{filestore.URIScheme: nil},
+	}.Validate(), ErrBuiltInScheme)
+}
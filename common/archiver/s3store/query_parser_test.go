@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/util"
 )
 
@@ -47,15 +50,23 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID:       util.Ptr("random workflowID"),
+				workflowTypeName: util.Ptr("random workflowTypeName"),
+			},
 		},
 		{
 			query:     "WorkflowId = \"random workflowID\" and WorkflowId = \"random workflowID\"",
-			expectErr: true,
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID: util.Ptr("random workflowID"),
+			},
 		},
 		{
-			query:     "RunId = \"random runID\"",
-			expectErr: true,
+			query:       "RunId = \"random runID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "WorkflowId = 'random workflowID'",
@@ -71,17 +82,27 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 				workflowID: util.Ptr("random workflowID"),
 			},
 		},
+		{
+			query:     "WorkflowType = \"random workflowTypeName\" and (WorkflowId = \"random workflowID\")",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID:       util.Ptr("random workflowID"),
+				workflowTypeName: util.Ptr("random workflowTypeName"),
+			},
+		},
 		{
 			query:     "runId = random workflowID",
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowId = \"random workflowID\" or WorkflowId = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
-			query:     "WorkflowId = \"random workflowID\" or runId = \"random runID\"",
-			expectErr: true,
+			query:       "WorkflowId != \"random workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
 		},
 		{
 			query:     "workflowid = \"random workflowID\"",
@@ -94,7 +115,7 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -149,10 +170,14 @@ func (s *queryParserSuite) TestParsePrecision() {
 			query:     commonQueryPart + "SearchPrecision = 'Invalid string'",
 			expectErr: true,
 		},
+		{
+			query:     "CloseTime = 1000 and SearchPrecision = 'Day'",
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -195,7 +220,7 @@ func (s *queryParserSuite) TestParseCloseTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -239,7 +264,7 @@ func (s *queryParserSuite) TestParseStartTime() {
 	}
 
 	for _, tc := range testCases {
-		parsedQuery, err := s.parser.Parse(tc.query)
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
 		if tc.expectErr {
 			s.Error(err)
 			continue
@@ -248,3 +273,46 @@ func (s *queryParserSuite) TestParseStartTime() {
 		s.Equal(tc.parsedQuery.closeTime, parsedQuery.closeTime)
 	}
 }
+
+func (s *queryParserSuite) TestParseFilter() {
+	execution := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "order-1", RunId: "run-1"},
+		Type:      &commonpb.WorkflowType{Name: "OrderWorkflow"},
+	}
+	testCases := []struct {
+		query         string
+		expectMatch   bool
+		expectIndexed bool
+	}{
+		{
+			query:         "WorkflowTypeName = 'OrderWorkflow' and WorkflowId STARTS_WITH 'order-'",
+			expectMatch:   true,
+			expectIndexed: true,
+		},
+		{
+			query:         "WorkflowTypeName = 'OrderWorkflow' and RunId = 'run-2'",
+			expectMatch:   false,
+			expectIndexed: true,
+		},
+		{
+			query:       "WorkflowId IN ('order-1', 'order-2') or RunId = 'run-2'",
+			expectMatch: true,
+		},
+		{
+			query:       "WorkflowId = 'order-1' or WorkflowId = 'order-2'",
+			expectMatch: true,
+		},
+		{
+			query:         "WorkflowId = 'order-1' and WorkflowId = 'order-2'",
+			expectMatch:   false,
+			expectIndexed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		parsedQuery, err := s.parser.Parse(tc.query, searchattribute.TestNameTypeMap())
+		s.NoError(err)
+		s.Equal(tc.expectIndexed, parsedQuery.workflowID != nil || parsedQuery.workflowTypeName != nil, tc.query)
+		s.Equal(tc.expectMatch, parsedQuery.filter.Match(execution), tc.query)
+	}
+}
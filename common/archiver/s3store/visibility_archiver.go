@@ -164,10 +164,20 @@ func (v *visibilityArchiver) Query(
 	}
 
 	if strings.TrimSpace(request.Query) == "" {
-		return v.queryAll(ctx, URI, request, saTypeMap)
+		return v.queryAll(
+			ctx,
+			URI,
+			&queryVisibilityRequest{
+				namespaceID:   request.NamespaceID,
+				pageSize:      request.PageSize,
+				nextPageToken: request.NextPageToken,
+				parsedQuery:   &parsedQuery{},
+			},
+			saTypeMap,
+		)
 	}
 
-	parsedQuery, err := v.queryParser.Parse(request.Query)
+	parsedQuery, err := v.queryParser.Parse(request.Query, saTypeMap)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
 	}
@@ -185,32 +195,56 @@ func (v *visibilityArchiver) Query(
 	)
 }
 
-// queryAll returns all workflow executions in the archive.
+// queryAll returns all workflow executions in the archive that match the in-memory filter of the query.
 func (v *visibilityArchiver) queryAll(
 	ctx context.Context,
 	uri archiver.URI,
-	request *archiver.QueryVisibilityRequest,
+	request *queryVisibilityRequest,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.namespaceID)
+	// We suffix searchPrefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
+	// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
+	// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
+	// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't have
+	// the primaryIndexValue when we make the call to query, so we can only specify the primaryIndexKey.
+	searchPrefix += "/" + primaryIndexKeyWorkflowTypeName
+	return v.queryPages(ctx, uri, request, saTypeMap, searchPrefix, func(key string) bool {
+		// We only want to return entries for the closeTimeout secondary index. Keys for this
+		// index are always of the form:
+		//   .../closeTimeout/<timestamp>/<runID>
+		// Walk from the end instead of splitting the whole string to avoid unnecessary
+		// allocations and to keep the logic clear:
+		//   - drop <runID>
+		//   - drop <timestamp>
+		//   - check the remaining last segment equals "closeTimeout".
+		dir := path.Dir(key) // drop runID
+		dir = path.Dir(dir)  // drop <timestamp>
+		return path.Base(dir) == secondaryIndexKeyCloseTimeout
+	})
+}
+
+// queryPages calls queryPrefix until pageSize workflow executions are found or there are no more keys to read.
+func (v *visibilityArchiver) queryPages(
+	ctx context.Context,
+	uri archiver.URI,
+	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
+	prefix string,
+	keyFilter func(key string) bool,
 ) (*archiver.QueryVisibilityResponse, error) {
 	// remaining is the number of workflow executions left to return before we reach pageSize.
-	remaining := request.PageSize
-	nextPageToken := request.NextPageToken
+	remaining := request.pageSize
+	nextPageToken := request.nextPageToken
 	var executions []*workflowpb.WorkflowExecutionInfo
 	// We need to loop because the number of workflow executions returned by each call to query may be fewer than
-	// pageSize. This is because we may have to skip some workflow executions after querying S3 (client-side filtering)
-	// because there are 2 entries in S3 for each workflow execution indexed by workflowTypeName (one for closeTimeout
-	// and one for startTimeout), and we only want to return one entry per workflow execution. See
-	// createIndexesToArchive for a list of all indexes.
+	// pageSize. This is because we may have to skip some workflow executions after querying S3 (client-side
+	// filtering), either because they don't match the query filter, or because there are 2 entries in S3 for each
+	// workflow execution indexed by workflowTypeName (one for closeTimeout and one for startTimeout), and we only
+	// want to return one entry per workflow execution. See createIndexesToArchive for a list of all indexes.
 	for {
-		searchPrefix := constructVisibilitySearchPrefix(uri.Path(), request.NamespaceID)
-		// We suffix searchPrefix with workflowTypeName because the data in S3 is duplicated across combinations of 2
-		// different primary indices (workflowID and workflowTypeName) and 2 different secondary indices (closeTimeout
-		// and startTimeout). We only want to return one entry per workflow execution, but the full path to the S3 key
-		// is <primaryIndexKey>/<primaryIndexValue>/<secondaryIndexKey>/<secondaryIndexValue>/<runID>, and we don't have
-		// the primaryIndexValue when we make the call to query, so we can only specify the primaryIndexKey.
-		searchPrefix += "/" + primaryIndexKeyWorkflowTypeName
 		// The pageSize we supply here is actually the maximum number of keys to fetch from S3. For each execution,
-		// there should be 2 keys in S3 for this prefix, so you might think that we should multiply the pageSize by 2.
+		// there may be 2 keys in S3 for this prefix, so you might think that we should multiply the pageSize by 2.
 		// However, if we do that, we may end up returning more than pageSize workflow executions to the end user of
 		// this API. This is because we aren't guaranteed that both keys for a given workflow execution will be returned
 		// in the same call. For example, if the user supplies a pageSize of 1, and we specify a maximum number of keys
@@ -219,23 +253,11 @@ func (v *visibilityArchiver) queryAll(
 		// need to make multiple calls to S3 to get the correct number of workflow executions, which will probably make
 		// this API call slower.
 		res, err := v.queryPrefix(ctx, uri, &queryVisibilityRequest{
-			namespaceID:   request.NamespaceID,
+			namespaceID:   request.namespaceID,
 			pageSize:      remaining,
 			nextPageToken: nextPageToken,
-			parsedQuery:   &parsedQuery{},
-		}, saTypeMap, searchPrefix, func(key string) bool {
-			// We only want to return entries for the closeTimeout secondary index. Keys for this
-			// index are always of the form:
-			//   .../closeTimeout/<timestamp>/<runID>
-			// Walk from the end instead of splitting the whole string to avoid unnecessary
-			// allocations and to keep the logic clear:
-			//   - drop <runID>
-			//   - drop <timestamp>
-			//   - check the remaining last segment equals "closeTimeout".
-			dir := path.Dir(key) // drop runID
-			dir = path.Dir(dir)  // drop <timestamp>
-			return path.Base(dir) == secondaryIndexKeyCloseTimeout
-		})
+			parsedQuery:   request.parsedQuery,
+		}, saTypeMap, prefix, keyFilter)
 		if err != nil {
 			return nil, err
 		}
@@ -258,6 +280,10 @@ func (v *visibilityArchiver) query(
 	request *queryVisibilityRequest,
 	saTypeMap searchattribute.NameTypeMap,
 ) (*archiver.QueryVisibilityResponse, error) {
+	if request.parsedQuery.workflowID == nil && request.parsedQuery.workflowTypeName == nil {
+		return v.queryAll(ctx, URI, request, saTypeMap)
+	}
+
 	primaryIndex := primaryIndexKeyWorkflowTypeName
 	primaryIndexValue := request.parsedQuery.workflowTypeName
 	if request.parsedQuery.workflowID != nil {
@@ -295,12 +321,12 @@ func (v *visibilityArchiver) query(
 		)
 	}
 
-	return v.queryPrefix(ctx, URI, request, saTypeMap, prefix, nil)
+	return v.queryPages(ctx, URI, request, saTypeMap, prefix, nil)
 }
 
-// queryPrefix returns all workflow executions in the archive that match the given prefix. The keyFilter function is an
-// optional filter that can be used to further filter the results. If keyFilter returns false for a given key, that key
-// will be skipped, and the object will not be downloaded from S3 or included in the results.
+// queryPrefix returns all workflow executions in the archive that match the given prefix and the query filter. The
+// keyFilter function is an optional filter that can be used to further filter the results. If keyFilter returns false
+// for a given key, that key will be skipped, and the object will not be downloaded from S3 or included in the results.
 func (v *visibilityArchiver) queryPrefix(
 	ctx context.Context,
 	uri archiver.URI,
@@ -355,6 +381,9 @@ func (v *visibilityArchiver) queryPrefix(
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
+		if !request.parsedQuery.filter.Match(executionInfo) {
+			continue
+		}
 		response.Executions = append(response.Executions, executionInfo)
 	}
 	return response, nil
@@ -232,7 +232,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidRequest() {
 func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("invalid query"))
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid query"))
 	visibilityArchiver.queryParser = mockParser
 	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
 		NamespaceID: "some random namespaceID",
@@ -246,7 +246,7 @@ func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
 func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID:      util.Ptr(testWorkflowID),
 		closeTime:       &time.Time{},
 		searchPrecision: util.Ptr(PrecisionSecond),
@@ -267,7 +267,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_DirectoryNotExist() {
 func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       util.Ptr(time.Unix(0, int64(1*time.Hour)).UTC()),
 		searchPrecision: util.Ptr(PrecisionHour),
 		workflowID:      util.Ptr(testWorkflowID),
@@ -293,7 +293,7 @@ func (s *visibilityArchiverSuite) TestQuery_Success_NoNextPageToken() {
 func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	visibilityArchiver := s.newTestVisibilityArchiver()
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		closeTime:       util.Ptr(time.Unix(0, 0).UTC()),
 		searchPrecision: util.Ptr(PrecisionDay),
 		workflowID:      util.Ptr(testWorkflowID),
@@ -509,7 +509,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 
 	for i, testData := range precisionTests {
 		mockParser := NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: util.Ptr(testData.precision),
 			workflowID:      util.Ptr(testWorkflowID),
@@ -522,7 +522,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:       util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision: util.Ptr(testData.precision),
 			workflowID:      util.Ptr(testWorkflowID),
@@ -535,7 +535,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			closeTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  util.Ptr(testData.precision),
 			workflowTypeName: util.Ptr(testWorkflowTypeName),
@@ -548,7 +548,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQueryPrecisions() {
 		s.Len(response.Executions, 2, "Iteration ", i)
 
 		mockParser = NewMockQueryParser(s.controller)
-		mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 			startTime:        util.Ptr(time.Date(2000, 1, testData.day, testData.hour, testData.minute, testData.second, 0, time.UTC)),
 			searchPrecision:  util.Ptr(testData.precision),
 			workflowTypeName: util.Ptr(testWorkflowTypeName),
@@ -572,7 +572,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	}
 
 	mockParser := NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowID: util.Ptr(testWorkflowID),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -603,7 +603,7 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 
 	mockParser = NewMockQueryParser(s.controller)
-	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+	mockParser.EXPECT().Parse(gomock.Any(), gomock.Any()).Return(&parsedQuery{
 		workflowTypeName: util.Ptr(testWorkflowTypeName),
 	}, nil).AnyTimes()
 	visibilityArchiver.queryParser = mockParser
@@ -634,6 +634,60 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[2])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Filter() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	URI, err := archiver.NewURI(testBucketURI + "/archive-and-query-filter")
+	s.NoError(err)
+	for _, record := range s.visibilityRecords {
+		err := visibilityArchiver.Archive(context.Background(), URI, (*archiverspb.VisibilityRecord)(record))
+		s.NoError(err)
+	}
+
+	testCases := []struct {
+		query    string
+		expected []*archiverspb.VisibilityRecord
+	}{
+		{
+			query:    "WorkflowTypeName = 'test-workflow-type' AND RunId = 'test-run-id1' AND CloseTime > '1970-01-01T02:00:00Z'",
+			expected: s.visibilityRecords[2:],
+		},
+		{
+			query:    "RunId = 'test-run-id1' AND ExecutionStatus = 'Failed'",
+			expected: s.visibilityRecords[1:],
+		},
+		{
+			query:    "WorkflowId STARTS_WITH 'test-workflow' AND (RunId = 'test-run-id' OR CloseTime >= '1970-01-01T03:00:00Z')",
+			expected: []*archiverspb.VisibilityRecord{s.visibilityRecords[0], s.visibilityRecords[2]},
+		},
+		{
+			query: "ExecutionStatus = 'Completed'",
+		},
+	}
+
+	for _, tc := range testCases {
+		request := &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    1,
+			Query:       tc.query,
+		}
+		var executions []*workflowpb.WorkflowExecutionInfo
+		first := true
+		for first || request.NextPageToken != nil {
+			response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap())
+			s.NoError(err, tc.query)
+			executions = append(executions, response.Executions...)
+			request.NextPageToken = response.NextPageToken
+			first = false
+		}
+		s.Len(executions, len(tc.expected), tc.query)
+		for i, record := range tc.expected {
+			ei, err := convertToExecutionInfo(record, searchattribute.TestNameTypeMap())
+			s.NoError(err)
+			s.Equal(ei, executions[i], tc.query)
+		}
+	}
+}
+
 func (s *visibilityArchiverSuite) setupVisibilityDirectory() {
 	s.visibilityRecords = []*archiverspb.VisibilityRecord{
 		{
@@ -0,0 +1,455 @@
+package archiver
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+type (
+	// VisibilityQueryFilter evaluates a ListWorkflowExecutions filter against archived workflow executions in memory.
+	// Archivers use it to filter the records left after pruning the search space with their own indexes.
+	// A nil *VisibilityQueryFilter matches every execution.
+	VisibilityQueryFilter struct {
+		predicate visibilityPredicate
+		saTypeMap searchattribute.NameTypeMap
+	}
+
+	// visibilityPredicate reports whether the given search attribute values (keyed by field name) match.
+	visibilityPredicate func(values map[string]any) bool
+
+	visibilityQueryConverter struct{}
+
+	// legacyFieldMapper resolves field names accepted by the archival query parsers before they supported
+	// the full List filter syntax.
+	legacyFieldMapper struct{}
+)
+
+var _ query.StoreQueryConverter[visibilityPredicate] = (*visibilityQueryConverter)(nil)
+var _ searchattribute.Mapper = (*legacyFieldMapper)(nil)
+
+var legacyFieldNames = map[string]string{
+	"WorkflowTypeName": sadefs.WorkflowType,
+}
+
+// NewVisibilityQueryFilter creates a VisibilityQueryFilter from the where clause of a List filter query.
+// It supports the same grammar as ListWorkflowExecutions: system and custom search attributes, comparison, IN,
+// STARTS_WITH, BETWEEN and IS NULL operators, and boolean combinations of them. An empty query returns a nil filter.
+func NewVisibilityQueryFilter(
+	queryString string,
+	saTypeMap searchattribute.NameTypeMap,
+) (*VisibilityQueryFilter, error) {
+	return NewNamespaceVisibilityQueryFilter(queryString, namespace.EmptyName, saTypeMap, &legacyFieldMapper{})
+}
+
+// NewNamespaceVisibilityQueryFilter is like NewVisibilityQueryFilter, but resolves custom search attribute aliases
+// of the namespace with the given mapper. Executions passed to Match must then carry search attributes keyed by
+// field name, the way history stores them.
+func NewNamespaceVisibilityQueryFilter(
+	queryString string,
+	nsName namespace.Name,
+	saTypeMap searchattribute.NameTypeMap,
+	saMapper searchattribute.Mapper,
+) (*VisibilityQueryFilter, error) {
+	if strings.TrimSpace(queryString) == "" {
+		return nil, nil
+	}
+
+	queryParams, err := query.NewQueryConverter(
+		&visibilityQueryConverter{},
+		nsName,
+		saTypeMap,
+		saMapper,
+	).Convert(queryString)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.OrderBy) > 0 || len(queryParams.GroupBy) > 0 {
+		return nil, query.NewConverterError(
+			"%s: 'ORDER BY' and 'GROUP BY' clauses are not supported in filters",
+			query.NotSupportedErrMessage,
+		)
+	}
+
+	return &VisibilityQueryFilter{
+		predicate: queryParams.QueryExpr,
+		saTypeMap: saTypeMap,
+	}, nil
+}
+
+// Match reports whether the execution matches the filter.
+func (f *VisibilityQueryFilter) Match(execution *workflowpb.WorkflowExecutionInfo) bool {
+	if f == nil || f.predicate == nil {
+		return true
+	}
+	return f.predicate(f.executionValues(execution))
+}
+
+func (f *VisibilityQueryFilter) executionValues(execution *workflowpb.WorkflowExecutionInfo) map[string]any {
+	// Search attributes that fail to decode are left out, which makes them behave like missing values.
+	values, _ := searchattribute.Decode(execution.GetSearchAttributes(), &f.saTypeMap, true)
+	if values == nil {
+		values = make(map[string]any)
+	}
+
+	values[sadefs.WorkflowID] = execution.GetExecution().GetWorkflowId()
+	values[sadefs.RunID] = execution.GetExecution().GetRunId()
+	values[sadefs.WorkflowType] = execution.GetType().GetName()
+	values[sadefs.ExecutionStatus] = execution.GetStatus().String()
+	values[sadefs.HistoryLength] = execution.GetHistoryLength()
+	if execution.GetStartTime() != nil {
+		values[sadefs.StartTime] = execution.GetStartTime().AsTime()
+	}
+	if execution.GetExecutionTime() != nil {
+		values[sadefs.ExecutionTime] = execution.GetExecutionTime().AsTime()
+	}
+	if execution.GetCloseTime() != nil {
+		values[sadefs.CloseTime] = execution.GetCloseTime().AsTime()
+	}
+	if execution.GetExecutionDuration() != nil {
+		values[sadefs.ExecutionDuration] = execution.GetExecutionDuration().AsDuration().Nanoseconds()
+	}
+	return values
+}
+
+func (m *legacyFieldMapper) GetAlias(fieldName string, _ string) (string, error) {
+	return fieldName, nil
+}
+
+func (m *legacyFieldMapper) GetFieldName(alias string, _ string) (string, error) {
+	if fieldName, ok := legacyFieldNames[alias]; ok {
+		return fieldName, nil
+	}
+	return alias, nil
+}
+
+func (c *visibilityQueryConverter) GetDatetimeFormat() string {
+	return time.RFC3339Nano
+}
+
+func (c *visibilityQueryConverter) BuildParenExpr(expr visibilityPredicate) (visibilityPredicate, error) {
+	return expr, nil
+}
+
+func (c *visibilityQueryConverter) BuildNotExpr(expr visibilityPredicate) (visibilityPredicate, error) {
+	return func(values map[string]any) bool {
+		return !expr(values)
+	}, nil
+}
+
+func (c *visibilityQueryConverter) BuildAndExpr(exprs ...visibilityPredicate) (visibilityPredicate, error) {
+	exprs = slices.DeleteFunc(exprs, func(expr visibilityPredicate) bool { return expr == nil })
+	return func(values map[string]any) bool {
+		for _, expr := range exprs {
+			if !expr(values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (c *visibilityQueryConverter) BuildOrExpr(exprs ...visibilityPredicate) (visibilityPredicate, error) {
+	exprs = slices.DeleteFunc(exprs, func(expr visibilityPredicate) bool { return expr == nil })
+	return func(values map[string]any) bool {
+		for _, expr := range exprs {
+			if expr(values) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func (c *visibilityQueryConverter) ConvertComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (visibilityPredicate, error) {
+	value, err := c.parseValue(col, value)
+	if err != nil {
+		return nil, err
+	}
+	return func(values map[string]any) bool {
+		actual, ok := values[col.FieldName]
+		if !ok || actual == nil {
+			return isNegationOperator(operator)
+		}
+		return compareValues(operator, actual, value)
+	}, nil
+}
+
+func (c *visibilityQueryConverter) ConvertKeywordComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (visibilityPredicate, error) {
+	switch operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		prefix, ok := value.(string)
+		if !ok {
+			return nil, query.NewConverterError(
+				"%s: right-hand side of '%s' must be a literal string (got: %v)",
+				query.InvalidExpressionErrMessage,
+				operator,
+				value,
+			)
+		}
+		negate := operator == sqlparser.NotStartsWithStr
+		return func(values map[string]any) bool {
+			actual, ok := values[col.FieldName].(string)
+			if !ok {
+				return negate
+			}
+			return strings.HasPrefix(actual, prefix) != negate
+		}, nil
+	default:
+		return c.ConvertComparisonExpr(operator, col, value)
+	}
+}
+
+func (c *visibilityQueryConverter) ConvertKeywordListComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (visibilityPredicate, error) {
+	var expected []any
+	switch v := value.(type) {
+	case []any:
+		expected = v
+	default:
+		expected = []any{v}
+	}
+	// For keyword lists, '=' and 'IN' match if the list contains any of the values.
+	negate := isNegationOperator(operator)
+	return func(values map[string]any) bool {
+		actual, ok := values[col.FieldName].([]string)
+		if !ok {
+			return negate
+		}
+		for _, e := range expected {
+			if slices.Contains(actual, e.(string)) {
+				return !negate
+			}
+		}
+		return negate
+	}, nil
+}
+
+func (c *visibilityQueryConverter) ConvertTextComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (visibilityPredicate, error) {
+	text, ok := value.(string)
+	if !ok {
+		return nil, query.NewConverterError(
+			"%s: right-hand side of '%s' must be a literal string (got: %v)",
+			query.InvalidExpressionErrMessage,
+			operator,
+			value,
+		)
+	}
+	// Approximate full text search: the value matches if it shares at least one case-insensitive token with the
+	// query text, which mirrors the default behaviour of the match query in Elasticsearch.
+	tokens := strings.Fields(strings.ToLower(text))
+	negate := isNegationOperator(operator)
+	return func(values map[string]any) bool {
+		actual, ok := values[col.FieldName].(string)
+		if !ok {
+			return negate
+		}
+		actualTokens := strings.Fields(strings.ToLower(actual))
+		for _, token := range tokens {
+			if slices.Contains(actualTokens, token) {
+				return !negate
+			}
+		}
+		return negate
+	}, nil
+}
+
+func (c *visibilityQueryConverter) ConvertRangeExpr(
+	operator string,
+	col *query.SAColumn,
+	from, to any,
+) (visibilityPredicate, error) {
+	from, err := c.parseValue(col, from)
+	if err != nil {
+		return nil, err
+	}
+	to, err = c.parseValue(col, to)
+	if err != nil {
+		return nil, err
+	}
+	var negate bool
+	switch operator {
+	case sqlparser.BetweenStr:
+	case sqlparser.NotBetweenStr:
+		negate = true
+	default:
+		return nil, query.NewConverterError(
+			"%s: unexpected operator '%s'",
+			query.InvalidExpressionErrMessage,
+			operator,
+		)
+	}
+	return func(values map[string]any) bool {
+		actual, ok := values[col.FieldName]
+		if !ok || actual == nil {
+			return negate
+		}
+		inRange := compareValues(sqlparser.GreaterEqualStr, actual, from) &&
+			compareValues(sqlparser.LessEqualStr, actual, to)
+		return inRange != negate
+	}, nil
+}
+
+func (c *visibilityQueryConverter) ConvertIsExpr(
+	operator string,
+	col *query.SAColumn,
+) (visibilityPredicate, error) {
+	var isNull bool
+	switch operator {
+	case sqlparser.IsNullStr:
+		isNull = true
+	case sqlparser.IsNotNullStr:
+	default:
+		return nil, query.NewConverterError(
+			"%s: 'IS' operator can only be used as 'IS NULL' or 'IS NOT NULL'",
+			query.InvalidExpressionErrMessage,
+		)
+	}
+	return func(values map[string]any) bool {
+		actual, ok := values[col.FieldName]
+		return (!ok || actual == nil) == isNull
+	}, nil
+}
+
+// parseValue converts the datetime strings produced by the query converter back to time.Time.
+func (c *visibilityQueryConverter) parseValue(col *query.SAColumn, value any) (any, error) {
+	switch v := value.(type) {
+	case []any:
+		parsed := make([]any, 0, len(v))
+		for _, item := range v {
+			item, err := c.parseValue(col, item)
+			if err != nil {
+				return nil, err
+			}
+			parsed = append(parsed, item)
+		}
+		return parsed, nil
+	case string:
+		if col.ValueType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return v, nil
+		}
+		tm, err := time.Parse(c.GetDatetimeFormat(), v)
+		if err != nil {
+			return nil, query.NewConverterError(
+				"%s: unable to parse datetime '%s'",
+				query.InvalidExpressionErrMessage,
+				v,
+			)
+		}
+		return tm, nil
+	default:
+		return v, nil
+	}
+}
+
+func isNegationOperator(operator string) bool {
+	switch operator {
+	case sqlparser.NotEqualStr, sqlparser.NotInStr, sqlparser.NotStartsWithStr:
+		return true
+	default:
+		return false
+	}
+}
+
+func compareValues(operator string, actual any, expected any) bool {
+	switch operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		expectedValues, ok := expected.([]any)
+		if !ok {
+			expectedValues = []any{expected}
+		}
+		found := slices.ContainsFunc(expectedValues, func(e any) bool {
+			return compareValues(sqlparser.EqualStr, actual, e)
+		})
+		return found == (operator == sqlparser.InStr)
+	}
+
+	result, ok := compareScalars(actual, expected)
+	if !ok {
+		return operator == sqlparser.NotEqualStr
+	}
+	switch operator {
+	case sqlparser.EqualStr:
+		return result == 0
+	case sqlparser.NotEqualStr:
+		return result != 0
+	case sqlparser.LessThanStr:
+		return result < 0
+	case sqlparser.GreaterThanStr:
+		return result > 0
+	case sqlparser.LessEqualStr:
+		return result <= 0
+	case sqlparser.GreaterEqualStr:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+// compareScalars returns the ordering of a and b, and false if they are not comparable.
+func compareScalars(a any, b any) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		return strings.Compare(a, b), ok
+	case time.Time:
+		b, ok := b.(time.Time)
+		return a.Compare(b), ok
+	case bool:
+		b, ok := b.(bool)
+		return cmp.Compare(boolToInt(a), boolToInt(b)), ok
+	case int64:
+		if b, ok := b.(int64); ok {
+			return cmp.Compare(a, b), true
+		}
+		b, ok := toFloat64(b)
+		return cmp.Compare(float64(a), b), ok
+	case float64:
+		b, ok := toFloat64(b)
+		return cmp.Compare(a, b), ok
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
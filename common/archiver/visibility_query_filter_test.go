@@ -0,0 +1,123 @@
+package archiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVisibilityQueryFilter(t *testing.T) {
+	saTypeMap := searchattribute.NewNameTypeMap(map[string]enumspb.IndexedValueType{
+		"CustomKeywordField":     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"CustomKeywordListField": enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		"CustomTextField":        enumspb.INDEXED_VALUE_TYPE_TEXT,
+		"CustomIntField":         enumspb.INDEXED_VALUE_TYPE_INT,
+		"CustomDoubleField":      enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		"CustomBoolField":        enumspb.INDEXED_VALUE_TYPE_BOOL,
+		"CustomDatetimeField":    enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	})
+	closeTime := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	searchAttributes, err := searchattribute.Encode(map[string]any{
+		"CustomKeywordField":     "order-123",
+		"CustomKeywordListField": []string{"red", "green"},
+		"CustomTextField":        "The quick brown fox",
+		"CustomIntField":         int64(42),
+		"CustomDoubleField":      1.5,
+		"CustomBoolField":        true,
+		"CustomDatetimeField":    closeTime.Add(-time.Hour),
+	}, &saTypeMap)
+	require.NoError(t, err)
+
+	execution := &workflowpb.WorkflowExecutionInfo{
+		Execution:         &commonpb.WorkflowExecution{WorkflowId: "wid-1", RunId: "rid-1"},
+		Type:              &commonpb.WorkflowType{Name: "order-workflow"},
+		StartTime:         timestamppb.New(closeTime.Add(-time.Minute)),
+		CloseTime:         timestamppb.New(closeTime),
+		ExecutionDuration: durationpb.New(time.Minute),
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		HistoryLength:     12,
+		SearchAttributes:  searchAttributes,
+	}
+
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{query: "WorkflowId = 'wid-1'", expected: true},
+		{query: "WorkflowId = 'wid-2'", expected: false},
+		{query: "WorkflowId != 'wid-2'", expected: true},
+		{query: "WorkflowType = 'order-workflow' AND RunId = 'rid-1'", expected: true},
+		{query: "WorkflowTypeName = 'order-workflow'", expected: true},
+		{query: "WorkflowId = 'wid-2' OR WorkflowType = 'order-workflow'", expected: true},
+		{query: "NOT (WorkflowId = 'wid-1')", expected: false},
+		{query: "WorkflowId IN ('wid-2', 'wid-1')", expected: true},
+		{query: "WorkflowId NOT IN ('wid-2', 'wid-1')", expected: false},
+		{query: "WorkflowId STARTS_WITH 'wid-'", expected: true},
+		{query: "WorkflowId NOT STARTS_WITH 'wid-'", expected: false},
+		{query: "ExecutionStatus = 'Completed'", expected: true},
+		{query: "ExecutionStatus = 'Failed'", expected: false},
+		{query: "HistoryLength > 10", expected: true},
+		{query: "HistoryLength BETWEEN 1 AND 11", expected: false},
+		{query: "ExecutionDuration = '1m'", expected: true},
+		{query: "CloseTime = '2024-03-01T10:00:00Z'", expected: true},
+		{query: "CloseTime > '2024-03-01T10:00:00Z'", expected: false},
+		{query: "CloseTime BETWEEN '2024-03-01T00:00:00Z' AND '2024-03-02T00:00:00Z'", expected: true},
+		{query: "StartTime NOT BETWEEN '2024-03-01T00:00:00Z' AND '2024-03-02T00:00:00Z'", expected: false},
+		{query: "ExecutionTime IS NULL", expected: true},
+		{query: "CloseTime IS NOT NULL", expected: true},
+		{query: "CustomKeywordField = 'order-123'", expected: true},
+		{query: "CustomKeywordField STARTS_WITH 'order-'", expected: true},
+		{query: "CustomKeywordListField = 'red'", expected: true},
+		{query: "CustomKeywordListField IN ('blue', 'green')", expected: true},
+		{query: "CustomKeywordListField != 'red'", expected: false},
+		{query: "CustomTextField = 'Fox'", expected: true},
+		{query: "CustomTextField = 'dog'", expected: false},
+		{query: "CustomIntField >= 42 AND CustomDoubleField < 2", expected: true},
+		{query: "CustomDoubleField = 1", expected: false},
+		{query: "CustomBoolField = true", expected: true},
+		{query: "CustomDatetimeField < '2024-03-01T10:00:00Z'", expected: true},
+		{query: "TemporalChangeVersion = 'v1'", expected: false},
+		{query: "TemporalChangeVersion != 'v1'", expected: true},
+		{query: "TemporalChangeVersion IS NULL", expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			filter, err := NewVisibilityQueryFilter(tc.query, saTypeMap)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, filter.Match(execution))
+		})
+	}
+}
+
+func TestVisibilityQueryFilter_Empty(t *testing.T) {
+	filter, err := NewVisibilityQueryFilter(" ", searchattribute.NameTypeMap{})
+	require.NoError(t, err)
+	require.Nil(t, filter)
+	require.True(t, filter.Match(&workflowpb.WorkflowExecutionInfo{}))
+}
+
+func TestVisibilityQueryFilter_Invalid(t *testing.T) {
+	saTypeMap := searchattribute.NewNameTypeMap(map[string]enumspb.IndexedValueType{
+		"CustomTextField": enumspb.INDEXED_VALUE_TYPE_TEXT,
+	})
+	for _, query := range []string{
+		"UnknownField = 'a'",
+		"WorkflowId = ",
+		"CustomTextField > 'a'",
+		"WorkflowId = 'a' ORDER BY CloseTime",
+		"ExecutionStatus = 'Unknown'",
+	} {
+		t.Run(query, func(t *testing.T) {
+			_, err := NewVisibilityQueryFilter(query, saTypeMap)
+			require.Error(t, err)
+		})
+	}
+}
@@ -17,6 +17,8 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
+	"go.temporal.io/server/common/tasktoken"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
@@ -90,6 +92,8 @@ type Interceptor struct {
 	authExtraHeaderName          string
 	exposeAuthorizerErrors       dynamicconfig.BoolPropertyFn
 	enableCrossNamespaceCommands dynamicconfig.BoolPropertyFn
+	sharedTaskQueues             dynamicconfig.TypedPropertyFn[[]sharedtaskqueue.Binding]
+	tokenSerializer              *tasktoken.Serializer
 }
 
 // NewInterceptor creates an authorization interceptor.
@@ -104,6 +108,7 @@ func NewInterceptor(
 	authExtraHeaderName string,
 	exposeAuthorizerErrors dynamicconfig.BoolPropertyFn,
 	enableCrossNamespaceCommands dynamicconfig.BoolPropertyFn,
+	sharedTaskQueues dynamicconfig.TypedPropertyFn[[]sharedtaskqueue.Binding],
 ) *Interceptor {
 	return &Interceptor{
 		claimMapper:                  claimMapper,
@@ -116,6 +121,8 @@ func NewInterceptor(
 		audienceGetter:               audienceGetter,
 		exposeAuthorizerErrors:       exposeAuthorizerErrors,
 		enableCrossNamespaceCommands: enableCrossNamespaceCommands,
+		sharedTaskQueues:             sharedTaskQueues,
+		tokenSerializer:              tasktoken.NewSerializer(),
 	}
 }
 
@@ -165,6 +172,11 @@ func (a *Interceptor) Intercept(
 		if err := a.authorizeTargetNamespaces(ctx, claims, namespace, req); err != nil {
 			return nil, err
 		}
+
+		// Authorize source namespaces of shared task queues
+		if err := a.authorizeSharedTaskQueueNamespaces(ctx, claims, namespace, req, info.FullMethod); err != nil {
+			return nil, err
+		}
 	}
 	return handler(ctx, req)
 }
@@ -338,3 +350,49 @@ func (a *Interceptor) authorizeTargetNamespaces(
 	}
 	return nil
 }
+
+// authorizeSharedTaskQueueNamespaces authorizes polls of shared task queues, and completions and
+// heartbeats of the activity tasks they dispatched. A shared task queue dispatches activity tasks of
+// several source namespaces, so the caller must be authorized in each of them, not just in the
+// shared namespace. The namespace validator makes sure that the namespace of the task token is one
+// of them.
+func (a *Interceptor) authorizeSharedTaskQueueNamespaces(
+	ctx context.Context,
+	claims *Claims,
+	sharedNamespace string,
+	req interface{},
+	apiName string,
+) error {
+	var sharedTaskQueue string
+	switch request := req.(type) {
+	case *workflowservice.PollActivityTaskQueueRequest:
+		sharedTaskQueue = request.GetTaskQueue().GetName()
+	default:
+		if !sharedtaskqueue.CompletesActivityTask(req) {
+			return nil
+		}
+		taskToken, err := a.tokenSerializer.Deserialize(req.(interface{ GetTaskToken() []byte }).GetTaskToken())
+		if err != nil {
+			// The namespace validator rejects the request.
+			return nil
+		}
+		sharedTaskQueue = taskToken.GetSharedTaskQueue()
+	}
+	if sharedTaskQueue == "" {
+		return nil
+	}
+	for _, sourceNamespace := range sharedtaskqueue.SourceNamespaces(
+		a.sharedTaskQueues(),
+		sharedNamespace,
+		sharedTaskQueue,
+	) {
+		if err := a.Authorize(ctx, claims, &CallTarget{
+			APIName:   apiName,
+			Namespace: sourceNamespace,
+			Request:   req,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
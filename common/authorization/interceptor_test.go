@@ -10,12 +10,16 @@ import (
 	commandpb "go.temporal.io/api/command/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
+	"go.temporal.io/server/common/tasktoken"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -84,6 +88,7 @@ func (s *authorizerInterceptorSuite) SetupTest() {
 		"",
 		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 	s.handler = func(ctx context.Context, req interface{}) (interface{}, error) { return true, nil }
 }
@@ -159,6 +164,7 @@ func (s *authorizerInterceptorSuite) TestAuthorizationFailedExposed() {
 		"",
 		dynamicconfig.GetBoolPropertyFn(true),  // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 
 	authErr := serviceerror.NewInternal("intentional test failure")
@@ -192,6 +198,7 @@ func (s *authorizerInterceptorSuite) TestNoopClaimMapperWithoutTLS() {
 		"",
 		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 	_, err := interceptor.Intercept(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
 	s.NoError(err)
@@ -209,6 +216,7 @@ func (s *authorizerInterceptorSuite) TestAlternateHeaders() {
 		"custom-extra-header",
 		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 
 	cases := []struct {
@@ -318,6 +326,7 @@ func (s *authorizerInterceptorSuite) newCrossNamespaceInterceptor(namespaces ...
 		"",
 		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(true),  // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 }
 
@@ -560,3 +569,174 @@ func (s *authorizerInterceptorSuite) TestMultipleTargetNamespaces() {
 	s.True(res.(bool))
 	s.NoError(err)
 }
+
+func (s *authorizerInterceptorSuite) newSharedTaskQueueInterceptor() *Interceptor {
+	return NewInterceptor(
+		s.mockClaimMapper,
+		s.mockAuthorizer,
+		s.mockMetricsHandler,
+		log.NewNoopLogger(),
+		multiNamespaceChecker([]string{testNamespace, targetNamespace, anotherNamespace}),
+		nil,
+		"",
+		"",
+		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
+		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding{
+			{Namespace: targetNamespace, TaskQueue: "tq-1", SharedNamespace: testNamespace, SharedTaskQueue: "shared"},
+			{Namespace: anotherNamespace, TaskQueue: "tq-2", SharedNamespace: testNamespace, SharedTaskQueue: "shared"},
+		}), // sharedTaskQueues
+	)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueuePoll_Authorized() {
+	request := &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: testNamespace,
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "shared"},
+	}
+	apiName := api.WorkflowServicePrefix + "PollActivityTaskQueue"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	for _, ns := range []string{testNamespace, targetNamespace, anotherNamespace} {
+		if ns != testNamespace {
+			s.mockMetricsHandler.EXPECT().WithTags(
+				metrics.OperationTag(metrics.AuthorizationScope),
+				metrics.NamespaceTag(ns),
+			).Return(s.mockMetricsHandler)
+		}
+		s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+			Namespace: ns, Request: request, APIName: apiName,
+		}).Return(Result{Decision: DecisionAllow}, nil)
+	}
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueuePoll_SourceNamespaceUnauthorized() {
+	request := &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: testNamespace,
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "shared"},
+	}
+	apiName := api.WorkflowServicePrefix + "PollActivityTaskQueue"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: testNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionAllow}, nil)
+	s.mockMetricsHandler.EXPECT().WithTags(
+		metrics.OperationTag(metrics.AuthorizationScope),
+		metrics.NamespaceTag(targetNamespace),
+	).Return(s.mockMetricsHandler)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: targetNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionDeny}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.Nil(res)
+	s.Error(err)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueuePoll_NotShared() {
+	request := &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: testNamespace,
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "tq-1"},
+	}
+	apiName := api.WorkflowServicePrefix + "PollActivityTaskQueue"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: testNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionAllow}, nil)
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueueActivityCompletion_Authorized() {
+	taskToken, err := tasktoken.NewSerializer().Serialize(&tokenspb.Task{
+		NamespaceId:     "target-namespace-id",
+		ActivityId:      "activity",
+		SharedTaskQueue: "shared",
+	})
+	s.NoError(err)
+	request := &workflowservice.RespondActivityTaskCompletedRequest{
+		Namespace: testNamespace,
+		TaskToken: taskToken,
+	}
+	apiName := api.WorkflowServicePrefix + "RespondActivityTaskCompleted"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	for _, ns := range []string{testNamespace, targetNamespace, anotherNamespace} {
+		if ns != testNamespace {
+			s.mockMetricsHandler.EXPECT().WithTags(
+				metrics.OperationTag(metrics.AuthorizationScope),
+				metrics.NamespaceTag(ns),
+			).Return(s.mockMetricsHandler)
+		}
+		s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+			Namespace: ns, Request: request, APIName: apiName,
+		}).Return(Result{Decision: DecisionAllow}, nil)
+	}
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueueActivityHeartbeat_SourceNamespaceUnauthorized() {
+	taskToken, err := tasktoken.NewSerializer().Serialize(&tokenspb.Task{
+		NamespaceId:     "target-namespace-id",
+		ActivityId:      "activity",
+		SharedTaskQueue: "shared",
+	})
+	s.NoError(err)
+	request := &workflowservice.RecordActivityTaskHeartbeatRequest{
+		Namespace: testNamespace,
+		TaskToken: taskToken,
+	}
+	apiName := api.WorkflowServicePrefix + "RecordActivityTaskHeartbeat"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: testNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionAllow}, nil)
+	s.mockMetricsHandler.EXPECT().WithTags(
+		metrics.OperationTag(metrics.AuthorizationScope),
+		metrics.NamespaceTag(targetNamespace),
+	).Return(s.mockMetricsHandler)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: targetNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionDeny}, nil)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ServiceErrUnauthorizedCounter.Name()).Return(metrics.NoopCounterMetricFunc)
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.Nil(res)
+	s.Error(err)
+}
+
+func (s *authorizerInterceptorSuite) TestSharedTaskQueueWorkflowTaskCompletion_NotAuthorizedInSourceNamespaces() {
+	taskToken, err := tasktoken.NewSerializer().Serialize(&tokenspb.Task{
+		NamespaceId:     "target-namespace-id",
+		SharedTaskQueue: "shared",
+	})
+	s.NoError(err)
+	request := &workflowservice.RespondWorkflowTaskCompletedRequest{
+		Namespace: testNamespace,
+		TaskToken: taskToken,
+	}
+	apiName := api.WorkflowServicePrefix + "RespondWorkflowTaskCompleted"
+	interceptor := s.newSharedTaskQueueInterceptor()
+
+	// Only the request namespace is authorized, the namespace validator rejects the token.
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, &CallTarget{
+		Namespace: testNamespace, Request: request, APIName: apiName,
+	}).Return(Result{Decision: DecisionAllow}, nil)
+
+	res, err := interceptor.Intercept(ctx, request, &grpc.UnaryServerInfo{FullMethod: apiName}, s.handler)
+	s.True(res.(bool))
+	s.NoError(err)
+}
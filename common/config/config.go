@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/masker"
 	"go.temporal.io/server/common/metrics"
+	chclient "go.temporal.io/server/common/persistence/visibility/store/clickhouse/client"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/telemetry"
@@ -282,6 +283,8 @@ type (
 		CustomDataStoreConfig *CustomDatastoreConfig `yaml:"customDatastore"`
 		// ElasticSearch contains the config for a ElasticSearch datastore
 		Elasticsearch *client.Config `yaml:"elasticsearch"`
+		// ClickHouse contains the config for a ClickHouse visibility datastore
+		ClickHouse *chclient.Config `yaml:"clickhouse"`
 	}
 
 	FaultInjection struct {
@@ -484,6 +487,7 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -501,12 +505,16 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// VisibilityFormat is the format of archived visibility records: "json" (default) writes
+		// one file per record, "parquet" writes Parquet files partitioned by close date.
+		VisibilityFormat string `yaml:"visibilityFormat"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
@@ -522,6 +530,24 @@ type (
 		LogLevel         uint    `yaml:"logLevel"`
 	}
 
+	// AzblobArchiver contains the config for Azure Blob Storage archiver
+	AzblobArchiver struct {
+		// AccountName is the storage account name. It is used to build the default
+		// endpoint https://<accountName>.blob.core.windows.net.
+		AccountName string `yaml:"accountName"`
+		// Endpoint overrides the blob service endpoint, e.g. for Azurite or sovereign clouds.
+		Endpoint string `yaml:"endpoint"`
+		// SASToken is a shared access signature with read, write and list permissions
+		// on the containers used for archival.
+		SASToken string `yaml:"sasToken"`
+		// RequestTimeout bounds each request to the blob service, including reading the
+		// response body. Defaults to 1 minute.
+		RequestTimeout time.Duration `yaml:"requestTimeout"`
+		// ConnectTimeout bounds establishing a connection to the blob service, including
+		// the TLS handshake. Defaults to 10 seconds.
+		ConnectTimeout time.Duration `yaml:"connectTimeout"`
+	}
+
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
 	// frontend. There are three methods of connecting:
 	// 1. Use membership to locate "internal-frontend" and connect to them using the Internode
@@ -47,10 +47,14 @@ func (c *Persistence) Validate() error {
 	// - visibilityStore (advanced sql),  secondaryVisibilityStore (advanced sql)
 	// - visibilityStore (es),            visibilityStore (es) [via elasticsearch.indices config]
 	// - visibilityStore (es),            secondaryVisibilityStore (es)
+	// - visibilityStore (es/clickhouse), secondaryVisibilityStore (es/clickhouse)
 	//
 	// Invalid dual visibility combinations:
-	// - visibilityStore (advanced sql),  secondaryVisibilityStore (es)
-	// - visibilityStore (es),            secondaryVisibilityStore (advanced sql)
+	// - visibilityStore (advanced sql),  secondaryVisibilityStore (es/clickhouse)
+	// - visibilityStore (es/clickhouse), secondaryVisibilityStore (advanced sql)
+	//
+	// ClickHouse, like Elasticsearch, stores custom search attributes by name instead of
+	// using aliases, so both can be combined.
 
 	if c.VisibilityStore == "" {
 		return fmt.Errorf("%w: visibilityStore must be specified", ErrPersistenceConfig)
@@ -58,8 +62,10 @@ func (c *Persistence) Validate() error {
 	if c.SecondaryVisibilityStore != "" {
 		isAnyCustom := c.DataStores[c.VisibilityStore].CustomDataStoreConfig != nil ||
 			c.DataStores[c.SecondaryVisibilityStore].CustomDataStoreConfig != nil
-		isPrimaryEs := c.DataStores[c.VisibilityStore].Elasticsearch != nil
-		isSecondaryEs := c.DataStores[c.SecondaryVisibilityStore].Elasticsearch != nil
+		isPrimaryEs := c.DataStores[c.VisibilityStore].Elasticsearch != nil ||
+			c.DataStores[c.VisibilityStore].ClickHouse != nil
+		isSecondaryEs := c.DataStores[c.SecondaryVisibilityStore].Elasticsearch != nil ||
+			c.DataStores[c.SecondaryVisibilityStore].ClickHouse != nil
 		if !isAnyCustom && isPrimaryEs != isSecondaryEs {
 			return fmt.Errorf(
 				"%w: cannot set visibilityStore and secondaryVisibilityStore with different datastore types",
@@ -141,6 +147,8 @@ func (ds *DataStore) GetIndexName() string {
 		return ds.Cassandra.Keyspace
 	case ds.Elasticsearch != nil:
 		return ds.Elasticsearch.GetVisibilityIndex()
+	case ds.ClickHouse != nil:
+		return ds.ClickHouse.GetVisibilityIndex()
 	case ds.CustomDataStoreConfig != nil:
 		return ds.CustomDataStoreConfig.IndexName
 	default:
@@ -163,10 +171,13 @@ func (ds *DataStore) Validate() error {
 	if ds.Elasticsearch != nil {
 		storeConfigCount++
 	}
+	if ds.ClickHouse != nil {
+		storeConfigCount++
+	}
 	if storeConfigCount != 1 {
 		return errors.New(
 			"must provide config for one and only one datastore: " +
-				"elasticsearch, cassandra, sql or custom store",
+				"elasticsearch, clickhouse, cassandra, sql or custom store",
 		)
 	}
 
@@ -183,6 +194,11 @@ func (ds *DataStore) Validate() error {
 			return err
 		}
 	}
+	if ds.ClickHouse != nil {
+		if err := ds.ClickHouse.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -28,6 +28,13 @@ var (
 		true,
 		`AdminEnableListHistoryTasks is the key for enabling listing history tasks`,
 	)
+	AdminTaskQueueStatsStreamMinInterval = NewGlobalDurationSetting(
+		"admin.taskQueueStatsStreamMinInterval",
+		5*time.Second,
+		`AdminTaskQueueStatsStreamMinInterval is the minimum and default interval between the stats that
+StreamTaskQueueStats sends. Each stats send fans out to all the partitions of the task queue, unless the
+stats cached by the root partition are fresh enough.`,
+	)
 	AdminMatchingNamespaceToPartitionDispatchRate = NewNamespaceFloatSetting(
 		"admin.matchingNamespaceToPartitionDispatchRate",
 		10000,
@@ -124,6 +131,22 @@ query.`,
 		true,
 		`EnableNamespaceNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster
 for signal / start / signal with start API if namespace is not active`,
+	)
+	ReplicationWorkflowFilter = NewNamespaceStringSetting(
+		"system.replicationWorkflowFilter",
+		"",
+		`ReplicationWorkflowFilter limits replication of a global namespace to the workflow executions matching
+this List filter, e.g. "WorkflowType IN ('Order', 'Payment')" or "CustomKeywordField = 'replicated'".
+Executions are matched against their workflow ID, type and search attributes when they start. Executions
+that don't match are kept local to the cluster that started them and are never replicated. An empty or
+invalid filter replicates every execution.`,
+	)
+	AllowFailoverWithLocalOnlyWorkflows = NewNamespaceBoolSetting(
+		"system.allowFailoverWithLocalOnlyWorkflows",
+		false,
+		`AllowFailoverWithLocalOnlyWorkflows allows failing over a namespace with a ReplicationWorkflowFilter
+while it has running executions that don't match the filter. Those executions stay on the cluster that
+started them and can't make progress until the namespace fails back. When false, such failovers are refused.`,
 	)
 	ForceNamespaceSelectedAPIAutoForwarding = NewNamespaceBoolSetting(
 		"system.forceNamespaceSelectedAPIAutoForwarding",
@@ -264,6 +287,16 @@ operator API calls (highest priority). Should be >0.0 and <= 1.0 (defaults to 20
 		`PersistenceQPSBurstRatio is the burst ratio for persistence QPS. This flag controls the burst ratio for all services.`,
 	)
 
+	PersistenceHistoryCompression = NewNamespaceIDTypedSetting(
+		"system.persistenceHistoryCompression",
+		DefaultHistoryCompressionSettings,
+		`PersistenceHistoryCompression configures transparent compression of history nodes written to
+persistence, per namespace. History is compressed only when it is written and decompressed when it is
+read, so raw history returned by APIs or sent to other clusters is always plain proto3, and the codec
+can be changed at any time. Only enable it once every node in the cluster runs a version that can read
+compressed history.`,
+	)
+
 	EnableDataLossMetrics = NewGlobalBoolSetting(
 		"system.enableDataLossMetrics",
 		false,
@@ -1203,6 +1236,57 @@ Note: this should be greater than matching.longPollExpirationInterval and matchi
 		defaultNumTaskQueuePartitions,
 		`MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue`,
 	)
+	MatchingEnablePartitionAutoScaling = NewTaskQueueBoolSetting(
+		"matching.enablePartitionAutoScaling",
+		false,
+		`MatchingEnablePartitionAutoScaling lets the root partition of a normal task queue grow and shrink the number of
+partitions based on observed add/poll rate, backlog and forwarding. The chosen counts are stored in task queue user
+data and take precedence over matching.numTaskqueueWritePartitions and matching.numTaskqueueReadPartitions, which are
+only used as the starting point.`,
+	)
+	MatchingPartitionAutoScalingInterval = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingInterval",
+		time.Minute,
+		`MatchingPartitionAutoScalingInterval is how often the root partition re-evaluates the partition count when
+partition auto-scaling is enabled`,
+	)
+	MatchingPartitionAutoScalingMinPartitions = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingMinPartitions",
+		1,
+		`MatchingPartitionAutoScalingMinPartitions is the lower bound on the partition count chosen by partition auto-scaling`,
+	)
+	MatchingPartitionAutoScalingMaxPartitions = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingMaxPartitions",
+		32,
+		`MatchingPartitionAutoScalingMaxPartitions is the upper bound on the partition count chosen by partition auto-scaling`,
+	)
+	MatchingPartitionAutoScalingTargetRatePerPartition = NewTaskQueueFloatSetting(
+		"matching.partitionAutoScalingTargetRatePerPartition",
+		200,
+		`MatchingPartitionAutoScalingTargetRatePerPartition is the add or poll rate (per second) that a single partition
+is expected to handle. Partition auto-scaling picks enough partitions to keep the busier of the two below this rate.`,
+	)
+	MatchingPartitionAutoScalingBacklogPerPartition = NewTaskQueueIntSetting(
+		"matching.partitionAutoScalingBacklogPerPartition",
+		1000,
+		`MatchingPartitionAutoScalingBacklogPerPartition is the backlog size of the root partition above which partition
+auto-scaling adds a partition, as long as tasks are not mostly being forwarded`,
+	)
+	MatchingPartitionAutoScalingMaxForwardedRatio = NewTaskQueueFloatSetting(
+		"matching.partitionAutoScalingMaxForwardedRatio",
+		0.5,
+		`MatchingPartitionAutoScalingMaxForwardedRatio is the fraction of tasks forwarded from child partitions to the
+root above which partition auto-scaling stops adding partitions. A high ratio means child partitions do not have
+enough pollers to match tasks locally, so more partitions would not help.`,
+	)
+	MatchingPartitionAutoScalingScaleDownDelay = NewTaskQueueDurationSetting(
+		"matching.partitionAutoScalingScaleDownDelay",
+		10*time.Minute,
+		`MatchingPartitionAutoScalingScaleDownDelay is the minimum time since the last partition count change before
+partition auto-scaling removes partitions. It applies to both steps of a scale-down: lowering the write partition
+count, and checking that the partitions no longer written to have drained before lowering the read partition count.
+It should be longer than it takes clients to pick up a new write partition count.`,
+	)
 	MetricsBreakdownByTaskQueue = NewTaskQueueBoolSetting(
 		"metrics.breakdownByTaskQueue",
 		true,
@@ -1396,6 +1480,50 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingPriorityAgingInterval = NewTaskQueueDurationSetting(
+		"matching.priorityAgingInterval",
+		0,
+		`MatchingPriorityAgingInterval is how long a backlog task has to wait before its effective priority is raised
+by one level. Tasks keep being raised every interval until they reach the highest priority level, which bounds how long
+a low-priority task can be starved by higher-priority ones. Zero disables aging (requires new matcher, not used with
+fairness).`,
+	)
+	MatchingMaxLabelSetSubqueues = NewTaskQueueIntSetting(
+		"matching.maxLabelSetSubqueues",
+		20,
+		`MatchingMaxLabelSetSubqueues is the maximum number of distinct sets of required labels that get their own backlog
+subqueue in a task queue partition. Tasks with other label sets share the subqueue of tasks without labels (requires
+new matcher).`,
+	)
+	MatchingConcurrencySlotLease = NewTaskQueueDurationSetting(
+		"matching.concurrencySlotLease",
+		5*time.Minute,
+		`MatchingConcurrencySlotLease is how long a task dispatched under a task queue concurrency limit holds its slot
+when its start-to-close timeout is unknown. Slots of tasks with a start-to-close timeout expire after that timeout, in
+case history never reports that the attempt ended (requires new matcher).`,
+	)
+	MatchingActivityAffinityFallbackTimeout = NewTaskQueueDurationSetting(
+		"matching.activityAffinityFallbackTimeout",
+		5*time.Second,
+		`MatchingActivityAffinityFallbackTimeout is how long an activity task with an affinity key waits for the worker
+that last received a task with the same key, as long as that worker keeps polling. After that, any worker can receive
+the task. Tasks with an affinity key are always added to the root partition of the task queue, which is where they wait.
+Zero disables worker affinity. Requires the new matcher, the classic matcher drops the affinity key of new tasks.`,
+	)
+	MatchingBacklogLimitCount = NewTaskQueueIntSetting(
+		"matching.backlogLimitCount",
+		0,
+		`MatchingBacklogLimitCount is the maximum number of backlogged tasks of a task queue, split evenly across its
+write partitions. New tasks are rejected with a resource exhausted error while the backlog is over the limit and
+history retries them with backoff. Zero disables the limit.`,
+	)
+	MatchingBacklogLimitAge = NewTaskQueueDurationSetting(
+		"matching.backlogLimitAge",
+		0,
+		`MatchingBacklogLimitAge is the maximum age of the oldest backlogged task of a task queue partition. New tasks are
+rejected with a resource exhausted error while the backlog is older than the limit and history retries them with
+backoff. Zero disables the limit.`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
@@ -1723,6 +1851,18 @@ currently only work for scheduled queues and the task range is 1s.`,
 		50,
 		`QueueCriticalSlicesCount is the max number of slices in one queue
 before force compacting slices`,
+	)
+	QueueNamespaceTaskLatencyCriticalThreshold = NewGlobalDurationSetting(
+		"history.queueNamespaceTaskLatencyCriticalThreshold",
+		0,
+		`QueueNamespaceTaskLatencyCriticalThreshold is the max time a loaded task of a namespace can stay pending
+before the namespace is isolated into its own queue reader. 0 disables the check.`,
+	)
+	QueueTaskAttemptsCriticalThreshold = NewGlobalIntSetting(
+		"history.queueTaskAttemptsCriticalThreshold",
+		0,
+		`QueueTaskAttemptsCriticalThreshold is the max number of attempts of a loaded task before the namespace
+of that task is isolated into its own queue reader. 0 disables the check.`,
 	)
 	QueuePendingTaskMaxCount = NewGlobalIntSetting(
 		"history.queuePendingTasksMaxCount",
@@ -2525,6 +2665,37 @@ the dlq (or will drop them if not enabled)`,
 		`HistoryTaskDLQErrorPattern specifies a regular expression. If a task processing error matches with this regex,
 that task will be sent to DLQ.`,
 	)
+	HistoryTaskDLQRedriveCategories = NewGlobalTypedSetting(
+		"history.TaskDLQRedriveCategories",
+		([]string)(nil),
+		`HistoryTaskDLQRedriveCategories is the list of task category names whose DLQs of the current cluster are
+managed by the automatic re-drive. The re-drive rewrites these DLQs, which changes the message IDs of the tasks in
+them, so manual purge and merge operations on them are rejected. Remove a category from this list before operating
+on its DLQ manually, for example to merge parked tasks.`,
+	)
+	HistoryTaskDLQRedrivePolicies = NewNamespaceTypedSetting(
+		"history.TaskDLQRedrivePolicies",
+		[]DLQRedrivePolicy(nil),
+		`HistoryTaskDLQRedrivePolicies is a list of policies to automatically re-drive tasks from the history task DLQ.
+Each policy has the fields Category (task category name, empty for all categories), InitialInterval,
+BackoffCoefficient, MaximumInterval and MaximumRedrives. The first policy matching the category of a task is used.
+A task is re-driven after an exponential backoff counted from when it was last sent to the DLQ, and is permanently
+parked after MaximumRedrives re-drives. Tasks of namespaces and categories without a policy are not re-driven.
+Policies only apply to the categories listed in history.TaskDLQRedriveCategories.`,
+	)
+	HistoryTaskDLQRedriveInterval = NewGlobalDurationSetting(
+		"history.TaskDLQRedriveInterval",
+		time.Minute,
+		`HistoryTaskDLQRedriveInterval is how often the history task DLQs are scanned for tasks to re-drive.`,
+	)
+	HistoryTaskDLQRedriveOutcomeTimeout = NewGlobalDurationSetting(
+		"history.TaskDLQRedriveOutcomeTimeout",
+		time.Hour,
+		`HistoryTaskDLQRedriveOutcomeTimeout is how long the attempt history of a re-driven task is kept after it is
+removed from the DLQ. If the task is sent back to the DLQ within this time, its new failure continues the attempt
+history, otherwise the task is considered successful. This should be longer than the time it takes a failing task to
+exhaust its attempts.`,
+	)
 
 	MaxLocalParentWorkflowVerificationDuration = NewGlobalDurationSetting(
 		"history.maxLocalParentWorkflowVerificationDuration",
@@ -2578,6 +2749,29 @@ that task will be sent to DLQ.`,
 		100,
 		`Maximum number of low priority replication tasks that can be sent per second per shard`,
 	)
+	ReplicationStreamSenderMaxBatchSize = NewGlobalIntSetting(
+		"history.ReplicationStreamSenderMaxBatchSize",
+		100,
+		`Maximum number of replication tasks the stream sender packs into a single stream message. The effective
+batch size is capped by what the receiver advertises, receivers that don't advertise a batch size get one task per message.`,
+	)
+	ReplicationStreamSenderMaxBatchBytes = NewGlobalIntSetting(
+		"history.ReplicationStreamSenderMaxBatchBytes",
+		1024*1024,
+		`Byte budget of a single replication stream message. A batch is flushed once adding the next task would exceed it,
+a single task larger than the budget is sent on its own.`,
+	)
+	ReplicationStreamCompression = NewGlobalStringSetting(
+		"history.ReplicationStreamCompression",
+		"none",
+		`Codec the stream sender compresses history blobs of replication tasks with: "none", "zstd" or "snappy".
+Blobs are only compressed when the receiver advertises support for the codec.`,
+	)
+	ReplicationStreamReceiverMaxBatchSize = NewGlobalIntSetting(
+		"history.ReplicationStreamReceiverMaxBatchSize",
+		100,
+		`Maximum number of replication tasks the stream receiver accepts in a single stream message. 1 disables batching.`,
+	)
 	ReplicationStreamEventLoopRetryMaxAttempts = NewGlobalIntSetting(
 		"history.ReplicationStreamEventLoopRetryMaxAttempts",
 		100, // 0 means retry forever
@@ -2953,6 +3147,34 @@ because executions scanner support for SQL is not yet implemented.`,
 		true,
 		`HistoryScannerVerifyRetention indicates if the history scavenger should verify data retention.
 When enabled, the scavenger will delete completed workflow execution data that are older than the namespace retention period plus worker.executionDataDurationBuffer.`,
+	)
+	ArchivalVerifierEnabled = NewGlobalBoolSetting(
+		"worker.archivalVerifierEnabled",
+		false,
+		`ArchivalVerifierEnabled indicates if the archival verifier should be started as part of worker.Scanner`,
+	)
+	ArchivalVerifierSampleSize = NewGlobalIntSetting(
+		"worker.archivalVerifierSampleSize",
+		100,
+		`ArchivalVerifierSampleSize is the maximum number of recently closed workflows per namespace whose archived
+history is read back and verified on each run of the archival verifier.`,
+	)
+	ArchivalVerifierDataMinAge = NewGlobalDurationSetting(
+		"worker.archivalVerifierDataMinAge",
+		time.Hour,
+		`ArchivalVerifierDataMinAge is the minimum time since a workflow closed before the archival verifier checks its
+archived history. This gives the archival queue time to archive the workflow.`,
+	)
+	ArchivalVerifierRPS = NewGlobalFloatSetting(
+		"worker.archivalVerifierRPS",
+		10,
+		`ArchivalVerifierRPS is the rate limit for workflows verified by the archival verifier`,
+	)
+	ArchivalVerifierRepairEnabled = NewGlobalBoolSetting(
+		"worker.archivalVerifierRepairEnabled",
+		true,
+		`ArchivalVerifierRepairEnabled indicates if the archival verifier should request re-archival of workflows whose
+archived history is missing or broken`,
 	)
 	EnableBatcherNamespace = NewNamespaceBoolSetting(
 		"worker.enableNamespaceBatcher",
@@ -101,6 +101,22 @@ type CircuitBreakerSettings struct {
 	Timeout time.Duration
 }
 
+// DLQRedrivePolicy is the policy used to automatically re-drive history tasks in a DLQ.
+type DLQRedrivePolicy struct {
+	// Category is the name of the task category the policy applies to, e.g. "transfer". An empty
+	// category matches all categories.
+	Category string
+	// InitialInterval is the delay before the first re-drive, counted from when the task was sent
+	// to the DLQ.
+	InitialInterval time.Duration
+	// BackoffCoefficient is multiplied with the interval for each subsequent re-drive.
+	BackoffCoefficient float64
+	// MaximumInterval caps the interval between re-drives. Zero means no cap.
+	MaximumInterval time.Duration
+	// MaximumRedrives is the number of re-drives after which the task is permanently parked.
+	MaximumRedrives int
+}
+
 type CacheBackgroundEvictSettings struct {
 	// Enabled controls whether background purging of expired entries is active. To enable,
 	// this must be set to true at process start, but can be dynamically set to false to
@@ -117,3 +133,17 @@ var DefaultHistoryCacheBackgroundEvictSettings = CacheBackgroundEvictSettings{
 	LoopInterval:    1 * time.Minute,
 	MaxEntryPerCall: 1024,
 }
+
+type HistoryCompressionSettings struct {
+	// Codec is the codec used to compress history nodes. Supported values are "" / "none"
+	// (no compression), "zstd" and "snappy".
+	Codec string
+	// MinSize is the smallest encoded history node size in bytes that is worth compressing.
+	// Smaller nodes are always written uncompressed.
+	MinSize int
+}
+
+var DefaultHistoryCompressionSettings = HistoryCompressionSettings{
+	Codec:   "",
+	MinSize: 1024,
+}
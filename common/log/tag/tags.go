@@ -618,6 +618,11 @@ func QueueAlert(alert interface{}) ZapTag {
 	return NewAnyTag("queue-alert", alert)
 }
 
+// QueueAction returns tag for queue action
+func QueueAction(action interface{}) ZapTag {
+	return NewAnyTag("queue-action", action)
+}
+
 // Task returns tag for Task
 func Task(task interface{}) ZapTag {
 	return NewAnyTag("queue-task", task)
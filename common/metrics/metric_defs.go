@@ -500,6 +500,8 @@ const (
 	VisibilityArchiverScope = "VisibilityArchiver"
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope = "HistoryScavenger"
+	// ArchivalVerifierScope is scope used by all metrics emitted by worker.archival.Activities module
+	ArchivalVerifierScope = "ArchivalVerifier"
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope = "ArchiverDeleteHistoryActivity"
 	// ArchiverUploadHistoryActivityScope is scope used by all metrics emitted by archiver.UploadHistoryActivity
@@ -1063,6 +1065,18 @@ var (
 		"dlq_writes",
 		WithDescription("The number of times a message is enqueued to DLQ. DLQ can be inspected using tdbg dlq command."),
 	)
+	DLQRedrives = NewCounterDef(
+		"dlq_redrives",
+		WithDescription("The number of times a task is automatically re-driven from the DLQ by its re-drive policy."),
+	)
+	DLQRedriveFailures = NewCounterDef(
+		"dlq_redrive_failures",
+		WithDescription("The number of failures to re-drive tasks from the DLQ."),
+	)
+	DLQTasksParked = NewCounterDef(
+		"dlq_tasks_parked",
+		WithDescription("The number of DLQ tasks permanently parked after exhausting their re-drives. Parked tasks need to be inspected with tdbg dlq command."),
+	)
 	DLQMessageCount = NewGaugeDef(
 		"dlq_message_count",
 		WithDescription("The number of messages currently in DLQ."),
@@ -1145,6 +1159,7 @@ var (
 	TaskDispatchLatencyPerTaskQueue        = NewTimerDef("task_dispatch_latency")
 	ApproximateBacklogCount                = NewGaugeDef("approximate_backlog_count")
 	ApproximateBacklogAgeSeconds           = NewGaugeDef("approximate_backlog_age_seconds")
+	ApproximateBacklogAgePerPriority       = NewGaugeDef("approximate_backlog_age_seconds_per_priority")
 	NonRetryableTasks                      = NewCounterDef(
 		"non_retryable_tasks",
 		WithDescription("The number of non-retryable matching tasks which are dropped due to specific errors"),
@@ -1157,6 +1172,30 @@ var (
 		"task_retry_transient",
 		WithDescription("Count of tasks that hit a transient error during match or forward and are retried immediately"),
 	)
+	AutoScaledWritePartitionsGauge = NewGaugeDef(
+		"auto_scaled_write_partitions",
+		WithDescription("Number of write partitions chosen by partition auto-scaling for a task queue"),
+	)
+	AutoScaledReadPartitionsGauge = NewGaugeDef(
+		"auto_scaled_read_partitions",
+		WithDescription("Number of read partitions chosen by partition auto-scaling for a task queue"),
+	)
+	BacklogLimitRejectedTasksCounter = NewCounterDef(
+		"backlog_limit_rejected_tasks",
+		WithDescription("Number of tasks rejected because the task queue backlog is over its limit"),
+	)
+	BacklogLimitExceededGauge = NewGaugeDef(
+		"backlog_limit_exceeded",
+		WithDescription("Whether the backlog of a task queue partition is over its limit (1) or not (0)"),
+	)
+	ConcurrencySlotsInUseGauge = NewGaugeDef(
+		"concurrency_slots_in_use",
+		WithDescription("Number of in-flight tasks holding a slot of the task queue concurrency limit in a partition"),
+	)
+	BacklogPurgedTasksCounter = NewCounterDef(
+		"backlog_purged_tasks",
+		WithDescription("Number of backlog tasks dropped or moved to another task queue by a backlog purge"),
+	)
 
 	// ----------------------------------------------------------------------------------------------------------------
 	// Matching service: Metrics to track the health of worker registry.
@@ -1229,6 +1268,11 @@ var (
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
+	ArchivalVerifierVerifiedCount                   = NewCounterDef("archival_verifier_verified")
+	ArchivalVerifierCorruptedCount                  = NewCounterDef("archival_verifier_corrupted")
+	ArchivalVerifierSkipCount                       = NewCounterDef("archival_verifier_skips")
+	ArchivalVerifierErrorCount                      = NewCounterDef("archival_verifier_errors")
+	ArchivalVerifierRepairRequestCount              = NewCounterDef("archival_verifier_repair_requests")
 
 	// Delete Namespace metrics.
 	ReclaimResourcesNamespaceDeleteSuccessCount = NewCounterDef(
@@ -1261,6 +1305,24 @@ var (
 		WithDescription("The number of workflow executions that wasn't found by DeleteExecutions workflow"),
 	)
 
+	// Visibility consistency check metrics.
+	VisibilityConsistencyCheckedCount = NewCounterDef(
+		"visibility_consistency_checked",
+		WithDescription("The number of visibility records compared between the primary and secondary visibility stores"),
+	)
+	VisibilityConsistencyDivergenceCount = NewCounterDef(
+		"visibility_consistency_divergence",
+		WithDescription("The number of visibility records that are missing or differ between the primary and secondary visibility stores"),
+	)
+	VisibilityConsistencyBackfillCount = NewCounterDef(
+		"visibility_consistency_backfill",
+		WithDescription("The number of divergent visibility records that were rewritten by the visibility consistency check"),
+	)
+	VisibilityConsistencyBackfillFailureCount = NewCounterDef(
+		"visibility_consistency_backfill_failure",
+		WithDescription("The number of divergent visibility records that could not be rewritten by the visibility consistency check"),
+	)
+
 	// Batcher metrics.
 	BatcherProcessorSuccess = NewCounterDef(
 		"batcher_processor_requests",
@@ -353,6 +353,12 @@ func ResourceExhaustedCauseTag(cause enumspb.ResourceExhaustedCause) Tag {
 	return Tag{Key: resourceExhaustedTag, Value: cause.String()}
 }
 
+// TaskQueueBacklogLimitCauseTag is the resource exhausted cause tag of tasks rejected by Matching
+// because the task queue backlog is over its limit. It has no equivalent ResourceExhaustedCause.
+func TaskQueueBacklogLimitCauseTag() Tag {
+	return Tag{Key: resourceExhaustedTag, Value: "TaskQueueBacklogLimit"}
+}
+
 func ResourceExhaustedScopeTag(scope enumspb.ResourceExhaustedScope) Tag {
 	return Tag{Key: resourceExhaustedScopeTag, Value: scope.String()}
 }
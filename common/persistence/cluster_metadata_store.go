@@ -204,7 +204,7 @@ func (m *clusterMetadataManagerImpl) convertInternalGetClusterMetadataResponse(
 func immutableFieldsChanged(old *persistencespb.ClusterMetadata, cur *persistencespb.ClusterMetadata) bool {
 	if (old.ClusterName != "" && old.ClusterName != cur.ClusterName) ||
 		(old.ClusterId != "" && old.ClusterId != cur.ClusterId) ||
-		(old.HistoryShardCount != 0 && old.HistoryShardCount != cur.HistoryShardCount) ||
+		(old.HistoryShardCount != 0 && !validShardCountChange(old.HistoryShardCount, cur.HistoryShardCount)) ||
 		(old.IsGlobalNamespaceEnabled && !cur.IsGlobalNamespaceEnabled) {
 		return true
 	}
@@ -216,3 +216,9 @@ func immutableFieldsChanged(old *persistencespb.ClusterMetadata, cur *persistenc
 	}
 	return false
 }
+
+// validShardCountChange reports whether the history shard count can change from old to cur. It can only
+// grow to a multiple of itself, which is what resharding does.
+func validShardCountChange(old int32, cur int32) bool {
+	return cur == old || (cur > old && cur%old == 0)
+}
@@ -769,6 +769,8 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the workflow, used to decide whether to compress the node
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -793,6 +795,8 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the workflow, used to decide whether to compress the node
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -1254,6 +1258,8 @@ type (
 	HistoryTaskQueueManager interface {
 		Closeable
 		EnqueueTask(ctx context.Context, request *EnqueueTaskRequest) (*EnqueueTaskResponse, error)
+		// EnqueueRawTask enqueues a task as it was read with ReadRawTasks, without deserializing its blob.
+		EnqueueRawTask(ctx context.Context, request *EnqueueRawTaskRequest) (*EnqueueTaskResponse, error)
 		ReadRawTasks(
 			ctx context.Context,
 			request *ReadRawTasksRequest,
@@ -1289,6 +1295,13 @@ type (
 		// SourceShardID of the task in its original cluster. Note that tasks may move between clusters, so this shard
 		// id may not be the same as the shard id of the task in the current cluster.
 		SourceShardID int
+		// DLQState is the re-drive state of the task. It is only set for tasks enqueued to a DLQ.
+		DLQState *persistencespb.HistoryDLQTaskState
+	}
+
+	EnqueueRawTaskRequest struct {
+		QueueKey QueueKey
+		Task     *persistencespb.HistoryTask
 	}
 
 	EnqueueTaskResponse struct {
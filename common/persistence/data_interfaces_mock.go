@@ -1401,6 +1401,21 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) DeleteTasks(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).DeleteTasks), ctx, request)
 }
 
+// EnqueueRawTask mocks base method.
+func (m *MockHistoryTaskQueueManager) EnqueueRawTask(ctx context.Context, request *EnqueueRawTaskRequest) (*EnqueueTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueRawTask", ctx, request)
+	ret0, _ := ret[0].(*EnqueueTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueRawTask indicates an expected call of EnqueueRawTask.
+func (mr *MockHistoryTaskQueueManagerMockRecorder) EnqueueRawTask(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueRawTask", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).EnqueueRawTask), ctx, request)
+}
+
 // EnqueueTask mocks base method.
 func (m *MockHistoryTaskQueueManager) EnqueueTask(ctx context.Context, request *EnqueueTaskRequest) (*EnqueueTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -522,9 +522,13 @@ func (m *executionManagerImpl) serializeWorkflowEventBatches(
 			workflowEvents.Events[len(workflowEvents.Events)-1].EventId+1,
 		)
 		newEvents.ShardID = shardID
-		workflowNewEvents = append(workflowNewEvents, newEvents)
 		historyStatistics.SizeDiff += len(newEvents.Node.Events.Data)
 		historyStatistics.CountDiff += len(workflowEvents.Events)
+		// The XDC cache and history size hold the plain events, only the stored node is compressed.
+		if newEvents.Node.Events, err = m.serializer.CompressEvents(workflowEvents.NamespaceID, newEvents.Node.Events); err != nil {
+			return nil, nil, nil, err
+		}
+		workflowNewEvents = append(workflowNewEvents, newEvents)
 	}
 	return xdcKVs, workflowNewEvents, &historyStatistics, nil
 }
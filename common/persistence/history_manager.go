@@ -12,6 +12,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/softassert"
 )
@@ -489,10 +490,15 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	if req.Node.Events, err = m.serializer.CompressEvents(request.NamespaceID, req.Node.Events); err != nil {
+		return nil, err
+	}
+
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -506,6 +512,9 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 	if err != nil {
 		return nil, err
 	}
+	if req.Node.Events, err = m.serializer.CompressEvents(request.NamespaceID, req.Node.Events); err != nil {
+		return nil, err
+	}
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
@@ -795,11 +804,17 @@ func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
 			if node.Events == nil {
 				return nil, nil, nil, nil, 0, softassert.UnexpectedDataLoss(m.logger, "no events in history node", nil)
 			}
-			dataSize += len(node.Events.Data)
+			// Raw history leaves the persistence layer (e.g. replication, raw history APIs),
+			// so blob compression must not be visible to callers.
+			events, err := serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = events
+			dataSize += len(events.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 			nodeIDs = append(nodeIDs, node.NodeID)
 		}
@@ -887,8 +902,12 @@ func (m *executionManagerImpl) readRawHistoryBranchReverseAndFilter(
 	if len(nodes) > 0 {
 		dataBlobs = make([]*commonpb.DataBlob, len(nodes))
 		for index, node := range nodes {
-			dataBlobs[index] = node.Events
-			dataSize += len(node.Events.Data)
+			events, err := serialization.DecompressBlob(node.Events)
+			if err != nil {
+				return nil, nil, nil, 0, err
+			}
+			dataBlobs[index] = events
+			dataSize += len(events.Data)
 			transactionIDs = append(transactionIDs, node.TransactionID)
 		}
 		lastNode := nodes[len(nodes)-1]
@@ -2,15 +2,21 @@ package persistence_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/mock"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.uber.org/mock/gomock"
 )
 
@@ -122,6 +128,85 @@ func TestHistoryManager_InvalidBranchToken_ReturnsInvalidArgument(t *testing.T)
 	}
 }
 
+func TestHistoryManager_CompressedHistory_ReturnedPlain(t *testing.T) {
+	serializer := serialization.NewSerializerWithCompression(func(namespaceID namespace.ID) dynamicconfig.HistoryCompressionSettings {
+		if namespaceID == "compressed-namespace" {
+			return dynamicconfig.HistoryCompressionSettings{Codec: "zstd"}
+		}
+		return dynamicconfig.HistoryCompressionSettings{}
+	})
+	branchUtil := p.NewHistoryBranchUtil(serializer)
+	branchToken, err := branchUtil.NewHistoryBranch("", "", "", primitives.NewUUID().String(), nil, nil, 0, 0, 0)
+	require.NoError(t, err)
+	events := []*historypb.HistoryEvent{{
+		EventId:   1,
+		Version:   1,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+			WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+				Identity: strings.Repeat("identity", 100),
+			},
+		},
+	}}
+	plainBlob, err := serialization.NewSerializer().SerializeEvents(events)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		namespaceID      string
+		expectCompressed bool
+	}{
+		{namespaceID: "compressed-namespace", expectCompressed: true},
+		{namespaceID: "plain-namespace", expectCompressed: false},
+	} {
+		t.Run(tc.namespaceID, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mock.NewMockExecutionStore(ctrl)
+			store.EXPECT().GetHistoryBranchUtil().AnyTimes().Return(branchUtil)
+			em := p.NewExecutionManager(
+				store,
+				serializer,
+				nil,
+				log.NewNoopLogger(),
+				dynamicconfig.GetIntPropertyFn(1024*1024),
+				dynamicconfig.GetBoolPropertyFn(false),
+			)
+
+			var stored p.InternalHistoryNode
+			store.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *p.InternalAppendHistoryNodesRequest) error {
+					stored = request.Node
+					return nil
+				})
+			appendResp, err := em.AppendHistoryNodes(context.Background(), &p.AppendHistoryNodesRequest{
+				ShardID:       1,
+				NamespaceID:   tc.namespaceID,
+				IsNewBranch:   true,
+				BranchToken:   branchToken,
+				Events:        events,
+				TransactionID: 1,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectCompressed, serialization.IsCompressedBlob(stored.Events))
+			require.Equal(t, len(plainBlob.Data), appendResp.Size)
+
+			store.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&p.InternalReadHistoryBranchResponse{
+				Nodes: []p.InternalHistoryNode{stored},
+			}, nil)
+			rawResp, err := em.ReadRawHistoryBranch(context.Background(), &p.ReadHistoryBranchRequest{
+				ShardID:     1,
+				BranchToken: branchToken,
+				MinEventID:  1,
+				MaxEventID:  2,
+				PageSize:    10,
+			})
+			require.NoError(t, err)
+			require.Len(t, rawResp.HistoryEventBlobs, 1)
+			protorequire.ProtoEqual(t, plainBlob, rawResp.HistoryEventBlobs[0])
+			require.Equal(t, len(plainBlob.Data), rawResp.Size)
+		})
+	}
+}
+
 func requireInvalidArgumentError(t *testing.T, err error, operation string) {
 	t.Helper()
 	require.Error(t, err, "%s should return an error for invalid branch token", operation)
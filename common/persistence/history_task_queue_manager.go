@@ -71,27 +71,40 @@ func (m *HistoryTaskQueueManagerImpl) EnqueueTask(
 		return nil, fmt.Errorf("%w: shardID = %d", ErrShardIDInvalid, request.SourceShardID)
 	}
 
-	taskCategory := request.Task.GetCategory()
-	task := persistencespb.HistoryTask{
-		ShardId: int32(request.SourceShardID),
-		Blob:    blob,
-	}
-	taskBytes, _ := task.Marshal()
-	blob = &commonpb.DataBlob{
-		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
-		Data:         taskBytes,
+	return m.EnqueueRawTask(ctx, &EnqueueRawTaskRequest{
+		QueueKey: QueueKey{
+			QueueType:     request.QueueType,
+			Category:      request.Task.GetCategory(),
+			SourceCluster: request.SourceCluster,
+			TargetCluster: request.TargetCluster,
+		},
+		Task: &persistencespb.HistoryTask{
+			ShardId:  int32(request.SourceShardID),
+			Blob:     blob,
+			DlqState: request.DLQState,
+		},
+	})
+}
+
+func (m *HistoryTaskQueueManagerImpl) EnqueueRawTask(
+	ctx context.Context,
+	request *EnqueueRawTaskRequest,
+) (*EnqueueTaskResponse, error) {
+	if request.Task == nil {
+		return nil, ErrEnqueueTaskRequestTaskIsNil
 	}
-	queueKey := QueueKey{
-		QueueType:     request.QueueType,
-		Category:      taskCategory,
-		SourceCluster: request.SourceCluster,
-		TargetCluster: request.TargetCluster,
+	if request.Task.ShardId <= 0 {
+		return nil, fmt.Errorf("%w: shardID = %d", ErrShardIDInvalid, request.Task.ShardId)
 	}
 
+	taskBytes, _ := request.Task.Marshal()
 	message, err := m.queue.EnqueueMessage(ctx, &InternalEnqueueMessageRequest{
-		QueueType: request.QueueType,
-		QueueName: queueKey.GetQueueName(),
-		Blob:      blob,
+		QueueType: request.QueueKey.QueueType,
+		QueueName: request.QueueKey.GetQueueName(),
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         taskBytes,
+		},
 	})
 	if err != nil {
 		return nil, err
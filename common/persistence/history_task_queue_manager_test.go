@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -106,6 +107,19 @@ func TestHistoryTaskQueueManager_InvalidShardID(t *testing.T) {
 	assert.ErrorIs(t, err, persistence.ErrShardIDInvalid)
 }
 
+func TestHistoryTaskQueueManager_EnqueueRawTask_InvalidShardID(t *testing.T) {
+	t.Parallel()
+
+	m := persistence.NewHistoryTaskQueueManager(nil, serialization.NewSerializer())
+	_, err := m.EnqueueRawTask(context.Background(), &persistence.EnqueueRawTaskRequest{
+		QueueKey: persistence.QueueKey{
+			Category: tasks.CategoryTransfer,
+		},
+		Task: &persistencespb.HistoryTask{},
+	})
+	assert.ErrorIs(t, err, persistence.ErrShardIDInvalid)
+}
+
 // corruptQueue is a QueueV2 implementation that returns a single message that cannot be deserialized into a task.
 type corruptQueue struct {
 	persistence.QueueV2
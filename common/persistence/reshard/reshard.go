@@ -0,0 +1,981 @@
+// Package reshard splits the history shards of a cluster into a larger number of shards.
+//
+// The target shard count must be a multiple of the source shard count, so that an execution of shard s
+// either stays in s or moves to one of the new shards s + i*sourceShardCount (see common.MapShardID).
+// Resharding works directly on persistence, one page at a time, in the following phases:
+//
+//   - copy-executions copies the mutable state, current execution record and history of the executions
+//     that move to their new shard. Executions that were copied before and did not change since are
+//     skipped, so this phase can run while the history service serves traffic, and can be repeated to
+//     catch up with the changes made since.
+//   - prune-executions deletes the copies of executions that no longer exist in their source shard.
+//   - copy-tasks replaces the pending history tasks of the new shards, replication tasks included, with
+//     the ones of the executions that move.
+//   - cutover updates the history shard count in cluster metadata.
+//   - cleanup deletes the executions, history and tasks that moved from their source shard.
+//
+// The last three phases only run when cutover is requested. Resharding is an offline migration: no
+// history host owns both the source and the new shards, so cutover needs the history service to be
+// stopped, after copy-executions caught up, and the admin API refuses it while history hosts are
+// running. All services must then be restarted to use the new shard count.
+package reshard
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// Phase is the step of resharding a Position is in.
+	Phase string
+
+	// Position is where resharding continues from.
+	Position struct {
+		Phase Phase
+		// ShardID is the shard being processed. Source shards are processed by all phases but
+		// prune-executions, which processes the new shards.
+		ShardID int32
+		// TaskCategoryID is the task category being processed by copy-tasks and cleanup. Cleanup
+		// processes executions when it is 0.
+		TaskCategoryID int
+		// PageToken is the position within the current shard and task category.
+		PageToken []byte
+	}
+
+	// Stats counts the executions and tasks processed by a step.
+	Stats struct {
+		// ExecutionsCopied is the number of executions copied to their new shard.
+		ExecutionsCopied int64
+		// ExecutionsUnchanged is the number of executions skipped because their copy is up to date.
+		ExecutionsUnchanged int64
+		// ExecutionsPruned is the number of copies deleted because their execution no longer exists.
+		ExecutionsPruned int64
+		// ExecutionsDeleted is the number of executions deleted from their source shard after cutover.
+		ExecutionsDeleted int64
+		// TasksCopied is the number of tasks copied to the shard of their execution.
+		TasksCopied int64
+		// TasksDeleted is the number of tasks deleted from their source shard after cutover.
+		TasksDeleted int64
+	}
+
+	// Resharder splits sourceShardCount history shards into targetShardCount shards. It is not safe
+	// for concurrent use.
+	Resharder struct {
+		shardManager           persistence.ShardManager
+		executionManager       persistence.ExecutionManager
+		clusterMetadataManager persistence.ClusterMetadataManager
+		sourceShardCount       int32
+		targetShardCount       int32
+		cutover                bool
+		// deleteSourceHistory is set for stores that partition history by shard, where moved history
+		// must be deleted from the source shard. Other stores share history between shards.
+		deleteSourceHistory bool
+		pageSize            int
+
+		// shard ID -> range ID of the new shards written to
+		rangeIDs map[int32]int64
+	}
+)
+
+const (
+	PhaseCopyExecutions  Phase = "copy-executions"
+	PhasePruneExecutions Phase = "prune-executions"
+	PhaseCopyTasks       Phase = "copy-tasks"
+	PhaseCutover         Phase = "cutover"
+	PhaseCleanup         Phase = "cleanup"
+	PhaseCompleted       Phase = "completed"
+
+	// historyPageSize is the number of history batches read at a time when copying history.
+	historyPageSize = 100
+)
+
+var (
+	// taskCategories are the categories of the tasks moved with their executions. Memory timer tasks
+	// are not persisted.
+	taskCategories = []tasks.Category{
+		tasks.CategoryTransfer,
+		tasks.CategoryTimer,
+		tasks.CategoryReplication,
+		tasks.CategoryVisibility,
+		tasks.CategoryArchival,
+		tasks.CategoryOutbound,
+	}
+
+	errCutoverNotRequested = serviceerror.NewInvalidArgument("Resharding position requires cutover.")
+)
+
+// NewResharder creates a Resharder that processes up to pageSize executions or tasks per step.
+// deleteSourceHistory must be set for stores that partition history by shard (SQL stores).
+func NewResharder(
+	shardManager persistence.ShardManager,
+	executionManager persistence.ExecutionManager,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	sourceShardCount int32,
+	targetShardCount int32,
+	cutover bool,
+	deleteSourceHistory bool,
+	pageSize int,
+) (*Resharder, error) {
+	if sourceShardCount <= 0 || targetShardCount <= sourceShardCount || targetShardCount%sourceShardCount != 0 {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"Target shard count %d must be a multiple of the source shard count %d larger than it.",
+			targetShardCount,
+			sourceShardCount,
+		)
+	}
+	if pageSize <= 0 {
+		return nil, serviceerror.NewInvalidArgumentf("Page size must be positive, but was %d.", pageSize)
+	}
+	return &Resharder{
+		shardManager:           shardManager,
+		executionManager:       executionManager,
+		clusterMetadataManager: clusterMetadataManager,
+		sourceShardCount:       sourceShardCount,
+		targetShardCount:       targetShardCount,
+		cutover:                cutover,
+		deleteSourceHistory:    deleteSourceHistory,
+		pageSize:               pageSize,
+		rangeIDs:               make(map[int32]int64),
+	}, nil
+}
+
+// Start returns the position resharding starts from.
+func Start() Position {
+	return Position{Phase: PhaseCopyExecutions, ShardID: 1}
+}
+
+// RequiresCutover reports whether a phase only runs when cutover is requested. The history service
+// must be stopped while these phases run.
+func RequiresCutover(phase Phase) bool {
+	switch phase {
+	case PhaseCopyTasks, PhaseCutover, PhaseCleanup:
+		return true
+	default:
+		return false
+	}
+}
+
+// Step processes a page at the given position, and returns the position to continue from. Resharding
+// is done when the returned position is in PhaseCompleted. Steps are idempotent and can be retried.
+func (r *Resharder) Step(ctx context.Context, position Position) (Position, Stats, error) {
+	var stats Stats
+	if err := r.validate(position); err != nil {
+		return position, stats, err
+	}
+
+	var pageToken []byte
+	var err error
+	switch position.Phase {
+	case PhaseCopyExecutions:
+		pageToken, err = r.copyExecutions(ctx, position.ShardID, position.PageToken, &stats)
+	case PhasePruneExecutions:
+		pageToken, err = r.pruneExecutions(ctx, position.ShardID, position.PageToken, &stats)
+	case PhaseCopyTasks:
+		category, _ := taskCategory(position.TaskCategoryID)
+		pageToken, err = r.copyTasks(ctx, position.ShardID, category, position.PageToken, &stats)
+	case PhaseCutover:
+		err = r.updateShardCount(ctx)
+	case PhaseCleanup:
+		if position.TaskCategoryID == 0 {
+			pageToken, err = r.cleanupExecutions(ctx, position.ShardID, position.PageToken, &stats)
+		} else {
+			category, _ := taskCategory(position.TaskCategoryID)
+			pageToken, err = r.cleanupTasks(ctx, position.ShardID, category, position.PageToken, &stats)
+		}
+	}
+	if err != nil {
+		return position, stats, err
+	}
+	if len(pageToken) > 0 {
+		position.PageToken = pageToken
+		return position, stats, nil
+	}
+	return r.next(position), stats, nil
+}
+
+func (r *Resharder) validate(position Position) error {
+	switch position.Phase {
+	case PhaseCopyExecutions:
+		return r.validateShardID(position.ShardID, 1, r.sourceShardCount)
+	case PhasePruneExecutions:
+		return r.validateShardID(position.ShardID, r.sourceShardCount+1, r.targetShardCount)
+	case PhaseCopyTasks, PhaseCleanup:
+		if !r.cutover {
+			return errCutoverNotRequested
+		}
+		if _, ok := taskCategory(position.TaskCategoryID); !ok &&
+			(position.Phase == PhaseCopyTasks || position.TaskCategoryID != 0) {
+			return serviceerror.NewInvalidArgumentf("Unknown task category %d.", position.TaskCategoryID)
+		}
+		return r.validateShardID(position.ShardID, 1, r.sourceShardCount)
+	case PhaseCutover:
+		if !r.cutover {
+			return errCutoverNotRequested
+		}
+		return nil
+	case PhaseCompleted:
+		return serviceerror.NewInvalidArgument("Resharding is completed.")
+	default:
+		return serviceerror.NewInvalidArgumentf("Unknown resharding phase %q.", position.Phase)
+	}
+}
+
+func (r *Resharder) validateShardID(shardID int32, minShardID int32, maxShardID int32) error {
+	if shardID < minShardID || shardID > maxShardID {
+		return serviceerror.NewInvalidArgumentf(
+			"Shard %d is out of range [%d, %d] of the resharding phase.", shardID, minShardID, maxShardID)
+	}
+	return nil
+}
+
+// next returns the position after the given one, once all its pages were processed.
+func (r *Resharder) next(position Position) Position {
+	switch position.Phase {
+	case PhaseCopyExecutions:
+		if position.ShardID < r.sourceShardCount {
+			return Position{Phase: PhaseCopyExecutions, ShardID: position.ShardID + 1}
+		}
+		return Position{Phase: PhasePruneExecutions, ShardID: r.sourceShardCount + 1}
+	case PhasePruneExecutions:
+		if position.ShardID < r.targetShardCount {
+			return Position{Phase: PhasePruneExecutions, ShardID: position.ShardID + 1}
+		}
+		if !r.cutover {
+			return Position{Phase: PhaseCompleted}
+		}
+		return Position{Phase: PhaseCopyTasks, ShardID: 1, TaskCategoryID: taskCategories[0].ID()}
+	case PhaseCopyTasks:
+		if categoryID, ok := nextTaskCategoryID(position.TaskCategoryID); ok {
+			return Position{Phase: PhaseCopyTasks, ShardID: position.ShardID, TaskCategoryID: categoryID}
+		}
+		if position.ShardID < r.sourceShardCount {
+			return Position{Phase: PhaseCopyTasks, ShardID: position.ShardID + 1, TaskCategoryID: taskCategories[0].ID()}
+		}
+		return Position{Phase: PhaseCutover}
+	case PhaseCutover:
+		return Position{Phase: PhaseCleanup, ShardID: 1}
+	case PhaseCleanup:
+		if categoryID, ok := nextTaskCategoryID(position.TaskCategoryID); ok {
+			return Position{Phase: PhaseCleanup, ShardID: position.ShardID, TaskCategoryID: categoryID}
+		}
+		if position.ShardID < r.sourceShardCount {
+			return Position{Phase: PhaseCleanup, ShardID: position.ShardID + 1}
+		}
+	}
+	return Position{Phase: PhaseCompleted}
+}
+
+func (r *Resharder) copyExecutions(
+	ctx context.Context,
+	shardID int32,
+	pageToken []byte,
+	stats *Stats,
+) ([]byte, error) {
+	resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  r.pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range resp.States {
+		key := workflowKey(state)
+		targetShardID := r.targetShardID(key.NamespaceID, key.WorkflowID)
+		if targetShardID == shardID {
+			continue
+		}
+		if err := r.copyExecution(ctx, shardID, targetShardID, key, archetypeID(state), stats); err != nil {
+			return nil, err
+		}
+	}
+	return resp.PageToken, nil
+}
+
+// copyExecution copies an execution with its history to its new shard, unless it was already copied
+// and did not change since. It replaces the current execution record of the new shard when the
+// execution is the current one.
+func (r *Resharder) copyExecution(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	key definition.WorkflowKey,
+	archetypeID chasm.ArchetypeID,
+	stats *Stats,
+) error {
+	source, err := r.getExecution(ctx, sourceShardID, key, archetypeID)
+	if err != nil || source == nil {
+		// The execution was deleted since it was listed.
+		return err
+	}
+	target, err := r.getExecution(ctx, targetShardID, key, archetypeID)
+	if err != nil {
+		return err
+	}
+	if target != nil && sameExecution(source, target) {
+		stats.ExecutionsUnchanged++
+		return nil
+	}
+	if len(source.State.BufferedEvents) > 0 && source.DBRecordVersion <= 1 {
+		return serviceerror.NewFailedPreconditionf(
+			"Execution %v has buffered events but no record version, retry once they are flushed.", key)
+	}
+
+	rangeID, err := r.getRangeID(ctx, targetShardID, sourceShardID)
+	if err != nil {
+		return err
+	}
+	if err := r.copyHistory(ctx, sourceShardID, targetShardID, key, source.State.ExecutionInfo); err != nil {
+		return err
+	}
+	sourceCurrentRunID, err := r.getCurrentRunID(ctx, sourceShardID, key, archetypeID)
+	if err != nil {
+		return err
+	}
+	targetCurrentRunID, err := r.getCurrentRunID(ctx, targetShardID, key, archetypeID)
+	if err != nil {
+		return err
+	}
+	isCurrent := sourceCurrentRunID == key.RunID
+
+	if target != nil {
+		if err := r.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+			ShardID:     targetShardID,
+			NamespaceID: key.NamespaceID,
+			WorkflowID:  key.WorkflowID,
+			RunID:       key.RunID,
+			ArchetypeID: archetypeID,
+		}); err != nil {
+			return err
+		}
+	}
+	if targetCurrentRunID != "" && (isCurrent || targetCurrentRunID == key.RunID) {
+		if err := r.deleteCurrentExecution(ctx, targetShardID, key, targetCurrentRunID, archetypeID); err != nil {
+			return err
+		}
+	}
+	if err := r.createExecution(ctx, targetShardID, rangeID, isCurrent, archetypeID, source); err != nil {
+		return err
+	}
+	stats.ExecutionsCopied++
+	return nil
+}
+
+// createExecution writes the mutable state of an execution to a shard. Buffered events cannot be
+// part of a snapshot, so they are written by an update that brings the record version to the one
+// of the source execution.
+func (r *Resharder) createExecution(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+	isCurrent bool,
+	archetypeID chasm.ArchetypeID,
+	execution *persistence.GetWorkflowExecutionResponse,
+) error {
+	state := execution.State
+	if state.ExecutionInfo.ExecutionStats == nil {
+		state.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{}
+	}
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIds))
+	for _, id := range state.SignalRequestedIds {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	dbRecordVersion := execution.DBRecordVersion
+	if len(state.BufferedEvents) > 0 {
+		dbRecordVersion--
+	}
+
+	mode := persistence.CreateWorkflowModeBypassCurrent
+	if isCurrent {
+		mode = persistence.CreateWorkflowModeBrandNew
+	}
+	if _, err := r.executionManager.CreateWorkflowExecution(ctx, &persistence.CreateWorkflowExecutionRequest{
+		ShardID:     shardID,
+		RangeID:     rangeID,
+		Mode:        mode,
+		ArchetypeID: archetypeID,
+		NewWorkflowSnapshot: persistence.WorkflowSnapshot{
+			ExecutionInfo:       state.ExecutionInfo,
+			ExecutionState:      state.ExecutionState,
+			NextEventID:         state.NextEventId,
+			ActivityInfos:       state.ActivityInfos,
+			TimerInfos:          state.TimerInfos,
+			ChildExecutionInfos: state.ChildExecutionInfos,
+			RequestCancelInfos:  state.RequestCancelInfos,
+			SignalInfos:         state.SignalInfos,
+			SignalRequestedIDs:  signalRequestedIDs,
+			ChasmNodes:          state.ChasmNodes,
+			Condition:           state.NextEventId,
+			DBRecordVersion:     dbRecordVersion,
+			Checksum:            state.Checksum,
+		},
+	}); err != nil {
+		return err
+	}
+	if len(state.BufferedEvents) == 0 {
+		return nil
+	}
+
+	updateMode := persistence.UpdateWorkflowModeBypassCurrent
+	if isCurrent {
+		updateMode = persistence.UpdateWorkflowModeUpdateCurrent
+	}
+	_, err := r.executionManager.UpdateWorkflowExecution(ctx, &persistence.UpdateWorkflowExecutionRequest{
+		ShardID:     shardID,
+		RangeID:     rangeID,
+		Mode:        updateMode,
+		ArchetypeID: archetypeID,
+		UpdateWorkflowMutation: persistence.WorkflowMutation{
+			ExecutionInfo:     state.ExecutionInfo,
+			ExecutionState:    state.ExecutionState,
+			NextEventID:       state.NextEventId,
+			NewBufferedEvents: state.BufferedEvents,
+			Condition:         state.NextEventId,
+			DBRecordVersion:   execution.DBRecordVersion,
+			Checksum:          state.Checksum,
+		},
+	})
+	return err
+}
+
+// copyHistory copies all the history branches of an execution. History nodes are upserted, so
+// branches that were copied before are overwritten.
+func (r *Resharder) copyHistory(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	key definition.WorkflowKey,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) error {
+	for _, versionHistory := range executionInfo.GetVersionHistories().GetHistories() {
+		items := versionHistory.GetItems()
+		if len(items) == 0 || len(versionHistory.GetBranchToken()) == 0 {
+			continue
+		}
+		lastEventID := items[len(items)-1].GetEventId()
+		if err := r.copyHistoryBranch(
+			ctx, sourceShardID, targetShardID, key, versionHistory.GetBranchToken(), lastEventID,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyHistoryBranch copies the history of a branch. Nodes of ancestor branches are written to the
+// ancestor branch they belong to, as readers of a branch look them up there.
+func (r *Resharder) copyHistoryBranch(
+	ctx context.Context,
+	sourceShardID int32,
+	targetShardID int32,
+	key definition.WorkflowKey,
+	branchToken []byte,
+	lastEventID int64,
+) error {
+	branchUtil := r.executionManager.GetHistoryBranchUtil()
+	branch, err := branchUtil.ParseHistoryBranchInfo(branchToken)
+	if err != nil {
+		return err
+	}
+	ancestors := slices.Clone(branch.GetAncestors())
+	slices.SortFunc(ancestors, func(a, b *persistencespb.HistoryBranchRange) int {
+		return int(a.GetBeginNodeId() - b.GetBeginNodeId())
+	})
+	info := persistence.BuildHistoryGarbageCleanupInfo(key.NamespaceID, key.WorkflowID, key.RunID)
+
+	isNewBranch := true
+	var prevTransactionID int64
+	var pageToken []byte
+	for {
+		resp, err := r.executionManager.ReadHistoryBranchByBatch(ctx, &persistence.ReadHistoryBranchRequest{
+			ShardID:       sourceShardID,
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    lastEventID + 1,
+			PageSize:      historyPageSize,
+			NextPageToken: pageToken,
+		})
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			// The history was deleted, which happens before the mutable state is.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for i, batch := range resp.History {
+			events := batch.GetEvents()
+			if len(events) == 0 {
+				continue
+			}
+			nodeBranchToken := branchToken
+			isAncestorNode := false
+			for j, ancestor := range ancestors {
+				if events[0].GetEventId() < ancestor.GetEndNodeId() {
+					nodeBranchToken, err = branchUtil.UpdateHistoryBranchInfo(branchToken, &persistencespb.HistoryBranch{
+						TreeId:    branch.GetTreeId(),
+						BranchId:  ancestor.GetBranchId(),
+						Ancestors: ancestors[:j],
+					}, key.RunID)
+					if err != nil {
+						return err
+					}
+					isAncestorNode = true
+					break
+				}
+			}
+			if _, err := r.executionManager.AppendHistoryNodes(ctx, &persistence.AppendHistoryNodesRequest{
+				ShardID:           targetShardID,
+				NamespaceID:       key.NamespaceID,
+				IsNewBranch:       isNewBranch && !isAncestorNode,
+				Info:              info,
+				BranchToken:       nodeBranchToken,
+				Events:            events,
+				PrevTransactionID: prevTransactionID,
+				TransactionID:     resp.TransactionIDs[i],
+			}); err != nil {
+				return err
+			}
+			if !isAncestorNode {
+				isNewBranch = false
+			}
+			prevTransactionID = resp.TransactionIDs[i]
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+func (r *Resharder) pruneExecutions(
+	ctx context.Context,
+	shardID int32,
+	pageToken []byte,
+	stats *Stats,
+) ([]byte, error) {
+	resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  r.pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sourceShardID := common.MapShardID(r.targetShardCount, r.sourceShardCount, shardID)[0]
+	for _, state := range resp.States {
+		key := workflowKey(state)
+		archetypeID := archetypeID(state)
+		source, err := r.getExecution(ctx, sourceShardID, key, archetypeID)
+		if err != nil {
+			return nil, err
+		}
+		if source != nil {
+			continue
+		}
+		if err := r.deleteExecution(ctx, shardID, key, archetypeID); err != nil {
+			return nil, err
+		}
+		stats.ExecutionsPruned++
+	}
+	return resp.PageToken, nil
+}
+
+// copyTasks copies the tasks of a category of the executions that move from a source shard. The tasks
+// the new shards have in this category are deleted before the first page is copied.
+func (r *Resharder) copyTasks(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	pageToken []byte,
+	stats *Stats,
+) ([]byte, error) {
+	minKey, maxKey := taskRange(category)
+	if len(pageToken) == 0 {
+		for _, targetShardID := range common.MapShardID(r.sourceShardCount, r.targetShardCount, shardID)[1:] {
+			if err := r.executionManager.RangeCompleteHistoryTasks(ctx, &persistence.RangeCompleteHistoryTasksRequest{
+				ShardID:             targetShardID,
+				TaskCategory:        category,
+				InclusiveMinTaskKey: minKey,
+				ExclusiveMaxTaskKey: maxKey,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resp, err := r.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+		ShardID:             shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: minKey,
+		ExclusiveMaxTaskKey: maxKey,
+		BatchSize:           r.pageSize,
+		NextPageToken:       pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range resp.Tasks {
+		targetShardID := r.targetShardID(task.GetNamespaceID(), task.GetWorkflowID())
+		if targetShardID == shardID {
+			continue
+		}
+		// Task IDs are allocated from the range ID, so the new shard must not allocate IDs below the
+		// ones of the tasks copied from the source shard.
+		rangeID, err := r.getRangeID(ctx, targetShardID, shardID)
+		if err != nil {
+			return nil, err
+		}
+		archetypeID := chasm.WorkflowArchetypeID
+		if t, ok := task.(tasks.HasArchetypeID); ok && t.GetArchetypeID() != chasm.UnspecifiedArchetypeID {
+			archetypeID = t.GetArchetypeID()
+		}
+		if err := r.executionManager.AddHistoryTasks(ctx, &persistence.AddHistoryTasksRequest{
+			ShardID:     targetShardID,
+			RangeID:     rangeID,
+			NamespaceID: task.GetNamespaceID(),
+			WorkflowID:  task.GetWorkflowID(),
+			ArchetypeID: archetypeID,
+			Tasks:       map[tasks.Category][]tasks.Task{category: {task}},
+		}); err != nil {
+			return nil, err
+		}
+		stats.TasksCopied++
+	}
+	return resp.NextPageToken, nil
+}
+
+// updateShardCount sets the history shard count of the current cluster to the target shard count.
+func (r *Resharder) updateShardCount(ctx context.Context) error {
+	resp, err := r.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	switch resp.GetHistoryShardCount() {
+	case r.targetShardCount:
+		return nil
+	case r.sourceShardCount:
+	default:
+		return serviceerror.NewFailedPreconditionf(
+			"Cluster has %d history shards, expected %d.", resp.GetHistoryShardCount(), r.sourceShardCount)
+	}
+	metadata := common.CloneProto(resp.ClusterMetadata)
+	metadata.HistoryShardCount = r.targetShardCount
+	applied, err := r.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: metadata,
+		Version:         resp.Version,
+	})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return serviceerror.NewUnavailable("Cluster metadata was updated concurrently, retry.")
+	}
+	return nil
+}
+
+// cleanupExecutions deletes the executions that moved from a source shard. Executions are copied
+// again first in case they changed since copy-executions.
+func (r *Resharder) cleanupExecutions(
+	ctx context.Context,
+	shardID int32,
+	pageToken []byte,
+	stats *Stats,
+) ([]byte, error) {
+	resp, err := r.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   shardID,
+		PageSize:  r.pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range resp.States {
+		key := workflowKey(state)
+		targetShardID := r.targetShardID(key.NamespaceID, key.WorkflowID)
+		if targetShardID == shardID {
+			continue
+		}
+		archetypeID := archetypeID(state)
+		if err := r.copyExecution(ctx, shardID, targetShardID, key, archetypeID, stats); err != nil {
+			return nil, err
+		}
+		if err := r.deleteExecution(ctx, shardID, key, archetypeID); err != nil {
+			return nil, err
+		}
+		stats.ExecutionsDeleted++
+	}
+	return resp.PageToken, nil
+}
+
+func (r *Resharder) cleanupTasks(
+	ctx context.Context,
+	shardID int32,
+	category tasks.Category,
+	pageToken []byte,
+	stats *Stats,
+) ([]byte, error) {
+	minKey, maxKey := taskRange(category)
+	resp, err := r.executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+		ShardID:             shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: minKey,
+		ExclusiveMaxTaskKey: maxKey,
+		BatchSize:           r.pageSize,
+		NextPageToken:       pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range resp.Tasks {
+		if r.targetShardID(task.GetNamespaceID(), task.GetWorkflowID()) == shardID {
+			continue
+		}
+		if err := r.executionManager.CompleteHistoryTask(ctx, &persistence.CompleteHistoryTaskRequest{
+			ShardID:      shardID,
+			TaskCategory: category,
+			TaskKey:      task.GetKey(),
+		}); err != nil {
+			return nil, err
+		}
+		stats.TasksDeleted++
+	}
+	return resp.NextPageToken, nil
+}
+
+// getRangeID returns the range ID of a new shard, creating the shard if needed. The range ID is raised
+// to the one of its source shard, so that the task IDs it allocates are above the ones of the source
+// shard.
+func (r *Resharder) getRangeID(ctx context.Context, shardID int32, sourceShardID int32) (int64, error) {
+	if rangeID, ok := r.rangeIDs[shardID]; ok {
+		return rangeID, nil
+	}
+	source, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: sourceShardID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	minRangeID := source.ShardInfo.GetRangeId()
+	resp, err := r.shardManager.GetOrCreateShard(ctx, &persistence.GetOrCreateShardRequest{
+		ShardID: shardID,
+		InitialShardInfo: &persistencespb.ShardInfo{
+			ShardId: shardID,
+			RangeId: minRangeID,
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	shardInfo := resp.ShardInfo
+	if previousRangeID := shardInfo.GetRangeId(); previousRangeID < minRangeID {
+		shardInfo.RangeId = minRangeID
+		if err := r.shardManager.UpdateShard(ctx, &persistence.UpdateShardRequest{
+			ShardInfo:       shardInfo,
+			PreviousRangeID: previousRangeID,
+		}); err != nil {
+			return 0, err
+		}
+	}
+	r.rangeIDs[shardID] = shardInfo.GetRangeId()
+	return shardInfo.GetRangeId(), nil
+}
+
+// getExecution returns an execution, or nil if it does not exist.
+func (r *Resharder) getExecution(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	archetypeID chasm.ArchetypeID,
+) (*persistence.GetWorkflowExecutionResponse, error) {
+	resp, err := r.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+		ArchetypeID: archetypeID,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	return resp, err
+}
+
+// getCurrentRunID returns the run ID of the current execution of a workflow, or an empty string if
+// there is none.
+func (r *Resharder) getCurrentRunID(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	archetypeID chasm.ArchetypeID,
+) (string, error) {
+	resp, err := r.executionManager.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		ArchetypeID: archetypeID,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.RunID, nil
+}
+
+// deleteExecution deletes an execution from a shard, along with the current execution record if it
+// points to it. Its history is deleted too when the store partitions history by shard.
+func (r *Resharder) deleteExecution(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	archetypeID chasm.ArchetypeID,
+) error {
+	if r.deleteSourceHistory {
+		if err := r.deleteHistory(ctx, shardID, key, archetypeID); err != nil {
+			return err
+		}
+	}
+	currentRunID, err := r.getCurrentRunID(ctx, shardID, key, archetypeID)
+	if err != nil {
+		return err
+	}
+	if currentRunID == key.RunID {
+		if err := r.deleteCurrentExecution(ctx, shardID, key, currentRunID, archetypeID); err != nil {
+			return err
+		}
+	}
+	return r.executionManager.DeleteWorkflowExecution(ctx, &persistence.DeleteWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+		ArchetypeID: archetypeID,
+	})
+}
+
+// deleteHistory deletes the history branches of an execution from a shard. Like the history
+// service, history is deleted before the mutable state, so that it is retried if that fails.
+func (r *Resharder) deleteHistory(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	archetypeID chasm.ArchetypeID,
+) error {
+	execution, err := r.getExecution(ctx, shardID, key, archetypeID)
+	if err != nil || execution == nil {
+		return err
+	}
+	for _, versionHistory := range execution.State.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		if len(versionHistory.GetBranchToken()) == 0 {
+			continue
+		}
+		err := r.executionManager.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+			ShardID:     shardID,
+			BranchToken: versionHistory.GetBranchToken(),
+		})
+		var notFound *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Resharder) deleteCurrentExecution(
+	ctx context.Context,
+	shardID int32,
+	key definition.WorkflowKey,
+	runID string,
+	archetypeID chasm.ArchetypeID,
+) error {
+	return r.executionManager.DeleteCurrentWorkflowExecution(ctx, &persistence.DeleteCurrentWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       runID,
+		ArchetypeID: archetypeID,
+	})
+}
+
+func (r *Resharder) targetShardID(namespaceID string, workflowID string) int32 {
+	return common.WorkflowIDToHistoryShard(namespaceID, workflowID, r.targetShardCount)
+}
+
+func workflowKey(state *persistencespb.WorkflowMutableState) definition.WorkflowKey {
+	return definition.NewWorkflowKey(
+		state.GetExecutionInfo().GetNamespaceId(),
+		state.GetExecutionInfo().GetWorkflowId(),
+		state.GetExecutionState().GetRunId(),
+	)
+}
+
+// archetypeID returns the archetype of an execution, which is the type of its root CHASM component.
+func archetypeID(state *persistencespb.WorkflowMutableState) chasm.ArchetypeID {
+	if typeID := state.GetChasmNodes()[""].GetMetadata().GetComponentAttributes().GetTypeId(); typeID != chasm.UnspecifiedArchetypeID {
+		return typeID
+	}
+	return chasm.WorkflowArchetypeID
+}
+
+// sameExecution reports whether two executions have the same mutable state.
+func sameExecution(a *persistence.GetWorkflowExecutionResponse, b *persistence.GetWorkflowExecutionResponse) bool {
+	return a.DBRecordVersion == b.DBRecordVersion && proto.Equal(normalizeState(a.State), normalizeState(b.State))
+}
+
+// normalizeState returns a copy of a mutable state without the differences that writing it to another
+// shard introduces.
+func normalizeState(state *persistencespb.WorkflowMutableState) *persistencespb.WorkflowMutableState {
+	state = common.CloneProto(state)
+	slices.Sort(state.SignalRequestedIds)
+	if state.ExecutionInfo != nil && state.ExecutionInfo.ExecutionStats == nil {
+		state.ExecutionInfo.ExecutionStats = &persistencespb.ExecutionStats{}
+	}
+	return state
+}
+
+func taskCategory(categoryID int) (tasks.Category, bool) {
+	for _, category := range taskCategories {
+		if category.ID() == categoryID {
+			return category, true
+		}
+	}
+	return tasks.Category{}, false
+}
+
+// nextTaskCategoryID returns the ID of the task category after the given one, or the first one if the
+// given ID is 0.
+func nextTaskCategoryID(categoryID int) (int, bool) {
+	if categoryID == 0 {
+		return taskCategories[0].ID(), true
+	}
+	i := slices.IndexFunc(taskCategories, func(category tasks.Category) bool {
+		return category.ID() == categoryID
+	})
+	if i < 0 || i+1 >= len(taskCategories) {
+		return 0, false
+	}
+	return taskCategories[i+1].ID(), true
+}
+
+// taskRange returns the range that contains all the tasks of a category.
+func taskRange(category tasks.Category) (tasks.Key, tasks.Key) {
+	if category.Type() == tasks.CategoryTypeScheduled {
+		return tasks.NewKey(tasks.DefaultFireTime, 0), tasks.NewKey(tasks.MaximumKey.FireTime, 0)
+	}
+	return tasks.NewImmediateKey(0), tasks.NewImmediateKey(math.MaxInt64)
+}
@@ -0,0 +1,62 @@
+package reshard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+// Resharding itself is tested against every persistence store by ReshardSuite in
+// common/persistence/tests.
+
+func TestNewResharder_InvalidArguments(t *testing.T) {
+	for _, tc := range []struct {
+		source, target int32
+		pageSize       int
+	}{
+		{source: 2, target: 2, pageSize: 10},
+		{source: 2, target: 1, pageSize: 10},
+		{source: 2, target: 5, pageSize: 10},
+		{source: 0, target: 4, pageSize: 10},
+		{source: 2, target: 4, pageSize: 0},
+	} {
+		_, err := NewResharder(nil, nil, nil, tc.source, tc.target, false, false, tc.pageSize)
+		var invalidArgument *serviceerror.InvalidArgument
+		require.ErrorAs(t, err, &invalidArgument, "source %d, target %d, page size %d", tc.source, tc.target, tc.pageSize)
+	}
+}
+
+func TestStep_InvalidPosition(t *testing.T) {
+	ctx := context.Background()
+	resharder, err := NewResharder(nil, nil, nil, 2, 6, false, false, 3)
+	require.NoError(t, err)
+	for _, position := range []Position{
+		{Phase: PhaseCopyExecutions, ShardID: 3},
+		{Phase: PhasePruneExecutions, ShardID: 1},
+		{Phase: PhaseCopyTasks, ShardID: 1, TaskCategoryID: tasks.CategoryIDTransfer},
+		{Phase: PhaseCutover},
+		{Phase: PhaseCompleted},
+		{Phase: "unknown"},
+	} {
+		_, _, err := resharder.Step(ctx, position)
+		var invalidArgument *serviceerror.InvalidArgument
+		require.ErrorAs(t, err, &invalidArgument, "position %+v", position)
+	}
+
+	resharder, err = NewResharder(nil, nil, nil, 2, 6, true, false, 3)
+	require.NoError(t, err)
+	_, _, err = resharder.Step(ctx, Position{Phase: PhaseCopyTasks, ShardID: 1, TaskCategoryID: tasks.CategoryIDMemoryTimer})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestRequiresCutover(t *testing.T) {
+	require.False(t, RequiresCutover(PhaseCopyExecutions))
+	require.False(t, RequiresCutover(PhasePruneExecutions))
+	require.True(t, RequiresCutover(PhaseCopyTasks))
+	require.True(t, RequiresCutover(PhaseCutover))
+	require.True(t, RequiresCutover(PhaseCleanup))
+}
@@ -55,7 +55,11 @@ func Decode(data *commonpb.DataBlob, result proto.Message) error {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
 	case enumspb.ENCODING_TYPE_PROTO3:
-		err := proto.Unmarshal(data.Data, result)
+		data, err := DecompressBlob(data)
+		if err != nil {
+			return err
+		}
+		err = proto.Unmarshal(data.Data, result)
 		if err != nil {
 			return NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
 		}
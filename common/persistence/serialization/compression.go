@@ -0,0 +1,160 @@
+package serialization
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+type (
+	// CompressionType identifies the codec used to compress a proto3 blob.
+	CompressionType byte
+
+	// CompressionConfig returns the history compression settings of a namespace.
+	CompressionConfig func(namespaceID namespace.ID) dynamicconfig.HistoryCompressionSettings
+)
+
+const (
+	CompressionTypeNone CompressionType = iota
+	CompressionTypeZstd
+	CompressionTypeSnappy
+)
+
+// compressedBlobMagic prefixes every compressed proto3 blob. The low three bits of a protobuf
+// field tag encode the wire type and 7 is not a valid wire type, so a well-formed proto3
+// message can never start with this byte. That lets Decode tell compressed and plain blobs
+// apart without a dedicated EncodingType.
+const compressedBlobMagic byte = 0xff
+
+// compressedBlobHeaderSize is the size of the magic byte plus the codec byte.
+const compressedBlobHeaderSize = 2
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+
+	errTruncatedCompressedBlob = errors.New("compressed blob is truncated")
+)
+
+// CompressionTypeFromString parses the codec names accepted by dynamic config.
+func CompressionTypeFromString(s string) (CompressionType, error) {
+	switch s {
+	case "", "none":
+		return CompressionTypeNone, nil
+	case "zstd":
+		return CompressionTypeZstd, nil
+	case "snappy":
+		return CompressionTypeSnappy, nil
+	default:
+		return CompressionTypeNone, fmt.Errorf("unknown compression type: %q", s)
+	}
+}
+
+func (c CompressionType) String() string {
+	switch c {
+	case CompressionTypeNone:
+		return "none"
+	case CompressionTypeZstd:
+		return "zstd"
+	case CompressionTypeSnappy:
+		return "snappy"
+	default:
+		return fmt.Sprintf("CompressionType(%d)", byte(c))
+	}
+}
+
+// IsCompressedBlob reports whether the blob carries a compressed proto3 payload.
+func IsCompressedBlob(blob *commonpb.DataBlob) bool {
+	return blob.GetEncodingType() == enumspb.ENCODING_TYPE_PROTO3 && isCompressedData(blob.GetData())
+}
+
+// CompressBlob returns a copy of a proto3 blob with its payload compressed using the given codec.
+// Blobs with other encodings, empty blobs and already compressed blobs are returned unchanged.
+func CompressBlob(blob *commonpb.DataBlob, compression CompressionType) (*commonpb.DataBlob, error) {
+	if compression == CompressionTypeNone ||
+		blob.GetEncodingType() != enumspb.ENCODING_TYPE_PROTO3 ||
+		len(blob.GetData()) == 0 ||
+		isCompressedData(blob.GetData()) {
+		return blob, nil
+	}
+	data, err := compress(blob.Data, compression)
+	if err != nil {
+		return nil, NewSerializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         data,
+	}, nil
+}
+
+// DecompressBlob returns a copy of the blob with a plain proto3 payload. Uncompressed blobs are
+// returned unchanged. Use this before handing persisted blobs to anything outside the server,
+// e.g. raw history returned to clients or sent to remote clusters.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsCompressedBlob(blob) {
+		return blob, nil
+	}
+	data, err := decompress(blob.Data)
+	if err != nil {
+		return nil, NewDeserializationError(enumspb.ENCODING_TYPE_PROTO3, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         data,
+	}, nil
+}
+
+func isCompressedData(data []byte) bool {
+	return len(data) > 0 && data[0] == compressedBlobMagic
+}
+
+func compress(data []byte, compression CompressionType) ([]byte, error) {
+	header := []byte{compressedBlobMagic, byte(compression)}
+	switch compression {
+	case CompressionTypeZstd:
+		return zstdEncoder.EncodeAll(data, header), nil
+	case CompressionTypeSnappy:
+		return append(header, snappy.Encode(nil, data)...), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression type: %v", compression)
+	}
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if len(data) < compressedBlobHeaderSize {
+		return nil, errTruncatedCompressedBlob
+	}
+	compression := CompressionType(data[1])
+	payload := data[compressedBlobHeaderSize:]
+	switch compression {
+	case CompressionTypeZstd:
+		return zstdDecoder.DecodeAll(payload, nil)
+	case CompressionTypeSnappy:
+		return snappy.Decode(nil, payload)
+	default:
+		return nil, fmt.Errorf("unsupported compression type: %v", compression)
+	}
+}
+
+// compressionFor resolves the codec configured for history of the namespace. Invalid codec
+// names disable compression rather than failing writes.
+func (c CompressionConfig) compressionFor(namespaceID string, size int) CompressionType {
+	if c == nil {
+		return CompressionTypeNone
+	}
+	settings := c(namespace.ID(namespaceID))
+	if size < settings.MinSize {
+		return CompressionTypeNone
+	}
+	compression, err := CompressionTypeFromString(settings.Codec)
+	if err != nil {
+		return CompressionTypeNone
+	}
+	return compression
+}
@@ -0,0 +1,131 @@
+package serialization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestCompressBlob_RoundTrip(t *testing.T) {
+	info := &persistencespb.WorkflowExecutionInfo{
+		WorkflowId: strings.Repeat("workflow-id-", 200),
+	}
+	plain, err := ProtoEncode(info)
+	require.NoError(t, err)
+
+	for _, compression := range []CompressionType{CompressionTypeZstd, CompressionTypeSnappy} {
+		t.Run(compression.String(), func(t *testing.T) {
+			compressed, err := CompressBlob(plain, compression)
+			require.NoError(t, err)
+			assert.Equal(t, enumspb.ENCODING_TYPE_PROTO3, compressed.EncodingType)
+			assert.True(t, IsCompressedBlob(compressed))
+			assert.Less(t, len(compressed.Data), len(plain.Data))
+
+			var result persistencespb.WorkflowExecutionInfo
+			require.NoError(t, Decode(compressed, &result))
+			protorequire.ProtoEqual(t, info, &result)
+
+			decompressed, err := DecompressBlob(compressed)
+			require.NoError(t, err)
+			assert.Equal(t, plain.Data, decompressed.Data)
+		})
+	}
+}
+
+func TestCompressBlob_Passthrough(t *testing.T) {
+	jsonBlob := &commonpb.DataBlob{Data: []byte(`{}`), EncodingType: enumspb.ENCODING_TYPE_JSON}
+	blob, err := CompressBlob(jsonBlob, CompressionTypeZstd)
+	require.NoError(t, err)
+	assert.Same(t, jsonBlob, blob)
+
+	emptyBlob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3}
+	blob, err = CompressBlob(emptyBlob, CompressionTypeZstd)
+	require.NoError(t, err)
+	assert.Same(t, emptyBlob, blob)
+
+	plain, err := ProtoEncode(&persistencespb.ShardInfo{ShardId: 1})
+	require.NoError(t, err)
+	blob, err = CompressBlob(plain, CompressionTypeNone)
+	require.NoError(t, err)
+	assert.Same(t, plain, blob)
+	assert.False(t, IsCompressedBlob(plain))
+	blob, err = DecompressBlob(plain)
+	require.NoError(t, err)
+	assert.Same(t, plain, blob)
+}
+
+func TestDecompressBlob_Corrupted(t *testing.T) {
+	_, err := DecompressBlob(&commonpb.DataBlob{
+		Data:         []byte{compressedBlobMagic},
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+	})
+	require.Error(t, err)
+	assert.IsType(t, &DeserializationError{}, err)
+
+	_, err = DecompressBlob(&commonpb.DataBlob{
+		Data:         []byte{compressedBlobMagic, 42, 1, 2, 3},
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported compression type")
+}
+
+func TestSerializerWithCompression(t *testing.T) {
+	settings := map[namespace.ID]dynamicconfig.HistoryCompressionSettings{
+		"zstd-namespace":   {Codec: "zstd"},
+		"snappy-namespace": {Codec: "snappy"},
+		"large-namespace":  {Codec: "zstd", MinSize: 1 << 20},
+	}
+	serializer := NewSerializerWithCompression(func(namespaceID namespace.ID) dynamicconfig.HistoryCompressionSettings {
+		return settings[namespaceID]
+	})
+
+	events := []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+	}
+	// Serialized events may leave the server, so they are never compressed.
+	historyBlob, err := serializer.SerializeEvents(events)
+	require.NoError(t, err)
+	assert.False(t, IsCompressedBlob(historyBlob))
+	infoBlob, err := serializer.WorkflowExecutionInfoToBlob(&persistencespb.WorkflowExecutionInfo{WorkflowId: "wid"})
+	require.NoError(t, err)
+	assert.False(t, IsCompressedBlob(infoBlob))
+	taskBlob, err := serializer.TransferTaskInfoToBlob(&persistencespb.TransferTaskInfo{WorkflowId: "wid"})
+	require.NoError(t, err)
+	assert.False(t, IsCompressedBlob(taskBlob))
+
+	for namespaceID, compression := range map[string]CompressionType{
+		"zstd-namespace":   CompressionTypeZstd,
+		"snappy-namespace": CompressionTypeSnappy,
+	} {
+		compressed, err := serializer.CompressEvents(namespaceID, historyBlob)
+		require.NoError(t, err)
+		require.True(t, IsCompressedBlob(compressed))
+		assert.Equal(t, byte(compression), compressed.Data[1])
+
+		// Blobs compressed for one namespace decode the same way with any serializer.
+		decoded, err := NewSerializer().DeserializeEvents(compressed)
+		require.NoError(t, err)
+		protorequire.ProtoSliceEqual(t, events, decoded)
+		stripped, err := serializer.DeserializeStrippedEvents(compressed)
+		require.NoError(t, err)
+		require.Len(t, stripped, 2)
+		assert.Equal(t, int64(2), stripped[1].EventId)
+	}
+
+	for _, namespaceID := range []string{"other-namespace", "large-namespace", ""} {
+		blob, err := serializer.CompressEvents(namespaceID, historyBlob)
+		require.NoError(t, err)
+		assert.Same(t, historyBlob, blob)
+	}
+}
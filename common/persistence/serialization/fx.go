@@ -3,5 +3,5 @@ package serialization
 import "go.uber.org/fx"
 
 var Module = fx.Options(
-	fx.Provide(NewSerializer),
+	fx.Provide(SerializerProvider),
 )
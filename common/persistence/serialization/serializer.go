@@ -14,6 +14,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/proto"
 )
@@ -28,6 +29,9 @@ type (
 	Encoder interface {
 		SerializeEvents(batch []*historypb.HistoryEvent) (*commonpb.DataBlob, error)
 		SerializeEvent(event *historypb.HistoryEvent) (*commonpb.DataBlob, error)
+		// CompressEvents compresses serialized events that are about to be written to persistence,
+		// as configured for the namespace. It must not be used on blobs that leave persistence.
+		CompressEvents(namespaceID string, data *commonpb.DataBlob) (*commonpb.DataBlob, error)
 		SerializeClusterMetadata(icm *persistencespb.ClusterMetadata) (*commonpb.DataBlob, error)
 		ShardInfoToBlob(info *persistencespb.ShardInfo) (*commonpb.DataBlob, error)
 		NamespaceDetailToBlob(info *persistencespb.NamespaceDetail) (*commonpb.DataBlob, error)
@@ -125,6 +129,7 @@ type (
 
 	serializerImpl struct {
 		encodingType enumspb.EncodingType
+		compression  CompressionConfig
 	}
 
 	marshaler interface {
@@ -136,6 +141,19 @@ func NewSerializer() Serializer {
 	return &serializerImpl{encodingType: enumspb.ENCODING_TYPE_PROTO3}
 }
 
+// NewSerializerWithCompression returns a Serializer that compresses history in CompressEvents
+// according to the given config. Blobs are decoded the same way regardless of the config.
+func NewSerializerWithCompression(compression CompressionConfig) Serializer {
+	return &serializerImpl{
+		encodingType: enumspb.ENCODING_TYPE_PROTO3,
+		compression:  compression,
+	}
+}
+
+func SerializerProvider(dc *dynamicconfig.Collection) Serializer {
+	return NewSerializerWithCompression(CompressionConfig(dynamicconfig.PersistenceHistoryCompression.Get(dc)))
+}
+
 func (t *serializerImpl) SerializeTask(
 	task tasks.Task,
 ) (*commonpb.DataBlob, error) {
@@ -208,8 +226,12 @@ func (t *serializerImpl) DeserializeStrippedEvents(data *commonpb.DataBlob) ([]*
 		return nil, nil
 	}
 
+	data, err := DecompressBlob(data)
+	if err != nil {
+		return nil, err
+	}
+
 	events := &historyspb.StrippedHistoryEvents{}
-	var err error
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Discard unknown fields to improve performance. StrippedHistoryEvents is usually deserialized from HistoryEvent
@@ -238,6 +260,10 @@ func (t *serializerImpl) SerializeEvent(event *historypb.HistoryEvent) (*commonp
 	return t.serialize(event)
 }
 
+func (t *serializerImpl) CompressEvents(namespaceID string, data *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	return CompressBlob(data, t.compression.compressionFor(namespaceID, len(data.GetData())))
+}
+
 func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.HistoryEvent, error) {
 	if data == nil {
 		return nil, nil
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -229,6 +230,26 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 		return nil, serviceerror.NewUnavailablef("GetWorkflowExecution: failed. Error: %v", err)
 	}
 
+	state, err := m.getWorkflowMutableState(ctx, request.ShardID, executionsRow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &p.InternalGetWorkflowExecutionResponse{
+		State:           state,
+		DBRecordVersion: executionsRow.DBRecordVersion,
+	}, nil
+}
+
+// getWorkflowMutableState reads the mutable state of the execution of an executions row.
+func (m *sqlExecutionStore) getWorkflowMutableState(
+	ctx context.Context,
+	shardID int32,
+	executionsRow *sqlplugin.ExecutionsRow,
+) (*p.InternalWorkflowMutableState, error) {
+	namespaceID := executionsRow.NamespaceID
+	workflowID := executionsRow.WorkflowID
+	runID := executionsRow.RunID
 	state := &p.InternalWorkflowMutableState{
 		ExecutionInfo:  p.NewDataBlob(executionsRow.Data, executionsRow.DataEncoding),
 		ExecutionState: p.NewDataBlob(executionsRow.State, executionsRow.StateEncoding),
@@ -237,9 +258,10 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 		DBRecordVersion: executionsRow.DBRecordVersion,
 	}
 
+	var err error
 	state.ActivityInfos, err = getActivityInfoMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -250,7 +272,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.TimerInfos, err = getTimerInfoMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -261,7 +283,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.ChildExecutionInfos, err = getChildExecutionInfoMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -272,7 +294,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.RequestCancelInfos, err = getRequestCancelInfoMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -283,7 +305,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.SignalInfos, err = getSignalInfoMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -294,7 +316,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.BufferedEvents, err = getBufferedEvents(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -305,7 +327,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.ChasmNodes, err = getChasmNodeMap(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -316,7 +338,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 
 	state.SignalRequestedIDs, err = getSignalsRequested(ctx,
 		m.DB,
-		request.ShardID,
+		shardID,
 		namespaceID,
 		workflowID,
 		runID,
@@ -324,11 +346,7 @@ func (m *sqlExecutionStore) GetWorkflowExecution(
 	if err != nil {
 		return nil, serviceerror.NewUnavailablef("GetWorkflowExecution: failed to get signals requested. Error: %v", err)
 	}
-
-	return &p.InternalGetWorkflowExecutionResponse{
-		State:           state,
-		DBRecordVersion: executionsRow.DBRecordVersion,
-	}, nil
+	return state, nil
 }
 
 func (m *sqlExecutionStore) UpdateWorkflowExecution(
@@ -733,11 +751,69 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 	)
 }
 
+// listConcreteExecutionsPageToken represents the primary key of the last row in the executions table
+// that we returned.
+type listConcreteExecutionsPageToken struct {
+	NamespaceID primitives.UUID
+	WorkflowID  string
+	RunID       primitives.UUID
+}
+
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		return nil, serviceerror.NewInvalidArgumentf("PageSize must be greater than 0, but was %d", pageSize)
+	}
+
+	filter := sqlplugin.ExecutionsRangeFilter{
+		ShardID: request.ShardID,
+		// Zero UUIDs rather than nil ones, which would compare as NULL.
+		MinNamespaceID: make(primitives.UUID, 16),
+		MinRunID:       make(primitives.UUID, 16),
+		PageSize:       pageSize,
+	}
+	if len(request.PageToken) != 0 {
+		var token listConcreteExecutionsPageToken
+		if err := json.Unmarshal(request.PageToken, &token); err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("ListConcreteExecutions: invalid page token. Error: %v", err)
+		}
+		filter.MinNamespaceID = token.NamespaceID
+		filter.MinWorkflowID = token.WorkflowID
+		filter.MinRunID = token.RunID
+	}
+
+	rows, err := m.DB.RangeSelectFromExecutions(ctx, filter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("ListConcreteExecutions: failed. Error: %v", err)
+	}
+	response := &p.InternalListConcreteExecutionsResponse{
+		States: make([]*p.InternalWorkflowMutableState, 0, len(rows)),
+	}
+	for i := range rows {
+		state, err := m.getWorkflowMutableState(ctx, request.ShardID, &rows[i])
+		if err != nil {
+			return nil, err
+		}
+		response.States = append(response.States, state)
+	}
+	if len(rows) < pageSize {
+		return response, nil
+	}
+
+	lastRow := rows[len(rows)-1]
+	token, err := json.Marshal(listConcreteExecutionsPageToken{
+		NamespaceID: lastRow.NamespaceID,
+		WorkflowID:  lastRow.WorkflowID,
+		RunID:       lastRow.RunID,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternalf("ListConcreteExecutions: failed to serialize page token. Error: %v", err)
+	}
+	response.NextPageToken = token
+	return response, nil
 }
 
 func (m *sqlExecutionStore) GetHistoryBranchUtil() p.HistoryBranchUtil {
@@ -210,7 +210,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -234,6 +234,22 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the column to group by. Datetime buckets are computed with datetime
+// arithmetic since UNIX_TIMESTAMP depends on the session time zone.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByColumn) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	if field.Interval == 0 {
+		return colName
+	}
+	seconds := int64(field.Interval / time.Second)
+	return fmt.Sprintf(
+		"TIMESTAMPADD(SECOND, FLOOR(TIMESTAMPDIFF(SECOND, TIMESTAMP('1970-01-01'), %s) / %d) * %d, TIMESTAMP('1970-01-01'))",
+		colName,
+		seconds,
+		seconds,
+	)
+}
+
 func (c *queryConverter) buildJSONOverlapsExpr(
 	col *query.SAColumn,
 	value sqlparser.Expr,
@@ -261,7 +261,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByColumn
 		stmt      string
 	}{
 		{
@@ -284,11 +284,22 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility ev LEFT JOIN custom_search_attributes USING (namespace_id, run_id) LEFT JOIN chasm_search_attributes USING (namespace_id, run_id) WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by date histogram",
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Interval: time.Hour,
+				},
+			},
+			stmt: "SELECT workflow_type_name, TIMESTAMPADD(SECOND, FLOOR(TIMESTAMPDIFF(SECOND, TIMESTAMP('1970-01-01'), start_time) / 3600) * 3600, TIMESTAMP('1970-01-01')), COUNT(*) FROM executions_visibility ev LEFT JOIN custom_search_attributes USING (namespace_id, run_id) LEFT JOIN chasm_search_attributes USING (namespace_id, run_id) GROUP BY workflow_type_name, TIMESTAMPADD(SECOND, FLOOR(TIMESTAMPDIFF(SECOND, TIMESTAMP('1970-01-01'), start_time) / 3600) * 3600, TIMESTAMP('1970-01-01'))",
+		},
 	}
 
 	for _, tc := range tests {
@@ -193,7 +193,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -209,6 +209,22 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the column to group by. Datetime buckets are computed from the Unix
+// timestamp and converted back to timestamp without time zone.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByColumn) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	if field.Interval == 0 {
+		return colName
+	}
+	seconds := int64(field.Interval / time.Second)
+	return fmt.Sprintf(
+		"to_timestamp(floor(extract(epoch from %s) / %d) * %d) AT TIME ZONE 'UTC'",
+		colName,
+		seconds,
+		seconds,
+	)
+}
+
 func (c *queryConverter) convertInExpr(
 	leftExpr sqlparser.Expr,
 	values sqlparser.ValTuple,
@@ -278,7 +278,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByColumn
 		stmt      string
 	}{
 		{
@@ -301,11 +301,22 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by date histogram",
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Interval: time.Hour,
+				},
+			},
+			stmt: "SELECT workflow_type_name, to_timestamp(floor(extract(epoch from start_time) / 3600) * 3600) AT TIME ZONE 'UTC', COUNT(*) FROM executions_visibility GROUP BY workflow_type_name, to_timestamp(floor(extract(epoch from start_time) / 3600) * 3600) AT TIME ZONE 'UTC'",
+		},
 	}
 
 	for _, tc := range tests {
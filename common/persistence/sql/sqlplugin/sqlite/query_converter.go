@@ -217,7 +217,7 @@ func (c *queryConverter) BuildCountStmt(
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy)+1)
 	for _, field := range queryParams.GroupBy {
-		groupBy = append(groupBy, sadefs.GetSqlDbColName(field.FieldName))
+		groupBy = append(groupBy, c.buildGroupByExpr(field))
 	}
 
 	groupByClause := ""
@@ -233,6 +233,22 @@ func (c *queryConverter) BuildCountStmt(
 	), nil
 }
 
+// buildGroupByExpr returns the column to group by. Datetime buckets are computed from the Unix
+// timestamp and returned as text in UTC.
+func (c *queryConverter) buildGroupByExpr(field *query.GroupByColumn) string {
+	colName := sadefs.GetSqlDbColName(field.FieldName)
+	if field.Interval == 0 {
+		return colName
+	}
+	seconds := int64(field.Interval / time.Second)
+	return fmt.Sprintf(
+		"datetime((CAST(strftime('%%s', %s) AS INTEGER) / %d) * %d, 'unixepoch')",
+		colName,
+		seconds,
+		seconds,
+	)
+}
+
 // buildFtsSelectStmt builds the following statement for querying FTS:
 //
 //	SELECT rowid FROM tableName WHERE tableName = '%s'
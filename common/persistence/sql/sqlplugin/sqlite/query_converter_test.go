@@ -296,7 +296,7 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 	tests := []struct {
 		name      string
 		queryExpr sqlparser.Expr
-		groupBy   []*query.SAColumn
+		groupBy   []*query.GroupByColumn
 		stmt      string
 	}{
 		{
@@ -319,11 +319,22 @@ func TestQueryConverter_BuildCountStmt(t *testing.T) {
 				Left:     keywordCol,
 				Right:    query.NewUnsafeSQLString("foo"),
 			},
-			groupBy: []*query.SAColumn{
-				query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.ExecutionStatus, sadefs.ExecutionStatus, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
 			},
 			stmt: "SELECT status, COUNT(*) FROM executions_visibility WHERE Keyword01 = 'foo' GROUP BY status",
 		},
+		{
+			name: "group by date histogram",
+			groupBy: []*query.GroupByColumn{
+				{SAColumn: query.NewSAColumn(sadefs.WorkflowType, sadefs.WorkflowType, enumspb.INDEXED_VALUE_TYPE_KEYWORD)},
+				{
+					SAColumn: query.NewSAColumn(sadefs.StartTime, sadefs.StartTime, enumspb.INDEXED_VALUE_TYPE_DATETIME),
+					Interval: time.Hour,
+				},
+			},
+			stmt: "SELECT workflow_type_name, datetime((CAST(strftime('%s', start_time) AS INTEGER) / 3600) * 3600, 'unixepoch'), COUNT(*) FROM executions_visibility GROUP BY workflow_type_name, datetime((CAST(strftime('%s', start_time) AS INTEGER) / 3600) * 3600, 'unixepoch')",
+		},
 	}
 
 	for _, tc := range tests {
@@ -198,6 +198,36 @@ func TestCassandraHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraReshardSuite(t *testing.T) {
+	testData, tearDown := setUpCassandraTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+	clusterMetadataStore, err := testData.Factory.NewClusterMetadataStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	// Cassandra doesn't partition history by shard, the new shards share it with the source shards.
+	s := NewReshardSuite(
+		t,
+		shardStore,
+		executionStore,
+		clusterMetadataStore,
+		serialization.NewSerializer(),
+		false,
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
 func TestCassandraTaskQueueSuite(t *testing.T) {
 	testData, tearDown := setUpCassandraTest(t)
 	defer tearDown()
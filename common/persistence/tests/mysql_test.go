@@ -95,6 +95,35 @@ func TestMySQLHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestMySQLReshardSuite(t *testing.T) {
+	testData, tearDown := setUpMySQLTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	clusterMetadataStore, err := testData.Factory.NewClusterMetadataStore()
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+
+	s := NewReshardSuite(
+		t,
+		shardStore,
+		executionStore,
+		clusterMetadataStore,
+		serialization.NewSerializer(),
+		true,
+		testData.Logger,
+	)
+	suite.Run(t, s)
+}
+
 func TestMySQLTaskQueueSuite(t *testing.T) {
 	testData, tearDown := setUpMySQLTest(t)
 	defer tearDown()
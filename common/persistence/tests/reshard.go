@@ -0,0 +1,512 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/reshard"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	ReshardSuite struct {
+		suite.Suite
+		*require.Assertions
+		protorequire.ProtoAssertions
+
+		ShardManager         p.ShardManager
+		ExecutionManager     p.ExecutionManager
+		ClusterMetadataStore p.ClusterMetadataStore
+		Serializer           serialization.Serializer
+		HistoryBranchUtil    p.HistoryBranchUtil
+		Logger               log.Logger
+		// DeleteSourceHistory is set for stores that partition history by shard.
+		DeleteSourceHistory bool
+
+		clusterMetadataManager p.ClusterMetadataManager
+		namespaceID            string
+		// shard ID -> range ID of the source shards
+		rangeIDs map[int32]int64
+
+		ctx    context.Context
+		cancel context.CancelFunc
+	}
+
+	reshardTestExecution struct {
+		key         definition.WorkflowKey
+		shardID     int32
+		branchToken []byte
+		events      []*historypb.HistoryEvent
+	}
+)
+
+const (
+	reshardSourceShardCount = 2
+	reshardTargetShardCount = 6
+)
+
+func NewReshardSuite(
+	t *testing.T,
+	shardStore p.ShardStore,
+	executionStore p.ExecutionStore,
+	clusterMetadataStore p.ClusterMetadataStore,
+	serializer serialization.Serializer,
+	deleteSourceHistory bool,
+	logger log.Logger,
+) *ReshardSuite {
+	return &ReshardSuite{
+		Assertions:   require.New(t),
+		ShardManager: p.NewShardManager(shardStore, serializer),
+		ExecutionManager: p.NewExecutionManager(
+			executionStore,
+			serializer,
+			nil,
+			logger,
+			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetBoolPropertyFn(false),
+		),
+		ClusterMetadataStore: clusterMetadataStore,
+		Serializer:           serializer,
+		HistoryBranchUtil:    p.NewHistoryBranchUtil(serializer),
+		Logger:               logger,
+		DeleteSourceHistory:  deleteSourceHistory,
+	}
+}
+
+func (s *ReshardSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second*debug.TimeoutMultiplier)
+
+	// Every test gets its own cluster, so that cutover doesn't affect the next ones.
+	clusterName := "reshard_" + uuid.NewString()
+	s.clusterMetadataManager = p.NewClusterMetadataManagerImpl(s.ClusterMetadataStore, s.Serializer, clusterName, s.Logger)
+	applied, err := s.clusterMetadataManager.SaveClusterMetadata(s.ctx, &p.SaveClusterMetadataRequest{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			ClusterName:       clusterName,
+			HistoryShardCount: reshardSourceShardCount,
+		},
+	})
+	s.NoError(err)
+	s.True(applied)
+
+	s.namespaceID = uuid.NewString()
+	s.rangeIDs = make(map[int32]int64)
+	for shardID := int32(1); shardID <= reshardSourceShardCount; shardID++ {
+		resp, err := s.ShardManager.GetOrCreateShard(s.ctx, &p.GetOrCreateShardRequest{
+			ShardID: shardID,
+			InitialShardInfo: &persistencespb.ShardInfo{
+				ShardId: shardID,
+				RangeId: 10 * int64(shardID),
+			},
+		})
+		s.NoError(err)
+		s.rangeIDs[shardID] = resp.ShardInfo.RangeId
+	}
+}
+
+// TearDownTest deletes the executions and tasks of all shards, as resharding processes all of them.
+func (s *ReshardSuite) TearDownTest() {
+	defer s.cancel()
+	for shardID := int32(1); shardID <= reshardTargetShardCount; shardID++ {
+		var pageToken []byte
+		for {
+			resp, err := s.ExecutionManager.ListConcreteExecutions(s.ctx, &p.ListConcreteExecutionsRequest{
+				ShardID:   shardID,
+				PageSize:  100,
+				PageToken: pageToken,
+			})
+			s.NoError(err)
+			for _, state := range resp.States {
+				key := definition.NewWorkflowKey(
+					state.GetExecutionInfo().GetNamespaceId(),
+					state.GetExecutionInfo().GetWorkflowId(),
+					state.GetExecutionState().GetRunId(),
+				)
+				s.NoError(s.ExecutionManager.DeleteCurrentWorkflowExecution(s.ctx, &p.DeleteCurrentWorkflowExecutionRequest{
+					ShardID:     shardID,
+					NamespaceID: key.NamespaceID,
+					WorkflowID:  key.WorkflowID,
+					RunID:       key.RunID,
+					ArchetypeID: chasm.WorkflowArchetypeID,
+				}))
+				s.NoError(s.ExecutionManager.DeleteWorkflowExecution(s.ctx, &p.DeleteWorkflowExecutionRequest{
+					ShardID:     shardID,
+					NamespaceID: key.NamespaceID,
+					WorkflowID:  key.WorkflowID,
+					RunID:       key.RunID,
+					ArchetypeID: chasm.WorkflowArchetypeID,
+				}))
+			}
+			if pageToken = resp.PageToken; len(pageToken) == 0 {
+				break
+			}
+		}
+		for _, category := range []tasks.Category{tasks.CategoryTransfer, tasks.CategoryTimer, tasks.CategoryReplication} {
+			minKey, maxKey := reshardTaskRange(category)
+			s.NoError(s.ExecutionManager.RangeCompleteHistoryTasks(s.ctx, &p.RangeCompleteHistoryTasksRequest{
+				ShardID:             shardID,
+				TaskCategory:        category,
+				InclusiveMinTaskKey: minKey,
+				ExclusiveMaxTaskKey: maxKey,
+			}))
+		}
+	}
+}
+
+func (s *ReshardSuite) TestReshard_CopyExecutions() {
+	var executions []reshardTestExecution
+	for i := 0; i < 20; i++ {
+		executions = append(executions, s.createExecution(int32(i%reshardSourceShardCount)+1, i%5 == 0))
+	}
+
+	stats := s.reshard(s.newResharder(false))
+	moved := s.assertCopied(executions)
+	s.Positive(moved)
+	s.Equal(reshard.Stats{ExecutionsCopied: int64(moved)}, stats)
+	// Executions are not deleted from their source shard before cutover.
+	for _, execution := range executions {
+		s.NotNil(s.getExecution(execution.shardID, execution.key))
+	}
+	s.assertShardCount(reshardSourceShardCount)
+
+	// Copies that are up to date are skipped.
+	stats = s.reshard(s.newResharder(false))
+	s.Equal(reshard.Stats{ExecutionsUnchanged: int64(moved)}, stats)
+
+	// Copies of executions deleted from their source shard are pruned.
+	var deleted reshardTestExecution
+	for _, execution := range executions {
+		if s.targetShardID(execution.key) != execution.shardID {
+			deleted = execution
+			break
+		}
+	}
+	s.NoError(s.ExecutionManager.DeleteWorkflowExecution(s.ctx, &p.DeleteWorkflowExecutionRequest{
+		ShardID:     deleted.shardID,
+		NamespaceID: deleted.key.NamespaceID,
+		WorkflowID:  deleted.key.WorkflowID,
+		RunID:       deleted.key.RunID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	}))
+	stats = s.reshard(s.newResharder(false))
+	s.Equal(reshard.Stats{ExecutionsUnchanged: int64(moved - 1), ExecutionsPruned: 1}, stats)
+	s.Nil(s.getExecution(s.targetShardID(deleted.key), deleted.key))
+}
+
+func (s *ReshardSuite) TestReshard_Cutover() {
+	var executions []reshardTestExecution
+	for i := 0; i < 20; i++ {
+		execution := s.createExecution(int32(i%reshardSourceShardCount)+1, i%5 == 0)
+		s.addTasks(execution)
+		executions = append(executions, execution)
+	}
+	// Tasks left in a new shard by an earlier attempt are replaced.
+	staleTask := reshardTestExecution{
+		key:     definition.NewWorkflowKey(s.namespaceID, uuid.NewString(), uuid.NewString()),
+		shardID: reshardSourceShardCount + 1,
+	}
+	s.addTasks(staleTask)
+
+	stats := s.reshard(s.newResharder(true))
+	moved := s.assertCopied(executions)
+	s.Equal(reshard.Stats{
+		ExecutionsCopied:    int64(moved),
+		ExecutionsUnchanged: int64(moved),
+		ExecutionsDeleted:   int64(moved),
+		TasksCopied:         int64(3 * moved),
+		TasksDeleted:        int64(3 * moved),
+	}, stats)
+	s.assertShardCount(reshardTargetShardCount)
+
+	for _, execution := range executions {
+		targetShardID := s.targetShardID(execution.key)
+		if targetShardID == execution.shardID {
+			s.NotNil(s.getExecution(execution.shardID, execution.key))
+			continue
+		}
+		s.Nil(s.getExecution(execution.shardID, execution.key))
+		_, err := s.ExecutionManager.GetCurrentExecution(s.ctx, &p.GetCurrentExecutionRequest{
+			ShardID:     execution.shardID,
+			NamespaceID: execution.key.NamespaceID,
+			WorkflowID:  execution.key.WorkflowID,
+			ArchetypeID: chasm.WorkflowArchetypeID,
+		})
+		var notFound *serviceerror.NotFound
+		s.ErrorAs(err, &notFound)
+
+		if s.DeleteSourceHistory {
+			_, err := s.ExecutionManager.ReadHistoryBranch(s.ctx, &p.ReadHistoryBranchRequest{
+				ShardID:     execution.shardID,
+				BranchToken: execution.branchToken,
+				MinEventID:  common.FirstEventID,
+				MaxEventID:  math.MaxInt64,
+				PageSize:    10,
+			})
+			s.ErrorAs(err, &notFound, "history of moved execution %v left in its source shard", execution.key)
+		}
+	}
+
+	categories := []tasks.Category{tasks.CategoryTransfer, tasks.CategoryTimer, tasks.CategoryReplication}
+	var taskCount int
+	for shardID := int32(1); shardID <= reshardTargetShardCount; shardID++ {
+		for _, category := range categories {
+			shardTasks := s.getTasks(shardID, category)
+			taskCount += len(shardTasks)
+			for _, task := range shardTasks {
+				key := definition.NewWorkflowKey(task.GetNamespaceID(), task.GetWorkflowID(), task.GetRunID())
+				s.Equal(shardID, s.targetShardID(key))
+				s.NotEqual(staleTask.key, key)
+			}
+		}
+		if shardID > reshardSourceShardCount {
+			sourceShardID := common.MapShardID(reshardTargetShardCount, reshardSourceShardCount, shardID)[0]
+			resp, err := s.ShardManager.GetOrCreateShard(s.ctx, &p.GetOrCreateShardRequest{ShardID: shardID})
+			s.NoError(err)
+			s.GreaterOrEqual(resp.ShardInfo.RangeId, s.rangeIDs[sourceShardID])
+		}
+	}
+	s.Equal(len(categories)*len(executions), taskCount)
+}
+
+func (s *ReshardSuite) newResharder(cutover bool) *reshard.Resharder {
+	resharder, err := reshard.NewResharder(
+		s.ShardManager,
+		s.ExecutionManager,
+		s.clusterMetadataManager,
+		reshardSourceShardCount,
+		reshardTargetShardCount,
+		cutover,
+		s.DeleteSourceHistory,
+		3,
+	)
+	s.NoError(err)
+	return resharder
+}
+
+// reshard runs a resharder to completion and returns the sum of the stats of its steps.
+func (s *ReshardSuite) reshard(resharder *reshard.Resharder) reshard.Stats {
+	var total reshard.Stats
+	position := reshard.Start()
+	for position.Phase != reshard.PhaseCompleted {
+		var stats reshard.Stats
+		var err error
+		position, stats, err = resharder.Step(s.ctx, position)
+		s.NoError(err)
+		total.ExecutionsCopied += stats.ExecutionsCopied
+		total.ExecutionsUnchanged += stats.ExecutionsUnchanged
+		total.ExecutionsPruned += stats.ExecutionsPruned
+		total.ExecutionsDeleted += stats.ExecutionsDeleted
+		total.TasksCopied += stats.TasksCopied
+		total.TasksDeleted += stats.TasksDeleted
+	}
+	return total
+}
+
+// createExecution creates an execution with a single history event in a source shard, and buffered
+// events if requested.
+func (s *ReshardSuite) createExecution(shardID int32, buffered bool) reshardTestExecution {
+	var key definition.WorkflowKey
+	for {
+		key = definition.NewWorkflowKey(s.namespaceID, uuid.NewString(), uuid.NewString())
+		if common.WorkflowIDToHistoryShard(key.NamespaceID, key.WorkflowID, reshardSourceShardCount) == shardID {
+			break
+		}
+	}
+	branchToken := RandomBranchToken(key.NamespaceID, key.WorkflowID, key.RunID, s.HistoryBranchUtil)
+	snapshot, events := RandomSnapshot(
+		s.T(),
+		key.NamespaceID,
+		key.WorkflowID,
+		key.RunID,
+		common.FirstEventID,
+		1,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		1,
+		branchToken,
+	)
+	_, err := s.ExecutionManager.CreateWorkflowExecution(s.ctx, &p.CreateWorkflowExecutionRequest{
+		ShardID:             shardID,
+		RangeID:             s.rangeIDs[shardID],
+		Mode:                p.CreateWorkflowModeBrandNew,
+		ArchetypeID:         chasm.WorkflowArchetypeID,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	s.NoError(err)
+
+	if buffered {
+		_, err := s.ExecutionManager.UpdateWorkflowExecution(s.ctx, &p.UpdateWorkflowExecutionRequest{
+			ShardID:     shardID,
+			RangeID:     s.rangeIDs[shardID],
+			Mode:        p.UpdateWorkflowModeUpdateCurrent,
+			ArchetypeID: chasm.WorkflowArchetypeID,
+			UpdateWorkflowMutation: p.WorkflowMutation{
+				ExecutionInfo:     snapshot.ExecutionInfo,
+				ExecutionState:    snapshot.ExecutionState,
+				NextEventID:       snapshot.NextEventID,
+				NewBufferedEvents: []*historypb.HistoryEvent{RandomHistoryEvent(common.BufferedEventID, 1)},
+				Condition:         snapshot.NextEventID,
+				DBRecordVersion:   2,
+			},
+		})
+		s.NoError(err)
+	}
+	return reshardTestExecution{
+		key:         key,
+		shardID:     shardID,
+		branchToken: branchToken,
+		events:      events[0].Events,
+	}
+}
+
+// addTasks adds a transfer, timer and replication task of an execution to its shard.
+func (s *ReshardSuite) addTasks(execution reshardTestExecution) {
+	resp, err := s.ShardManager.GetOrCreateShard(s.ctx, &p.GetOrCreateShardRequest{
+		ShardID: execution.shardID,
+		InitialShardInfo: &persistencespb.ShardInfo{
+			ShardId: execution.shardID,
+			RangeId: 1,
+		},
+	})
+	s.NoError(err)
+	taskID := resp.ShardInfo.RangeId<<20 + int64(len(s.getTasks(execution.shardID, tasks.CategoryTransfer))) + 1
+	s.NoError(s.ExecutionManager.AddHistoryTasks(s.ctx, &p.AddHistoryTasksRequest{
+		ShardID:     execution.shardID,
+		RangeID:     resp.ShardInfo.RangeId,
+		NamespaceID: execution.key.NamespaceID,
+		WorkflowID:  execution.key.WorkflowID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer: {&tasks.ActivityTask{
+				WorkflowKey: execution.key,
+				TaskID:      taskID,
+			}},
+			tasks.CategoryTimer: {&tasks.UserTimerTask{
+				WorkflowKey:         execution.key,
+				TaskID:              taskID,
+				VisibilityTimestamp: time.Now().Add(time.Hour).Truncate(common.ScheduledTaskMinPrecision).UTC(),
+			}},
+			tasks.CategoryReplication: {&tasks.HistoryReplicationTask{
+				WorkflowKey:  execution.key,
+				TaskID:       taskID,
+				FirstEventID: common.FirstEventID,
+				NextEventID:  common.FirstEventID + 1,
+			}},
+		},
+	}))
+}
+
+// assertCopied asserts that the executions that move have an identical copy in their new shard, and
+// returns their number.
+func (s *ReshardSuite) assertCopied(executions []reshardTestExecution) int {
+	var moved int
+	for _, execution := range executions {
+		targetShardID := s.targetShardID(execution.key)
+		if targetShardID == execution.shardID {
+			continue
+		}
+		moved++
+
+		copied := s.getExecution(targetShardID, execution.key)
+		s.NotNil(copied)
+		if source := s.getExecution(execution.shardID, execution.key); source != nil {
+			s.Equal(source.DBRecordVersion, copied.DBRecordVersion)
+			s.ProtoEqual(source.State.GetExecutionInfo(), copied.State.GetExecutionInfo())
+			s.ProtoEqual(source.State.GetExecutionState(), copied.State.GetExecutionState())
+			s.ProtoElementsMatch(source.State.GetBufferedEvents(), copied.State.GetBufferedEvents())
+		}
+		current, err := s.ExecutionManager.GetCurrentExecution(s.ctx, &p.GetCurrentExecutionRequest{
+			ShardID:     targetShardID,
+			NamespaceID: execution.key.NamespaceID,
+			WorkflowID:  execution.key.WorkflowID,
+			ArchetypeID: chasm.WorkflowArchetypeID,
+		})
+		s.NoError(err)
+		s.Equal(execution.key.RunID, current.RunID)
+
+		history, err := s.ExecutionManager.ReadHistoryBranch(s.ctx, &p.ReadHistoryBranchRequest{
+			ShardID:     targetShardID,
+			BranchToken: execution.branchToken,
+			MinEventID:  common.FirstEventID,
+			MaxEventID:  math.MaxInt64,
+			PageSize:    10,
+		})
+		s.NoError(err)
+		s.Len(history.HistoryEvents, len(execution.events))
+		for i, event := range execution.events {
+			s.Equal(event.GetEventId(), history.HistoryEvents[i].GetEventId())
+		}
+	}
+	return moved
+}
+
+func (s *ReshardSuite) assertShardCount(shardCount int32) {
+	resp, err := s.clusterMetadataManager.GetCurrentClusterMetadata(s.ctx)
+	s.NoError(err)
+	s.Equal(shardCount, resp.HistoryShardCount)
+}
+
+func (s *ReshardSuite) getExecution(
+	shardID int32,
+	key definition.WorkflowKey,
+) *p.GetWorkflowExecutionResponse {
+	resp, err := s.ExecutionManager.GetWorkflowExecution(s.ctx, &p.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: key.NamespaceID,
+		WorkflowID:  key.WorkflowID,
+		RunID:       key.RunID,
+		ArchetypeID: chasm.WorkflowArchetypeID,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+	s.NoError(err)
+	return resp
+}
+
+func (s *ReshardSuite) getTasks(shardID int32, category tasks.Category) []tasks.Task {
+	minKey, maxKey := reshardTaskRange(category)
+	resp, err := s.ExecutionManager.GetHistoryTasks(s.ctx, &p.GetHistoryTasksRequest{
+		ShardID:             shardID,
+		TaskCategory:        category,
+		InclusiveMinTaskKey: minKey,
+		ExclusiveMaxTaskKey: maxKey,
+		BatchSize:           1000,
+	})
+	s.NoError(err)
+	return resp.Tasks
+}
+
+func (s *ReshardSuite) targetShardID(key definition.WorkflowKey) int32 {
+	return common.WorkflowIDToHistoryShard(key.NamespaceID, key.WorkflowID, reshardTargetShardCount)
+}
+
+func reshardTaskRange(category tasks.Category) (tasks.Key, tasks.Key) {
+	if category.Type() == tasks.CategoryTypeScheduled {
+		return tasks.NewKey(tasks.DefaultFireTime, 0), tasks.NewKey(tasks.MaximumKey.FireTime, 0)
+	}
+	return tasks.NewImmediateKey(0), tasks.NewImmediateKey(math.MaxInt64)
+}
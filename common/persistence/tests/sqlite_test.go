@@ -176,6 +176,45 @@ func TestSQLiteHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteReshardSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := sql.NewFactory(
+		*cfg,
+		resolver.NewNoopResolver(),
+		testSQLiteClusterName,
+		logger,
+		metrics.NoopMetricsHandler,
+		serialization.NewSerializer(),
+	)
+	shardStore, err := factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	executionStore, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	clusterMetadataStore, err := factory.NewClusterMetadataStore()
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	defer func() {
+		factory.Close()
+	}()
+
+	s := NewReshardSuite(
+		t,
+		shardStore,
+		executionStore,
+		clusterMetadataStore,
+		serialization.NewSerializer(),
+		true,
+		logger,
+	)
+	suite.Run(t, s)
+}
+
 func TestSQLiteTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
 	logger := log.NewNoopLogger()
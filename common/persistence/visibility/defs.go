@@ -5,6 +5,7 @@ import (
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse"
 )
 
 const (
@@ -28,6 +29,9 @@ func AllowListForValidation(
 	case mysql.PluginName, postgresql.PluginName, postgresql.PluginNamePGX, sqlite.PluginName:
 		// Advanced visibility with SQL DB don't support list of values
 		return dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
+	case clickhouse.PersistenceName:
+		// ClickHouse columns only hold lists for keyword list search attributes
+		return dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false)
 	default:
 		// Otherwise (ES), check dynamic config
 		return allowList
@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/persistence/visibility/store/sql"
 	"go.temporal.io/server/common/resolver"
@@ -281,6 +282,13 @@ func newVisibilityStoreFromDataStoreConfig(
 			metricsHandler,
 			logger,
 		)
+	} else if dsConfig.ClickHouse != nil {
+		visStore, err = clickhouse.NewVisibilityStore(
+			dsConfig.ClickHouse,
+			searchAttributesProvider,
+			searchAttributesMapperProvider,
+			chasmRegistry,
+		)
 	} else if dsConfig.CustomDataStoreConfig != nil {
 		if customVisibilityStoreFactory == nil {
 			logger.Fatal("custom visibility store factory must be defined")
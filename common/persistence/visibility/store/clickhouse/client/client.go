@@ -0,0 +1,38 @@
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+type (
+	// Client is a minimal client for the ClickHouse HTTP interface.
+	// Rows are exchanged in JSONEachRow format: every row is a map from column name to value, where
+	// numbers are decoded as json.Number and DateTime64 values are ISO 8601 strings.
+	Client interface {
+		// Query runs a statement returning rows (eg: SELECT).
+		Query(ctx context.Context, query string) ([]map[string]any, error)
+		// Exec runs a statement that doesn't return rows (eg: ALTER TABLE, DELETE).
+		Exec(ctx context.Context, stmt string) error
+		// Insert inserts rows into the given table.
+		Insert(ctx context.Context, table string, rows ...map[string]any) error
+		Close()
+	}
+
+	// Error is returned when ClickHouse rejects a request.
+	Error struct {
+		StatusCode int
+		// Code is the ClickHouse exception code, if available.
+		Code    string
+		Message string
+	}
+)
+
+func (e *Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("clickhouse error (status %d, code %s): %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("clickhouse error (status %d): %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.temporal.io/server/common/auth"
+)
+
+const (
+	exceptionCodeHeader = "X-ClickHouse-Exception-Code"
+	userHeader          = "X-ClickHouse-User"
+	keyHeader           = "X-ClickHouse-Key"
+
+	// maxErrorMessageSize caps how much of an error response body is read.
+	maxErrorMessageSize = 4096
+)
+
+type (
+	// httpClient implements Client
+	httpClient struct {
+		httpClient *http.Client
+		url        url.URL
+		database   string
+		username   string
+		password   string
+	}
+)
+
+var _ Client = (*httpClient)(nil)
+
+// settings are ClickHouse settings sent with every request. They pin the wire format so that
+// rows can be decoded without knowing the server defaults.
+var settings = map[string]string{
+	"default_format":                          "JSONEachRow",
+	"date_time_input_format":                  "best_effort",
+	"date_time_output_format":                 "iso",
+	"input_format_skip_unknown_fields":        "1",
+	"output_format_json_quote_64bit_integers": "0",
+	"output_format_json_quote_denormals":      "0",
+}
+
+// NewClient creates a ClickHouse client. If hc is nil, an HTTP client is built from the config.
+func NewClient(cfg *Config, hc *http.Client) (Client, error) {
+	if hc == nil {
+		if cfg.TLS != nil && cfg.TLS.Enabled {
+			tlsConfig, err := auth.NewTLSConfig(cfg.TLS)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create TLS HTTP client: %w", err)
+			}
+			hc = &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+		} else {
+			hc = &http.Client{}
+		}
+		hc.Timeout = cfg.Timeout
+	}
+	return &httpClient{
+		httpClient: hc,
+		url:        cfg.URL,
+		database:   cfg.Database,
+		username:   cfg.Username,
+		password:   cfg.Password,
+	}, nil
+}
+
+func (c *httpClient) Query(ctx context.Context, query string) ([]map[string]any, error) {
+	body, err := c.do(ctx, nil, strings.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+
+	var rows []map[string]any
+	decoder := json.NewDecoder(bufio.NewReader(body))
+	// Critical to ensure decode of int64 won't lose precision.
+	decoder.UseNumber()
+	for {
+		var row map[string]any
+		if err := decoder.Decode(&row); err == io.EOF {
+			return rows, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to decode clickhouse response: %w", err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func (c *httpClient) Exec(ctx context.Context, stmt string) error {
+	body, err := c.do(ctx, nil, strings.NewReader(stmt))
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, body)
+	return body.Close()
+}
+
+func (c *httpClient) Insert(ctx context.Context, table string, rows ...map[string]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return fmt.Errorf("unable to encode clickhouse row: %w", err)
+		}
+	}
+	params := url.Values{
+		"query": []string{fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", QuoteIdentifier(table))},
+	}
+	body, err := c.do(ctx, params, &buf)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, body)
+	return body.Close()
+}
+
+func (c *httpClient) Close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+func (c *httpClient) do(ctx context.Context, params url.Values, payload io.Reader) (io.ReadCloser, error) {
+	u := c.url
+	query := u.Query()
+	for k, v := range settings {
+		query.Set(k, v)
+	}
+	query.Set("database", c.database)
+	for k, v := range params {
+		query[k] = v
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), payload)
+	if err != nil {
+		return nil, err
+	}
+	if c.username != "" {
+		req.Header.Set(userHeader, c.username)
+		req.Header.Set(keyHeader, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer func() { _ = resp.Body.Close() }()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorMessageSize))
+		return nil, &Error{
+			StatusCode: resp.StatusCode,
+			Code:       resp.Header.Get(exceptionCodeHeader),
+			Message:    strings.TrimSpace(string(msg)),
+		}
+	}
+	return resp.Body, nil
+}
+
+// QuoteIdentifier quotes a table or column name.
+func QuoteIdentifier(name string) string {
+	return "`" + strings.NewReplacer("\\", "\\\\", "`", "\\`").Replace(name) + "`"
+}
+
+// QuoteString quotes a string literal.
+func QuoteString(s string) string {
+	return "'" + strings.NewReplacer("\\", "\\\\", "'", "\\'").Replace(s) + "'"
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	params  url.Values
+	headers http.Header
+	body    string
+}
+
+// newTestServer starts a stand-in for the ClickHouse HTTP interface which records requests and
+// replies with the given status and body.
+func newTestServer(t *testing.T, status int, response string) (*Config, *[]recordedRequest) {
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, recordedRequest{
+			params:  r.URL.Query(),
+			headers: r.Header,
+			body:    string(body),
+		})
+		if status != http.StatusOK {
+			w.Header().Set(exceptionCodeHeader, "60")
+		}
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return &Config{
+		URL:      *u,
+		Database: "temporal_visibility",
+		Username: "user",
+		Password: "pass",
+	}, &requests
+}
+
+func TestQuery(t *testing.T) {
+	cfg, requests := newTestServer(t, http.StatusOK, `{"RunId":"run-1","HistoryLength":9007199254740993}
+{"RunId":"run-2","HistoryLength":1}
+`)
+	c, err := NewClient(cfg, nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	rows, err := c.Query(context.Background(), "SELECT RunId, HistoryLength FROM t")
+	require.NoError(t, err)
+	require.Equal(t, []map[string]any{
+		{"RunId": "run-1", "HistoryLength": json.Number("9007199254740993")},
+		{"RunId": "run-2", "HistoryLength": json.Number("1")},
+	}, rows)
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	require.Equal(t, "SELECT RunId, HistoryLength FROM t", req.body)
+	require.Equal(t, "temporal_visibility", req.params.Get("database"))
+	require.Equal(t, "JSONEachRow", req.params.Get("default_format"))
+	require.Equal(t, "iso", req.params.Get("date_time_output_format"))
+	require.Equal(t, "user", req.headers.Get(userHeader))
+	require.Equal(t, "pass", req.headers.Get(keyHeader))
+}
+
+func TestQuery_Empty(t *testing.T) {
+	cfg, _ := newTestServer(t, http.StatusOK, "")
+	c, err := NewClient(cfg, nil)
+	require.NoError(t, err)
+
+	rows, err := c.Query(context.Background(), "SELECT 1 WHERE 0")
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
+
+func TestInsert(t *testing.T) {
+	cfg, requests := newTestServer(t, http.StatusOK, "")
+	c, err := NewClient(cfg, nil)
+	require.NoError(t, err)
+
+	err = c.Insert(
+		context.Background(),
+		"executions_visibility",
+		map[string]any{"RunId": "run-1"},
+		map[string]any{"RunId": "run-2"},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	req := (*requests)[0]
+	require.Equal(t, "INSERT INTO `executions_visibility` FORMAT JSONEachRow", req.params.Get("query"))
+	scanner := bufio.NewScanner(strings.NewReader(req.body))
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.Equal(t, []string{`{"RunId":"run-1"}`, `{"RunId":"run-2"}`}, lines)
+
+	// Inserting no rows doesn't send a request.
+	require.NoError(t, c.Insert(context.Background(), "executions_visibility"))
+	require.Len(t, *requests, 1)
+}
+
+func TestExec_Error(t *testing.T) {
+	cfg, _ := newTestServer(t, http.StatusNotFound, "Code: 60. DB::Exception: Table doesn't exist.\n")
+	c, err := NewClient(cfg, nil)
+	require.NoError(t, err)
+
+	err = c.Exec(context.Background(), "ALTER TABLE t ADD COLUMN c String")
+	var chErr *Error
+	require.ErrorAs(t, err, &chErr)
+	require.Equal(t, http.StatusNotFound, chErr.StatusCode)
+	require.Equal(t, "60", chErr.Code)
+	require.Equal(t, "Code: 60. DB::Exception: Table doesn't exist.", chErr.Message)
+}
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, "`a\\`b`", QuoteIdentifier("a`b"))
+	require.Equal(t, `'it\'s'`, QuoteString("it's"))
+	require.Equal(t, `'a\\b'`, QuoteString(`a\b`))
+}
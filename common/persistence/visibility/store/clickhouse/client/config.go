@@ -0,0 +1,57 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"go.temporal.io/server/common/auth"
+)
+
+const (
+	// DefaultVisibilityTable is the name of the visibility table used when none is configured.
+	DefaultVisibilityTable = "executions_visibility"
+)
+
+// Config for connecting to ClickHouse through its HTTP interface
+type Config struct {
+	URL      url.URL `yaml:"url"`
+	Database string  `yaml:"database"`
+	// Table is the name of the visibility table. Defaults to DefaultVisibilityTable.
+	Table    string    `yaml:"table"`
+	Username string    `yaml:"username"`
+	Password string    `yaml:"password"`
+	TLS      *auth.TLS `yaml:"tls"`
+	// Timeout is the maximum duration of a single HTTP request. Zero means no timeout.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// GetVisibilityIndex returns the name under which search attributes are registered in cluster
+// metadata, or empty string if ClickHouse is not configured.
+func (cfg *Config) GetVisibilityIndex() string {
+	if cfg == nil {
+		return ""
+	}
+	return cfg.Database
+}
+
+// GetTable returns the visibility table name.
+func (cfg *Config) GetTable() string {
+	if cfg == nil || cfg.Table == "" {
+		return DefaultVisibilityTable
+	}
+	return cfg.Table
+}
+
+func (cfg *Config) Validate() error {
+	if cfg == nil {
+		return errors.New("clickhouse config: config not found")
+	}
+	if cfg.URL.Host == "" {
+		return errors.New("clickhouse config: missing url")
+	}
+	if cfg.Database == "" {
+		return errors.New("clickhouse config: missing database")
+	}
+	return nil
+}
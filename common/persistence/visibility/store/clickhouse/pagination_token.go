@@ -0,0 +1,36 @@
+package clickhouse
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	// pageToken holds the sort key of the last execution returned in a page.
+	pageToken struct {
+		CloseTime time.Time
+		StartTime time.Time
+		RunID     string
+	}
+)
+
+func deserializePageToken(data []byte) (*pageToken, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var token *pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("unable to deserialize page token: %v", err)
+	}
+	return token, nil
+}
+
+func serializePageToken(token *pageToken) ([]byte, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to serialize page token: %v", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,253 @@
+package clickhouse
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse/client"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+)
+
+const (
+	// datetimeFormat is the format of datetime literals passed to parseDateTime64BestEffort.
+	datetimeFormat = "2006-01-02T15:04:05.999999999Z"
+)
+
+// QueryConverter builds ClickHouse boolean expressions from the parsed visibility query.
+// Expressions are plain strings; values are inlined as escaped literals.
+type QueryConverter struct{}
+
+var _ query.StoreQueryConverter[string] = (*QueryConverter)(nil)
+
+func NewQueryConverter() *QueryConverter {
+	return &QueryConverter{}
+}
+
+func (c *QueryConverter) GetDatetimeFormat() string {
+	return datetimeFormat
+}
+
+func (c *QueryConverter) BuildParenExpr(expr string) (string, error) {
+	if expr == "" {
+		return expr, nil
+	}
+	return "(" + expr + ")", nil
+}
+
+func (c *QueryConverter) BuildNotExpr(expr string) (string, error) {
+	if expr == "" {
+		return expr, nil
+	}
+	expr, _ = c.BuildParenExpr(expr)
+	return "NOT " + expr, nil
+}
+
+func (c *QueryConverter) BuildAndExpr(exprs ...string) (string, error) {
+	return c.joinExprs(" AND ", exprs), nil
+}
+
+func (c *QueryConverter) BuildOrExpr(exprs ...string) (string, error) {
+	return c.joinExprs(" OR ", exprs), nil
+}
+
+func (c *QueryConverter) joinExprs(sep string, exprs []string) string {
+	nonEmpty := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr != "" {
+			nonEmpty = append(nonEmpty, expr)
+		}
+	}
+	if len(nonEmpty) == 1 {
+		return nonEmpty[0]
+	}
+	for i := range nonEmpty {
+		nonEmpty[i], _ = c.BuildParenExpr(nonEmpty[i])
+	}
+	return strings.Join(nonEmpty, sep)
+}
+
+func (c *QueryConverter) ConvertComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (string, error) {
+	valueExpr, err := c.buildValueExpr(col, value)
+	if err != nil {
+		return "", err
+	}
+	return columnExpr(col) + " " + strings.ToUpper(operator) + " " + valueExpr, nil
+}
+
+func (c *QueryConverter) ConvertKeywordComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (string, error) {
+	switch operator {
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		v, ok := value.(string)
+		if !ok {
+			return "", query.NewConverterError(
+				"%s: right-hand side of %q operator must be a literal string (got: %v)",
+				query.InvalidExpressionErrMessage,
+				operator,
+				value,
+			)
+		}
+		expr := "startsWith(" + columnExpr(col) + ", " + client.QuoteString(v) + ")"
+		if operator == sqlparser.NotStartsWithStr {
+			return c.BuildNotExpr(expr)
+		}
+		return expr, nil
+	default:
+		return c.ConvertComparisonExpr(operator, col, value)
+	}
+}
+
+func (c *QueryConverter) ConvertKeywordListComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (string, error) {
+	var expr string
+	switch operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		v, err := c.buildValueExpr(col, value)
+		if err != nil {
+			return "", err
+		}
+		expr = "has(" + columnExpr(col) + ", " + v + ")"
+	case sqlparser.InStr, sqlparser.NotInStr:
+		values, ok := value.([]any)
+		if !ok {
+			return "", query.NewConverterError(
+				"%s: right-hand side of %q operator must be a tuple (got: %v)",
+				query.InvalidExpressionErrMessage,
+				operator,
+				value,
+			)
+		}
+		items := make([]string, len(values))
+		for i, item := range values {
+			var err error
+			items[i], err = c.buildValueExpr(col, item)
+			if err != nil {
+				return "", err
+			}
+		}
+		expr = "hasAny(" + columnExpr(col) + ", [" + strings.Join(items, ", ") + "])"
+	default:
+		// this should never happen: query.QueryConverter validates the operators
+		return "", query.NewOperatorNotSupportedError(col.Alias, col.ValueType, operator)
+	}
+	if operator == sqlparser.NotEqualStr || operator == sqlparser.NotInStr {
+		return c.BuildNotExpr(expr)
+	}
+	return expr, nil
+}
+
+func (c *QueryConverter) ConvertTextComparisonExpr(
+	operator string,
+	col *query.SAColumn,
+	value any,
+) (string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return "", query.NewConverterError(
+			"%s: right-hand side of %q operator must be a literal string (got: %v)",
+			query.InvalidExpressionErrMessage,
+			operator,
+			value,
+		)
+	}
+	tokens := query.TokenizeTextQueryString(v)
+	if len(tokens) == 0 {
+		return "", query.NewConverterError(
+			"%s: unexpected value for text search attribute %s: %s",
+			query.InvalidExpressionErrMessage,
+			col.Alias,
+			v,
+		)
+	}
+	quotedTokens := make([]string, len(tokens))
+	for i, token := range tokens {
+		quotedTokens[i] = client.QuoteString(token)
+	}
+	// Match any of the tokens, same as full text search in Elasticsearch and MySQL.
+	expr := "multiSearchAnyCaseInsensitive(" + columnExpr(col) + ", [" + strings.Join(quotedTokens, ", ") + "])"
+	if operator == sqlparser.NotEqualStr {
+		return c.BuildNotExpr(expr)
+	}
+	return expr, nil
+}
+
+func (c *QueryConverter) ConvertRangeExpr(
+	operator string,
+	col *query.SAColumn,
+	from, to any,
+) (string, error) {
+	fromExpr, err := c.buildValueExpr(col, from)
+	if err != nil {
+		return "", err
+	}
+	toExpr, err := c.buildValueExpr(col, to)
+	if err != nil {
+		return "", err
+	}
+	return columnExpr(col) + " " + strings.ToUpper(operator) + " " + fromExpr + " AND " + toExpr, nil
+}
+
+func (c *QueryConverter) ConvertIsExpr(
+	operator string,
+	col *query.SAColumn,
+) (string, error) {
+	// Arrays can't be nullable in ClickHouse, missing keyword lists are stored as empty arrays.
+	if col.ValueType == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		if operator == sqlparser.IsNullStr {
+			return "empty(" + columnExpr(col) + ")", nil
+		}
+		return "notEmpty(" + columnExpr(col) + ")", nil
+	}
+	return columnExpr(col) + " " + strings.ToUpper(operator), nil
+}
+
+func (c *QueryConverter) buildValueExpr(col *query.SAColumn, value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if col.ValueType == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return "parseDateTime64BestEffort(" + client.QuoteString(v) + ", 9, 'UTC')", nil
+		}
+		return client.QuoteString(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			var err error
+			items[i], err = c.buildValueExpr(col, item)
+			if err != nil {
+				return "", err
+			}
+		}
+		return "(" + strings.Join(items, ", ") + ")", nil
+	default:
+		// this should never happen: query.ParseSqlValue returns one of the types above
+		return "", query.NewConverterError(
+			"%s: unexpected value type %T",
+			query.InvalidExpressionErrMessage,
+			value,
+		)
+	}
+}
+
+// columnExpr returns the column of a search attribute. Columns are named after the search
+// attribute field names, like Elasticsearch document fields.
+func columnExpr(col *query.SAColumn) string {
+	return client.QuoteIdentifier(col.FieldName)
+}
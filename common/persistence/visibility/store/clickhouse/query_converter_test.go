@@ -0,0 +1,141 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+)
+
+var (
+	keywordCol     = query.NewSAColumn("Keyword", "CustomKeywordField", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	keywordListCol = query.NewSAColumn("KeywordList", "CustomKeywordListField", enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	textCol        = query.NewSAColumn("Text", "CustomTextField", enumspb.INDEXED_VALUE_TYPE_TEXT)
+	intCol         = query.NewSAColumn("Int", "CustomIntField", enumspb.INDEXED_VALUE_TYPE_INT)
+	datetimeCol    = query.NewSAColumn("Datetime", "CustomDatetimeField", enumspb.INDEXED_VALUE_TYPE_DATETIME)
+)
+
+func TestQueryConverter_BuildNotExpr(t *testing.T) {
+	qc := NewQueryConverter()
+
+	out, err := qc.BuildNotExpr("")
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	out, err = qc.BuildNotExpr("`a` = 1")
+	require.NoError(t, err)
+	require.Equal(t, "NOT (`a` = 1)", out)
+}
+
+func TestQueryConverter_BuildAndOrExpr(t *testing.T) {
+	qc := NewQueryConverter()
+
+	out, err := qc.BuildAndExpr("", "`a` = 1")
+	require.NoError(t, err)
+	require.Equal(t, "`a` = 1", out)
+
+	out, err = qc.BuildAndExpr("`a` = 1", "`b` = 2")
+	require.NoError(t, err)
+	require.Equal(t, "(`a` = 1) AND (`b` = 2)", out)
+
+	out, err = qc.BuildOrExpr("`a` = 1", "`b` = 2", "")
+	require.NoError(t, err)
+	require.Equal(t, "(`a` = 1) OR (`b` = 2)", out)
+}
+
+func TestQueryConverter_ConvertExprs(t *testing.T) {
+	qc := NewQueryConverter()
+	testCases := []struct {
+		name    string
+		convert func() (string, error)
+		out     string
+	}{
+		{
+			name: "keyword equal",
+			convert: func() (string, error) {
+				return qc.ConvertKeywordComparisonExpr(sqlparser.EqualStr, keywordCol, "it's")
+			},
+			out: "`CustomKeywordField` = 'it\\'s'",
+		},
+		{
+			name: "keyword in",
+			convert: func() (string, error) {
+				return qc.ConvertKeywordComparisonExpr(sqlparser.InStr, keywordCol, []any{"a", "b"})
+			},
+			out: "`CustomKeywordField` IN ('a', 'b')",
+		},
+		{
+			name: "keyword not starts with",
+			convert: func() (string, error) {
+				return qc.ConvertKeywordComparisonExpr(sqlparser.NotStartsWithStr, keywordCol, "foo")
+			},
+			out: "NOT (startsWith(`CustomKeywordField`, 'foo'))",
+		},
+		{
+			name: "keyword list equal",
+			convert: func() (string, error) {
+				return qc.ConvertKeywordListComparisonExpr(sqlparser.EqualStr, keywordListCol, "a")
+			},
+			out: "has(`CustomKeywordListField`, 'a')",
+		},
+		{
+			name: "keyword list not in",
+			convert: func() (string, error) {
+				return qc.ConvertKeywordListComparisonExpr(sqlparser.NotInStr, keywordListCol, []any{"a", "b"})
+			},
+			out: "NOT (hasAny(`CustomKeywordListField`, ['a', 'b']))",
+		},
+		{
+			name: "text",
+			convert: func() (string, error) {
+				return qc.ConvertTextComparisonExpr(sqlparser.EqualStr, textCol, "foo bar")
+			},
+			out: "multiSearchAnyCaseInsensitive(`CustomTextField`, ['foo', 'bar'])",
+		},
+		{
+			name: "int range",
+			convert: func() (string, error) {
+				return qc.ConvertRangeExpr(sqlparser.BetweenStr, intCol, int64(1), int64(5))
+			},
+			out: "`CustomIntField` BETWEEN 1 AND 5",
+		},
+		{
+			name: "datetime comparison",
+			convert: func() (string, error) {
+				return qc.ConvertComparisonExpr(sqlparser.GreaterThanStr, datetimeCol, "2024-03-01T10:00:00Z")
+			},
+			out: "`CustomDatetimeField` > parseDateTime64BestEffort('2024-03-01T10:00:00Z', 9, 'UTC')",
+		},
+		{
+			name: "keyword is null",
+			convert: func() (string, error) {
+				return qc.ConvertIsExpr(sqlparser.IsNullStr, keywordCol)
+			},
+			out: "`CustomKeywordField` IS NULL",
+		},
+		{
+			name: "keyword list is not null",
+			convert: func() (string, error) {
+				return qc.ConvertIsExpr(sqlparser.IsNotNullStr, keywordListCol)
+			},
+			out: "notEmpty(`CustomKeywordListField`)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.convert()
+			require.NoError(t, err)
+			require.Equal(t, tc.out, out)
+		})
+	}
+}
+
+func TestQueryConverter_ConvertTextComparisonExpr_InvalidValue(t *testing.T) {
+	qc := NewQueryConverter()
+	_, err := qc.ConvertTextComparisonExpr(sqlparser.EqualStr, textCol, int64(1))
+	var converterErr *query.ConverterError
+	require.ErrorAs(t, err, &converterErr)
+}
@@ -0,0 +1,855 @@
+package clickhouse
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse/client"
+	"go.temporal.io/server/common/persistence/visibility/store/query"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+)
+
+const (
+	PersistenceName = "clickhouse"
+
+	// versionColumn is the version used by the ReplacingMergeTree engine to keep only the latest row
+	// of an execution.
+	versionColumn = "_version"
+	countColumn   = "_count"
+	// groupByColumnPrefix is the prefix of the aliases of columns in 'GROUP BY' clause.
+	groupByColumnPrefix = "_group"
+)
+
+type (
+	VisibilityStore struct {
+		chClient                       client.Client
+		index                          string
+		table                          string
+		searchAttributesProvider       searchattribute.Provider
+		searchAttributesMapperProvider searchattribute.MapperProvider
+		chasmRegistry                  *chasm.Registry
+	}
+
+	listExecutionsRequestInternal struct {
+		NamespaceID   namespace.ID
+		Namespace     namespace.Name
+		Query         string
+		PageSize      int
+		NextPageToken []byte
+		ArchetypeID   chasm.ArchetypeID
+		ChasmMapper   *chasm.VisibilitySearchAttributesMapper
+	}
+
+	countExecutionsRequestInternal struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name
+		Query       string
+		ArchetypeID chasm.ArchetypeID
+		ChasmMapper *chasm.VisibilitySearchAttributesMapper
+	}
+)
+
+var _ store.VisibilityStore = (*VisibilityStore)(nil)
+
+var (
+	errUnexpectedFieldType = errors.New("unexpected field type")
+
+	// DateTime64(9) can represent dates between 1900-01-01 and 2262-04-11.
+	minDatetime = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxDatetime = time.Date(2262, time.April, 11, 23, 47, 16, 0, time.UTC)
+
+	// Executions are sorted by close time with open executions first, then by start time and run ID.
+	closeTimeSortExpr = fmt.Sprintf(
+		"coalesce(%s, %s)",
+		client.QuoteIdentifier(sadefs.CloseTime),
+		datetimeLiteral(maxDatetime),
+	)
+)
+
+// NewVisibilityStore creates a visibility store connecting to ClickHouse
+func NewVisibilityStore(
+	cfg *client.Config,
+	searchAttributesProvider searchattribute.Provider,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	chasmRegistry *chasm.Registry,
+) (*VisibilityStore, error) {
+	chClient, err := client.NewClient(cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VisibilityStore{
+		chClient:                       chClient,
+		index:                          cfg.GetVisibilityIndex(),
+		table:                          cfg.GetTable(),
+		searchAttributesProvider:       searchAttributesProvider,
+		searchAttributesMapperProvider: searchAttributesMapperProvider,
+		chasmRegistry:                  chasmRegistry,
+	}, nil
+}
+
+func (s *VisibilityStore) Close() {
+	s.chClient.Close()
+}
+
+func (s *VisibilityStore) GetName() string {
+	return PersistenceName
+}
+
+func (s *VisibilityStore) GetIndexName() string {
+	return s.index
+}
+
+func (s *VisibilityStore) ValidateCustomSearchAttributes(
+	searchAttributes map[string]any,
+) (map[string]any, error) {
+	validatedSearchAttributes := make(map[string]any, len(searchAttributes))
+	var invalidValueErrs []error
+	for saName, saValue := range searchAttributes {
+		if value, ok := saValue.(time.Time); ok {
+			if err := validateDatetime(value); err != nil {
+				invalidValueErrs = append(invalidValueErrs, err)
+				continue
+			}
+		}
+		validatedSearchAttributes[saName] = saValue
+	}
+	var retError error
+	if len(invalidValueErrs) > 0 {
+		retError = store.NewVisibilityStoreInvalidValuesError(invalidValueErrs)
+	}
+	return validatedSearchAttributes, retError
+}
+
+func (s *VisibilityStore) RecordWorkflowExecutionStarted(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionStartedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	if err := s.chClient.Insert(ctx, s.table, row); err != nil {
+		return convertClickHouseError("RecordWorkflowExecutionStarted operation failed.", err)
+	}
+	return nil
+}
+
+func (s *VisibilityStore) RecordWorkflowExecutionClosed(
+	ctx context.Context,
+	request *store.InternalRecordWorkflowExecutionClosedRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	row[sadefs.CloseTime] = request.CloseTime.UTC()
+	row[sadefs.ExecutionDuration] = request.ExecutionDuration.Nanoseconds()
+	row[sadefs.HistoryLength] = request.HistoryLength
+	row[sadefs.HistorySizeBytes] = request.HistorySizeBytes
+	row[sadefs.StateTransitionCount] = request.StateTransitionCount
+
+	if err := s.chClient.Insert(ctx, s.table, row); err != nil {
+		return convertClickHouseError("RecordWorkflowExecutionClosed operation failed.", err)
+	}
+	return nil
+}
+
+func (s *VisibilityStore) UpsertWorkflowExecution(
+	ctx context.Context,
+	request *store.InternalUpsertWorkflowExecutionRequest,
+) error {
+	row, err := s.generateRow(request.InternalVisibilityRequestBase)
+	if err != nil {
+		return err
+	}
+	if err := s.chClient.Insert(ctx, s.table, row); err != nil {
+		return convertClickHouseError("UpsertWorkflowExecution operation failed.", err)
+	}
+	return nil
+}
+
+func (s *VisibilityStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *manager.VisibilityDeleteWorkflowExecutionRequest,
+) error {
+	stmt := fmt.Sprintf(
+		"DELETE FROM %s WHERE %s = %s AND %s = %s",
+		client.QuoteIdentifier(s.table),
+		client.QuoteIdentifier(sadefs.NamespaceID),
+		client.QuoteString(request.NamespaceID.String()),
+		client.QuoteIdentifier(sadefs.RunID),
+		client.QuoteString(request.RunID),
+	)
+	if err := s.chClient.Exec(ctx, stmt); err != nil {
+		return convertClickHouseError("DeleteWorkflowExecution operation failed.", err)
+	}
+	return nil
+}
+
+func (s *VisibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ListWorkflowExecutionsRequestV2,
+) (*store.InternalListExecutionsResponse, error) {
+	return s.listExecutionsInternal(ctx, &listExecutionsRequestInternal{
+		NamespaceID:   request.NamespaceID,
+		Namespace:     request.Namespace,
+		Query:         request.Query,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		ArchetypeID:   chasm.UnspecifiedArchetypeID,
+	})
+}
+
+func (s *VisibilityStore) ListChasmExecutions(
+	ctx context.Context,
+	request *manager.ListChasmExecutionsRequest,
+) (*store.InternalListExecutionsResponse, error) {
+	rc, ok := s.chasmRegistry.ComponentByID(request.ArchetypeID)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown archetype ID: %d", request.ArchetypeID))
+	}
+	return s.listExecutionsInternal(ctx, &listExecutionsRequestInternal{
+		NamespaceID:   request.NamespaceID,
+		Namespace:     request.Namespace,
+		Query:         request.Query,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		ArchetypeID:   request.ArchetypeID,
+		ChasmMapper:   rc.SearchAttributesMapper(),
+	})
+}
+
+func (s *VisibilityStore) listExecutionsInternal(
+	ctx context.Context,
+	request *listExecutionsRequestInternal,
+) (*store.InternalListExecutionsResponse, error) {
+	queryParams, err := s.buildQueryParams(
+		request.NamespaceID,
+		request.Namespace,
+		request.Query,
+		request.ChasmMapper,
+		request.ArchetypeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("'GROUP BY' clause is only supported in count queries")
+	}
+
+	token, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	whereExpr := queryParams.QueryExpr
+	if token != nil {
+		whereExpr = fmt.Sprintf(
+			"(%s) AND (%s < %s OR (%s = %s AND (%s < %s OR (%s = %s AND %s > %s))))",
+			whereExpr,
+			closeTimeSortExpr, datetimeLiteral(token.CloseTime),
+			closeTimeSortExpr, datetimeLiteral(token.CloseTime),
+			client.QuoteIdentifier(sadefs.StartTime), datetimeLiteral(token.StartTime),
+			client.QuoteIdentifier(sadefs.StartTime), datetimeLiteral(token.StartTime),
+			client.QuoteIdentifier(sadefs.RunID), client.QuoteString(token.RunID),
+		)
+	}
+	selectStmt := fmt.Sprintf(
+		"SELECT * FROM %s FINAL WHERE %s ORDER BY %s DESC, %s DESC, %s LIMIT %d",
+		client.QuoteIdentifier(s.table),
+		whereExpr,
+		closeTimeSortExpr,
+		client.QuoteIdentifier(sadefs.StartTime),
+		client.QuoteIdentifier(sadefs.RunID),
+		request.PageSize,
+	)
+
+	rows, err := s.chClient.Query(ctx, selectStmt)
+	if err != nil {
+		return nil, convertClickHouseError("ListWorkflowExecutions operation failed.", err)
+	}
+	if len(rows) == 0 {
+		return &store.InternalListExecutionsResponse{}, nil
+	}
+
+	saTypeMap, err := s.getSearchAttributes()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*store.InternalExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i], err = s.parseRow(row, saTypeMap, request.ChasmMapper)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var nextPageToken []byte
+	if len(infos) == request.PageSize {
+		lastInfo := infos[len(infos)-1]
+		closeTime := maxDatetime
+		if !lastInfo.CloseTime.IsZero() {
+			closeTime = lastInfo.CloseTime
+		}
+		nextPageToken, err = serializePageToken(&pageToken{
+			CloseTime: closeTime,
+			StartTime: lastInfo.StartTime,
+			RunID:     lastInfo.RunID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &store.InternalListExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *VisibilityStore) CountWorkflowExecutions(
+	ctx context.Context,
+	request *manager.CountWorkflowExecutionsRequest,
+) (*store.InternalCountExecutionsResponse, error) {
+	return s.countExecutionsInternal(ctx, &countExecutionsRequestInternal{
+		NamespaceID: request.NamespaceID,
+		Namespace:   request.Namespace,
+		Query:       request.Query,
+		ArchetypeID: chasm.UnspecifiedArchetypeID,
+	})
+}
+
+func (s *VisibilityStore) CountChasmExecutions(
+	ctx context.Context,
+	request *manager.CountChasmExecutionsRequest,
+) (*store.InternalCountExecutionsResponse, error) {
+	rc, ok := s.chasmRegistry.ComponentByID(request.ArchetypeID)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unknown archetype ID: %d", request.ArchetypeID))
+	}
+	return s.countExecutionsInternal(ctx, &countExecutionsRequestInternal{
+		NamespaceID: request.NamespaceID,
+		Namespace:   request.Namespace,
+		Query:       request.Query,
+		ArchetypeID: request.ArchetypeID,
+		ChasmMapper: rc.SearchAttributesMapper(),
+	})
+}
+
+func (s *VisibilityStore) countExecutionsInternal(
+	ctx context.Context,
+	request *countExecutionsRequestInternal,
+) (*store.InternalCountExecutionsResponse, error) {
+	queryParams, err := s.buildQueryParams(
+		request.NamespaceID,
+		request.Namespace,
+		request.Query,
+		request.ChasmMapper,
+		request.ArchetypeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(queryParams.GroupBy) > 0 {
+		return s.countGroupByExecutions(ctx, queryParams)
+	}
+
+	countStmt := fmt.Sprintf(
+		"SELECT count() AS %s FROM %s FINAL WHERE %s",
+		countColumn,
+		client.QuoteIdentifier(s.table),
+		queryParams.QueryExpr,
+	)
+	rows, err := s.chClient.Query(ctx, countStmt)
+	if err != nil {
+		return nil, convertClickHouseError("CountWorkflowExecutions operation failed.", err)
+	}
+	if len(rows) != 1 {
+		return nil, serviceerror.NewInternalf("CountWorkflowExecutions returned %d rows", len(rows))
+	}
+	count, err := parseCount(rows[0])
+	if err != nil {
+		return nil, err
+	}
+	return &store.InternalCountExecutionsResponse{Count: count}, nil
+}
+
+func (s *VisibilityStore) countGroupByExecutions(
+	ctx context.Context,
+	queryParams *query.QueryParams[string],
+) (*store.InternalCountExecutionsResponse, error) {
+	// Group by columns are aliased since ClickHouse would substitute an alias named after a column
+	// in the WHERE clause.
+	selectExprs := make([]string, len(queryParams.GroupBy))
+	groupByAliases := make([]string, len(queryParams.GroupBy))
+	for i, col := range queryParams.GroupBy {
+		groupByAliases[i] = groupByAlias(i)
+		selectExprs[i] = groupByExpr(col) + " AS " + groupByAliases[i]
+	}
+	groupByList := strings.Join(groupByAliases, ", ")
+	countStmt := fmt.Sprintf(
+		"SELECT %s, count() AS %s FROM %s FINAL WHERE %s GROUP BY %s ORDER BY %s DESC, %s",
+		strings.Join(selectExprs, ", "),
+		countColumn,
+		client.QuoteIdentifier(s.table),
+		queryParams.QueryExpr,
+		groupByList,
+		countColumn,
+		groupByList,
+	)
+	rows, err := s.chClient.Query(ctx, countStmt)
+	if err != nil {
+		return nil, convertClickHouseError("CountWorkflowExecutions operation failed.", err)
+	}
+
+	resp := &store.InternalCountExecutionsResponse{
+		Count:  0,
+		Groups: make([]store.InternalAggregationGroup, 0, len(rows)),
+	}
+	for _, row := range rows {
+		count, err := parseCount(row)
+		if err != nil {
+			return nil, err
+		}
+		groupValues := make([]*commonpb.Payload, len(queryParams.GroupBy))
+		for i, col := range queryParams.GroupBy {
+			value, err := parseValue(row[groupByAlias(i)], col.ValueType)
+			if err != nil {
+				return nil, serviceerror.NewInternalf(
+					"unable to parse group by value of %q: %v", col.FieldName, err,
+				)
+			}
+			// ExecutionStatus is stored as a keyword, same as in Elasticsearch.
+			groupValues[i], err = searchattribute.EncodeValue(value, col.ValueType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.Groups = append(resp.Groups, store.InternalAggregationGroup{
+			GroupValues: groupValues,
+			Count:       count,
+		})
+		resp.Count += count
+	}
+	return resp, nil
+}
+
+func (s *VisibilityStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *manager.GetWorkflowExecutionRequest,
+) (*store.InternalGetWorkflowExecutionResponse, error) {
+	selectStmt := fmt.Sprintf(
+		"SELECT * FROM %s FINAL WHERE %s = %s AND %s = %s LIMIT 1",
+		client.QuoteIdentifier(s.table),
+		client.QuoteIdentifier(sadefs.NamespaceID),
+		client.QuoteString(request.NamespaceID.String()),
+		client.QuoteIdentifier(sadefs.RunID),
+		client.QuoteString(request.RunID),
+	)
+	rows, err := s.chClient.Query(ctx, selectStmt)
+	if err != nil {
+		return nil, convertClickHouseError("GetWorkflowExecution operation failed.", err)
+	}
+	if len(rows) == 0 {
+		return nil, serviceerror.NewNotFoundf(
+			"Workflow execution with RunId %s not found", request.RunID,
+		)
+	}
+
+	saTypeMap, err := s.getSearchAttributes()
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.parseRow(rows[0], saTypeMap, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &store.InternalGetWorkflowExecutionResponse{
+		Execution: info,
+	}, nil
+}
+
+// AddSearchAttributes adds a column for each new search attribute to the visibility table.
+func (s *VisibilityStore) AddSearchAttributes(
+	ctx context.Context,
+	request *manager.AddSearchAttributesRequest,
+) error {
+	if len(request.SearchAttributes) == 0 {
+		return nil
+	}
+	saNames := make([]string, 0, len(request.SearchAttributes))
+	for saName := range request.SearchAttributes {
+		saNames = append(saNames, saName)
+	}
+	slices.Sort(saNames)
+
+	addColumns := make([]string, len(saNames))
+	for i, saName := range saNames {
+		colType, err := columnType(request.SearchAttributes[saName])
+		if err != nil {
+			return err
+		}
+		addColumns[i] = fmt.Sprintf("ADD COLUMN IF NOT EXISTS %s %s", client.QuoteIdentifier(saName), colType)
+	}
+	stmt := fmt.Sprintf("ALTER TABLE %s %s", client.QuoteIdentifier(s.table), strings.Join(addColumns, ", "))
+	if err := s.chClient.Exec(ctx, stmt); err != nil {
+		return convertClickHouseError("AddSearchAttributes operation failed.", err)
+	}
+	return nil
+}
+
+func (s *VisibilityStore) buildQueryParams(
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	queryString string,
+	chasmMapper *chasm.VisibilitySearchAttributesMapper,
+	archetypeID chasm.ArchetypeID,
+) (*query.QueryParams[string], error) {
+	saTypeMap, err := s.getSearchAttributes()
+	if err != nil {
+		return nil, err
+	}
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	chQC := NewQueryConverter()
+	c := query.NewQueryConverter(chQC, namespaceName, saTypeMap, saMapper).
+		WithChasmMapper(chasmMapper).
+		WithArchetypeID(archetypeID)
+	queryParams, err := c.Convert(queryString)
+	if err == nil && len(queryParams.OrderBy) > 0 {
+		err = query.NewConverterError("%s: 'ORDER BY' clause", query.NotSupportedErrMessage)
+	}
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be
+		// only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	nsFilterExpr, err := chQC.ConvertComparisonExpr(sqlparser.EqualStr, query.NamespaceIDSAColumn, namespaceID.String())
+	if err != nil {
+		return nil, err
+	}
+	queryParams.QueryExpr, err = chQC.BuildAndExpr(nsFilterExpr, queryParams.QueryExpr)
+	if err != nil {
+		return nil, err
+	}
+	return queryParams, nil
+}
+
+func (s *VisibilityStore) getSearchAttributes() (searchattribute.NameTypeMap, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return searchattribute.NameTypeMap{}, serviceerror.NewUnavailablef(
+			"unable to read search attribute types: %v", err,
+		)
+	}
+	return saTypeMap, nil
+}
+
+func (s *VisibilityStore) generateRow(
+	request *store.InternalVisibilityRequestBase,
+) (map[string]any, error) {
+	row := map[string]any{
+		versionColumn:          request.TaskID,
+		sadefs.NamespaceID:     request.NamespaceID,
+		sadefs.WorkflowID:      request.WorkflowID,
+		sadefs.RunID:           request.RunID,
+		sadefs.WorkflowType:    request.WorkflowTypeName,
+		sadefs.StartTime:       request.StartTime.UTC(),
+		sadefs.ExecutionTime:   request.ExecutionTime.UTC(),
+		sadefs.ExecutionStatus: request.Status.String(),
+		sadefs.TaskQueue:       request.TaskQueue,
+		sadefs.RootWorkflowID:  request.RootWorkflowID,
+		sadefs.RootRunID:       request.RootRunID,
+	}
+	if request.ParentWorkflowID != nil {
+		row[sadefs.ParentWorkflowID] = *request.ParentWorkflowID
+	}
+	if request.ParentRunID != nil {
+		row[sadefs.ParentRunID] = *request.ParentRunID
+	}
+	if len(request.Memo.GetData()) > 0 {
+		// JSON encodes []byte as base64 string.
+		row[sadefs.Memo] = request.Memo.GetData()
+		row[sadefs.MemoEncoding] = request.Memo.GetEncodingType().String()
+	}
+
+	saTypeMap, err := s.getSearchAttributes()
+	if err != nil {
+		return nil, err
+	}
+	searchAttributes, err := searchattribute.Decode(request.SearchAttributes, &saTypeMap, false)
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to decode search attributes: %v", err)
+	}
+	// This is to prevent existing tasks to fail indefinitely.
+	// If it's only invalid values error, then silently continue without them.
+	searchAttributes, err = s.ValidateCustomSearchAttributes(searchAttributes)
+	if err != nil {
+		if _, ok := err.(*serviceerror.InvalidArgument); !ok {
+			return nil, err
+		}
+	}
+	for saName, saValue := range searchAttributes {
+		if saValue == nil {
+			// Empty slices are converted to `nil` while decoding.
+			continue
+		}
+		if value, ok := saValue.(time.Time); ok {
+			saValue = value.UTC()
+		}
+		row[saName] = saValue
+	}
+	return row, nil
+}
+
+//nolint:revive // cyclomatic complexity
+func (s *VisibilityStore) parseRow(
+	row map[string]any,
+	saTypeMap searchattribute.NameTypeMap,
+	chasmMapper *chasm.VisibilitySearchAttributesMapper,
+) (*store.InternalExecutionInfo, error) {
+	combinedTypeMap := store.CombineTypeMaps(saTypeMap, chasmMapper)
+
+	var (
+		memo                []byte
+		memoEncoding        string
+		allSearchAttributes map[string]any
+	)
+	record := &store.InternalExecutionInfo{}
+	for colName, colValue := range row {
+		if colValue == nil || strings.HasPrefix(colName, "_") {
+			continue
+		}
+		switch colName {
+		case sadefs.NamespaceID:
+			continue
+		case sadefs.Memo:
+			memoStr, ok := colValue.(string)
+			if !ok {
+				return nil, parseError(colName, colValue, fmt.Errorf("%w: expected string got %T", errUnexpectedFieldType, colValue))
+			}
+			var err error
+			if memo, err = base64.StdEncoding.DecodeString(memoStr); err != nil {
+				return nil, parseError(colName, colValue, err)
+			}
+			continue
+		case sadefs.MemoEncoding:
+			var ok bool
+			if memoEncoding, ok = colValue.(string); !ok {
+				return nil, parseError(colName, colValue, fmt.Errorf("%w: expected string got %T", errUnexpectedFieldType, colValue))
+			}
+			continue
+		}
+
+		colType, err := combinedTypeMap.GetType(colName)
+		if err != nil {
+			// Silently ignore ErrInvalidName because it indicates a column of a search attribute which
+			// is not registered (anymore).
+			if errors.Is(err, searchattribute.ErrInvalidName) {
+				continue
+			}
+			return nil, serviceerror.NewInternalf("unable to get type for column %q: %v", colName, err)
+		}
+
+		value, err := parseValue(colValue, colType)
+		if err != nil {
+			return nil, parseError(colName, colValue, err)
+		}
+		if value == nil {
+			continue
+		}
+
+		switch colName {
+		case sadefs.WorkflowID:
+			record.WorkflowID = value.(string)
+		case sadefs.RunID:
+			record.RunID = value.(string)
+		case sadefs.WorkflowType:
+			record.TypeName = value.(string)
+		case sadefs.StartTime:
+			record.StartTime = value.(time.Time)
+		case sadefs.ExecutionTime:
+			record.ExecutionTime = value.(time.Time)
+		case sadefs.CloseTime:
+			record.CloseTime = value.(time.Time)
+		case sadefs.ExecutionDuration:
+			record.ExecutionDuration = time.Duration(value.(int64))
+		case sadefs.TaskQueue:
+			record.TaskQueue = value.(string)
+		case sadefs.ExecutionStatus:
+			status, err := enumspb.WorkflowExecutionStatusFromString(value.(string))
+			if err != nil {
+				return nil, parseError(colName, colValue, err)
+			}
+			record.Status = status
+		case sadefs.HistoryLength:
+			record.HistoryLength = value.(int64)
+		case sadefs.StateTransitionCount:
+			record.StateTransitionCount = value.(int64)
+		case sadefs.HistorySizeBytes:
+			record.HistorySizeBytes = value.(int64)
+		case sadefs.ParentWorkflowID:
+			record.ParentWorkflowID = value.(string)
+		case sadefs.ParentRunID:
+			record.ParentRunID = value.(string)
+		case sadefs.RootWorkflowID:
+			record.RootWorkflowID = value.(string)
+		case sadefs.RootRunID:
+			record.RootRunID = value.(string)
+		default:
+			if allSearchAttributes == nil {
+				allSearchAttributes = map[string]any{}
+			}
+			allSearchAttributes[colName] = value
+		}
+	}
+
+	var err error
+	record.SearchAttributes, err = searchattribute.Encode(allSearchAttributes, &combinedTypeMap)
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to encode search attributes: %v", err)
+	}
+	if memoEncoding != "" {
+		record.Memo = persistence.NewDataBlob(memo, memoEncoding)
+	} else if memo != nil {
+		return nil, serviceerror.NewInternalf("%q column is empty", sadefs.MemoEncoding)
+	}
+	return record, nil
+}
+
+// parseValue converts a value decoded from a JSONEachRow row to the Go type of the search
+// attribute type. Returns nil for missing values (NULL or empty array).
+func parseValue(value any, t enumspb.IndexedValueType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		if v, ok := value.(json.Number); ok {
+			return v.Int64()
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if v, ok := value.(json.Number); ok {
+			return v.Float64()
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		if v, ok := value.(string); ok {
+			return time.Parse(time.RFC3339Nano, v)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		if v, ok := value.([]any); ok {
+			if len(v) == 0 {
+				return nil, nil
+			}
+			res := make([]string, len(v))
+			for i, item := range v {
+				if res[i], ok = item.(string); !ok {
+					return nil, fmt.Errorf("%w: expected list of strings got %T item", errUnexpectedFieldType, item)
+				}
+			}
+			return res, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: %v", searchattribute.ErrInvalidType, t)
+	}
+	return nil, fmt.Errorf("%w: %T for search attribute type %v", errUnexpectedFieldType, value, t)
+}
+
+// groupByExpr returns the expression of a column in 'GROUP BY' clause.
+func groupByExpr(col *query.GroupByColumn) string {
+	if col.Interval == 0 {
+		return columnExpr(col.SAColumn)
+	}
+	return fmt.Sprintf(
+		"toStartOfInterval(%s, INTERVAL %d SECOND)",
+		columnExpr(col.SAColumn),
+		int64(col.Interval/time.Second),
+	)
+}
+
+func groupByAlias(i int) string {
+	return fmt.Sprintf("%s%d", groupByColumnPrefix, i)
+}
+
+func parseCount(row map[string]any) (int64, error) {
+	count, ok := row[countColumn].(json.Number)
+	if !ok {
+		return 0, serviceerror.NewInternalf("unable to parse count: unexpected value %v", row[countColumn])
+	}
+	return count.Int64()
+}
+
+func parseError(colName string, colValue any, err error) error {
+	return serviceerror.NewInternalf("unable to parse ClickHouse column %q value %v: %v", colName, colValue, err)
+}
+
+// columnType returns the ClickHouse type of the column storing a search attribute type.
+func columnType(t enumspb.IndexedValueType) (string, error) {
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT:
+		return "Nullable(String)", nil
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return "Nullable(Int64)", nil
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return "Nullable(Float64)", nil
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return "Nullable(Bool)", nil
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return "Nullable(DateTime64(9, 'UTC'))", nil
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		// Arrays can't be nullable, missing keyword lists are stored as empty arrays.
+		return "Array(String)", nil
+	default:
+		return "", serviceerror.NewInvalidArgumentf("unknown search attribute type: %v", t)
+	}
+}
+
+func datetimeLiteral(t time.Time) string {
+	return "parseDateTime64BestEffort(" + client.QuoteString(t.UTC().Format(datetimeFormat)) + ", 9, 'UTC')"
+}
+
+func validateDatetime(value time.Time) error {
+	if value.Before(minDatetime) || value.After(maxDatetime) {
+		return serviceerror.NewInvalidArgumentf("Date not supported in ClickHouse: %v", value)
+	}
+	return nil
+}
+
+func convertClickHouseError(message string, err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%s: %w", message, err)
+	}
+	return serviceerror.NewUnavailable(fmt.Sprintf("%s: %v", message, err))
+}
@@ -0,0 +1,366 @@
+package clickhouse
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	visibilityStoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller      *gomock.Controller
+		mockClient      *client.MockClient
+		visibilityStore *VisibilityStore
+	}
+)
+
+const (
+	testTable       = "executions_visibility"
+	testNamespace   = namespace.Name("test-namespace")
+	testNamespaceID = namespace.ID("bfd5c907-f899-4baf-a7b2-2ab85e623ebd")
+
+	testWorkflowFilter = "(`TemporalNamespaceDivision` IS NULL)"
+)
+
+var (
+	testStartTime = time.Date(2024, time.March, 1, 10, 0, 0, 123456789, time.UTC)
+	testCloseTime = time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC)
+)
+
+func TestVisibilityStoreSuite(t *testing.T) {
+	suite.Run(t, new(visibilityStoreSuite))
+}
+
+func (s *visibilityStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockClient = client.NewMockClient(s.controller)
+
+	mapperProvider := searchattribute.NewMockMapperProvider(s.controller)
+	mapperProvider.EXPECT().GetMapper(testNamespace).Return(searchattribute.NewNoopMapper(), nil).AnyTimes()
+
+	s.visibilityStore = &VisibilityStore{
+		chClient:                       s.mockClient,
+		index:                          "temporal_visibility",
+		table:                          testTable,
+		searchAttributesProvider:       searchattribute.NewTestEsProvider(),
+		searchAttributesMapperProvider: mapperProvider,
+		chasmRegistry:                  chasm.NewRegistry(log.NewNoopLogger()),
+	}
+}
+
+func (s *visibilityStoreSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *visibilityStoreSuite) TestRecordWorkflowExecutionClosed() {
+	saTypeMap := searchattribute.TestEsNameTypeMap()
+	customSA, err := searchattribute.Encode(
+		map[string]any{
+			"CustomKeywordField":  "foo",
+			"CustomIntField":      int64(42),
+			"CustomDatetimeField": time.Date(1800, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		&saTypeMap,
+	)
+	s.NoError(err)
+
+	request := &store.InternalRecordWorkflowExecutionClosedRequest{
+		InternalVisibilityRequestBase: &store.InternalVisibilityRequestBase{
+			NamespaceID:      testNamespaceID.String(),
+			WorkflowID:       "wid",
+			RunID:            "rid",
+			WorkflowTypeName: "wtype",
+			StartTime:        testStartTime,
+			ExecutionTime:    testStartTime,
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			TaskID:           123,
+			TaskQueue:        "tq",
+			Memo:             persistence.NewDataBlob([]byte("memo"), enumspb.ENCODING_TYPE_PROTO3.String()),
+			SearchAttributes: customSA,
+		},
+		CloseTime:         testCloseTime,
+		ExecutionDuration: time.Hour,
+		HistoryLength:     10,
+		HistorySizeBytes:  1024,
+	}
+
+	s.mockClient.EXPECT().Insert(gomock.Any(), testTable, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, rows ...map[string]any) error {
+			s.Len(rows, 1)
+			row := rows[0]
+			s.Equal(int64(123), row[versionColumn])
+			s.Equal("rid", row["RunId"])
+			s.Equal("Completed", row["ExecutionStatus"])
+			s.Equal(testCloseTime, row["CloseTime"])
+			s.Equal(time.Hour.Nanoseconds(), row["ExecutionDuration"])
+			s.Equal([]byte("memo"), row["Memo"])
+			s.Equal("foo", row["CustomKeywordField"])
+			s.Equal(int64(42), row["CustomIntField"])
+			// Datetime values out of the DateTime64 range are dropped.
+			s.NotContains(row, "CustomDatetimeField")
+			return nil
+		})
+
+	s.NoError(s.visibilityStore.RecordWorkflowExecutionClosed(context.Background(), request))
+}
+
+func (s *visibilityStoreSuite) TestDeleteWorkflowExecution() {
+	s.mockClient.EXPECT().Exec(
+		gomock.Any(),
+		"DELETE FROM `executions_visibility` WHERE `NamespaceId` = '"+testNamespaceID.String()+"' AND `RunId` = 'rid'",
+	).Return(nil)
+
+	err := s.visibilityStore.DeleteWorkflowExecution(context.Background(), &manager.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		RunID:       "rid",
+	})
+	s.NoError(err)
+}
+
+func (s *visibilityStoreSuite) TestListWorkflowExecutions() {
+	row := map[string]any{
+		"_version":           json.Number("1"),
+		"NamespaceId":        testNamespaceID.String(),
+		"WorkflowId":         "wid",
+		"RunId":              "rid",
+		"WorkflowType":       "wtype",
+		"StartTime":          "2024-03-01T10:00:00.123456789Z",
+		"ExecutionTime":      "2024-03-01T10:00:00.123456789Z",
+		"CloseTime":          "2024-03-01T11:00:00Z",
+		"ExecutionStatus":    "Completed",
+		"TaskQueue":          "tq",
+		"HistoryLength":      json.Number("10"),
+		"Memo":               "bWVtbw==",
+		"MemoEncoding":       "Proto3",
+		"CustomKeywordField": "foo",
+		"CustomIntField":     nil,
+	}
+	expectedStmt := "SELECT * FROM `executions_visibility` FINAL WHERE " +
+		"(`NamespaceId` = '" + testNamespaceID.String() + "') AND " +
+		"(" + testWorkflowFilter + " AND (`CustomKeywordField` = 'foo')) " +
+		"ORDER BY coalesce(`CloseTime`, parseDateTime64BestEffort('2262-04-11T23:47:16Z', 9, 'UTC')) DESC, " +
+		"`StartTime` DESC, `RunId` LIMIT 1"
+	s.mockClient.EXPECT().Query(gomock.Any(), expectedStmt).Return([]map[string]any{row}, nil)
+
+	resp, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "CustomKeywordField = 'foo'",
+		PageSize:    1,
+	})
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	info := resp.Executions[0]
+	s.Equal("wid", info.WorkflowID)
+	s.Equal("rid", info.RunID)
+	s.Equal("wtype", info.TypeName)
+	s.Equal(testStartTime, info.StartTime)
+	s.Equal(testCloseTime, info.CloseTime)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, info.Status)
+	s.Equal(int64(10), info.HistoryLength)
+	s.Equal([]byte("memo"), info.Memo.GetData())
+	s.Len(info.SearchAttributes.GetIndexedFields(), 1)
+	s.Equal(`"foo"`, string(info.SearchAttributes.GetIndexedFields()["CustomKeywordField"].GetData()))
+
+	// Page is full, so the next page starts after the last execution.
+	s.NotNil(resp.NextPageToken)
+	token, err := deserializePageToken(resp.NextPageToken)
+	s.NoError(err)
+	s.Equal(&pageToken{CloseTime: testCloseTime, StartTime: testStartTime, RunID: "rid"}, token)
+
+	s.mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, stmt string) ([]map[string]any, error) {
+			s.Contains(stmt, "`RunId` > 'rid'")
+			return nil, nil
+		})
+	resp, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceID,
+		Namespace:     testNamespace,
+		Query:         "CustomKeywordField = 'foo'",
+		PageSize:      1,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Empty(resp.Executions)
+	s.Nil(resp.NextPageToken)
+}
+
+func (s *visibilityStoreSuite) TestListWorkflowExecutions_InvalidQuery() {
+	for _, q := range []string{
+		"CustomKeywordField = 'foo' ORDER BY StartTime",
+		"GROUP BY ExecutionStatus",
+		"UnknownField = 'foo'",
+	} {
+		_, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID: testNamespaceID,
+			Namespace:   testNamespace,
+			Query:       q,
+			PageSize:    10,
+		})
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgErr, q)
+	}
+}
+
+func (s *visibilityStoreSuite) TestListChasmExecutions_UnknownArchetype() {
+	_, err := s.visibilityStore.ListChasmExecutions(context.Background(), &manager.ListChasmExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		ArchetypeID: 12345,
+		PageSize:    10,
+	})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *visibilityStoreSuite) TestCountWorkflowExecutions() {
+	expectedStmt := "SELECT count() AS _count FROM `executions_visibility` FINAL WHERE " +
+		"(`NamespaceId` = '" + testNamespaceID.String() + "') AND " +
+		"(" + testWorkflowFilter + " AND (`ExecutionStatus` = 'Running'))"
+	s.mockClient.EXPECT().Query(gomock.Any(), expectedStmt).
+		Return([]map[string]any{{"_count": json.Number("7")}}, nil)
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "ExecutionStatus = 'Running'",
+	})
+	s.NoError(err)
+	s.Equal(int64(7), resp.Count)
+	s.Empty(resp.Groups)
+}
+
+func (s *visibilityStoreSuite) TestCountWorkflowExecutions_GroupBy() {
+	expectedStmt := "SELECT `ExecutionStatus` AS _group0, count() AS _count FROM `executions_visibility` FINAL WHERE " +
+		"(`NamespaceId` = '" + testNamespaceID.String() + "') AND " + testWorkflowFilter + " " +
+		"GROUP BY _group0 ORDER BY _count DESC, _group0"
+	s.mockClient.EXPECT().Query(gomock.Any(), expectedStmt).Return([]map[string]any{
+		{"_group0": "Running", "_count": json.Number("5")},
+		{"_group0": "Completed", "_count": json.Number("2")},
+	}, nil)
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY ExecutionStatus",
+	})
+	s.NoError(err)
+	s.Equal(int64(7), resp.Count)
+	s.Len(resp.Groups, 2)
+	running, _ := searchattribute.EncodeValue("Running", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	completed, _ := searchattribute.EncodeValue("Completed", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.Equal([]*commonpb.Payload{running}, resp.Groups[0].GroupValues)
+	s.Equal(int64(5), resp.Groups[0].Count)
+	s.Equal([]*commonpb.Payload{completed}, resp.Groups[1].GroupValues)
+	s.Equal(int64(2), resp.Groups[1].Count)
+}
+
+func (s *visibilityStoreSuite) TestCountWorkflowExecutions_GroupByDateHistogram() {
+	expectedStmt := "SELECT `WorkflowType` AS _group0, " +
+		"toStartOfInterval(`StartTime`, INTERVAL 3600 SECOND) AS _group1, " +
+		"count() AS _count FROM `executions_visibility` FINAL WHERE " +
+		"(`NamespaceId` = '" + testNamespaceID.String() + "') AND " + testWorkflowFilter + " " +
+		"GROUP BY _group0, _group1 ORDER BY _count DESC, _group0, _group1"
+	s.mockClient.EXPECT().Query(gomock.Any(), expectedStmt).Return([]map[string]any{
+		{"_group0": "wtype", "_group1": "2024-03-01T10:00:00.000000000Z", "_count": json.Number("3")},
+	}, nil)
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY WorkflowType, date_histogram(StartTime, '1h')",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+	wfType, _ := searchattribute.EncodeValue("wtype", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	bucket, _ := searchattribute.EncodeValue(
+		time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	s.Equal([]store.InternalAggregationGroup{
+		{GroupValues: []*commonpb.Payload{wfType, bucket}, Count: 3},
+	}, resp.Groups)
+}
+
+func (s *visibilityStoreSuite) TestGetWorkflowExecution_NotFound() {
+	s.mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	_, err := s.visibilityStore.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		RunID:       "rid",
+	})
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func (s *visibilityStoreSuite) TestAddSearchAttributes() {
+	s.mockClient.EXPECT().Exec(
+		gomock.Any(),
+		"ALTER TABLE `executions_visibility` "+
+			"ADD COLUMN IF NOT EXISTS `NewBool` Nullable(Bool), "+
+			"ADD COLUMN IF NOT EXISTS `NewKeywordList` Array(String), "+
+			"ADD COLUMN IF NOT EXISTS `NewTime` Nullable(DateTime64(9, 'UTC'))",
+	).Return(nil)
+
+	err := s.visibilityStore.AddSearchAttributes(context.Background(), &manager.AddSearchAttributesRequest{
+		SearchAttributes: map[string]enumspb.IndexedValueType{
+			"NewTime":        enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			"NewBool":        enumspb.INDEXED_VALUE_TYPE_BOOL,
+			"NewKeywordList": enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *visibilityStoreSuite) TestAddSearchAttributes_Error() {
+	s.mockClient.EXPECT().Exec(gomock.Any(), gomock.Any()).
+		Return(&client.Error{StatusCode: 500, Code: "999", Message: "Keeper exception"})
+
+	err := s.visibilityStore.AddSearchAttributes(context.Background(), &manager.AddSearchAttributesRequest{
+		SearchAttributes: map[string]enumspb.IndexedValueType{"NewInt": enumspb.INDEXED_VALUE_TYPE_INT},
+	})
+	var unavailableErr *serviceerror.Unavailable
+	s.ErrorAs(err, &unavailableErr)
+}
+
+func (s *visibilityStoreSuite) TestParseValue() {
+	v, err := parseValue("2024-03-01T10:00:00.123456789Z", enumspb.INDEXED_VALUE_TYPE_DATETIME)
+	s.NoError(err)
+	s.Equal(testStartTime, v)
+
+	v, err = parseValue(json.Number("1.5"), enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	s.NoError(err)
+	s.Equal(1.5, v)
+
+	v, err = parseValue([]any{}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	s.NoError(err)
+	s.Nil(v)
+
+	v, err = parseValue([]any{"a", "b"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	s.NoError(err)
+	s.Equal([]string{"a", "b"}, v)
+
+	_, err = parseValue("1", enumspb.INDEXED_VALUE_TYPE_INT)
+	s.ErrorIs(err, errUnexpectedFieldType)
+}
@@ -36,6 +36,11 @@ const (
 	PersistenceName = "elasticsearch"
 
 	delimiter = "~"
+
+	// maxGroupByTermsSize is the number of buckets returned for each Keyword field in 'GROUP BY'
+	// clause. Elasticsearch returns only 10 buckets by default. Queries with more values are
+	// rejected instead of silently returning partial counts.
+	maxGroupByTermsSize = 1000
 )
 
 type (
@@ -62,6 +67,8 @@ type (
 		Query   elastic.Query
 		Sorter  []elastic.Sorter
 		GroupBy []string
+		// GroupByIntervals maps Datetime fields in GroupBy to the interval of their date histogram.
+		GroupByIntervals map[string]time.Duration
 	}
 
 	fieldSort struct {
@@ -432,7 +439,7 @@ func (s *VisibilityStore) CountChasmExecutions(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	if len(queryParams.GroupBy) > 0 {
@@ -463,7 +470,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	if len(queryParams.GroupBy) > 0 {
@@ -503,18 +510,34 @@ func (s *VisibilityStore) countGroupByExecutions(
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
-	for i := len(groupByFields) - 2; i >= 0; i-- {
-		termsAgg = elastic.NewTermsAggregation().
-			Field(groupByFields[i]).
-			SubAggregation(groupByFields[i+1], termsAgg)
+	//
+	// Datetime fields are grouped with a date histogram instead of terms aggregation.
+	var agg elastic.Aggregation
+	for i := len(groupByFields) - 1; i >= 0; i-- {
+		field := groupByFields[i]
+		if interval, ok := queryParams.GroupByIntervals[field]; ok {
+			histogramAgg := elastic.NewDateHistogramAggregation().
+				Field(field).
+				FixedInterval(fmt.Sprintf("%ds", int64(interval/time.Second))).
+				MinDocCount(1)
+			if agg != nil {
+				histogramAgg = histogramAgg.SubAggregation(groupByFields[i+1], agg)
+			}
+			agg = histogramAgg
+			continue
+		}
+		termsAgg := elastic.NewTermsAggregation().Field(field).Size(maxGroupByTermsSize)
+		if agg != nil {
+			termsAgg = termsAgg.SubAggregation(groupByFields[i+1], agg)
+		}
+		agg = termsAgg
 	}
 	esResponse, err := s.esClient.CountGroupBy(
 		ctx,
 		s.index,
 		queryParams.Query,
 		groupByFields[0],
-		termsAgg,
+		agg,
 	)
 	if err != nil {
 		return nil, ConvertElasticsearchClientError("CountWorkflowExecutions failed", err)
@@ -607,7 +630,7 @@ func (s *VisibilityStore) buildSearchParametersInternal(
 		if err != nil {
 			return nil, err
 		}
-		queryParams = (*esQueryParams)(queryParamsLegacy)
+		queryParams = newESQueryParamsFromLegacy(queryParamsLegacy)
 	}
 
 	searchParams := &client.SearchParameters{
@@ -761,17 +784,33 @@ func (s *VisibilityStore) convertQuery(
 	}
 
 	groupBy := make([]string, 0, len(queryParams.GroupBy))
+	var groupByIntervals map[string]time.Duration
 	for _, field := range queryParams.GroupBy {
 		groupBy = append(groupBy, field.FieldName)
+		if field.Interval > 0 {
+			if groupByIntervals == nil {
+				groupByIntervals = make(map[string]time.Duration)
+			}
+			groupByIntervals[field.FieldName] = field.Interval
+		}
 	}
 
 	return &esQueryParams{
-		Query:   queryParams.QueryExpr,
-		Sorter:  orderBy,
-		GroupBy: groupBy,
+		Query:            queryParams.QueryExpr,
+		Sorter:           orderBy,
+		GroupBy:          groupBy,
+		GroupByIntervals: groupByIntervals,
 	}, nil
 }
 
+func newESQueryParamsFromLegacy(queryParams *query.QueryParamsLegacy) *esQueryParams {
+	return &esQueryParams{
+		Query:   queryParams.Query,
+		Sorter:  queryParams.Sorter,
+		GroupBy: queryParams.GroupBy,
+	}
+}
+
 func (s *VisibilityStore) convertQueryLegacy(
 	namespace namespace.Name,
 	namespaceID namespace.ID,
@@ -1177,10 +1216,33 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 
 		index := len(bucketValues)
 		fieldName := groupByFields[index]
-		buckets := aggs[fieldName].(map[string]any)["buckets"].([]any)
+		fieldAgg := aggs[fieldName].(map[string]any)
+		// Terms aggregations count the documents of the values past the requested size here.
+		if otherDocCount, ok := fieldAgg["sum_other_doc_count"]; ok {
+			cnt, err := parseJsonNumber(otherDocCount)
+			if err != nil {
+				return fmt.Errorf("unable to parse 'sum_other_doc_count' field: %w", err)
+			}
+			if cnt > 0 {
+				return serviceerror.NewInvalidArgumentf(
+					"'GROUP BY' field %s has more than %d values, narrow down the query to count it",
+					fieldName,
+					maxGroupByTermsSize,
+				)
+			}
+		}
+		buckets := fieldAgg["buckets"].([]any)
 		for i := range buckets {
 			bucket := buckets[i].(map[string]any)
-			value, err := finishParseJSONValue(bucket["key"], groupByTypes[index])
+			var value any
+			if groupByTypes[index] == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+				// Date histogram bucket key is the bucket start in epoch milliseconds.
+				var millis int64
+				millis, err = parseJsonNumber(bucket["key"])
+				value = time.UnixMilli(millis).UTC()
+			} else {
+				value, err = finishParseJSONValue(bucket["key"], groupByTypes[index])
+			}
 			if err != nil {
 				return fmt.Errorf("unable to parse value %v: %w", bucket["key"], err)
 			}
@@ -1045,7 +1045,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 					namespaceDivisionIsNull,
 				),
 			sadefs.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus),
+			elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).Size(maxGroupByTermsSize),
 		).
 		Return(
 			&elastic.SearchResult{
@@ -1078,17 +1078,63 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 	}
 	s.True(temporalproto.DeepEqual(expectedResp, resp))
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test only allowed to group by Keyword fields
+	request.Query = "GROUP BY StartTime"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
-	s.ErrorContains(err, "'GROUP BY' clause supports only a single field")
+	s.ErrorContains(err, "'GROUP BY' clause is only supported for search attributes of type Keyword")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus and LowCardinalityKeyword fields
-	request.Query = "GROUP BY WorkflowType"
+	// test date histogram of a Datetime field
+	request.Query = "GROUP BY WorkflowType, date_histogram(StartTime, '1h')"
+	s.mockESClient.EXPECT().
+		CountGroupBy(
+			gomock.Any(),
+			testIndex,
+			elastic.NewBoolQuery().
+				Filter(
+					elastic.NewTermQuery(sadefs.NamespaceID, testNamespaceID.String()),
+					namespaceDivisionIsNull,
+				),
+			sadefs.WorkflowType,
+			elastic.NewTermsAggregation().Field(sadefs.WorkflowType).Size(maxGroupByTermsSize).SubAggregation(
+				sadefs.StartTime,
+				elastic.NewDateHistogramAggregation().Field(sadefs.StartTime).FixedInterval("3600s").MinDocCount(1),
+			),
+		).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					sadefs.WorkflowType: json.RawMessage(
+						`{"buckets":[{"key":"wf-type-1","doc_count":3,"StartTime":{"buckets":[` +
+							`{"key_as_string":"2024-03-01T10:00:00.000Z","key":1709287200000,"doc_count":1},` +
+							`{"key_as_string":"2024-03-01T11:00:00.000Z","key":1709290800000,"doc_count":2}]}}]}`,
+					),
+				},
+			},
+			nil,
+		)
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
-	s.ErrorContains(err, "'GROUP BY' clause is only supported for ExecutionStatus")
-	s.Nil(resp)
+	s.NoError(err)
+	expectedResp = &store.InternalCountExecutionsResponse{
+		Count: 3,
+		Groups: []store.InternalAggregationGroup{
+			{
+				GroupValues: []*commonpb.Payload{
+					mustEncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					mustEncodeValue(time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+				Count: 1,
+			},
+			{
+				GroupValues: []*commonpb.Payload{
+					mustEncodeValue("wf-type-1", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+					mustEncodeValue(time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC), enumspb.INDEXED_VALUE_TYPE_DATETIME),
+				},
+				Count: 2,
+			},
+		},
+	}
+	s.True(temporalproto.DeepEqual(expectedResp, resp))
 }
 
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
@@ -1104,7 +1150,7 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by one field",
 			groupBy: []string{sadefs.ExecutionStatus},
 			aggName: sadefs.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus),
+			agg:     elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).Size(maxGroupByTermsSize),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
 					sadefs.ExecutionStatus: json.RawMessage(
@@ -1146,9 +1192,9 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 			name:    "group by two fields",
 			groupBy: []string{sadefs.ExecutionStatus, sadefs.WorkflowType},
 			aggName: sadefs.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).Size(maxGroupByTermsSize).SubAggregation(
 				sadefs.WorkflowType,
-				elastic.NewTermsAggregation().Field(sadefs.WorkflowType),
+				elastic.NewTermsAggregation().Field(sadefs.WorkflowType).Size(maxGroupByTermsSize),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
@@ -1235,11 +1281,11 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				sadefs.WorkflowID,
 			},
 			aggName: sadefs.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(sadefs.ExecutionStatus).Size(maxGroupByTermsSize).SubAggregation(
 				sadefs.WorkflowType,
-				elastic.NewTermsAggregation().Field(sadefs.WorkflowType).SubAggregation(
+				elastic.NewTermsAggregation().Field(sadefs.WorkflowType).Size(maxGroupByTermsSize).SubAggregation(
 					sadefs.WorkflowID,
-					elastic.NewTermsAggregation().Field(sadefs.WorkflowID),
+					elastic.NewTermsAggregation().Field(sadefs.WorkflowID).Size(maxGroupByTermsSize),
 				),
 			),
 			mockResponse: &elastic.SearchResult{
@@ -1398,6 +1444,31 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}
 }
 
+func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions_TooManyValues() {
+	searchParams := &esQueryParams{
+		Query:   elastic.NewBoolQuery(),
+		GroupBy: []string{sadefs.ExecutionStatus, sadefs.WorkflowType},
+	}
+	s.mockESClient.EXPECT().
+		CountGroupBy(gomock.Any(), testIndex, gomock.Any(), sadefs.ExecutionStatus, gomock.Any()).
+		Return(
+			&elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					sadefs.ExecutionStatus: json.RawMessage(
+						`{"sum_other_doc_count":0,"buckets":[{"key":"Running","doc_count":1005,` +
+							`"WorkflowType":{"sum_other_doc_count":5,"buckets":[{"key":"wf-type-1","doc_count":1000}]}}]}`,
+					),
+				},
+			},
+			nil,
+		)
+	resp, err := s.visibilityStore.countGroupByExecutions(context.Background(), searchParams, nil)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, sadefs.WorkflowType)
+	s.Nil(resp)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
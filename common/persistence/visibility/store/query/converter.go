@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	"go.temporal.io/server/common/sqlquery"
@@ -71,11 +72,26 @@ type (
 	QueryParams[ExprT any] struct {
 		QueryExpr ExprT
 		OrderBy   sqlparser.OrderBy
-		// List of search attributes to group by (field name).
-		GroupBy []*SAColumn
+		// List of search attributes to group by.
+		GroupBy []*GroupByColumn
+	}
+
+	// GroupByColumn is a search attribute in the 'GROUP BY' clause. If Interval is set, the
+	// search attribute is a Datetime and executions are grouped in buckets of fixed size.
+	GroupByColumn struct {
+		*SAColumn
+		Interval time.Duration
 	}
 )
 
+const (
+	// DateHistogramFuncName is the function used in 'GROUP BY' clause to group Datetime search
+	// attributes into buckets, eg: `GROUP BY date_histogram(StartTime, '1h')`.
+	DateHistogramFuncName = "date_histogram"
+
+	maxGroupByFields = 3
+)
+
 var (
 	groupByFieldAllowlist = []string{
 		sadefs.ExecutionStatus,
@@ -269,24 +285,28 @@ func (c *QueryConverter[ExprT]) convertSelectStmt(
 		res.QueryExpr = queryExpr
 	}
 
-	if len(sel.GroupBy) > 1 {
+	if len(sel.GroupBy) > maxGroupByFields {
 		return nil, NewConverterError(
-			"%s: 'GROUP BY' clause supports only a single field",
+			"%s: 'GROUP BY' clause supports up to %d fields",
 			NotSupportedErrMessage,
+			maxGroupByFields,
 		)
 	}
 	for k := range sel.GroupBy {
-		colName, err := c.convertColName(sel.GroupBy[k])
+		groupByCol, err := c.convertGroupByExpr(sel.GroupBy[k])
 		if err != nil {
 			return nil, err
 		}
-		if !IsGroupByFieldAllowed(colName.FieldName) {
-			return nil, NewConverterError(
-				"%s: 'GROUP BY' clause is only supported for ExecutionStatus",
-				NotSupportedErrMessage,
-			)
+		for _, col := range res.GroupBy {
+			if col.FieldName == groupByCol.FieldName {
+				return nil, NewConverterError(
+					"%s: duplicate field %s in 'GROUP BY' clause",
+					InvalidExpressionErrMessage,
+					groupByCol.Alias,
+				)
+			}
 		}
-		res.GroupBy = append(res.GroupBy, colName)
+		res.GroupBy = append(res.GroupBy, groupByCol)
 	}
 
 	for k := range sel.OrderBy {
@@ -493,6 +513,88 @@ func (c *QueryConverter[ExprT]) convertColName(in sqlparser.Expr) (*SAColumn, er
 	return colName, nil
 }
 
+func (c *QueryConverter[ExprT]) convertGroupByExpr(in sqlparser.Expr) (*GroupByColumn, error) {
+	if funcExpr, ok := in.(*sqlparser.FuncExpr); ok {
+		return c.convertDateHistogramExpr(funcExpr)
+	}
+	colName, err := c.convertColName(in)
+	if err != nil {
+		return nil, err
+	}
+	if colName.ValueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+		return nil, NewConverterError(
+			"%s: 'GROUP BY' clause is only supported for search attributes of type %s, got %s of type %s",
+			NotSupportedErrMessage,
+			enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			colName.Alias,
+			colName.ValueType,
+		)
+	}
+	return &GroupByColumn{SAColumn: colName}, nil
+}
+
+func (c *QueryConverter[ExprT]) convertDateHistogramExpr(funcExpr *sqlparser.FuncExpr) (*GroupByColumn, error) {
+	if !funcExpr.Name.EqualString(DateHistogramFuncName) {
+		return nil, NewConverterError(
+			"%s: function %s in 'GROUP BY' clause",
+			NotSupportedErrMessage,
+			funcExpr.Name.String(),
+		)
+	}
+	if funcExpr.Distinct || len(funcExpr.Exprs) != 2 {
+		return nil, NewConverterError(
+			"%s: %s expects a search attribute and an interval",
+			InvalidExpressionErrMessage,
+			DateHistogramFuncName,
+		)
+	}
+	colExpr, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, NewConverterError(
+			"%s: %s expects a search attribute and an interval",
+			InvalidExpressionErrMessage,
+			DateHistogramFuncName,
+		)
+	}
+	colName, err := c.convertColName(colExpr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if colName.ValueType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return nil, NewConverterError(
+			"%s: %s is only supported for search attributes of type %s, got %s of type %s",
+			NotSupportedErrMessage,
+			DateHistogramFuncName,
+			enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			colName.Alias,
+			colName.ValueType,
+		)
+	}
+
+	var intervalVal *sqlparser.SQLVal
+	if intervalExpr, ok := funcExpr.Exprs[1].(*sqlparser.AliasedExpr); ok {
+		intervalVal, _ = intervalExpr.Expr.(*sqlparser.SQLVal)
+	}
+	if intervalVal == nil || intervalVal.Type != sqlparser.StrVal {
+		return nil, NewConverterError(
+			"%s: %s interval must be a string, eg: '1h'",
+			InvalidExpressionErrMessage,
+			DateHistogramFuncName,
+		)
+	}
+	interval, err := timestamp.ParseDuration(string(intervalVal.Val))
+	// Buckets are computed from Unix timestamps in seconds in SQL databases.
+	if err != nil || interval < time.Second || interval%time.Second != 0 {
+		return nil, NewConverterError(
+			"%s: %s interval must be a whole number of seconds, got '%s'",
+			InvalidExpressionErrMessage,
+			DateHistogramFuncName,
+			intervalVal.Val,
+		)
+	}
+	return &GroupByColumn{SAColumn: colName, Interval: interval}, nil
+}
+
 func (c *QueryConverter[ExprT]) resolveSearchAttributeAlias(
 	alias string,
 ) (fieldName string, fieldType enumspb.IndexedValueType, retErr error) {
@@ -717,6 +819,8 @@ func (c *QueryConverter[ExprT]) validateValueType(
 	}
 }
 
+// IsGroupByFieldAllowed is used by the legacy converters which support grouping only by a small
+// set of fields. QueryConverter allows any Keyword search attribute.
 func IsGroupByFieldAllowed(fieldName string) bool {
 	for _, allowedField := range groupByFieldAllowlist {
 		if fieldName == allowedField {
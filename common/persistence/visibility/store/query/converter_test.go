@@ -349,12 +349,14 @@ func TestQueryConverter_ConvertWhereString(t *testing.T) {
 			name: "success empty group by",
 			in:   "group by ExecutionStatus",
 			out: &QueryParams[sqlparser.Expr]{
-				GroupBy: []*SAColumn{
-					NewSAColumn(
-						sadefs.ExecutionStatus,
-						sadefs.ExecutionStatus,
-						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					),
+				GroupBy: []*GroupByColumn{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.ExecutionStatus,
+							sadefs.ExecutionStatus,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
 				},
 			},
 		},
@@ -462,12 +464,14 @@ func TestQueryConverter_ConvertSelectStmt(t *testing.T) {
 			name: "success empty group by ExecutionStatus",
 			in:   "select * from t group by ExecutionStatus",
 			out: &QueryParams[sqlparser.Expr]{
-				GroupBy: []*SAColumn{
-					NewSAColumn(
-						sadefs.ExecutionStatus,
-						sadefs.ExecutionStatus,
-						enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-					),
+				GroupBy: []*GroupByColumn{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.ExecutionStatus,
+							sadefs.ExecutionStatus,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
 				},
 			},
 		},
@@ -485,23 +489,134 @@ func TestQueryConverter_ConvertSelectStmt(t *testing.T) {
 		},
 
 		{
-			name: "fail multiple group by",
-			in:   "select * from t group by ExecutionStatus, RunId",
+			name: "success group by multiple keyword fields",
+			in:   "select * from t group by WorkflowType, TaskQueue, AliasForKeyword01",
+			out: &QueryParams[sqlparser.Expr]{
+				GroupBy: []*GroupByColumn{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.WorkflowType,
+							sadefs.WorkflowType,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
+					{
+						SAColumn: NewSAColumn(
+							sadefs.TaskQueue,
+							sadefs.TaskQueue,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
+					{SAColumn: keywordCol},
+				},
+			},
+		},
+
+		{
+			name: "success group by date histogram",
+			in:   "select * from t group by ExecutionStatus, date_histogram(StartTime, '1d')",
+			out: &QueryParams[sqlparser.Expr]{
+				GroupBy: []*GroupByColumn{
+					{
+						SAColumn: NewSAColumn(
+							sadefs.ExecutionStatus,
+							sadefs.ExecutionStatus,
+							enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+						),
+					},
+					{
+						SAColumn: NewSAColumn(
+							sadefs.StartTime,
+							sadefs.StartTime,
+							enumspb.INDEXED_VALUE_TYPE_DATETIME,
+						),
+						Interval: 24 * time.Hour,
+					},
+				},
+			},
+		},
+
+		{
+			name: "fail too many group by fields",
+			in:   "select * from t group by ExecutionStatus, WorkflowType, TaskQueue, AliasForKeyword01",
 			err: fmt.Sprintf(
-				"%s: 'GROUP BY' clause supports only a single field",
+				"%s: 'GROUP BY' clause supports up to 3 fields",
 				NotSupportedErrMessage,
 			),
 		},
 
 		{
-			name: "fail not supported group by field",
-			in:   "select * from t group by RunId",
+			name: "fail duplicate group by field",
+			in:   "select * from t group by WorkflowType, WorkflowType",
+			err: fmt.Sprintf(
+				"%s: duplicate field WorkflowType in 'GROUP BY' clause",
+				InvalidExpressionErrMessage,
+			),
+		},
+
+		{
+			name: "fail not supported group by field type",
+			in:   "select * from t group by AliasForInt01",
 			err: fmt.Sprintf(
-				"%s: 'GROUP BY' clause is only supported for ExecutionStatus",
+				"%s: 'GROUP BY' clause is only supported for search attributes of type Keyword, got AliasForInt01 of type Int",
 				NotSupportedErrMessage,
 			),
 		},
 
+		{
+			name: "fail group by datetime without date histogram",
+			in:   "select * from t group by StartTime",
+			err: fmt.Sprintf(
+				"%s: 'GROUP BY' clause is only supported for search attributes of type Keyword",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail not supported group by function",
+			in:   "select * from t group by lower(WorkflowType)",
+			err: fmt.Sprintf(
+				"%s: function lower in 'GROUP BY' clause",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail date histogram not datetime field",
+			in:   "select * from t group by date_histogram(WorkflowType, '1h')",
+			err: fmt.Sprintf(
+				"%s: date_histogram is only supported for search attributes of type Datetime",
+				NotSupportedErrMessage,
+			),
+		},
+
+		{
+			name: "fail date histogram missing interval",
+			in:   "select * from t group by date_histogram(StartTime)",
+			err: fmt.Sprintf(
+				"%s: date_histogram expects a search attribute and an interval",
+				InvalidExpressionErrMessage,
+			),
+		},
+
+		{
+			name: "fail date histogram invalid interval",
+			in:   "select * from t group by date_histogram(StartTime, '1500ms')",
+			err: fmt.Sprintf(
+				"%s: date_histogram interval must be a whole number of seconds, got '1500ms'",
+				InvalidExpressionErrMessage,
+			),
+		},
+
+		{
+			name: "fail date histogram interval not string",
+			in:   "select * from t group by date_histogram(StartTime, 3600)",
+			err: fmt.Sprintf(
+				"%s: date_histogram interval must be a string",
+				InvalidExpressionErrMessage,
+			),
+		},
+
 		{
 			name: "fail invalid group by field",
 			in:   "select * from t group by InvalidField",
@@ -707,6 +707,12 @@ func (s *VisibilityStore) countGroupByExecutions(
 	for _, row := range rows {
 		groupValues := make([]*commonpb.Payload, len(row.GroupValues))
 		for i, val := range row.GroupValues {
+			if groupByTypes[i] == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+				val, err = parseGroupByDatetimeValue(val)
+				if err != nil {
+					return nil, err
+				}
+			}
 			groupValues[i], err = searchattribute.EncodeValue(val, groupByTypes[i])
 			if err != nil {
 				return nil, err
@@ -724,6 +730,30 @@ func (s *VisibilityStore) countGroupByExecutions(
 	return resp, nil
 }
 
+// parseGroupByDatetimeValue converts a date histogram bucket returned by the database to UTC time.
+// SQLite returns the bucket as text while MySQL and PostgreSQL drivers return time.Time.
+func parseGroupByDatetimeValue(value any) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return v.UTC(), nil
+	case []byte:
+		return parseGroupByDatetimeValue(string(v))
+	case string:
+		for _, layout := range []string{time.DateTime, time.RFC3339Nano} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t.UTC(), nil
+			}
+		}
+	}
+	return nil, serviceerror.NewInternalf(
+		"Unable to parse date histogram value from DB (got: %v of type: %T)",
+		value,
+		value,
+	)
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -3,6 +3,7 @@ package sql
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/temporalio/sqlparser"
@@ -89,3 +90,26 @@ func TestBuildQueryParams(t *testing.T) {
 		}
 	}
 }
+
+func TestParseGroupByDatetimeValue(t *testing.T) {
+	t.Parallel()
+
+	expected := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	for _, value := range []any{
+		expected.In(time.FixedZone("UTC+2", 2*60*60)),
+		"2024-03-01 10:00:00",
+		[]byte("2024-03-01 10:00:00"),
+		"2024-03-01T10:00:00Z",
+	} {
+		out, err := parseGroupByDatetimeValue(value)
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	}
+
+	out, err := parseGroupByDatetimeValue(nil)
+	require.NoError(t, err)
+	require.Nil(t, out)
+
+	_, err = parseGroupByDatetimeValue(int64(1))
+	require.Error(t, err)
+}
@@ -344,6 +344,7 @@ func ArchivalMetadataProvider(dc *dynamicconfig.Collection, cfg *config.Config)
 
 func ArchiverProviderProvider(
 	cfg *config.Config,
+	customArchiverFactories provider.ArchiverFactories,
 	persistenceExecutionManager persistence.ExecutionManager,
 	logger log.SnTaggedLogger,
 	metricsHandler metrics.Handler,
@@ -351,6 +352,7 @@ func ArchiverProviderProvider(
 	return provider.NewArchiverProvider(
 		cfg.Archival.History.Provider,
 		cfg.Archival.Visibility.Provider,
+		customArchiverFactories,
 		persistenceExecutionManager,
 		logger,
 		metricsHandler,
@@ -66,6 +66,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeVisibilityConsistencyCheckRequest:
+		return nil
+	case *adminservice.DescribeVisibilityConsistencyCheckResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -150,6 +154,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListTaskQueueBacklogRequest:
+		return nil
+	case *adminservice.ListTaskQueueBacklogResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -170,6 +178,13 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.PurgeDLQTasksResponse:
 		return nil
+	case *adminservice.PurgeTaskQueueBacklogRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.PurgeTaskQueueBacklogResponse:
+		return nil
 	case *adminservice.ReapplyEventsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -210,10 +225,29 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.ReshardHistoryRequest:
+		return nil
+	case *adminservice.ReshardHistoryResponse:
+		return nil
+	case *adminservice.RestoreArchivedWorkflowExecutionRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.RestoreArchivedWorkflowExecutionResponse:
+		return nil
+	case *adminservice.RunQueueActionRequest:
+		return nil
+	case *adminservice.RunQueueActionResponse:
+		return nil
 	case *adminservice.StartAdminBatchOperationRequest:
 		return nil
 	case *adminservice.StartAdminBatchOperationResponse:
 		return nil
+	case *adminservice.StartVisibilityConsistencyCheckRequest:
+		return nil
+	case *adminservice.StartVisibilityConsistencyCheckResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -221,6 +255,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateTaskQueueConcurrencyLimitRequest:
+		return nil
+	case *adminservice.UpdateTaskQueueConcurrencyLimitResponse:
+		return nil
 	default:
 		return nil
 	}
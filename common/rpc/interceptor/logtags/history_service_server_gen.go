@@ -359,6 +359,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return wt.fromTaskToken(r.GetFailedRequest().GetTaskToken())
 	case *historyservice.RespondWorkflowTaskFailedResponse:
 		return nil
+	case *historyservice.RunQueueActionRequest:
+		return nil
+	case *historyservice.RunQueueActionResponse:
+		return nil
 	case *historyservice.ScheduleWorkflowTaskRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -83,6 +83,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.GetBuildIdTaskQueueMappingResponse:
 		return nil
+	case *matchingservice.GetTaskQueueStatsRequest:
+		return nil
+	case *matchingservice.GetTaskQueueStatsResponse:
+		return nil
 	case *matchingservice.GetTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.GetTaskQueueUserDataResponse:
@@ -136,6 +140,13 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.RecordWorkerHeartbeatResponse:
 		return nil
+	case *matchingservice.ReleaseConcurrencySlotRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *matchingservice.ReleaseConcurrencySlotResponse:
+		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.ReplicateTaskQueueUserDataResponse:
@@ -168,6 +179,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateTaskQueueConfigResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueuePartitionCountsRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueuePartitionCountsResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
@@ -12,6 +12,7 @@ import (
 	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/tasktoken"
 	"google.golang.org/grpc"
 )
@@ -27,6 +28,7 @@ type (
 		tokenSerializer                 *tasktoken.Serializer
 		enableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn
 		maxNamespaceLength              dynamicconfig.IntPropertyFn
+		sharedTaskQueues                dynamicconfig.TypedPropertyFn[[]sharedtaskqueue.Binding]
 	}
 )
 
@@ -92,12 +94,14 @@ func NewNamespaceValidatorInterceptor(
 	namespaceRegistry namespace.Registry,
 	enableTokenNamespaceEnforcement dynamicconfig.BoolPropertyFn,
 	maxNamespaceLength dynamicconfig.IntPropertyFn,
+	sharedTaskQueues dynamicconfig.TypedPropertyFn[[]sharedtaskqueue.Binding],
 ) *NamespaceValidatorInterceptor {
 	return &NamespaceValidatorInterceptor{
 		namespaceRegistry:               namespaceRegistry,
 		tokenSerializer:                 tasktoken.NewSerializer(),
 		enableTokenNamespaceEnforcement: enableTokenNamespaceEnforcement,
 		maxNamespaceLength:              maxNamespaceLength,
+		sharedTaskQueues:                sharedTaskQueues,
 	}
 }
 
@@ -236,7 +240,7 @@ func (ni *NamespaceValidatorInterceptor) extractNamespace(req interface{}) (*nam
 		return nil, requestErr
 	}
 
-	err := ni.checkNamespaceMatch(requestNamespaceEntry, tokenNamespaceEntry)
+	err := ni.checkNamespaceMatch(req, requestNamespaceEntry, tokenNamespaceEntry)
 	if err != nil {
 		return nil, err
 	}
@@ -345,17 +349,36 @@ func (ni *NamespaceValidatorInterceptor) extractNamespaceFromTaskToken(req inter
 	return ni.namespaceRegistry.GetNamespaceByID(namespaceID)
 }
 
-func (ni *NamespaceValidatorInterceptor) checkNamespaceMatch(requestNamespace *namespace.Namespace, tokenNamespace *namespace.Namespace) error {
+func (ni *NamespaceValidatorInterceptor) checkNamespaceMatch(req interface{}, requestNamespace *namespace.Namespace, tokenNamespace *namespace.Namespace) error {
 	if tokenNamespace == nil || requestNamespace == nil || !ni.enableTokenNamespaceEnforcement() {
 		return nil
 	}
 
-	if requestNamespace.ID() != tokenNamespace.ID() {
+	if requestNamespace.ID() != tokenNamespace.ID() && !ni.isSharedTaskQueueToken(req, requestNamespace, tokenNamespace) {
 		return errTaskTokenNamespaceMismatch
 	}
 	return nil
 }
 
+// isSharedTaskQueueToken reports whether the request completes or heartbeats an activity task that a
+// shared task queue of the request namespace dispatched from the token namespace. Workers of a
+// shared task queue complete tasks of its source namespaces with requests of the shared namespace.
+func (ni *NamespaceValidatorInterceptor) isSharedTaskQueueToken(req interface{}, requestNamespace *namespace.Namespace, tokenNamespace *namespace.Namespace) bool {
+	if !sharedtaskqueue.CompletesActivityTask(req) {
+		return false
+	}
+	taskToken, err := ni.tokenSerializer.Deserialize(req.(TaskTokenGetter).GetTaskToken())
+	if err != nil || taskToken.GetActivityId() == "" || taskToken.GetSharedTaskQueue() == "" {
+		return false
+	}
+	return sharedtaskqueue.IsBound(
+		ni.sharedTaskQueues(),
+		tokenNamespace.Name().String(),
+		requestNamespace.Name().String(),
+		taskToken.GetSharedTaskQueue(),
+	)
+}
+
 func (ni *NamespaceValidatorInterceptor) checkNamespaceState(namespaceEntry *namespace.Namespace, fullMethod string) error {
 	if namespaceEntry == nil {
 		return nil
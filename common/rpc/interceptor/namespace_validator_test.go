@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/api"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/tasktoken"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
@@ -64,7 +65,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_NamespaceNotSet(
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -115,7 +117,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_NamespaceNotFoun
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -390,7 +393,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromNamesp
 			nvi := NewNamespaceValidatorInterceptor(
 				s.mockRegistry,
 				dynamicconfig.GetBoolPropertyFn(false),
-				dynamicconfig.GetIntPropertyFn(100))
+				dynamicconfig.GetIntPropertyFn(100),
+				dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 			serverInfo := &grpc.UnaryServerInfo{
 				FullMethod: testCase.method,
 			}
@@ -466,7 +470,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_StatusFromToken(
 		nvi := NewNamespaceValidatorInterceptor(
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
-			dynamicconfig.GetIntPropertyFn(100))
+			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: testCase.method,
 		}
@@ -491,7 +496,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_DescribeNamespac
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -521,7 +527,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_GetClusterInfo()
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -542,7 +549,8 @@ func (s *namespaceValidatorSuite) Test_Intercept_RegisterNamespace() {
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(100))
+		dynamicconfig.GetIntPropertyFn(100),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: "/temporal/random",
 	}
@@ -575,6 +583,7 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_TokenNamespaceEn
 		requestNamespaceID              namespace.ID
 		requestNamespaceName            namespace.Name
 		enableTokenNamespaceEnforcement bool
+		sharedTaskQueues                []sharedtaskqueue.Binding
 		expectedErr                     error
 	}{
 		{
@@ -609,6 +618,29 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_TokenNamespaceEn
 			enableTokenNamespaceEnforcement: false,
 			expectedErr:                     nil,
 		},
+		{
+			tokenNamespaceID:                "valid-id",
+			tokenNamespaceName:              "valid-name",
+			requestNamespaceID:              "shared-id",
+			requestNamespaceName:            "shared-name",
+			enableTokenNamespaceEnforcement: true,
+			sharedTaskQueues: []sharedtaskqueue.Binding{
+				{Namespace: "valid-name", TaskQueue: "tq", SharedNamespace: "shared-name", SharedTaskQueue: "shared-tq"},
+			},
+			// shared task queues only serve activity tasks, workflow and query tokens are rejected
+			expectedErr: &serviceerror.InvalidArgument{},
+		},
+		{
+			tokenNamespaceID:                "valid-id",
+			tokenNamespaceName:              "valid-name",
+			requestNamespaceID:              "shared-id",
+			requestNamespaceName:            "shared-name",
+			enableTokenNamespaceEnforcement: true,
+			sharedTaskQueues: []sharedtaskqueue.Binding{
+				{Namespace: "other-name", TaskQueue: "tq", SharedNamespace: "shared-name", SharedTaskQueue: "shared-tq"},
+			},
+			expectedErr: &serviceerror.InvalidArgument{},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -655,7 +687,8 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_TokenNamespaceEn
 		nvi := NewNamespaceValidatorInterceptor(
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(testCase.enableTokenNamespaceEnforcement),
-			dynamicconfig.GetIntPropertyFn(100))
+			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetTypedPropertyFn(testCase.sharedTaskQueues))
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "RandomMethod",
 		}
@@ -682,6 +715,93 @@ func (s *namespaceValidatorSuite) Test_StateValidationIntercept_TokenNamespaceEn
 	}
 }
 
+func (s *namespaceValidatorSuite) Test_StateValidationIntercept_SharedTaskQueueToken() {
+	bindings := []sharedtaskqueue.Binding{
+		{Namespace: "source-name", TaskQueue: "tq", SharedNamespace: "shared-name", SharedTaskQueue: "shared-tq"},
+	}
+	testCases := []struct {
+		name        string
+		token       *tokenspb.Task
+		expectedErr error
+	}{
+		{
+			name:  "activity token of the bound shared task queue",
+			token: &tokenspb.Task{NamespaceId: "source-id", ActivityId: "1", SharedTaskQueue: "shared-tq"},
+		},
+		{
+			name:        "token not dispatched by a shared task queue",
+			token:       &tokenspb.Task{NamespaceId: "source-id", ActivityId: "1"},
+			expectedErr: &serviceerror.InvalidArgument{},
+		},
+		{
+			name:        "token of another shared task queue",
+			token:       &tokenspb.Task{NamespaceId: "source-id", ActivityId: "1", SharedTaskQueue: "other-tq"},
+			expectedErr: &serviceerror.InvalidArgument{},
+		},
+		{
+			name:        "workflow task token",
+			token:       &tokenspb.Task{NamespaceId: "source-id", SharedTaskQueue: "shared-tq"},
+			expectedErr: &serviceerror.InvalidArgument{},
+		},
+	}
+
+	newNamespace := func(id namespace.ID, name namespace.Name) *namespace.Namespace {
+		detail := &persistencespb.NamespaceDetail{
+			Config:            &persistencespb.NamespaceConfig{},
+			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{},
+			Info: &persistencespb.NamespaceInfo{
+				Id:    id.String(),
+				Name:  name.String(),
+				State: enumspb.NAMESPACE_STATE_REGISTERED,
+			},
+		}
+		ns, err := namespace.FromPersistentState(detail, namespace.NewDefaultReplicationResolverFactory()(detail))
+		s.Require().NoError(err)
+		return ns
+	}
+	sourceNamespace := newNamespace("source-id", "source-name")
+	sharedNamespace := newNamespace("shared-id", "shared-name")
+
+	for _, testCase := range testCases {
+		s.Run(testCase.name, func() {
+			taskToken, err := tasktoken.NewSerializer().Serialize(testCase.token)
+			s.NoError(err)
+			s.mockRegistry.EXPECT().GetNamespace(sharedNamespace.Name()).Return(sharedNamespace, nil).AnyTimes()
+			s.mockRegistry.EXPECT().GetNamespaceByID(sourceNamespace.ID()).Return(sourceNamespace, nil).AnyTimes()
+
+			nvi := NewNamespaceValidatorInterceptor(
+				s.mockRegistry,
+				dynamicconfig.GetBoolPropertyFn(true),
+				dynamicconfig.GetIntPropertyFn(100),
+				dynamicconfig.GetTypedPropertyFn(bindings))
+			serverInfo := &grpc.UnaryServerInfo{
+				FullMethod: api.WorkflowServicePrefix + "RespondActivityTaskCompleted",
+			}
+
+			handlerCalled := false
+			_, err = nvi.StateValidationIntercept(
+				context.Background(),
+				&workflowservice.RespondActivityTaskCompletedRequest{
+					Namespace: sharedNamespace.Name().String(),
+					TaskToken: taskToken,
+				},
+				serverInfo,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					handlerCalled = true
+					return &workflowservice.RespondActivityTaskCompletedResponse{}, nil
+				},
+			)
+			if testCase.expectedErr != nil {
+				s.IsType(testCase.expectedErr, err)
+				s.False(handlerCalled)
+			} else {
+				s.NoError(err)
+				s.True(handlerCalled)
+			}
+		})
+	}
+}
+
 func (s *namespaceValidatorSuite) Test_Intercept_DescribeHistoryHostRequests() {
 	// it's just a list of requests
 	testCases := []struct {
@@ -700,6 +820,7 @@ func (s *namespaceValidatorSuite) Test_Intercept_DescribeHistoryHostRequests() {
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
 			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)),
 		)
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "random",
@@ -785,6 +906,7 @@ func (s *namespaceValidatorSuite) Test_Intercept_SearchAttributeRequests() {
 			s.mockRegistry,
 			dynamicconfig.GetBoolPropertyFn(false),
 			dynamicconfig.GetIntPropertyFn(100),
+			dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)),
 		)
 		serverInfo := &grpc.UnaryServerInfo{
 			FullMethod: api.WorkflowServicePrefix + "random",
@@ -809,7 +931,8 @@ func (s *namespaceValidatorSuite) Test_NamespaceValidateIntercept() {
 	nvi := NewNamespaceValidatorInterceptor(
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
-		dynamicconfig.GetIntPropertyFn(10))
+		dynamicconfig.GetIntPropertyFn(10),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)))
 	serverInfo := &grpc.UnaryServerInfo{
 		FullMethod: api.WorkflowServicePrefix + "random",
 	}
@@ -878,6 +1001,7 @@ func (s *namespaceValidatorSuite) TestSetNamespace() {
 		s.mockRegistry,
 		dynamicconfig.GetBoolPropertyFn(false),
 		dynamicconfig.GetIntPropertyFn(10),
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)),
 	)
 
 	queryReq := &workflowservice.RespondQueryTaskCompletedRequest{}
@@ -40,6 +40,11 @@ func FromStatus(st *status.Status) error {
 		case *errordetailsspb.StickyWorkerUnavailableFailure:
 			return newStickyWorkerUnavailable(st)
 		}
+	case codes.ResourceExhausted:
+		switch errDetails.(type) {
+		case *errordetailsspb.TaskQueueBacklogLimitExceededFailure:
+			return newTaskQueueBacklogLimitExceeded(st)
+		}
 	case codes.FailedPrecondition:
 		switch errDetails.(type) {
 		case *errordetailsspb.ObsoleteDispatchBuildIdFailure:
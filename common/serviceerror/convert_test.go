@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
 )
 
 func TestFromToStatus(t *testing.T) {
@@ -24,3 +25,16 @@ func TestFromToStatus(t *testing.T) {
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
 }
+
+func TestFromToStatus_TaskQueueBacklogLimitExceeded(t *testing.T) {
+	err := NewTaskQueueBacklogLimitExceeded("backlog limit exceeded")
+
+	st := serviceerror.ToStatus(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	err1 := FromStatus(st)
+	var limitErr *TaskQueueBacklogLimitExceeded
+	if !errors.As(err1, &limitErr) {
+		assert.Fail(t, "Returned error is not of type *TaskQueueBacklogLimitExceeded")
+	}
+	assert.Equal(t, "backlog limit exceeded", limitErr.Message)
+}
@@ -0,0 +1,47 @@
+package serviceerror
+
+import (
+	errordetailsspb "go.temporal.io/server/api/errordetails/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// TaskQueueBacklogLimitExceeded represents an error returned by Matching when a task queue
+	// backlog is over its configured limit and new tasks are rejected.
+	TaskQueueBacklogLimitExceeded struct {
+		Message string
+		st      *status.Status
+	}
+)
+
+// NewTaskQueueBacklogLimitExceeded returns new TaskQueueBacklogLimitExceeded error.
+func NewTaskQueueBacklogLimitExceeded(message string) error {
+	return &TaskQueueBacklogLimitExceeded{
+		Message: message,
+	}
+}
+
+// Error returns string message.
+func (e *TaskQueueBacklogLimitExceeded) Error() string {
+	return e.Message
+}
+
+func (e *TaskQueueBacklogLimitExceeded) Status() *status.Status {
+	if e.st != nil {
+		return e.st
+	}
+
+	st := status.New(codes.ResourceExhausted, e.Message)
+	st, _ = st.WithDetails(
+		&errordetailsspb.TaskQueueBacklogLimitExceededFailure{},
+	)
+	return st
+}
+
+func newTaskQueueBacklogLimitExceeded(st *status.Status) error {
+	return &TaskQueueBacklogLimitExceeded{
+		Message: st.Message(),
+		st:      st,
+	}
+}
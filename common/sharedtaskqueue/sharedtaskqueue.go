@@ -0,0 +1,164 @@
+// Package sharedtaskqueue implements activity task queues shared by several namespaces.
+//
+// An admin binds activity task queues of several source namespaces to a single shared task queue
+// in a shared namespace. Matching redirects activity tasks added to a bound task queue to the
+// shared task queue, where a single pool of workers polls them. Tasks keep the namespace their
+// workflow runs in, and dispatch is fair across source namespaces. Pollers of a shared task queue
+// must be authorized in all of its source namespaces.
+//
+// Task tokens of tasks dispatched by a shared task queue name the shared task queue. Workers
+// complete and heartbeat such tasks with requests of the shared namespace; the frontend accepts
+// them only for activity tasks whose namespace is bound to that shared task queue, and only from
+// callers authorized in all of its source namespaces. Other task tokens must be used with their own
+// namespace.
+//
+// Bindings are set with the dynamic config setting system.sharedTaskQueues, e.g.
+//
+//	system.sharedTaskQueues:
+//	  - value:
+//	      - Namespace: tenant-a
+//	        TaskQueue: payments
+//	        SharedNamespace: platform
+//	        SharedTaskQueue: shared-payments
+//
+// A setting that fails Validate is rejected as a whole, which unbinds all task queues.
+package sharedtaskqueue
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// Binding binds the activity task queue of a source namespace to a shared task queue.
+	Binding struct {
+		Namespace       string
+		TaskQueue       string
+		SharedNamespace string
+		SharedTaskQueue string
+	}
+)
+
+var Bindings = dynamicconfig.NewGlobalTypedSettingWithConverter(
+	"system.sharedTaskQueues",
+	convertBindings,
+	([]Binding)(nil),
+	`Bindings of activity task queues of source namespaces to shared task queues. Each entry has the
+fields Namespace and TaskQueue of the source task queue, and SharedNamespace and SharedTaskQueue of the
+shared task queue that workers poll. Activity tasks added to a source task queue are redirected to its
+shared task queue with the source namespace as fairness key, so fairness should be enabled on shared
+task queues. A task queue can be bound to at most one shared task queue, and a shared task queue can't be
+bound itself. A value that violates these rules is rejected as a whole.`,
+)
+
+var convertBindingsStructure = dynamicconfig.ConvertStructure(([]Binding)(nil))
+
+func convertBindings(v any) ([]Binding, error) {
+	bindings, err := convertBindingsStructure(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(bindings); err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
+
+// Validate checks that every binding is complete, that no task queue is bound more than once, and
+// that no shared task queue is bound to another one.
+func Validate(bindings []Binding) error {
+	type taskQueueKey struct {
+		namespace string
+		taskQueue string
+	}
+	bound := make(map[taskQueueKey]struct{}, len(bindings))
+	shared := make(map[taskQueueKey]struct{}, len(bindings))
+	for _, b := range bindings {
+		if !b.valid() {
+			return fmt.Errorf("invalid shared task queue binding %+v: all fields must be set and the task queue can't be bound to itself", b)
+		}
+		key := taskQueueKey{b.Namespace, b.TaskQueue}
+		if _, ok := bound[key]; ok {
+			return fmt.Errorf("task queue %q of namespace %q is bound to more than one shared task queue", b.TaskQueue, b.Namespace)
+		}
+		bound[key] = struct{}{}
+		shared[taskQueueKey{b.SharedNamespace, b.SharedTaskQueue}] = struct{}{}
+	}
+	for key := range bound {
+		if _, ok := shared[key]; ok {
+			return fmt.Errorf("shared task queue %q of namespace %q can't be bound to another shared task queue", key.taskQueue, key.namespace)
+		}
+	}
+	return nil
+}
+
+// CompletesActivityTask reports whether a frontend request completes or heartbeats an activity task
+// by task token. These are the only requests workers of a shared task queue send with task tokens
+// of its source namespaces.
+func CompletesActivityTask(req any) bool {
+	switch req.(type) {
+	case *workflowservice.RespondActivityTaskCompletedRequest,
+		*workflowservice.RespondActivityTaskFailedRequest,
+		*workflowservice.RespondActivityTaskCanceledRequest,
+		*workflowservice.RecordActivityTaskHeartbeatRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+// Find returns the binding of the activity task queue of a namespace.
+func Find(bindings []Binding, namespace, taskQueue string) (Binding, bool) {
+	for _, b := range bindings {
+		if b.Namespace == namespace && b.TaskQueue == taskQueue && b.valid() {
+			return b, true
+		}
+	}
+	return Binding{}, false
+}
+
+// FindShared returns the binding through which a namespace dispatches tasks to a shared task
+// queue with the given name.
+func FindShared(bindings []Binding, namespace, sharedTaskQueue string) (Binding, bool) {
+	for _, b := range bindings {
+		if b.Namespace == namespace && b.SharedTaskQueue == sharedTaskQueue && b.valid() {
+			return b, true
+		}
+	}
+	return Binding{}, false
+}
+
+// SourceNamespaces returns the distinct namespaces with task queues bound to a shared task queue,
+// excluding the shared namespace itself.
+func SourceNamespaces(bindings []Binding, sharedNamespace, sharedTaskQueue string) []string {
+	var namespaces []string
+	seen := make(map[string]struct{})
+	for _, b := range bindings {
+		if b.SharedNamespace != sharedNamespace || b.SharedTaskQueue != sharedTaskQueue || !b.valid() {
+			continue
+		}
+		if _, ok := seen[b.Namespace]; ok || b.Namespace == sharedNamespace {
+			continue
+		}
+		seen[b.Namespace] = struct{}{}
+		namespaces = append(namespaces, b.Namespace)
+	}
+	return namespaces
+}
+
+// IsBound reports whether a namespace has a task queue bound to the given shared task queue.
+func IsBound(bindings []Binding, namespace, sharedNamespace, sharedTaskQueue string) bool {
+	for _, b := range bindings {
+		if b.Namespace == namespace && b.SharedNamespace == sharedNamespace && b.SharedTaskQueue == sharedTaskQueue && b.valid() {
+			return true
+		}
+	}
+	return false
+}
+
+func (b Binding) valid() bool {
+	return b.Namespace != "" && b.TaskQueue != "" && b.SharedNamespace != "" && b.SharedTaskQueue != "" &&
+		(b.Namespace != b.SharedNamespace || b.TaskQueue != b.SharedTaskQueue)
+}
@@ -0,0 +1,96 @@
+package sharedtaskqueue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+var testBindings = []Binding{
+	{Namespace: "tenant-a", TaskQueue: "payments", SharedNamespace: "platform", SharedTaskQueue: "shared"},
+	{Namespace: "tenant-b", TaskQueue: "billing", SharedNamespace: "platform", SharedTaskQueue: "shared"},
+	{Namespace: "tenant-b", TaskQueue: "payments", SharedNamespace: "platform", SharedTaskQueue: "shared"},
+	{Namespace: "tenant-c", TaskQueue: "reports", SharedNamespace: "platform", SharedTaskQueue: "other"},
+	// Invalid bindings are ignored.
+	{Namespace: "tenant-d", TaskQueue: "reports", SharedNamespace: "platform"},
+	{Namespace: "platform", TaskQueue: "shared", SharedNamespace: "platform", SharedTaskQueue: "shared"},
+}
+
+func TestFind(t *testing.T) {
+	b, ok := Find(testBindings, "tenant-b", "billing")
+	require.True(t, ok)
+	require.Equal(t, testBindings[1], b)
+
+	_, ok = Find(testBindings, "tenant-a", "billing")
+	require.False(t, ok)
+	_, ok = Find(testBindings, "tenant-d", "reports")
+	require.False(t, ok)
+	_, ok = Find(testBindings, "platform", "shared")
+	require.False(t, ok)
+	_, ok = Find(nil, "tenant-a", "payments")
+	require.False(t, ok)
+}
+
+func TestFindShared(t *testing.T) {
+	b, ok := FindShared(testBindings, "tenant-c", "other")
+	require.True(t, ok)
+	require.Equal(t, "platform", b.SharedNamespace)
+
+	_, ok = FindShared(testBindings, "tenant-c", "shared")
+	require.False(t, ok)
+}
+
+func TestSourceNamespaces(t *testing.T) {
+	require.Equal(t, []string{"tenant-a", "tenant-b"}, SourceNamespaces(testBindings, "platform", "shared"))
+	require.Equal(t, []string{"tenant-c"}, SourceNamespaces(testBindings, "platform", "other"))
+	require.Empty(t, SourceNamespaces(testBindings, "tenant-a", "shared"))
+}
+
+func TestIsBound(t *testing.T) {
+	require.True(t, IsBound(testBindings, "tenant-a", "platform", "shared"))
+	require.False(t, IsBound(testBindings, "tenant-a", "platform", "other"))
+	require.False(t, IsBound(testBindings, "tenant-d", "platform", "shared"))
+	require.False(t, IsBound(testBindings, "platform", "tenant-a", "shared"))
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(testBindings[:4]))
+	require.NoError(t, Validate(nil))
+
+	// incomplete
+	require.Error(t, Validate(testBindings[4:5]))
+	// bound to itself
+	require.Error(t, Validate(testBindings[5:6]))
+	// task queue bound twice
+	require.Error(t, Validate([]Binding{
+		testBindings[0],
+		{Namespace: "tenant-a", TaskQueue: "payments", SharedNamespace: "platform", SharedTaskQueue: "other"},
+	}))
+	// shared task queue bound to another shared task queue
+	require.Error(t, Validate([]Binding{
+		testBindings[0],
+		{Namespace: "platform", TaskQueue: "shared", SharedNamespace: "platform-2", SharedTaskQueue: "shared"},
+	}))
+}
+
+func TestConvertBindings(t *testing.T) {
+	bindings, err := convertBindings([]any{
+		map[string]any{"Namespace": "tenant-a", "TaskQueue": "payments", "SharedNamespace": "platform", "SharedTaskQueue": "shared"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, testBindings[:1], bindings)
+
+	_, err = convertBindings([]any{
+		map[string]any{"Namespace": "tenant-a", "TaskQueue": "payments", "SharedNamespace": "platform"},
+	})
+	require.Error(t, err)
+}
+
+func TestCompletesActivityTask(t *testing.T) {
+	require.True(t, CompletesActivityTask(&workflowservice.RespondActivityTaskCompletedRequest{}))
+	require.True(t, CompletesActivityTask(&workflowservice.RecordActivityTaskHeartbeatRequest{}))
+	require.False(t, CompletesActivityTask(&workflowservice.RespondActivityTaskCompletedByIdRequest{}))
+	require.False(t, CompletesActivityTask(&workflowservice.RespondWorkflowTaskCompletedRequest{}))
+	require.False(t, CompletesActivityTask(&workflowservice.RespondQueryTaskCompletedRequest{}))
+}
@@ -0,0 +1,39 @@
+// Package taskaffinity implements worker affinity for activity tasks.
+//
+// An activity declares an affinity key in its header. Matching remembers the worker that last
+// handled a task with a given key and prefers to dispatch later tasks with the same key to that
+// worker, falling back to any worker if it doesn't poll in time.
+package taskaffinity
+
+import (
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	// AffinityKeyHeaderKey is the key in an activity header that holds its affinity key. The
+	// value is a payload holding a string.
+	AffinityKeyHeaderKey = "temporal-affinity-key"
+
+	maxAffinityKeyLength = 256
+)
+
+// FromHeader returns the affinity key from an activity header, or an empty string if there is
+// none. Malformed and too long values are ignored.
+func FromHeader(header *commonpb.Header) string {
+	p, ok := header.GetFields()[AffinityKeyHeaderKey]
+	if !ok {
+		return ""
+	}
+	var key string
+	if err := payload.Decode(p, &key); err != nil {
+		return ""
+	}
+	key = strings.TrimSpace(key)
+	if len(key) > maxAffinityKeyLength {
+		return ""
+	}
+	return key
+}
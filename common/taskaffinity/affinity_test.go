@@ -0,0 +1,21 @@
+package taskaffinity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+func TestFromHeader(t *testing.T) {
+	header := func(p *commonpb.Payload) *commonpb.Header {
+		return &commonpb.Header{Fields: map[string]*commonpb.Payload{AffinityKeyHeaderKey: p}}
+	}
+	require.Equal(t, "session-1", FromHeader(header(payload.EncodeString(" session-1 "))))
+	require.Empty(t, FromHeader(header(payload.EncodeBytes([]byte{1}))))
+	require.Empty(t, FromHeader(header(payload.EncodeString(strings.Repeat("a", maxAffinityKeyLength+1)))))
+	require.Empty(t, FromHeader(&commonpb.Header{}))
+	require.Empty(t, FromHeader(nil))
+}
@@ -0,0 +1,105 @@
+// Package tasklabels implements label-based routing between workers and tasks.
+//
+// Workers advertise the labels they have (e.g. "gpu", "region=us-east") on each poll, and
+// workflows and activities declare the labels a worker must have to receive their tasks. A task
+// only matches a poll whose labels include all of the task's required labels. Only the new
+// matcher checks labels, matching rejects tasks that require labels on task queues that still use
+// the classic matcher.
+package tasklabels
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// WorkerLabelsHeaderName is the gRPC header a worker sets on poll requests to advertise its
+	// labels, as a comma-separated list.
+	WorkerLabelsHeaderName = "temporal-worker-labels"
+	// RequiredLabelsHeaderKey is the key in a workflow or activity header that holds the labels
+	// a worker must have to receive its tasks. The value is a payload holding either a list of
+	// strings or a comma-separated string.
+	RequiredLabelsHeaderKey = "temporal-required-labels"
+
+	labelSetSeparator = ","
+	maxLabels         = 32
+	maxLabelLength    = 128
+)
+
+// Normalize trims, sorts, and de-duplicates labels, and drops empty ones. Returns nil if no
+// labels remain, and an InvalidArgument error if there are too many labels or a label is invalid.
+func Normalize(labels []string) ([]string, error) {
+	var result []string
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		if len(l) > maxLabelLength {
+			return nil, serviceerror.NewInvalidArgumentf("label %q exceeds the maximum length of %d", l, maxLabelLength)
+		}
+		if strings.Contains(l, labelSetSeparator) {
+			return nil, serviceerror.NewInvalidArgumentf("label %q must not contain %q", l, labelSetSeparator)
+		}
+		result = append(result, l)
+	}
+	slices.Sort(result)
+	result = slices.Compact(result)
+	if len(result) > maxLabels {
+		return nil, serviceerror.NewInvalidArgumentf("%d labels exceed the maximum of %d", len(result), maxLabels)
+	}
+	return result, nil
+}
+
+// FromContext returns the normalized worker labels from the incoming gRPC metadata.
+func FromContext(ctx context.Context) ([]string, error) {
+	var labels []string
+	for _, v := range metadata.ValueFromIncomingContext(ctx, WorkerLabelsHeaderName) {
+		labels = append(labels, strings.Split(v, labelSetSeparator)...)
+	}
+	return Normalize(labels)
+}
+
+// FromHeader returns the normalized required labels from a workflow or activity header.
+// Malformed values are ignored, but invalid labels are an error.
+func FromHeader(header *commonpb.Header) ([]string, error) {
+	p, ok := header.GetFields()[RequiredLabelsHeaderKey]
+	if !ok {
+		return nil, nil
+	}
+	var labels []string
+	if err := payload.Decode(p, &labels); err == nil {
+		return Normalize(labels)
+	}
+	var s string
+	if err := payload.Decode(p, &s); err == nil {
+		return Normalize(strings.Split(s, labelSetSeparator))
+	}
+	return nil, nil
+}
+
+// Key returns a string that identifies a normalized set of labels.
+func Key(labels []string) string {
+	return strings.Join(labels, labelSetSeparator)
+}
+
+// Satisfies returns true if the normalized set of labels contains all normalized required
+// labels.
+func Satisfies(labels []string, required []string) bool {
+	i := 0
+	for _, r := range required {
+		for i < len(labels) && labels[i] < r {
+			i++
+		}
+		if i == len(labels) || labels[i] != r {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package tasklabels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/payload"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNormalize(t *testing.T) {
+	labels, err := Normalize(nil)
+	require.NoError(t, err)
+	require.Nil(t, labels)
+	labels, err = Normalize([]string{" ", ""})
+	require.NoError(t, err)
+	require.Nil(t, labels)
+	labels, err = Normalize([]string{"b", " a", "b "})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, labels)
+
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err = Normalize([]string{"a,b"})
+	require.ErrorAs(t, err, &invalidArgument)
+	_, err = Normalize([]string{strings.Repeat("a", maxLabelLength+1)})
+	require.ErrorAs(t, err, &invalidArgument)
+	tooMany := make([]string, maxLabels+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("label-%d", i)
+	}
+	_, err = Normalize(tooMany)
+	require.ErrorAs(t, err, &invalidArgument)
+	labels, err = Normalize(append(tooMany[:maxLabels], tooMany[0]))
+	require.NoError(t, err)
+	require.Len(t, labels, maxLabels)
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(WorkerLabelsHeaderName, "gpu, region=us"))
+	labels, err := FromContext(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"gpu", "region=us"}, labels)
+	labels, err = FromContext(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, labels)
+}
+
+func TestFromHeader(t *testing.T) {
+	list, err := payload.Encode([]string{"b", "a"})
+	require.NoError(t, err)
+	labels, err := FromHeader(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{RequiredLabelsHeaderKey: list},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, labels)
+	labels, err = FromHeader(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{RequiredLabelsHeaderKey: payload.EncodeString("b,a")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, labels)
+	labels, err = FromHeader(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{RequiredLabelsHeaderKey: payload.EncodeBytes([]byte{1})},
+	})
+	require.NoError(t, err)
+	require.Nil(t, labels)
+	labels, err = FromHeader(nil)
+	require.NoError(t, err)
+	require.Nil(t, labels)
+
+	invalid, err := payload.Encode([]string{"a,b"})
+	require.NoError(t, err)
+	_, err = FromHeader(&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{RequiredLabelsHeaderKey: invalid},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	require.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestSatisfies(t *testing.T) {
+	require.True(t, Satisfies(nil, nil))
+	require.True(t, Satisfies([]string{"a"}, nil))
+	require.True(t, Satisfies([]string{"a", "b", "c"}, []string{"a", "c"}))
+	require.False(t, Satisfies([]string{"a", "c"}, []string{"b"}))
+	require.False(t, Satisfies(nil, []string{"a"}))
+	require.False(t, Satisfies([]string{"a"}, []string{"a", "b"}))
+}
@@ -354,6 +354,13 @@ func IsStickyWorkerUnavailable(err error) bool {
 	return false
 }
 
+// IsTaskQueueBacklogLimitExceeded checks if the error is returned by Matching because a task queue
+// backlog is over its limit.
+func IsTaskQueueBacklogLimitExceeded(err error) bool {
+	var limitErr *serviceerrors.TaskQueueBacklogLimitExceeded
+	return errors.As(err, &limitErr)
+}
+
 // IsResourceExhausted checks if the error is a service busy error.
 func IsResourceExhausted(err error) bool {
 	switch err.(type) {
@@ -0,0 +1,69 @@
+-- Columns are named after the search attribute field names. Custom search attributes are added
+-- as columns by the AddSearchAttributes API (ALTER TABLE ... ADD COLUMN).
+CREATE TABLE IF NOT EXISTS executions_visibility (
+  _version                              Int64,
+  NamespaceId                           String,
+  RunId                                 String,
+  WorkflowId                            String,
+  WorkflowType                          String,
+  StartTime                             DateTime64(9, 'UTC'),
+  ExecutionTime                         DateTime64(9, 'UTC'),
+  CloseTime                             Nullable(DateTime64(9, 'UTC')),
+  ExecutionStatus                       LowCardinality(String),
+  TaskQueue                             String,
+  HistoryLength                         Nullable(Int64),
+  HistorySizeBytes                      Nullable(Int64),
+  ExecutionDuration                     Nullable(Int64),
+  StateTransitionCount                  Nullable(Int64),
+  Memo                                  Nullable(String),
+  MemoEncoding                          Nullable(String),
+  ParentWorkflowId                      Nullable(String),
+  ParentRunId                           Nullable(String),
+  RootWorkflowId                        String,
+  RootRunId                             String,
+
+  -- Predefined search attributes
+  TemporalNamespaceDivision             Nullable(String),
+  TemporalChangeVersion                 Array(String),
+  BinaryChecksums                       Array(String),
+  BuildIds                              Array(String),
+  BatcherNamespace                      Nullable(String),
+  BatcherUser                           Nullable(String),
+  TemporalScheduledStartTime            Nullable(DateTime64(9, 'UTC')),
+  TemporalScheduledById                 Nullable(String),
+  TemporalSchedulePaused                Nullable(Bool),
+  TemporalPauseInfo                     Array(String),
+  TemporalReportedProblems              Array(String),
+  TemporalWorkerDeploymentVersion       Nullable(String),
+  TemporalWorkflowVersioningBehavior    Nullable(String),
+  TemporalWorkerDeployment              Nullable(String),
+  TemporalUsedWorkerDeploymentVersions  Array(String),
+  TemporalExternalPayloadCount          Nullable(Int64),
+  TemporalExternalPayloadSizeBytes      Nullable(Int64),
+
+  -- Pre-allocated CHASM search attributes
+  TemporalBool01                        Nullable(Bool),
+  TemporalBool02                        Nullable(Bool),
+  TemporalDatetime01                    Nullable(DateTime64(9, 'UTC')),
+  TemporalDatetime02                    Nullable(DateTime64(9, 'UTC')),
+  TemporalDouble01                      Nullable(Float64),
+  TemporalDouble02                      Nullable(Float64),
+  TemporalInt01                         Nullable(Int64),
+  TemporalInt02                         Nullable(Int64),
+  TemporalKeyword01                     Nullable(String),
+  TemporalKeyword02                     Nullable(String),
+  TemporalKeyword03                     Nullable(String),
+  TemporalKeyword04                     Nullable(String),
+  TemporalLowCardinalityKeyword01       LowCardinality(Nullable(String)),
+  TemporalKeywordList01                 Array(String),
+  TemporalKeywordList02                 Array(String),
+
+  INDEX idx_workflow_id WorkflowId TYPE bloom_filter GRANULARITY 4,
+  INDEX idx_workflow_type WorkflowType TYPE set(1000) GRANULARITY 4,
+  INDEX idx_start_time StartTime TYPE minmax GRANULARITY 1,
+  INDEX idx_close_time CloseTime TYPE minmax GRANULARITY 1
+)
+-- Every write inserts a full row; only the row with the highest _version of an execution is kept.
+-- Reads use FINAL to deduplicate rows that are not merged yet.
+ENGINE = ReplacingMergeTree(_version)
+ORDER BY (NamespaceId, RunId);
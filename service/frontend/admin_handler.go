@@ -8,6 +8,7 @@ import (
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/google/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
@@ -27,16 +29,20 @@ import (
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/chasm"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -52,9 +58,12 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/reshard"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/sdk"
@@ -66,6 +75,7 @@ import (
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/visibilityconsistency"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -75,6 +85,11 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	defaultReshardHistoryPageSize           = 100
+
+	restoreArchivedHistoryPageSize   = 1000
+	restoreArchivedHistoryBatchCount = 256
+	restoreArchivedHistoryBlobSize   = 4 * 1024 * 1024 // 4MB
 )
 
 type (
@@ -86,11 +101,14 @@ type (
 
 		logger                     log.Logger
 		numberOfHistoryShards      int32
+		persistenceStoreType       string
 		config                     *Config
 		namespaceDLQHandler        nsreplication.DLQMessageHandler
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		persistenceExecutionName   string
+		executionManager           persistence.ExecutionManager
+		shardManager               persistence.ShardManager
 		namespaceReplicationQueue  persistence.NamespaceReplicationQueue
 		taskManager                persistence.TaskManager
 		fairTaskManager            persistence.FairTaskManager
@@ -112,6 +130,8 @@ type (
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
 		chasmRegistry              *chasm.Registry
+		archivalMetadata           archiver.ArchivalMetadata
+		archiverProvider           provider.ArchiverProvider
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -129,6 +149,7 @@ type (
 		TaskManager                         persistence.TaskManager
 		FairTaskManager                     persistence.FairTaskManager
 		PersistenceExecutionManager         persistence.ExecutionManager
+		ShardManager                        persistence.ShardManager
 		ClusterMetadataManager              persistence.ClusterMetadataManager
 		PersistenceMetadataManager          persistence.MetadataManager
 		ClientFactory                       serverClient.Factory
@@ -147,6 +168,8 @@ type (
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
 		ChasmRegistry                       *chasm.Registry
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		ArchiverProvider                    provider.ArchiverProvider
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -188,6 +211,7 @@ func NewAdminHandler(
 		logger:                args.Logger,
 		status:                common.DaemonStatusInitialized,
 		numberOfHistoryShards: args.PersistenceConfig.NumHistoryShards,
+		persistenceStoreType:  args.PersistenceConfig.DefaultStoreType(),
 		config:                args.Config,
 		namespaceDLQHandler: nsreplication.NewDLQMessageHandler(
 			namespaceReplicationTaskExecutor,
@@ -197,6 +221,8 @@ func NewAdminHandler(
 		eventSerializer:            args.EventSerializer,
 		visibilityMgr:              args.visibilityMgr,
 		persistenceExecutionName:   args.PersistenceExecutionManager.GetName(),
+		executionManager:           args.PersistenceExecutionManager,
+		shardManager:               args.ShardManager,
 		namespaceReplicationQueue:  args.NamespaceReplicationQueue,
 		taskManager:                args.TaskManager,
 		fairTaskManager:            args.FairTaskManager,
@@ -232,6 +258,8 @@ func NewAdminHandler(
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
 		chasmRegistry:        args.ChasmRegistry,
+		archivalMetadata:     args.ArchivalMetadata,
+		archiverProvider:     args.ArchiverProvider,
 	}
 }
 
@@ -313,7 +341,9 @@ func (adh *AdminHandler) AddSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) ||
+		adh.visibilityMgr.HasStoreName(clickhouse.PersistenceName) ||
+		indexName == "" {
 		err = adh.addSearchAttributesElasticsearch(ctx, request, indexName)
 	} else {
 		err = adh.addSearchAttributesSQL(ctx, request, currentSearchAttributes)
@@ -330,6 +360,16 @@ func (adh *AdminHandler) addSearchAttributesElasticsearch(
 	request *adminservice.AddSearchAttributesRequest,
 	indexName string,
 ) error {
+	// The workflow only updates Elasticsearch mappings, ClickHouse columns are added here.
+	if adh.visibilityMgr.HasStoreName(clickhouse.PersistenceName) && !request.GetSkipSchemaUpdate() {
+		if err := adh.visibilityMgr.AddSearchAttributes(
+			ctx,
+			&manager.AddSearchAttributesRequest{SearchAttributes: request.GetSearchAttributes()},
+		); err != nil {
+			return serviceerror.NewUnavailablef(errUnableToSaveSearchAttributesMessage, err)
+		}
+	}
+
 	// Execute workflow.
 	wfParams := addsearchattributes.WorkflowParams{
 		CustomAttributesToAdd: request.GetSearchAttributes(),
@@ -466,7 +506,9 @@ func (adh *AdminHandler) RemoveSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) ||
+		adh.visibilityMgr.HasStoreName(clickhouse.PersistenceName) ||
+		indexName == "" {
 		err = adh.removeSearchAttributesElasticsearch(ctx, request, indexName, currentSearchAttributes)
 	} else {
 		err = adh.removeSearchAttributesSQL(ctx, request, currentSearchAttributes)
@@ -577,7 +619,9 @@ func (adh *AdminHandler) GetSearchAttributes(
 	// register the search attributes in the cluster metadata if ES is up or if
 	// `skip-schema-update` is set. This is for backward compatibility using
 	// standard visibility.
-	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) || indexName == "" {
+	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) ||
+		adh.visibilityMgr.HasStoreName(clickhouse.PersistenceName) ||
+		indexName == "" {
 		return adh.getSearchAttributesElasticsearch(ctx, indexName, searchAttributes)
 	}
 	return adh.getSearchAttributesSQL(ctx, request, searchAttributes)
@@ -715,6 +759,191 @@ func (adh *AdminHandler) ImportWorkflowExecution(
 	}, nil
 }
 
+func (adh *AdminHandler) RestoreArchivedWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.RestoreArchivedWorkflowExecutionRequest,
+) (_ *adminservice.RestoreArchivedWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.Execution.GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+
+	if !adh.archivalMetadata.GetHistoryConfig().ReadEnabled() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	namespaceEntry, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := namespaceEntry.ID()
+
+	// only executions which have been removed from primary storage can be restored
+	_, err = adh.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+	})
+	switch err.(type) {
+	case nil:
+		return nil, errWorkflowExecutionNotArchived
+	case *serviceerror.NotFound:
+	default:
+		return nil, err
+	}
+
+	archivedHistory, err := adh.getArchivedHistory(namespaceEntry, request.Execution)
+	if err != nil {
+		return nil, err
+	}
+
+	// The archived history is read twice, one page at a time, so that it is never held in memory: first to build
+	// the version history, which is sent with every import request, and then to import the events.
+	versionHistory := &historyspb.VersionHistory{}
+	historyLength := int64(0)
+	err = archivedHistory.forEachPage(ctx, func(historyBatches []*historypb.History) error {
+		for _, historyBatch := range historyBatches {
+			for _, event := range historyBatch.Events {
+				item := versionhistory.NewVersionHistoryItem(event.EventId, event.Version)
+				if err := versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, item); err != nil {
+					return serviceerror.NewInternalf("unable to generate version history: %v", err)
+				}
+				historyLength++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if historyLength == 0 {
+		return nil, errArchivedHistoryEmpty
+	}
+
+	var token []byte
+	importHistory := func(blobs []*commonpb.DataBlob) error {
+		resp, err := adh.historyClient.ImportWorkflowExecution(ctx, &historyservice.ImportWorkflowExecutionRequest{
+			NamespaceId:          namespaceID.String(),
+			Execution:            request.Execution,
+			HistoryBatches:       blobs,
+			VersionHistory:       versionHistory,
+			Token:                token,
+			RestoredFromArchival: true,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		return nil
+	}
+
+	var blobs []*commonpb.DataBlob
+	blobSize := 0
+	importedLength := int64(0)
+	err = archivedHistory.forEachPage(ctx, func(historyBatches []*historypb.History) error {
+		for _, historyBatch := range historyBatches {
+			blob, err := adh.eventSerializer.SerializeEvents(historyBatch.Events)
+			if err != nil {
+				return err
+			}
+			blobs = append(blobs, blob)
+			blobSize += len(blob.Data)
+			importedLength += int64(len(historyBatch.Events))
+			if blobSize >= restoreArchivedHistoryBlobSize || len(blobs) >= restoreArchivedHistoryBatchCount {
+				if err := importHistory(blobs); err != nil {
+					return err
+				}
+				blobs = nil
+				blobSize = 0
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if importedLength != historyLength {
+		return nil, serviceerror.NewInternalf("archived history changed while restoring: read %d events, then %d", historyLength, importedLength)
+	}
+	if len(blobs) > 0 {
+		if err := importHistory(blobs); err != nil {
+			return nil, err
+		}
+	}
+	// import with empty history to commit
+	if err := importHistory(nil); err != nil {
+		return nil, err
+	}
+	if len(token) != 0 {
+		return nil, serviceerror.NewInternal("unable to restore workflow execution, import was not committed")
+	}
+
+	return &adminservice.RestoreArchivedWorkflowExecutionResponse{
+		HistoryLength: historyLength,
+	}, nil
+}
+
+// archivedHistory reads the history of an execution from a history archival store.
+type archivedHistory struct {
+	historyArchiver archiver.HistoryArchiver
+	URI             archiver.URI
+	request         archiver.GetHistoryRequest
+}
+
+// getArchivedHistory returns the history of an execution in the namespace's history archival store.
+func (adh *AdminHandler) getArchivedHistory(
+	namespaceEntry *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) (*archivedHistory, error) {
+	URIString := namespaceEntry.HistoryArchivalState().URI
+	if URIString == "" {
+		return nil, errNamespaceIsNotConfiguredForHistoryArchival
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	return &archivedHistory{
+		historyArchiver: historyArchiver,
+		URI:             URI,
+		request: archiver.GetHistoryRequest{
+			NamespaceID: namespaceEntry.ID().String(),
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+			PageSize:    restoreArchivedHistoryPageSize,
+		},
+	}, nil
+}
+
+// forEachPage reads the archived history from the beginning and calls fn with each page of history batches.
+func (h *archivedHistory) forEachPage(ctx context.Context, fn func(historyBatches []*historypb.History) error) error {
+	request := h.request
+	for {
+		resp, err := h.historyArchiver.Get(ctx, h.URI, &request)
+		if err != nil {
+			return err
+		}
+		if err := fn(resp.HistoryBatches); err != nil {
+			return err
+		}
+		request.NextPageToken = resp.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			return nil
+		}
+	}
+}
+
 func (adh *AdminHandler) unaliasAndValidateSearchAttributes(historyBatches []*commonpb.DataBlob, nsName namespace.Name) ([]*commonpb.DataBlob, error) {
 	var unaliasedBatches []*commonpb.DataBlob
 	for _, historyBatch := range historyBatches {
@@ -1753,6 +1982,94 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// ListTaskQueueBacklog returns decoded tasks from the backlog of a physical task queue that
+// match a filter, together with counts of the matching tasks.
+func (adh *AdminHandler) ListTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.ListTaskQueueBacklogRequest,
+) (_ *adminservice.ListTaskQueueBacklogResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetTaskQueue() == "" {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	getTasksRequest := &persistence.GetTasksRequest{
+		NamespaceID:        namespaceID.String(),
+		TaskQueue:          request.GetTaskQueue(),
+		TaskType:           request.GetTaskQueueType(),
+		ExclusiveMaxTaskID: math.MaxInt64,
+		Subqueue:           int(request.GetSubqueue()),
+		PageSize:           int(request.GetPageSize()),
+		NextPageToken:      request.GetNextPageToken(),
+	}
+	if getTasksRequest.PageSize <= 0 {
+		getTasksRequest.PageSize = defaultBacklogPageSize
+	}
+	taskManager := adh.taskManager
+	if request.GetFairness() {
+		if adh.fairTaskManager == nil {
+			return nil, serviceerror.NewInvalidArgument("Fairness table is not available on this cluster")
+		}
+		taskManager = adh.fairTaskManager
+		getTasksRequest.InclusiveMinPass = 1
+	}
+
+	decoder := newBacklogTaskDecoder(adh.historyClient, namespaceID.String(), request.GetTaskQueueType())
+	filter := request.GetFilter()
+	resolveTypes := request.GetResolveTypes() || filtersResolvedFields(filter)
+	if resolveTypes {
+		// Each task read can take a lookup, so reads stop before they could exceed the lookups.
+		getTasksRequest.PageSize = min(getTasksRequest.PageSize, maxBacklogLookupsPerPage)
+	}
+	now := time.Now()
+	resp := &adminservice.ListTaskQueueBacklogResponse{
+		Summary:       &adminservice.BacklogSummary{},
+		TypesResolved: resolveTypes,
+	}
+	for range maxBacklogReadsPerPage {
+		if resolveTypes && decoder.lookups()+getTasksRequest.PageSize > maxBacklogLookupsPerPage {
+			break
+		}
+		tasksResp, err := taskManager.GetTasks(ctx, getTasksRequest)
+		if err != nil {
+			return nil, err
+		}
+		resp.ScannedTasks += int64(len(tasksResp.Tasks))
+		for _, t := range tasksResp.Tasks {
+			task := decoder.decode(t, now)
+			if !matchesStoredFields(filter, task) {
+				continue
+			}
+			if resolveTypes {
+				decoder.resolve(ctx, task)
+				if !matchesResolvedFields(filter, task) {
+					continue
+				}
+			}
+			resp.Tasks = append(resp.Tasks, task)
+			addToBacklogSummary(resp.Summary, task)
+		}
+		getTasksRequest.NextPageToken = tasksResp.NextPageToken
+		if len(getTasksRequest.NextPageToken) == 0 || len(resp.Tasks) >= getTasksRequest.PageSize {
+			break
+		}
+	}
+	resp.NextPageToken = getTasksRequest.NextPageToken
+	return resp, nil
+}
+
 // DescribeTaskQueuePartition returns information for a given task queue partition of the task queue
 func (adh *AdminHandler) DescribeTaskQueuePartition(
 	ctx context.Context,
@@ -1788,6 +2105,7 @@ func (adh *AdminHandler) DescribeTaskQueuePartition(
 
 	return &adminservice.DescribeTaskQueuePartitionResponse{
 		VersionsInfoInternal: resp.VersionsInfoInternal,
+		BacklogLimitStatus:   resp.BacklogLimitStatus,
 	}, nil
 }
 
@@ -1826,6 +2144,321 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+func (adh *AdminHandler) UpdateTaskQueueConcurrencyLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueConcurrencyLimitRequest,
+) (_ *adminservice.UpdateTaskQueueConcurrencyLimitResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	// Matching validates the limits and stores them next to the rest of the task queue config.
+	resp, err := adh.matchingClient.UpdateTaskQueueConfig(ctx, &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId: namespaceID.String(),
+		UpdateTaskqueueConfig: &workflowservice.UpdateTaskQueueConfigRequest{
+			Namespace:     request.GetNamespace(),
+			Identity:      request.GetIdentity(),
+			TaskQueue:     request.GetTaskQueue(),
+			TaskQueueType: request.GetTaskQueueType(),
+		},
+		UpdateConcurrencyLimit: &persistencespb.TaskQueueConcurrencyLimit{
+			MaxInFlight:            request.GetMaxInFlight(),
+			FairnessKeyMaxInFlight: request.GetFairnessKeyMaxInFlight(),
+			Metadata:               &taskqueuepb.ConfigMetadata{Reason: request.GetReason()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateTaskQueueConcurrencyLimitResponse{
+		ConcurrencyLimit: resp.GetConcurrencyLimit(),
+	}, nil
+}
+
+func (adh *AdminHandler) PurgeTaskQueueBacklog(
+	ctx context.Context,
+	request *adminservice.PurgeTaskQueueBacklogRequest,
+) (_ *adminservice.PurgeTaskQueueBacklogResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	matchingRequest := &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId: namespaceID.String(),
+		UpdateTaskqueueConfig: &workflowservice.UpdateTaskQueueConfigRequest{
+			Namespace:     request.GetNamespace(),
+			Identity:      request.GetIdentity(),
+			TaskQueue:     request.GetTaskQueue(),
+			TaskQueueType: request.GetTaskQueueType(),
+		},
+	}
+	if id := request.GetCancelPurgeId(); id != "" {
+		matchingRequest.CancelBacklogPurgeId = id
+	} else {
+		matchingRequest.AddBacklogPurge = newBacklogPurge(request, time.Now())
+	}
+
+	// Matching validates the purge and stores it next to the rest of the task queue config, where it
+	// stays as an audit entry after it expired.
+	resp, err := adh.matchingClient.UpdateTaskQueueConfig(ctx, matchingRequest)
+	if err != nil {
+		return nil, err
+	}
+	adh.logger.Info("Updated task queue backlog purges.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowTaskQueueName(request.GetTaskQueue()),
+		tag.NewStringTag("cancel-purge-id", request.GetCancelPurgeId()),
+		tag.NewStringTag("identity", request.GetIdentity()),
+		tag.NewStringTag("reason", request.GetReason()))
+	return &adminservice.PurgeTaskQueueBacklogResponse{
+		Purges: resp.GetBacklogPurges(),
+	}, nil
+}
+
+// ReshardHistory processes a page of history resharding and returns the position to continue from.
+func (adh *AdminHandler) ReshardHistory(
+	ctx context.Context,
+	request *adminservice.ReshardHistoryRequest,
+) (_ *adminservice.ReshardHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultReshardHistoryPageSize
+	}
+
+	var sourceShardCount int32
+	var position reshard.Position
+	if len(request.GetNextPageToken()) == 0 {
+		resp, err := adh.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sourceShardCount = resp.GetHistoryShardCount()
+		position = reshard.Start()
+	} else {
+		token := &adminservice.ReshardHistoryPageToken{}
+		if err := token.Unmarshal(request.GetNextPageToken()); err != nil {
+			return nil, errInvalidReshardHistoryPageToken
+		}
+		sourceShardCount = token.GetSourceShardCount()
+		position = reshard.Position{
+			Phase:          reshard.Phase(token.GetPhase()),
+			ShardID:        token.GetShardId(),
+			TaskCategoryID: int(token.GetTaskCategoryId()),
+			PageToken:      token.GetPageToken(),
+		}
+	}
+
+	resharder, err := reshard.NewResharder(
+		adh.shardManager,
+		adh.executionManager,
+		adh.clusterMetadataManager,
+		sourceShardCount,
+		request.GetTargetShardCount(),
+		request.GetCutover(),
+		// SQL stores partition history by shard, NoSQL stores share it between shards.
+		adh.persistenceStoreType == config.StoreTypeSQL,
+		pageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetCutover() && reshard.RequiresCutover(position.Phase) {
+		// There is no handoff between the owners of the source and new shards, so no history host
+		// may serve traffic while tasks move and the shard count changes.
+		resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+		if err != nil {
+			return nil, err
+		}
+		if count := resolver.MemberCount(); count > 0 {
+			return nil, serviceerror.NewFailedPreconditionf(
+				"History service must be stopped for resharding cutover, but %d history hosts are running.", count)
+		}
+	}
+	next, stats, err := resharder.Step(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	if position.Phase == reshard.PhaseCutover {
+		adh.logger.Info("Updated history shard count, all services must be restarted.",
+			tag.NewInt32("source-shard-count", sourceShardCount),
+			tag.NewInt32("target-shard-count", request.GetTargetShardCount()))
+	}
+
+	resp := &adminservice.ReshardHistoryResponse{
+		Phase:               string(position.Phase),
+		ShardId:             position.ShardID,
+		SourceShardCount:    sourceShardCount,
+		TargetShardCount:    request.GetTargetShardCount(),
+		ExecutionsCopied:    stats.ExecutionsCopied,
+		ExecutionsUnchanged: stats.ExecutionsUnchanged,
+		ExecutionsPruned:    stats.ExecutionsPruned,
+		ExecutionsDeleted:   stats.ExecutionsDeleted,
+		TasksCopied:         stats.TasksCopied,
+		TasksDeleted:        stats.TasksDeleted,
+	}
+	if next.Phase != reshard.PhaseCompleted {
+		token := &adminservice.ReshardHistoryPageToken{
+			SourceShardCount: sourceShardCount,
+			Phase:            string(next.Phase),
+			ShardId:          next.ShardID,
+			TaskCategoryId:   int32(next.TaskCategoryID),
+			PageToken:        next.PageToken,
+		}
+		if resp.NextPageToken, err = token.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// RunQueueAction runs a mitigation action on the queue of the given task category of a shard.
+func (adh *AdminHandler) RunQueueAction(
+	ctx context.Context,
+	request *adminservice.RunQueueActionRequest,
+) (_ *adminservice.RunQueueActionResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetAction() == "" {
+		return nil, errQueueActionNotSet
+	}
+	if _, ok := adh.taskCategoryRegistry.GetCategoryByID(int(request.GetCategory())); !ok {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid task category ID: %v", request.GetCategory())
+	}
+
+	var namespaceID namespace.ID
+	if request.GetNamespace() != "" {
+		var err error
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := adh.historyClient.RunQueueAction(ctx, &historyservice.RunQueueActionRequest{
+		ShardId:     request.GetShardId(),
+		Category:    request.GetCategory(),
+		Action:      request.GetAction(),
+		NamespaceId: namespaceID.String(),
+		ReaderId:    request.GetReaderId(),
+		Threshold:   request.GetThreshold(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	adh.logger.Info("Ran queue action",
+		tag.ShardID(request.GetShardId()),
+		tag.TaskCategoryID(int(request.GetCategory())),
+		tag.QueueAction(request.GetAction()),
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.NewBoolTag("action-taken", resp.GetActionTaken()),
+	)
+	return &adminservice.RunQueueActionResponse{
+		ActionTaken: resp.GetActionTaken(),
+	}, nil
+}
+
+// StreamTaskQueueStats sends the stats of a task queue every interval until the caller cancels the
+// stream. Matching aggregates the stats of all partitions at the root partition of each task queue
+// type.
+func (adh *AdminHandler) StreamTaskQueueStats(
+	request *adminservice.StreamTaskQueueStatsRequest,
+	stream adminservice.AdminService_StreamTaskQueueStatsServer,
+) (retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return errTaskQueueNotSet
+	}
+	taskQueueTypes := request.GetTaskQueueTypes()
+	if len(taskQueueTypes) == 0 {
+		taskQueueTypes = []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+	}
+	for _, taskQueueType := range taskQueueTypes {
+		if taskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+			return serviceerror.NewInvalidArgument("TaskQueueTypes must not contain TASK_QUEUE_TYPE_UNSPECIFIED.")
+		}
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	interval := max(request.GetInterval().AsDuration(), adh.config.AdminTaskQueueStatsStreamMinInterval())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		resp := &adminservice.StreamTaskQueueStatsResponse{}
+		for _, taskQueueType := range taskQueueTypes {
+			statsResp, err := adh.matchingClient.GetTaskQueueStats(ctx, &matchingservice.GetTaskQueueStatsRequest{
+				NamespaceId: namespaceID.String(),
+				TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+					TaskQueue:     request.GetTaskQueue(),
+					TaskQueueType: taskQueueType,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			resp.Stats = append(resp.Stats, statsResp.GetStats())
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -2073,6 +2706,9 @@ func (adh *AdminHandler) PurgeDLQTasks(
 	if err := validateHistoryDLQKey(request.DlqKey); err != nil {
 		return nil, err
 	}
+	if err := adh.validateDLQNotRedriven(request.DlqKey); err != nil {
+		return nil, err
+	}
 
 	workflowID := adh.getDLQWorkflowID(request.DlqKey)
 	client := adh.sdkClientFactory.GetSystemClient()
@@ -2108,6 +2744,9 @@ func (adh *AdminHandler) MergeDLQTasks(ctx context.Context, request *adminservic
 	if err := validateHistoryDLQKey(request.DlqKey); err != nil {
 		return nil, err
 	}
+	if err := adh.validateDLQNotRedriven(request.DlqKey); err != nil {
+		return nil, err
+	}
 
 	workflowID := adh.getDLQWorkflowID(request.DlqKey)
 	client := adh.sdkClientFactory.GetSystemClient()
@@ -2214,6 +2853,107 @@ func (adh *AdminHandler) CancelDLQJob(ctx context.Context, request *adminservice
 	return &adminservice.CancelDLQJobResponse{Canceled: true}, nil
 }
 
+func (adh *AdminHandler) StartVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.StartVisibilityConsistencyCheckRequest,
+) (_ *adminservice.StartVisibilityConsistencyCheckResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if _, ok := adh.visibilityMgr.(*visibility.VisibilityManagerDual); !ok {
+		return nil, errSecondaryVisibilityNotConfigured
+	}
+	if _, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	// Only one check can run for a namespace at a time.
+	workflowID := fmt.Sprintf("%s-%s", visibilityconsistency.WorkflowName, request.GetNamespace())
+	client := adh.sdkClientFactory.GetSystemClient()
+	run, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: primitives.DefaultWorkerTaskQueue,
+	}, visibilityconsistency.WorkflowName, visibilityconsistency.WorkflowParams{
+		Namespace: request.GetNamespace(),
+		Query:     request.GetQuery(),
+		PageSize:  int(request.GetPageSize()), // Let the workflow code validate and set the default value if needed.
+		RPS:       request.GetRps(),
+		Backfill:  request.GetBackfill(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobToken := adminservice.VisibilityConsistencyCheckJobToken{
+		WorkflowId: workflowID,
+		RunId:      run.GetRunID(),
+	}
+	jobTokenBytes, _ := jobToken.Marshal()
+	return &adminservice.StartVisibilityConsistencyCheckResponse{
+		JobToken: jobTokenBytes,
+	}, nil
+}
+
+func (adh *AdminHandler) DescribeVisibilityConsistencyCheck(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityConsistencyCheckRequest,
+) (_ *adminservice.DescribeVisibilityConsistencyCheckResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	jt := adminservice.VisibilityConsistencyCheckJobToken{}
+	if err := jt.Unmarshal(request.GetJobToken()); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidVisibilityConsistencyCheckJobToken, err)
+	}
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, jt.WorkflowId, jt.RunId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.QueryWorkflow(ctx, jt.WorkflowId, jt.RunId, visibilityconsistency.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var report visibilityconsistency.Report
+	if err = response.Get(&report); err != nil {
+		return nil, err
+	}
+
+	divergences := make([]*adminservice.DescribeVisibilityConsistencyCheckResponse_Divergence, 0, len(report.Divergences))
+	for _, divergence := range report.Divergences {
+		divergences = append(divergences, &adminservice.DescribeVisibilityConsistencyCheckResponse_Divergence{
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: divergence.WorkflowID,
+				RunId:      divergence.RunID,
+			},
+			Kind:       string(divergence.Kind),
+			Fields:     divergence.Fields,
+			Backfilled: divergence.Backfilled,
+		})
+	}
+	return &adminservice.DescribeVisibilityConsistencyCheckResponse{
+		Namespace:          report.Namespace,
+		Status:             execution.WorkflowExecutionInfo.Status,
+		Phase:              string(report.Phase),
+		Backfill:           report.Backfill,
+		StartTime:          execution.WorkflowExecutionInfo.StartTime,
+		EndTime:            execution.WorkflowExecutionInfo.CloseTime,
+		Checked:            report.Checked,
+		MissingInSecondary: report.MissingInSecondary,
+		MissingInPrimary:   report.MissingInPrimary,
+		Mismatched:         report.Mismatched,
+		Backfilled:         report.Backfilled,
+		BackfillFailures:   report.BackfillFailures,
+		Divergences:        divergences,
+	}, nil
+}
+
 // AddTasks just translates the admin service's request proto into a history service request proto and then sends it.
 func (adh *AdminHandler) AddTasks(
 	ctx context.Context,
@@ -2376,6 +3116,23 @@ func validateHistoryDLQKey(
 	return nil
 }
 
+// validateDLQNotRedriven rejects manual operations on the DLQs managed by the automatic re-drive of history tasks,
+// which rewrites them and changes the message IDs of their tasks.
+func (adh *AdminHandler) validateDLQNotRedriven(key *commonspb.HistoryDLQKey) error {
+	currentCluster := adh.clusterMetadata.GetCurrentClusterName()
+	if key.SourceCluster != currentCluster || key.TargetCluster != currentCluster {
+		return nil
+	}
+	category, ok := adh.taskCategoryRegistry.GetCategoryByID(int(key.TaskCategory))
+	if !ok {
+		return nil
+	}
+	if slices.Contains(adh.config.HistoryTaskDLQRedriveCategories(), category.Name()) {
+		return errDLQManagedByRedrive
+	}
+	return nil
+}
+
 func convertClusterReplicationConfigToProto(
 	input []string,
 ) []*replicationpb.ClusterReplicationConfig {
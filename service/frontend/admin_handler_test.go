@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,7 @@ import (
 	chasmworkflow "go.temporal.io/server/chasm/lib/workflow"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -42,8 +44,11 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/reshard"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/clickhouse"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -57,11 +62,14 @@ import (
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/visibilityconsistency"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -162,6 +170,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetTaskManager(),
 		s.mockResource.GetTaskManager(),
 		s.mockResource.GetExecutionManager(),
+		s.mockResource.GetShardManager(),
 		s.mockResource.GetClusterMetadataManager(),
 		s.mockResource.GetMetadataManager(),
 		s.mockResource.GetClientFactory(),
@@ -180,6 +189,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
 		chasmRegistry,
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -290,6 +301,7 @@ func (s *adminHandlerSuite) Test_AddSearchAttributes() {
 	mockSdkClient := mocksdk.NewMockClient(s.controller)
 	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(true).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().HasStoreName(clickhouse.PersistenceName).Return(false).AnyTimes()
 
 	// Start workflow failed.
 	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), "temporal-sys-add-search-attributes-workflow", gomock.Any()).Return(nil, errors.New("start failed"))
@@ -1331,6 +1343,43 @@ func (s *adminHandlerSuite) TestPurgeDLQTasks_ClusterNotSet() {
 	s.ErrorContains(err, errSourceClusterNotSet.Error())
 }
 
+func (s *adminHandlerSuite) TestManualDLQOperations_ManagedByRedrive() {
+	s.handler.config.HistoryTaskDLQRedriveCategories = dynamicconfig.GetTypedPropertyFn([]string{tasks.CategoryTransfer.Name()})
+	currentCluster := s.mockMetadata.GetCurrentClusterName()
+	dlqKey := &commonspb.HistoryDLQKey{
+		TaskCategory:  int32(tasks.CategoryTransfer.ID()),
+		SourceCluster: currentCluster,
+		TargetCluster: currentCluster,
+	}
+
+	_, err := s.handler.PurgeDLQTasks(context.Background(), &adminservice.PurgeDLQTasksRequest{
+		DlqKey:                   dlqKey,
+		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 42},
+	})
+	s.Equal(codes.FailedPrecondition, serviceerror.ToStatus(err).Code())
+	s.ErrorIs(err, errDLQManagedByRedrive)
+
+	_, err = s.handler.MergeDLQTasks(context.Background(), &adminservice.MergeDLQTasksRequest{
+		DlqKey:                   dlqKey,
+		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 42},
+	})
+	s.Equal(codes.FailedPrecondition, serviceerror.ToStatus(err).Code())
+	s.ErrorIs(err, errDLQManagedByRedrive)
+
+	// The DLQs of categories that aren't re-driven can still be operated on manually.
+	dlqKey.TaskCategory = int32(tasks.CategoryTimer.ID())
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	run := mocksdk.NewMockWorkflowRun(s.controller)
+	run.EXPECT().GetRunID().Return("test-run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(gomock.Any(), gomock.Any(), dlq.WorkflowName, gomock.Any()).Return(run, nil)
+	_, err = s.handler.PurgeDLQTasks(context.Background(), &adminservice.PurgeDLQTasksRequest{
+		DlqKey:                   dlqKey,
+		InclusiveMaxTaskMetadata: &commonspb.HistoryDLQTaskMetadata{MessageId: 42},
+	})
+	s.NoError(err)
+}
+
 func (s *adminHandlerSuite) TestDescribeDLQJob() {
 	workflowID := "test-workflow-id"
 	runID := "test-run-id"
@@ -1603,6 +1652,103 @@ func (s *adminHandlerSuite) TestCancelDLQJob_InvalidJobToken() {
 	s.ErrorContains(err, "Invalid DLQ job token")
 }
 
+func (s *adminHandlerSuite) TestStartVisibilityConsistencyCheck() {
+	s.handler.visibilityMgr = visibility.NewVisibilityManagerDual(s.mockVisibilityMgr, s.mockVisibilityMgr, nil, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(s.namespaceEntry, nil)
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	run := mocksdk.NewMockWorkflowRun(s.controller)
+	run.EXPECT().GetRunID().Return("test-run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		gomock.Any(),
+		gomock.Any(),
+		visibilityconsistency.WorkflowName,
+		visibilityconsistency.WorkflowParams{
+			Namespace: s.namespace.String(),
+			Query:     "WorkflowType = 'test-type'",
+			PageSize:  10,
+			RPS:       5,
+			Backfill:  true,
+		},
+	).Return(run, nil)
+
+	response, err := s.handler.StartVisibilityConsistencyCheck(context.Background(), &adminservice.StartVisibilityConsistencyCheckRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowType = 'test-type'",
+		PageSize:  10,
+		Rps:       5,
+		Backfill:  true,
+	})
+	s.NoError(err)
+	var token adminservice.VisibilityConsistencyCheckJobToken
+	s.NoError(token.Unmarshal(response.JobToken))
+	s.Equal(visibilityconsistency.WorkflowName+"-"+s.namespace.String(), token.WorkflowId)
+	s.Equal("test-run-id", token.RunId)
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityConsistencyCheck_NotDualVisibility() {
+	_, err := s.handler.StartVisibilityConsistencyCheck(context.Background(), &adminservice.StartVisibilityConsistencyCheckRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorIs(err, errSecondaryVisibilityNotConfigured)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityConsistencyCheck() {
+	workflowID := "test-workflow-id"
+	runID := "test-run-id"
+	startTime := timestamppb.New(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC))
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), workflowID, runID).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: startTime,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result interface{}) {
+		*(result.(*visibilityconsistency.Report)) = visibilityconsistency.Report{
+			Namespace:          s.namespace.String(),
+			Phase:              visibilityconsistency.PhaseCompareSecondary,
+			Checked:            10,
+			MissingInSecondary: 1,
+			Divergences: []visibilityconsistency.Divergence{
+				{WorkflowID: "wid", RunID: "rid", Kind: visibilityconsistency.DivergenceKindMissingInSecondary},
+			},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(gomock.Any(), workflowID, runID, visibilityconsistency.QueryTypeProgress).Return(mockValue, nil)
+
+	jobToken := adminservice.VisibilityConsistencyCheckJobToken{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+	jobTokenBytes, _ := jobToken.Marshal()
+	response, err := s.handler.DescribeVisibilityConsistencyCheck(context.Background(), &adminservice.DescribeVisibilityConsistencyCheckRequest{
+		JobToken: jobTokenBytes,
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeVisibilityConsistencyCheckResponse{
+		Namespace:          s.namespace.String(),
+		Status:             enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		Phase:              string(visibilityconsistency.PhaseCompareSecondary),
+		StartTime:          startTime,
+		Checked:            10,
+		MissingInSecondary: 1,
+		Divergences: []*adminservice.DescribeVisibilityConsistencyCheckResponse_Divergence{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+				Kind:      string(visibilityconsistency.DivergenceKindMissingInSecondary),
+			},
+		},
+	}, response)
+}
+
+func (s *adminHandlerSuite) TestDescribeVisibilityConsistencyCheck_InvalidJobToken() {
+	_, err := s.handler.DescribeVisibilityConsistencyCheck(context.Background(), &adminservice.DescribeVisibilityConsistencyCheckRequest{JobToken: []byte("invalid_token")})
+	s.ErrorContains(err, "Invalid visibility consistency check job token")
+}
+
 func (s *adminHandlerSuite) TestAddDLQTasks_Ok() {
 	s.mockHistoryClient.EXPECT().AddTasks(gomock.Any(), &historyservice.AddTasksRequest{
 		ShardId: 13,
@@ -1725,6 +1871,336 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestListTaskQueueBacklog() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.ListTaskQueueBacklog(ctx, &adminservice.ListTaskQueueBacklogRequest{Namespace: s.namespace.String()})
+	s.Equal(errTaskQueueNotSet, err)
+
+	now := time.Now()
+	newTask := func(id int64, runID string, scheduledEventID int64, fairnessKey string) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			TaskId: id,
+			Data: &persistencespb.TaskInfo{
+				WorkflowId:       "wf",
+				RunId:            runID,
+				ScheduledEventId: scheduledEventID,
+				CreateTime:       timestamppb.New(now.Add(-time.Duration(id) * time.Minute)),
+				Priority:         &commonpb.Priority{FairnessKey: fairnessKey},
+			},
+		}
+	}
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+			s.Equal("/_sys/tq/1", request.TaskQueue)
+			s.Nil(request.NextPageToken)
+			return &persistence.GetTasksResponse{
+				Tasks: []*persistencespb.AllocatedTaskInfo{
+					newTask(1, "run1", 5, "a"),
+					newTask(2, "run1", 7, "b"),
+					newTask(3, "run2", 5, "a"),
+				},
+				NextPageToken: []byte("page2"),
+			}, nil
+		})
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+			s.Equal([]byte("page2"), request.NextPageToken)
+			return &persistence.GetTasksResponse{
+				Tasks: []*persistencespb.AllocatedTaskInfo{
+					newTask(4, "run1", 9, "a"),
+					newTask(60, "run1", 9, "a"),
+				},
+			}, nil
+		})
+	// mutable state is looked up once per run
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.DescribeMutableStateRequest, _ ...grpc.CallOption) (*historyservice.DescribeMutableStateResponse, error) {
+			s.Equal("run1", request.GetExecution().GetRunId())
+			return &historyservice.DescribeMutableStateResponse{
+				DatabaseMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{WorkflowTypeName: "my-workflow"},
+					ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+						5: {ActivityType: &commonpb.ActivityType{Name: "act-a"}, Attempt: 3},
+						7: {ActivityType: &commonpb.ActivityType{Name: "act-b"}},
+						9: {ActivityType: &commonpb.ActivityType{Name: "act-a"}},
+					},
+				},
+			}, nil
+		})
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	resp, err := s.handler.ListTaskQueueBacklog(ctx, &adminservice.ListTaskQueueBacklogRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "/_sys/tq/1",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Filter: &adminservice.BacklogTaskFilter{
+			ActivityType: "act-a",
+			MaxAge:       durationpb.New(time.Hour),
+		},
+		PageSize: 3,
+	})
+	s.NoError(err)
+	s.Equal(int64(5), resp.GetScannedTasks())
+	s.Empty(resp.GetNextPageToken())
+	s.Len(resp.GetTasks(), 2)
+	s.Equal(int64(1), resp.GetTasks()[0].GetTaskId())
+	s.Equal("my-workflow", resp.GetTasks()[0].GetWorkflowType())
+	s.Equal(int32(3), resp.GetTasks()[0].GetAttempt())
+	s.Equal(int64(4), resp.GetTasks()[1].GetTaskId())
+
+	summary := resp.GetSummary()
+	s.Equal(int64(2), summary.GetTotal())
+	s.Equal(map[string]int64{"act-a": 2}, summary.GetByActivityType())
+	s.Equal(map[string]int64{"a": 2}, summary.GetByFairnessKey())
+	s.Equal(resp.GetTasks()[1].GetCreateTime(), summary.GetOldestCreateTime())
+	s.True(resp.GetTypesResolved())
+
+	// types are not looked up unless asked for
+	s.mockResource.TaskMgr.EXPECT().GetTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetTasksResponse{
+		Tasks: []*persistencespb.AllocatedTaskInfo{
+			newTask(1, "run1", 5, "a"),
+			newTask(3, "run2", 5, "a"),
+		},
+	}, nil)
+	resp, err = s.handler.ListTaskQueueBacklog(ctx, &adminservice.ListTaskQueueBacklogRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "/_sys/tq/1",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.False(resp.GetTypesResolved())
+	s.Len(resp.GetTasks(), 2)
+	s.Empty(resp.GetTasks()[0].GetActivityType())
+	s.Equal(int64(2), resp.GetSummary().GetTotal())
+	s.Empty(resp.GetSummary().GetByActivityType())
+}
+
+func (s *adminHandlerSuite) TestPurgeTaskQueueBacklog() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.PurgeTaskQueueBacklog(ctx, &adminservice.PurgeTaskQueueBacklogRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "tq",
+	})
+	s.Error(err)
+
+	purges := []*persistencespb.TaskQueueBacklogPurge{{Id: "purge-id"}}
+	before := time.Now()
+	s.mockMatchingClient.EXPECT().UpdateTaskQueueConfig(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateTaskQueueConfigRequest, _ ...grpc.CallOption) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("tq", request.GetUpdateTaskqueueConfig().GetTaskQueue())
+			s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetUpdateTaskqueueConfig().GetTaskQueueType())
+			purge := request.GetAddBacklogPurge()
+			s.Equal("bad", purge.GetFairnessKey())
+			s.Equal("other", purge.GetDestinationTaskQueue())
+			s.Equal("poison", purge.GetMetadata().GetReason())
+			s.False(purge.GetCreatedBefore().AsTime().Before(before))
+			s.WithinDuration(before.Add(defaultBacklogPurgeDuration), purge.GetExpireTime().AsTime(), time.Minute)
+			s.Empty(request.GetCancelBacklogPurgeId())
+			return &matchingservice.UpdateTaskQueueConfigResponse{BacklogPurges: purges}, nil
+		})
+	resp, err := s.handler.PurgeTaskQueueBacklog(ctx, &adminservice.PurgeTaskQueueBacklogRequest{
+		Namespace:            s.namespace.String(),
+		TaskQueue:            "tq",
+		TaskQueueType:        enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		FairnessKey:          "bad",
+		DestinationTaskQueue: "other",
+		Reason:               "poison",
+	})
+	s.NoError(err)
+	s.Equal(purges, resp.GetPurges())
+
+	s.mockMatchingClient.EXPECT().UpdateTaskQueueConfig(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.UpdateTaskQueueConfigRequest, _ ...grpc.CallOption) (*matchingservice.UpdateTaskQueueConfigResponse, error) {
+			s.Equal("purge-id", request.GetCancelBacklogPurgeId())
+			s.Nil(request.GetAddBacklogPurge())
+			return &matchingservice.UpdateTaskQueueConfigResponse{BacklogPurges: purges}, nil
+		})
+	_, err = s.handler.PurgeTaskQueueBacklog(ctx, &adminservice.PurgeTaskQueueBacklogRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		CancelPurgeId: "purge-id",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestReshardHistory() {
+	ctx := context.Background()
+
+	_, err := s.handler.ReshardHistory(ctx, nil)
+	s.Error(err)
+
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(ctx).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{HistoryShardCount: 2},
+	}, nil)
+	s.mockExecutionMgr.EXPECT().ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:  1,
+		PageSize: defaultReshardHistoryPageSize,
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+	resp, err := s.handler.ReshardHistory(ctx, &adminservice.ReshardHistoryRequest{TargetShardCount: 4})
+	s.NoError(err)
+	s.Equal(string(reshard.PhaseCopyExecutions), resp.GetPhase())
+	s.EqualValues(1, resp.GetShardId())
+	s.EqualValues(2, resp.GetSourceShardCount())
+	s.EqualValues(4, resp.GetTargetShardCount())
+	token := &adminservice.ReshardHistoryPageToken{}
+	s.NoError(token.Unmarshal(resp.GetNextPageToken()))
+	s.Equal(string(reshard.PhaseCopyExecutions), token.GetPhase())
+	s.EqualValues(2, token.GetShardId())
+	s.EqualValues(2, token.GetSourceShardCount())
+
+	// The source shard count is taken from the token, as cluster metadata changes at cutover.
+	s.mockExecutionMgr.EXPECT().ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:  2,
+		PageSize: 10,
+	}).Return(&persistence.ListConcreteExecutionsResponse{}, nil)
+	resp, err = s.handler.ReshardHistory(ctx, &adminservice.ReshardHistoryRequest{
+		TargetShardCount: 4,
+		PageSize:         10,
+		NextPageToken:    resp.GetNextPageToken(),
+	})
+	s.NoError(err)
+	s.EqualValues(2, resp.GetShardId())
+	s.NoError(token.Unmarshal(resp.GetNextPageToken()))
+	s.Equal(string(reshard.PhasePruneExecutions), token.GetPhase())
+	s.EqualValues(3, token.GetShardId())
+
+	_, err = s.handler.ReshardHistory(ctx, &adminservice.ReshardHistoryRequest{
+		TargetShardCount: 4,
+		NextPageToken:    []byte("invalid"),
+	})
+	s.ErrorIs(err, errInvalidReshardHistoryPageToken)
+
+	cutoverToken, err := (&adminservice.ReshardHistoryPageToken{
+		SourceShardCount: 2,
+		Phase:            string(reshard.PhaseCutover),
+	}).Marshal()
+	s.NoError(err)
+	_, err = s.handler.ReshardHistory(ctx, &adminservice.ReshardHistoryRequest{
+		TargetShardCount: 4,
+		NextPageToken:    cutoverToken,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	// Cutover is refused while history hosts are running.
+	s.mockResource.HistoryServiceResolver.EXPECT().MemberCount().Return(1)
+	_, err = s.handler.ReshardHistory(ctx, &adminservice.ReshardHistoryRequest{
+		TargetShardCount: 4,
+		Cutover:          true,
+		NextPageToken:    cutoverToken,
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
+func (s *adminHandlerSuite) TestRunQueueAction() {
+	ctx := context.Background()
+
+	_, err := s.handler.RunQueueAction(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = s.handler.RunQueueAction(ctx, &adminservice.RunQueueActionRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTransfer),
+	})
+	s.Equal(errQueueActionNotSet, err)
+
+	_, err = s.handler.RunQueueAction(ctx, &adminservice.RunQueueActionRequest{
+		ShardId:  1,
+		Category: -1,
+		Action:   "isolate-namespace",
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockHistoryClient.EXPECT().RunQueueAction(ctx, &historyservice.RunQueueActionRequest{
+		ShardId:     1,
+		Category:    int32(tasks.CategoryIDTransfer),
+		Action:      "isolate-namespace",
+		NamespaceId: s.namespaceID.String(),
+	}).Return(&historyservice.RunQueueActionResponse{ActionTaken: true}, nil)
+	resp, err := s.handler.RunQueueAction(ctx, &adminservice.RunQueueActionRequest{
+		ShardId:   1,
+		Category:  int32(tasks.CategoryIDTransfer),
+		Action:    "isolate-namespace",
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.True(resp.GetActionTaken())
+
+	s.mockHistoryClient.EXPECT().RunQueueAction(ctx, &historyservice.RunQueueActionRequest{
+		ShardId:   2,
+		Category:  int32(tasks.CategoryIDTimer),
+		Action:    "slice-count",
+		Threshold: 10,
+	}).Return(&historyservice.RunQueueActionResponse{}, nil)
+	resp, err = s.handler.RunQueueAction(ctx, &adminservice.RunQueueActionRequest{
+		ShardId:   2,
+		Category:  int32(tasks.CategoryIDTimer),
+		Action:    "slice-count",
+		Threshold: 10,
+	})
+	s.NoError(err)
+	s.False(resp.GetActionTaken())
+}
+
+func (s *adminHandlerSuite) TestStreamTaskQueueStats() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.handler.config.AdminTaskQueueStatsStreamMinInterval = dynamicconfig.GetDurationPropertyFn(time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := adminservicemock.NewMockAdminService_StreamTaskQueueStatsServer(s.controller)
+	stream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	err := s.handler.StreamTaskQueueStats(&adminservice.StreamTaskQueueStatsRequest{
+		Namespace: s.namespace.String(),
+	}, stream)
+	s.Error(err)
+
+	s.mockMatchingClient.EXPECT().GetTaskQueueStats(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.GetTaskQueueStatsRequest, _ ...grpc.CallOption) (*matchingservice.GetTaskQueueStatsResponse, error) {
+			s.Equal(s.namespaceID.String(), request.GetNamespaceId())
+			s.Equal("tq", request.GetTaskQueuePartition().GetTaskQueue())
+			s.Zero(request.GetTaskQueuePartition().GetNormalPartitionId())
+			s.False(request.GetPartitionOnly())
+			return &matchingservice.GetTaskQueueStatsResponse{
+				Stats: &taskqueuespb.TaskQueueStatsSnapshot{
+					TaskQueueType:  request.GetTaskQueuePartition().GetTaskQueueType(),
+					PartitionCount: 4,
+				},
+			}, nil
+		}).Times(4)
+	var sent []*adminservice.StreamTaskQueueStatsResponse
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *adminservice.StreamTaskQueueStatsResponse) error {
+		sent = append(sent, resp)
+		if len(sent) == 2 {
+			cancel()
+		}
+		return nil
+	}).Times(2)
+
+	err = s.handler.StreamTaskQueueStats(&adminservice.StreamTaskQueueStatsRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "tq",
+	}, stream)
+	s.NoError(err)
+	s.Len(sent, 2)
+	for _, resp := range sent {
+		s.Len(resp.GetStats(), 2)
+		s.Equal(enumspb.TASK_QUEUE_TYPE_WORKFLOW, resp.GetStats()[0].GetTaskQueueType())
+		s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, resp.GetStats()[1].GetTaskQueueType())
+		s.EqualValues(4, resp.GetStats()[0].GetPartitionCount())
+	}
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
@@ -2087,6 +2563,93 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_Success() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID).WithRunID(uuid.NewString())
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: tv.NamespaceName().String(), Id: tv.NamespaceID().String()},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   "test:///history/archival",
+		},
+		&persistencespb.NamespaceReplicationConfig{},
+		1,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+
+	historyArchiver := archiver.NewMockHistoryArchiver(s.controller)
+	s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test").Return(historyArchiver, nil)
+	historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+			s.Equal(tv.NamespaceID().String(), request.NamespaceID)
+			s.Equal(tv.WorkflowID(), request.WorkflowID)
+			s.Equal(tv.RunID(), request.RunID)
+			if request.NextPageToken == nil {
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1}, {EventId: 2, Version: 1}}}},
+					NextPageToken:  []byte("next page"),
+				}, nil
+			}
+			return &archiver.GetHistoryResponse{
+				HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 3, Version: 2}}}},
+			}, nil
+		}).Times(4) // each page is read once for the version history and once to import it
+
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+		s.Equal(tv.NamespaceID().String(), request.NamespaceId)
+		s.True(request.RestoredFromArchival)
+		s.Len(request.HistoryBatches, 2)
+		s.Nil(request.Token)
+		s.Len(request.VersionHistory.GetItems(), 2)
+		return &historyservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+	})
+	s.mockHistoryClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, request *historyservice.ImportWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ImportWorkflowExecutionResponse, error) {
+		s.True(request.RestoredFromArchival)
+		s.Empty(request.HistoryBatches)
+		s.Equal([]byte("token"), request.Token)
+		return &historyservice.ImportWorkflowExecutionResponse{}, nil
+	})
+
+	resp, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetHistoryLength())
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_ExecutionExists() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID).WithRunID(uuid.NewString())
+	s.mockResource.ArchivalMetadata.SetHistoryEnabledByDefault()
+	s.mockNamespaceCache.EXPECT().GetNamespace(tv.NamespaceName()).Return(s.namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.GetMutableStateResponse{}, nil)
+
+	_, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	var alreadyExists *serviceerror.AlreadyExists
+	s.ErrorAs(err, &alreadyExists)
+}
+
+func (s *adminHandlerSuite) TestRestoreArchivedWorkflowExecution_InvalidRequest() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithRunID(uuid.NewString())
+
+	_, err := s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: &commonpb.WorkflowExecution{WorkflowId: tv.WorkflowID()},
+	})
+	s.Equal(errRunIDNotSet, err)
+
+	// history archival is disabled by default
+	_, err = s.handler.RestoreArchivedWorkflowExecution(context.Background(), &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+	})
+	s.Equal(errClusterIsNotConfiguredForReadingArchivalHistory, err)
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
@@ -42,6 +42,11 @@ var (
 	errClusterIsNotConfiguredForVisibilityArchival        = serviceerror.NewInvalidArgument("Cluster is not configured for visibility archival.")
 	errClusterIsNotConfiguredForReadingArchivalVisibility = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived visibility records.")
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
+	errClusterIsNotConfiguredForReadingArchivalHistory    = serviceerror.NewInvalidArgument("Cluster is not configured for reading archived history.")
+	errNamespaceIsNotConfiguredForHistoryArchival         = serviceerror.NewInvalidArgument("Namespace is not configured for history archival.")
+	errRunIDNotSet                                        = serviceerror.NewInvalidArgument("RunId is not set on request.")
+	errArchivedHistoryEmpty                               = serviceerror.NewNotFound("Archived workflow history is empty.")
+	errWorkflowExecutionNotArchived                       = serviceerror.NewAlreadyExists("Workflow execution still exists and cannot be restored from archival.")
 	errSearchAttributesNotSet                             = serviceerror.NewInvalidArgument("SearchAttributes are not set on request.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")                                 // DEPRECATED
 	errInvalidPaginationToken                             = serviceerror.NewInvalidArgument("Invalid pagination token.")                         // DEPRECATED
@@ -88,6 +93,12 @@ var (
 	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errDLQManagedByRedrive    = serviceerror.NewFailedPrecondition("The DLQ is managed by the automatic re-drive. Remove its category from history.TaskDLQRedriveCategories to operate on it manually.")
+
+	errInvalidVisibilityConsistencyCheckJobToken = serviceerror.NewInvalidArgument("Invalid visibility consistency check job token.")
+	errSecondaryVisibilityNotConfigured          = serviceerror.NewFailedPrecondition("Secondary visibility store is not configured.")
+	errInvalidReshardHistoryPageToken            = serviceerror.NewInvalidArgument("Invalid reshard history page token.")
+	errQueueActionNotSet                         = serviceerror.NewInvalidArgument("Queue action is not set on request.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/rpc/interceptor"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/telemetry"
 	nexusfrontend "go.temporal.io/server/components/nexusoperations/frontend"
 	"go.temporal.io/server/service"
@@ -180,6 +181,7 @@ func AuthorizationInterceptorProvider(
 		cfg.Global.Authorization.AuthExtraHeaderName,
 		serviceConfig.ExposeAuthorizerErrors,
 		dynamicconfig.EnableCrossNamespaceCommands.Get(dc),
+		sharedtaskqueue.Bindings.Get(dc),
 	)
 }
 
@@ -553,11 +555,13 @@ func NamespaceCountLimitInterceptorProvider(
 func NamespaceValidatorInterceptorProvider(
 	serviceConfig *Config,
 	namespaceRegistry namespace.Registry,
+	dc *dynamicconfig.Collection,
 ) *interceptor.NamespaceValidatorInterceptor {
 	return interceptor.NewNamespaceValidatorInterceptor(
 		namespaceRegistry,
 		serviceConfig.EnableTokenNamespaceEnforcement,
 		serviceConfig.MaxIDLengthLimit,
+		sharedtaskqueue.Bindings.Get(dc),
 	)
 }
 
@@ -666,6 +670,7 @@ func AdminHandlerProvider(
 	taskManager persistence.TaskManager,
 	fairTaskManager persistence.FairTaskManager,
 	persistenceExecutionManager persistence.ExecutionManager,
+	shardManager persistence.ShardManager,
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	persistenceMetadataManager persistence.MetadataManager,
 	clientFactory client.Factory,
@@ -686,6 +691,8 @@ func AdminHandlerProvider(
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
 	chasmRegistry *chasm.Registry,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -697,6 +704,7 @@ func AdminHandlerProvider(
 		taskManager,
 		fairTaskManager,
 		persistenceExecutionManager,
+		shardManager,
 		clusterMetadataManager,
 		persistenceMetadataManager,
 		clientFactory,
@@ -715,6 +723,8 @@ func AdminHandlerProvider(
 		eventSerializer,
 		timeSource,
 		chasmRegistry,
+		archivalMetadata,
+		archiverProvider,
 		taskCategoryRegistry,
 		matchingClient,
 	}
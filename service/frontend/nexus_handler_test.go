@@ -27,6 +27,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/util"
 )
 
@@ -128,6 +129,7 @@ func newOperationContext(options contextOptions) *operationContext {
 		"",
 		dynamicconfig.GetBoolPropertyFn(false), // exposeAuthorizerErrors
 		dynamicconfig.GetBoolPropertyFn(false), // enableCrossNamespaceCommands
+		dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding(nil)), // sharedTaskQueues
 	)
 	oc.namespaceConcurrencyLimitInterceptor = interceptor.NewConcurrentRequestLimitInterceptor(
 		nil,
@@ -101,6 +101,8 @@ type Config struct {
 	ForceNamespaceSelectedAPIAutoForwarding dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	NamespaceMinRetentionLocal              dynamicconfig.DurationPropertyFn
 	NamespaceMinRetentionGlobal             dynamicconfig.DurationPropertyFn
+	ReplicationWorkflowFilter               dynamicconfig.StringPropertyFnWithNamespaceFilter
+	AllowFailoverWithLocalOnlyWorkflows     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	SearchAttributesNumberOfKeysLimit dynamicconfig.IntPropertyFnWithNamespaceFilter
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -207,7 +209,9 @@ type Config struct {
 	LinkMaxSize        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MaxLinksPerRequest dynamicconfig.IntPropertyFnWithNamespaceFilter
 
-	AdminEnableListHistoryTasks dynamicconfig.BoolPropertyFn
+	AdminEnableListHistoryTasks          dynamicconfig.BoolPropertyFn
+	AdminTaskQueueStatsStreamMinInterval dynamicconfig.DurationPropertyFn
+	HistoryTaskDLQRedriveCategories      dynamicconfig.TypedPropertyFn[[]string]
 
 	MaskInternalErrorDetails dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -316,6 +320,8 @@ func NewConfig(
 		ForceNamespaceSelectedAPIAutoForwarding:  dynamicconfig.ForceNamespaceSelectedAPIAutoForwarding.Get(dc),
 		NamespaceMinRetentionLocal:               dynamicconfig.NamespaceMinRetentionLocal.Get(dc),
 		NamespaceMinRetentionGlobal:              dynamicconfig.NamespaceMinRetentionGlobal.Get(dc),
+		ReplicationWorkflowFilter:                dynamicconfig.ReplicationWorkflowFilter.Get(dc),
+		AllowFailoverWithLocalOnlyWorkflows:      dynamicconfig.AllowFailoverWithLocalOnlyWorkflows.Get(dc),
 		SearchAttributesNumberOfKeysLimit:        dynamicconfig.SearchAttributesNumberOfKeysLimit.Get(dc),
 		SearchAttributesSizeOfValueLimit:         dynamicconfig.SearchAttributesSizeOfValueLimit.Get(dc),
 		SearchAttributesTotalSizeLimit:           dynamicconfig.SearchAttributesTotalSizeLimit.Get(dc),
@@ -375,8 +381,10 @@ func NewConfig(
 		LinkMaxSize:        dynamicconfig.FrontendLinkMaxSize.Get(dc),
 		MaxLinksPerRequest: dynamicconfig.FrontendMaxLinksPerRequest.Get(dc),
 
-		CallbackEndpointConfigs:     callbacks.AllowedAddresses.Get(dc),
-		AdminEnableListHistoryTasks: dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		CallbackEndpointConfigs:              callbacks.AllowedAddresses.Get(dc),
+		AdminEnableListHistoryTasks:          dynamicconfig.AdminEnableListHistoryTasks.Get(dc),
+		AdminTaskQueueStatsStreamMinInterval: dynamicconfig.AdminTaskQueueStatsStreamMinInterval.Get(dc),
+		HistoryTaskDLQRedriveCategories:      dynamicconfig.HistoryTaskDLQRedriveCategories.Get(dc),
 
 		MaskInternalErrorDetails: dynamicconfig.FrontendMaskInternalErrorDetails.Get(dc),
 
@@ -0,0 +1,219 @@
+package frontend
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultBacklogPageSize = 100
+	// maxBacklogReadsPerPage bounds the number of persistence reads of a ListTaskQueueBacklog call,
+	// so that a filter that matches few tasks doesn't scan the whole backlog at once.
+	maxBacklogReadsPerPage = 10
+	// maxBacklogLookupsPerPage bounds the number of mutable state lookups of a ListTaskQueueBacklog
+	// call that resolves types, which also bounds the number of tasks it reads.
+	maxBacklogLookupsPerPage = 100
+	// defaultBacklogPurgeDuration is how long partitions apply a purge if the request doesn't say.
+	defaultBacklogPurgeDuration = time.Hour
+)
+
+type (
+	// backlogTaskDecoder turns persisted backlog tasks into readable form. It looks up the
+	// workflow and activity types in mutable state, once per run.
+	backlogTaskDecoder struct {
+		historyClient historyservice.HistoryServiceClient
+		namespaceID   string
+		taskType      enumspb.TaskQueueType
+		runs          map[string]backlogRun
+	}
+
+	backlogRun struct {
+		state *persistencespb.WorkflowMutableState
+		err   error
+	}
+)
+
+func newBacklogTaskDecoder(
+	historyClient historyservice.HistoryServiceClient,
+	namespaceID string,
+	taskType enumspb.TaskQueueType,
+) *backlogTaskDecoder {
+	return &backlogTaskDecoder{
+		historyClient: historyClient,
+		namespaceID:   namespaceID,
+		taskType:      taskType,
+		runs:          make(map[string]backlogRun),
+	}
+}
+
+// decode returns the fields of task that are stored with it. Workflow and activity types are
+// filled in by resolve.
+func (d *backlogTaskDecoder) decode(task *persistencespb.AllocatedTaskInfo, now time.Time) *adminservice.BacklogTask {
+	data := task.GetData()
+	result := &adminservice.BacklogTask{
+		TaskId:           task.GetTaskId(),
+		Pass:             task.GetTaskPass(),
+		WorkflowId:       data.GetWorkflowId(),
+		RunId:            data.GetRunId(),
+		ScheduledEventId: data.GetScheduledEventId(),
+		FairnessKey:      data.GetPriority().GetFairnessKey(),
+		PriorityKey:      data.GetPriority().GetPriorityKey(),
+		BuildId:          backlogTaskBuildID(data),
+		CreateTime:       data.GetCreateTime(),
+		ExpiryTime:       data.GetExpiryTime(),
+		RawTask:          data,
+	}
+	if createTime := data.GetCreateTime(); createTime != nil {
+		result.Age = durationpb.New(now.Sub(createTime.AsTime()))
+	}
+	return result
+}
+
+// lookups returns the number of mutable state lookups made so far.
+func (d *backlogTaskDecoder) lookups() int {
+	return len(d.runs)
+}
+
+// resolve fills in the workflow type, and for activity tasks the activity type and attempt,
+// from the mutable state of the task's run.
+func (d *backlogTaskDecoder) resolve(ctx context.Context, task *adminservice.BacklogTask) {
+	run, ok := d.runs[task.GetRunId()]
+	if !ok {
+		run.state, run.err = d.getMutableState(ctx, task.GetWorkflowId(), task.GetRunId())
+		d.runs[task.GetRunId()] = run
+	}
+	if run.err != nil {
+		task.LookupError = run.err.Error()
+		return
+	}
+	task.WorkflowType = run.state.GetExecutionInfo().GetWorkflowTypeName()
+	if d.taskType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return
+	}
+	activity, ok := run.state.GetActivityInfos()[task.GetScheduledEventId()]
+	if !ok {
+		task.LookupError = "activity not found"
+		return
+	}
+	task.ActivityType = activity.GetActivityType().GetName()
+	task.Attempt = activity.GetAttempt()
+}
+
+func (d *backlogTaskDecoder) getMutableState(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+) (*persistencespb.WorkflowMutableState, error) {
+	resp, err := d.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     d.namespaceID,
+		Execution:       &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		SkipForceReload: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if state := resp.GetCacheMutableState(); state != nil {
+		return state, nil
+	}
+	return resp.GetDatabaseMutableState(), nil
+}
+
+func backlogTaskBuildID(data *persistencespb.TaskInfo) string {
+	directive := data.GetVersionDirective()
+	if buildID := directive.GetAssignedBuildId(); buildID != "" {
+		return buildID
+	}
+	return directive.GetDeploymentVersion().GetBuildId()
+}
+
+// matchesStoredFields checks the parts of the filter that only need the stored task, so that
+// tasks can be skipped before looking up their types.
+func matchesStoredFields(filter *adminservice.BacklogTaskFilter, task *adminservice.BacklogTask) bool {
+	if filter.GetFairnessKey() != "" && filter.GetFairnessKey() != task.GetFairnessKey() {
+		return false
+	}
+	if filter.GetPriorityKey() != 0 && filter.GetPriorityKey() != task.GetPriorityKey() {
+		return false
+	}
+	if filter.GetBuildId() != "" && filter.GetBuildId() != task.GetBuildId() {
+		return false
+	}
+	age := task.GetAge().AsDuration()
+	if filter.GetMinAge() != nil && age < filter.GetMinAge().AsDuration() {
+		return false
+	}
+	if filter.GetMaxAge() != nil && age > filter.GetMaxAge().AsDuration() {
+		return false
+	}
+	return true
+}
+
+// filtersResolvedFields returns true if the filter needs the types of tasks to be resolved.
+func filtersResolvedFields(filter *adminservice.BacklogTaskFilter) bool {
+	return filter.GetWorkflowType() != "" || filter.GetActivityType() != ""
+}
+
+func matchesResolvedFields(filter *adminservice.BacklogTaskFilter, task *adminservice.BacklogTask) bool {
+	if filter.GetWorkflowType() != "" && filter.GetWorkflowType() != task.GetWorkflowType() {
+		return false
+	}
+	if filter.GetActivityType() != "" && filter.GetActivityType() != task.GetActivityType() {
+		return false
+	}
+	return true
+}
+
+func addToBacklogSummary(summary *adminservice.BacklogSummary, task *adminservice.BacklogTask) {
+	if summary.ByWorkflowType == nil {
+		summary.ByWorkflowType = make(map[string]int64)
+		summary.ByActivityType = make(map[string]int64)
+		summary.ByFairnessKey = make(map[string]int64)
+		summary.ByPriorityKey = make(map[int32]int64)
+		summary.ByBuildId = make(map[string]int64)
+	}
+	summary.Total++
+	if createTime := task.GetCreateTime(); createTime != nil &&
+		(summary.OldestCreateTime == nil || createTime.AsTime().Before(summary.OldestCreateTime.AsTime())) {
+		summary.OldestCreateTime = createTime
+	}
+	if task.GetWorkflowType() != "" {
+		summary.ByWorkflowType[task.GetWorkflowType()]++
+	}
+	if task.GetActivityType() != "" {
+		summary.ByActivityType[task.GetActivityType()]++
+	}
+	summary.ByFairnessKey[task.GetFairnessKey()]++
+	summary.ByPriorityKey[task.GetPriorityKey()]++
+	summary.ByBuildId[task.GetBuildId()]++
+}
+
+func newBacklogPurge(request *adminservice.PurgeTaskQueueBacklogRequest, now time.Time) *persistencespb.TaskQueueBacklogPurge {
+	createdBefore := request.GetCreatedBefore()
+	if createdBefore == nil {
+		createdBefore = timestamppb.New(now)
+	}
+	duration := defaultBacklogPurgeDuration
+	if request.GetDuration() != nil {
+		duration = request.GetDuration().AsDuration()
+	}
+	return &persistencespb.TaskQueueBacklogPurge{
+		WorkflowId:           request.GetWorkflowId(),
+		RunId:                request.GetRunId(),
+		FairnessKey:          request.GetFairnessKey(),
+		PriorityKey:          request.GetPriorityKey(),
+		BuildId:              request.GetBuildId(),
+		CreatedBefore:        createdBefore,
+		DestinationTaskQueue: request.GetDestinationTaskQueue(),
+		ExpireTime:           timestamppb.New(now.Add(duration)),
+		Metadata:             &taskqueuepb.ConfigMetadata{Reason: request.GetReason()},
+	}
+}
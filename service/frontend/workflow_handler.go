@@ -71,6 +71,7 @@ import (
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/searchattribute/sadefs"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
@@ -354,6 +355,10 @@ func (wh *WorkflowHandler) UpdateNamespace(ctx context.Context, request *workflo
 		return nil, errRequestNotSet
 	}
 
+	if err := wh.validateFailoverWithLocalOnlyWorkflows(ctx, request); err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.namespaceHandler.UpdateNamespace(ctx, request)
 	if err != nil {
 		return resp, err
@@ -361,6 +366,64 @@ func (wh *WorkflowHandler) UpdateNamespace(ctx context.Context, request *workflo
 	return resp, err
 }
 
+// validateFailoverWithLocalOnlyWorkflows refuses to fail over a namespace with a replication workflow filter while
+// it has running workflows that don't match the filter. Those workflows were never replicated, so they stay on this
+// cluster and can't make progress until the namespace fails back. Matching is done by visibility, i.e. against the
+// current search attributes of the workflows, which may differ from the ones they were filtered by when they started.
+func (wh *WorkflowHandler) validateFailoverWithLocalOnlyWorkflows(
+	ctx context.Context,
+	request *workflowservice.UpdateNamespaceRequest,
+) error {
+	activeClusterName := request.GetReplicationConfig().GetActiveClusterName()
+	if activeClusterName == "" {
+		return nil
+	}
+	namespaceName := namespace.Name(request.GetNamespace())
+	filter := wh.config.ReplicationWorkflowFilter(namespaceName.String())
+	if filter == "" {
+		return nil
+	}
+	namespaceEntry, err := wh.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return err
+	}
+	if !namespaceEntry.IsGlobalNamespace() || namespaceEntry.ActiveClusterName(namespace.EmptyBusinessID) == activeClusterName {
+		return nil
+	}
+
+	resp, err := wh.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceEntry.ID(),
+		Namespace:   namespaceName,
+		Query: fmt.Sprintf(
+			"%s = '%s' AND NOT (%s)",
+			sadefs.ExecutionStatus,
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String(),
+			filter,
+		),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Count == 0 {
+		return nil
+	}
+	if wh.config.AllowFailoverWithLocalOnlyWorkflows(namespaceName.String()) {
+		wh.logger.Warn("Failing over namespace with running workflows that were not replicated",
+			tag.WorkflowNamespace(namespaceName.String()),
+			tag.TargetCluster(activeClusterName),
+			tag.Counter(int(resp.Count)),
+		)
+		return nil
+	}
+	return serviceerror.NewFailedPreconditionf(
+		"namespace %s has %d running workflows that don't match its replication workflow filter and were not replicated to %s; set %s to fail over anyway",
+		namespaceName,
+		resp.Count,
+		activeClusterName,
+		dynamicconfig.AllowFailoverWithLocalOnlyWorkflows.Key(),
+	)
+}
+
 // DeprecateNamespace us used to update status of a registered namespace to DEPRECATED.  Once the namespace is deprecated
 // it cannot be used to start new workflow executions.  Existing workflow executions will continue to run on
 // deprecated namespaces.
@@ -520,6 +583,10 @@ func (wh *WorkflowHandler) prepareStartWorkflowRequest(
 		return nil, err
 	}
 
+	if _, err := tasklabels.FromHeader(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateWorkflowCompletionCallbacks(namespaceName, request.GetCompletionCallbacks()); err != nil {
 		return nil, err
 	}
@@ -894,6 +961,11 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	conditions, err := pollConditionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	callTime := time.Now().UTC()
 
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
@@ -919,6 +991,7 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		NamespaceId: namespaceID.String(),
 		PollerId:    pollerID,
 		PollRequest: request,
+		Conditions:  conditions,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueue, pollerID)
@@ -990,6 +1063,16 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	}, nil
 }
 
+// pollConditionsFromContext returns the poll conditions for the labels the worker advertised in
+// the request headers, or nil if it didn't advertise any.
+func pollConditionsFromContext(ctx context.Context) (*matchingservice.PollConditions, error) {
+	labels, err := tasklabels.FromContext(ctx)
+	if err != nil || len(labels) == 0 {
+		return nil, err
+	}
+	return &matchingservice.PollConditions{Labels: labels}, nil
+}
+
 func contextNearDeadline(ctx context.Context, tailroom time.Duration) bool {
 	if ctxDeadline, ok := ctx.Deadline(); ok {
 		return time.Now().Add(tailroom).After(ctxDeadline)
@@ -1157,6 +1240,11 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	conditions, err := pollConditionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
 	if err != nil {
 		return nil, err
@@ -1173,6 +1261,7 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		NamespaceId: namespaceID.String(),
 		PollerId:    pollerID,
 		PollRequest: request,
+		Conditions:  conditions,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(childCtx, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
@@ -2201,6 +2290,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, err
 	}
 
+	if _, err := tasklabels.FromHeader(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateLinks(namespaceName, request.GetLinks()); err != nil {
 		return nil, err
 	}
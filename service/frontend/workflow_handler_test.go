@@ -1807,6 +1807,45 @@ func (s *WorkflowHandlerSuite) TestUpdateNamespace_Success_ArchivalNeverEnabledT
 	s.Equal(testVisibilityArchivalURI, result.Config.GetVisibilityArchivalUri())
 }
 
+func (s *WorkflowHandlerSuite) TestUpdateNamespace_Failover_LocalOnlyWorkflows() {
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.testNamespaceID.String(), Name: s.testNamespace.String()},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		1,
+	)
+	failoverRequest := &workflowservice.UpdateNamespaceRequest{
+		Namespace: s.testNamespace.String(),
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.testNamespace).Return(namespaceEntry, nil).AnyTimes()
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.testNamespaceID,
+		Namespace:   s.testNamespace,
+		Query:       "ExecutionStatus = 'Running' AND NOT (WorkflowType = 'Order')",
+	}).Return(&manager.CountWorkflowExecutionsResponse{Count: 3}, nil).Times(2)
+
+	config := s.newConfig()
+	config.ReplicationWorkflowFilter = dc.GetStringPropertyFnFilteredByNamespace("WorkflowType = 'Order'")
+	wh := s.getWorkflowHandler(config)
+
+	_, err := wh.UpdateNamespace(context.Background(), failoverRequest)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	// With failover of local-only workflows allowed, the request reaches the namespace handler.
+	config.AllowFailoverWithLocalOnlyWorkflows = dc.GetBoolPropertyFnFilteredByNamespace(true)
+	metadataErr := serviceerror.NewUnavailable("metadata unavailable")
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(nil, metadataErr)
+	_, err = wh.UpdateNamespace(context.Background(), failoverRequest)
+	s.ErrorIs(err, metadataErr)
+}
+
 func (s *WorkflowHandlerSuite) TestHistoryArchived() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
@@ -4,10 +4,13 @@ import (
 	"context"
 
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	historyi "go.temporal.io/server/service/history/interfaces"
 )
 
@@ -78,3 +81,46 @@ func IsActivityTaskNotFoundForToken(
 	}
 	return false
 }
+
+// NewReleaseConcurrencySlotRequest returns the request to release the task queue concurrency slot
+// held by the current attempt of the activity, or nil if it doesn't hold one. It must be called
+// before the attempt is retried or closed.
+func NewReleaseConcurrencySlotRequest(
+	mutableState historyi.MutableState,
+	ai *persistencespb.ActivityInfo,
+) *matchingservice.ReleaseConcurrencySlotRequest {
+	if ai.GetConcurrencySlotPartition() == nil || ai.GetStartedEventId() == common.EmptyEventID {
+		return nil
+	}
+	workflowKey := mutableState.GetWorkflowKey()
+	return &matchingservice.ReleaseConcurrencySlotRequest{
+		NamespaceId:        workflowKey.NamespaceID,
+		TaskQueuePartition: ai.GetConcurrencySlotPartition(),
+		WorkflowId:         workflowKey.WorkflowID,
+		RunId:              workflowKey.RunID,
+		ScheduledEventId:   ai.GetScheduledEventId(),
+		Attempt:            ai.GetAttempt(),
+	}
+}
+
+// ReleaseConcurrencySlot asks matching to release a task queue concurrency slot, if request is
+// not nil. Errors are only logged since matching expires slots that are never released.
+func ReleaseConcurrencySlot(
+	ctx context.Context,
+	matchingClient matchingservice.MatchingServiceClient,
+	logger log.Logger,
+	request *matchingservice.ReleaseConcurrencySlotRequest,
+) {
+	if request == nil {
+		return
+	}
+	if _, err := matchingClient.ReleaseConcurrencySlot(ctx, request); err != nil {
+		logger.Warn("Failed to release task queue concurrency slot",
+			tag.WorkflowNamespaceID(request.GetNamespaceId()),
+			tag.WorkflowID(request.GetWorkflowId()),
+			tag.WorkflowRunID(request.GetRunId()),
+			tag.WorkflowScheduledEventID(request.GetScheduledEventId()),
+			tag.Error(err),
+		)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/retrypolicy"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/service/history/configs"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -122,6 +123,10 @@ func (v *CommandAttrValidator) ValidateActivityScheduleAttributes(
 		return failedCause, err
 	}
 
+	if _, err := tasklabels.FromHeader(attributes.GetHeader()); err != nil {
+		return failedCause, err
+	}
+
 	attributes.ScheduleToCloseTimeout = opts.ScheduleToCloseTimeout
 	attributes.ScheduleToStartTimeout = opts.ScheduleToStartTimeout
 	attributes.StartToCloseTimeout = opts.StartToCloseTimeout
@@ -441,6 +446,10 @@ func (v *CommandAttrValidator) ValidateContinueAsNewWorkflowExecutionAttributes(
 		return failedCause, fmt.Errorf("invalid WorkflowRetryPolicy on ContinueAsNewWorkflowExecutionCommand: %w. WorkflowType=%s TaskQueue=%s", err, wfType, attributes.TaskQueue)
 	}
 
+	if _, err := tasklabels.FromHeader(attributes.GetHeader()); err != nil {
+		return failedCause, fmt.Errorf("invalid required labels on ContinueAsNewWorkflowExecutionCommand: %w. WorkflowType=%s TaskQueue=%s", err, wfType, attributes.TaskQueue)
+	}
+
 	if err := v.searchAttributesValidator.Validate(attributes.GetSearchAttributes(), namespaceName.String()); err != nil {
 		return enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES, fmt.Errorf("invalid SearchAttributes on ContinueAsNewWorkflowExecutionCommand: %w. WorkflowType=%s TaskQueue=%s", err, wfType, attributes.TaskQueue)
 	}
@@ -527,6 +536,10 @@ func (v *CommandAttrValidator) ValidateStartChildExecutionAttributes(
 		return failedCause, err
 	}
 
+	if _, err := tasklabels.FromHeader(attributes.GetHeader()); err != nil {
+		return failedCause, err
+	}
+
 	// Inherit taskqueue from parent workflow execution if not provided on command
 	if attributes.TaskQueue == nil {
 		attributes.TaskQueue = &taskqueuepb.TaskQueue{
@@ -245,6 +245,16 @@ func recordActivityTaskStarted(
 	); err != nil {
 		return nil, rejectCodeUndefined, err
 	}
+	// Remember which matching partition holds a concurrency slot for this attempt, so that it can
+	// be released when the attempt ends.
+	if slotPartition := request.GetConcurrencySlotPartition(); slotPartition != nil || ai.GetConcurrencySlotPartition() != nil {
+		if err := mutableState.UpdateActivity(scheduledEventID, func(activityInfo *persistencespb.ActivityInfo, _ historyi.MutableState) error {
+			activityInfo.ConcurrencySlotPartition = slotPartition
+			return nil
+		}); err != nil {
+			return nil, rejectCodeUndefined, err
+		}
+	}
 
 	scheduleToStartLatency := ai.GetStartedTime().AsTime().Sub(ai.GetScheduledTime().AsTime())
 	metrics.TaskScheduleToStartLatency.With(
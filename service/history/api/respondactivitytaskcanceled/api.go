@@ -6,6 +6,7 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -22,6 +23,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskCanceledRequest,
 	shard historyi.ShardContext,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	matchingClient matchingservice.MatchingServiceClient,
 ) (resp *historyservice.RespondActivityTaskCanceledResponse, retError error) {
 	request := req.CancelRequest
 	tokenSerializer := tasktoken.NewSerializer()
@@ -44,6 +46,7 @@ func Invoke(
 	var taskQueue string
 	var workflowTypeName string
 	var versioningBehavior enumspb.VersioningBehavior
+	var releaseSlotRequest *matchingservice.ReleaseConcurrencySlotRequest
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
@@ -80,6 +83,7 @@ func Invoke(
 			if !isRunning || api.IsActivityTaskNotFoundForToken(token, ai, nil) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			releaseSlotRequest = api.NewReleaseConcurrencySlotRequest(mutableState, ai)
 
 			// sanity check if activity is requested to be cancelled
 			if !ai.CancelRequested {
@@ -111,6 +115,7 @@ func Invoke(
 	)
 
 	if err == nil {
+		api.ReleaseConcurrencySlot(ctx, matchingClient, shard.GetLogger(), releaseSlotRequest)
 		workflow.RecordActivityCompletionMetrics(
 			shard,
 			namespaceName,
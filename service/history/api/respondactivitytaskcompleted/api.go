@@ -6,6 +6,7 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -22,6 +23,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskCompletedRequest,
 	shard historyi.ShardContext,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	matchingClient matchingservice.MatchingServiceClient,
 ) (resp *historyservice.RespondActivityTaskCompletedResponse, retError error) {
 	tokenSerializer := tasktoken.NewSerializer()
 	request := req.CompleteRequest
@@ -45,6 +47,7 @@ func Invoke(
 	var workflowTypeName string
 	var fabricateStartedEvent bool
 	var versioningBehavior enumspb.VersioningBehavior
+	var releaseSlotRequest *matchingservice.ReleaseConcurrencySlotRequest
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
@@ -83,6 +86,7 @@ func Invoke(
 			if !isRunning || api.IsActivityTaskNotFoundForToken(token, ai, &isCompletedByID) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			releaseSlotRequest = api.NewReleaseConcurrencySlotRequest(mutableState, ai)
 
 			// We fabricate a started event only when the activity is not started yet and
 			// we need to force complete an activity
@@ -127,6 +131,7 @@ func Invoke(
 	)
 
 	if err == nil {
+		api.ReleaseConcurrencySlot(ctx, matchingClient, shard.GetLogger(), releaseSlotRequest)
 		workflow.RecordActivityCompletionMetrics(
 			shard,
 			namespaceName,
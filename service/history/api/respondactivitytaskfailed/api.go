@@ -7,6 +7,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -23,6 +24,7 @@ func Invoke(
 	req *historyservice.RespondActivityTaskFailedRequest,
 	shard historyi.ShardContext,
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	matchingClient matchingservice.MatchingServiceClient,
 ) (resp *historyservice.RespondActivityTaskFailedResponse, retError error) {
 	request := req.FailedRequest
 	tokenSerializer := tasktoken.NewSerializer()
@@ -46,6 +48,7 @@ func Invoke(
 	var workflowTypeName string
 	var closed bool
 	var versioningBehavior enumspb.VersioningBehavior
+	var releaseSlotRequest *matchingservice.ReleaseConcurrencySlotRequest
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
 		token.Clock,
@@ -82,6 +85,7 @@ func Invoke(
 			if !activityRunning || api.IsActivityTaskNotFoundForToken(token, ai, nil) {
 				return nil, consts.ErrActivityTaskNotFound
 			}
+			releaseSlotRequest = api.NewReleaseConcurrencySlotRequest(mutableState, ai)
 
 			if request.GetLastHeartbeatDetails() != nil {
 				// Save heartbeat details as progress
@@ -125,6 +129,7 @@ func Invoke(
 		workflowConsistencyChecker,
 	)
 	if err == nil {
+		api.ReleaseConcurrencySlot(ctx, matchingClient, shard.GetLogger(), releaseSlotRequest)
 		completionMetrics := workflow.ActivityCompletionMetrics{
 			AttemptStartedTime: attemptStartedTime,
 			FirstScheduledTime: firstScheduledTime,
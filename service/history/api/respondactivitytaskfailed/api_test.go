@@ -12,13 +12,17 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/cluster/clustertest"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -31,6 +35,8 @@ import (
 	"go.temporal.io/server/service/history/tests"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
@@ -44,6 +50,7 @@ type (
 
 		workflowCache              *wcache.MockCache
 		workflowConsistencyChecker api.WorkflowConsistencyChecker
+		matchingClient             *matchingservicemock.MockMatchingServiceClient
 
 		workflowContext     *historyi.MockWorkflowContext
 		currentMutableState *historyi.MockMutableState
@@ -66,6 +73,7 @@ func (s *workflowSuite) TearDownSuite() {
 func (s *workflowSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
+	s.matchingClient = matchingservicemock.NewMockMatchingServiceClient(s.controller)
 }
 
 func (s *workflowSuite) TearDownTest() {
@@ -112,7 +120,44 @@ func (s *workflowSuite) Test_NormalFlowShouldRescheduleActivity_UpdatesWorkflowE
 	s.workflowContext.EXPECT().UpdateWorkflowExecutionAsActive(ctx, s.shardContext).Return(nil)
 	s.currentMutableState.EXPECT().GetEffectiveVersioningBehavior().Return(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED)
 
-	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker)
+	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker, s.matchingClient)
+	s.NoError(err)
+}
+
+func (s *workflowSuite) Test_ConcurrencySlotHeld_ReleasesSlotAfterUpdate() {
+	ctx := context.Background()
+	uc := newUseCase(UsecaseConfig{
+		attempt:             int32(3),
+		startedEventId:      int64(40),
+		scheduledEventId:    int64(42),
+		taskQueueId:         "some-task-queue",
+		expectRetryActivity: true,
+		isCacheStale:        false,
+		retryActivityState:  enumspb.RETRY_STATE_IN_PROGRESS,
+	})
+	request := s.newRespondActivityTaskFailedRequest(uc)
+	s.setupStubs(uc)
+	slotPartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     uc.taskQueueId,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 2},
+	}
+	s.activityInfo.ConcurrencySlotPartition = slotPartition
+
+	s.expectTransientFailureMetricsRecorded(uc, s.shardContext)
+	s.currentMutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(uc.namespaceId.String(), tests.WorkflowID, tests.RunID))
+	s.workflowContext.EXPECT().UpdateWorkflowExecutionAsActive(ctx, s.shardContext).Return(nil)
+	s.currentMutableState.EXPECT().GetEffectiveVersioningBehavior().Return(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED)
+	s.matchingClient.EXPECT().ReleaseConcurrencySlot(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.ReleaseConcurrencySlotRequest, _ ...grpc.CallOption) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+			s.Equal(tests.RunID, req.GetRunId())
+			s.Equal(uc.scheduledEventId, req.GetScheduledEventId())
+			s.Equal(uc.attempt, req.GetAttempt())
+			s.True(proto.Equal(slotPartition, req.GetTaskQueuePartition()))
+			return &matchingservice.ReleaseConcurrencySlotResponse{}, nil
+		})
+
+	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker, s.matchingClient)
 	s.NoError(err)
 }
 
@@ -130,6 +175,7 @@ func (s *workflowSuite) Test_WorkflowExecutionIsNotRunning_ReturnWorkflowNotRunn
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrWorkflowCompleted, err)
@@ -153,6 +199,7 @@ func (s *workflowSuite) Test_CacheRefreshRequired_ReturnCacheStaleError() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrStaleState, err)
@@ -174,6 +221,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_ActivityNotRunning() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -196,6 +244,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_TokenVersionDoesNotMatchAc
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -219,6 +268,7 @@ func (s *workflowSuite) Test_ActivityTaskDoesNotExist_TokenVersionNonZeroAndAtte
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 	s.Error(err)
 	s.EqualValues(consts.ErrActivityTaskNotFound, err)
@@ -255,6 +305,7 @@ func (s *workflowSuite) Test_LastHeartBeatDetailsExist_UpdatesMutableState() {
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 
 	s.NoError(err)
@@ -278,6 +329,7 @@ func (s *workflowSuite) Test_RetryActivityFailsWithAnError_WillReturnTheError()
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 
 	s.Error(err)
@@ -310,7 +362,7 @@ func (s *workflowSuite) Test_NoMoreRetriesAndMutableStateHasNoPendingTasks_WillR
 	s.currentMutableState.EXPECT().GetEffectiveVersioningBehavior().Return(enumspb.VERSIONING_BEHAVIOR_UNSPECIFIED)
 	s.workflowContext.EXPECT().UpdateWorkflowExecutionAsActive(ctx, s.shardContext).Return(nil)
 
-	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker)
+	_, err := Invoke(ctx, request, s.shardContext, s.workflowConsistencyChecker, s.matchingClient)
 
 	s.NoError(err)
 }
@@ -341,6 +393,7 @@ func (s *workflowSuite) Test_AttemptToAddActivityTaskFailedEventFails_ReturnErro
 		request,
 		s.shardContext,
 		s.workflowConsistencyChecker,
+		s.matchingClient,
 	)
 
 	s.Error(err)
@@ -184,9 +184,11 @@ func (f *archivalQueueFactory) newScheduledQueue(shard historyi.ShardContext, ex
 				MaxPredicateSize:     f.Config.QueueMaxPredicateSize,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:             f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:           f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:           f.Config.QueueCriticalSlicesCount,
+				NamespaceTaskLatencyCriticalThreshold: f.Config.QueueNamespaceTaskLatencyCriticalThreshold,
+				TaskAttemptsCriticalThreshold:         f.Config.QueueTaskAttemptsCriticalThreshold,
 			},
 			MaxPollRPS:                          f.Config.ArchivalProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.ArchivalProcessorMaxPollInterval,
@@ -97,18 +97,24 @@ type Config struct {
 	StandbyTaskMissingEventsResendDelay  dynamicconfig.DurationPropertyFnWithTaskTypeFilter
 	StandbyTaskMissingEventsDiscardDelay dynamicconfig.DurationPropertyFnWithTaskTypeFilter
 
-	QueuePendingTaskCriticalCount     dynamicconfig.IntPropertyFn
-	QueueReaderStuckCriticalAttempts  dynamicconfig.IntPropertyFn
-	QueueCriticalSlicesCount          dynamicconfig.IntPropertyFn
-	QueuePendingTaskMaxCount          dynamicconfig.IntPropertyFn
-	QueueMaxPredicateSize             dynamicconfig.IntPropertyFn
-	QueueMoveGroupTaskCountBase       dynamicconfig.IntPropertyFn
-	QueueMoveGroupTaskCountMultiplier dynamicconfig.FloatPropertyFn
+	QueuePendingTaskCriticalCount              dynamicconfig.IntPropertyFn
+	QueueReaderStuckCriticalAttempts           dynamicconfig.IntPropertyFn
+	QueueCriticalSlicesCount                   dynamicconfig.IntPropertyFn
+	QueueNamespaceTaskLatencyCriticalThreshold dynamicconfig.DurationPropertyFn
+	QueueTaskAttemptsCriticalThreshold         dynamicconfig.IntPropertyFn
+	QueuePendingTaskMaxCount                   dynamicconfig.IntPropertyFn
+	QueueMaxPredicateSize                      dynamicconfig.IntPropertyFn
+	QueueMoveGroupTaskCountBase                dynamicconfig.IntPropertyFn
+	QueueMoveGroupTaskCountMultiplier          dynamicconfig.FloatPropertyFn
 
 	TaskDLQEnabled                 dynamicconfig.BoolPropertyFn
 	TaskDLQUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 	TaskDLQInternalErrors          dynamicconfig.BoolPropertyFn
 	TaskDLQErrorPattern            dynamicconfig.StringPropertyFn
+	TaskDLQRedriveCategories       dynamicconfig.TypedPropertyFn[[]string]
+	TaskDLQRedrivePolicies         dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]dynamicconfig.DLQRedrivePolicy]
+	TaskDLQRedriveInterval         dynamicconfig.DurationPropertyFn
+	TaskDLQRedriveOutcomeTimeout   dynamicconfig.DurationPropertyFn
 
 	TaskSchedulerEnableRateLimiter            dynamicconfig.BoolPropertyFn
 	TaskSchedulerEnableRateLimiterShadowMode  dynamicconfig.BoolPropertyFn
@@ -273,6 +279,7 @@ type Config struct {
 	ReplicationEnableDLQMetrics                          dynamicconfig.BoolPropertyFn
 	ReplicationEnableUpdateWithNewTaskMerge              dynamicconfig.BoolPropertyFn
 	ReplicationMultipleBatches                           dynamicconfig.BoolPropertyFn
+	ReplicationWorkflowFilter                            dynamicconfig.StringPropertyFnWithNamespaceFilter
 	ReplicationStreamSenderErrorRetryWait                dynamicconfig.DurationPropertyFn
 	ReplicationStreamSenderErrorRetryBackoffCoefficient  dynamicconfig.FloatPropertyFn
 	ReplicationStreamSenderErrorRetryMaxInterval         dynamicconfig.DurationPropertyFn
@@ -294,6 +301,10 @@ type Config struct {
 	EnableReplicationTaskTieredProcessing               dynamicconfig.BoolPropertyFn
 	ReplicationStreamSenderHighPriorityQPS              dynamicconfig.IntPropertyFn
 	ReplicationStreamSenderLowPriorityQPS               dynamicconfig.IntPropertyFn
+	ReplicationStreamSenderMaxBatchSize                 dynamicconfig.IntPropertyFn
+	ReplicationStreamSenderMaxBatchBytes                dynamicconfig.IntPropertyFn
+	ReplicationStreamCompression                        dynamicconfig.StringPropertyFn
+	ReplicationStreamReceiverMaxBatchSize               dynamicconfig.IntPropertyFn
 	ReplicationStreamEventLoopRetryMaxAttempts          dynamicconfig.IntPropertyFn
 	ReplicationReceiverMaxOutstandingTaskCount          dynamicconfig.IntPropertyFn
 	ReplicationReceiverSlowSubmissionLatencyThreshold   dynamicconfig.DurationPropertyFn
@@ -497,18 +508,24 @@ func NewConfig(
 		StandbyTaskMissingEventsResendDelay:  dynamicconfig.StandbyTaskMissingEventsResendDelay.Get(dc),
 		StandbyTaskMissingEventsDiscardDelay: dynamicconfig.StandbyTaskMissingEventsDiscardDelay.Get(dc),
 
-		QueuePendingTaskCriticalCount:     dynamicconfig.QueuePendingTaskCriticalCount.Get(dc),
-		QueueReaderStuckCriticalAttempts:  dynamicconfig.QueueReaderStuckCriticalAttempts.Get(dc),
-		QueueCriticalSlicesCount:          dynamicconfig.QueueCriticalSlicesCount.Get(dc),
-		QueuePendingTaskMaxCount:          dynamicconfig.QueuePendingTaskMaxCount.Get(dc),
-		QueueMaxPredicateSize:             dynamicconfig.QueueMaxPredicateSize.Get(dc),
-		QueueMoveGroupTaskCountBase:       dynamicconfig.QueueMoveGroupTaskCountBase.Get(dc),
-		QueueMoveGroupTaskCountMultiplier: dynamicconfig.QueueMoveGroupTaskCountMultiplier.Get(dc),
+		QueuePendingTaskCriticalCount:              dynamicconfig.QueuePendingTaskCriticalCount.Get(dc),
+		QueueReaderStuckCriticalAttempts:           dynamicconfig.QueueReaderStuckCriticalAttempts.Get(dc),
+		QueueCriticalSlicesCount:                   dynamicconfig.QueueCriticalSlicesCount.Get(dc),
+		QueueNamespaceTaskLatencyCriticalThreshold: dynamicconfig.QueueNamespaceTaskLatencyCriticalThreshold.Get(dc),
+		QueueTaskAttemptsCriticalThreshold:         dynamicconfig.QueueTaskAttemptsCriticalThreshold.Get(dc),
+		QueuePendingTaskMaxCount:                   dynamicconfig.QueuePendingTaskMaxCount.Get(dc),
+		QueueMaxPredicateSize:                      dynamicconfig.QueueMaxPredicateSize.Get(dc),
+		QueueMoveGroupTaskCountBase:                dynamicconfig.QueueMoveGroupTaskCountBase.Get(dc),
+		QueueMoveGroupTaskCountMultiplier:          dynamicconfig.QueueMoveGroupTaskCountMultiplier.Get(dc),
 
 		TaskDLQEnabled:                 dynamicconfig.HistoryTaskDLQEnabled.Get(dc),
 		TaskDLQUnexpectedErrorAttempts: dynamicconfig.HistoryTaskDLQUnexpectedErrorAttempts.Get(dc),
 		TaskDLQInternalErrors:          dynamicconfig.HistoryTaskDLQInternalErrors.Get(dc),
 		TaskDLQErrorPattern:            dynamicconfig.HistoryTaskDLQErrorPattern.Get(dc),
+		TaskDLQRedriveCategories:       dynamicconfig.HistoryTaskDLQRedriveCategories.Get(dc),
+		TaskDLQRedrivePolicies:         dynamicconfig.HistoryTaskDLQRedrivePolicies.Get(dc),
+		TaskDLQRedriveInterval:         dynamicconfig.HistoryTaskDLQRedriveInterval.Get(dc),
+		TaskDLQRedriveOutcomeTimeout:   dynamicconfig.HistoryTaskDLQRedriveOutcomeTimeout.Get(dc),
 
 		TaskSchedulerEnableRateLimiter:            dynamicconfig.TaskSchedulerEnableRateLimiter.Get(dc),
 		TaskSchedulerEnableRateLimiterShadowMode:  dynamicconfig.TaskSchedulerEnableRateLimiterShadowMode.Get(dc),
@@ -586,6 +603,10 @@ func NewConfig(
 		EnableReplicationTaskTieredProcessing:               dynamicconfig.EnableReplicationTaskTieredProcessing.Get(dc),
 		ReplicationStreamSenderHighPriorityQPS:              dynamicconfig.ReplicationStreamSenderHighPriorityQPS.Get(dc),
 		ReplicationStreamSenderLowPriorityQPS:               dynamicconfig.ReplicationStreamSenderLowPriorityQPS.Get(dc),
+		ReplicationStreamSenderMaxBatchSize:                 dynamicconfig.ReplicationStreamSenderMaxBatchSize.Get(dc),
+		ReplicationStreamSenderMaxBatchBytes:                dynamicconfig.ReplicationStreamSenderMaxBatchBytes.Get(dc),
+		ReplicationStreamCompression:                        dynamicconfig.ReplicationStreamCompression.Get(dc),
+		ReplicationStreamReceiverMaxBatchSize:               dynamicconfig.ReplicationStreamReceiverMaxBatchSize.Get(dc),
 		ReplicationStreamEventLoopRetryMaxAttempts:          dynamicconfig.ReplicationStreamEventLoopRetryMaxAttempts.Get(dc),
 		ReplicationReceiverMaxOutstandingTaskCount:          dynamicconfig.ReplicationReceiverMaxOutstandingTaskCount.Get(dc),
 		ReplicationReceiverSlowSubmissionLatencyThreshold:   dynamicconfig.ReplicationReceiverSlowSubmissionLatencyThreshold.Get(dc),
@@ -667,6 +688,7 @@ func NewConfig(
 		ReplicationTaskProcessorCleanupInterval:              dynamicconfig.ReplicationTaskProcessorCleanupInterval.Get(dc),
 		ReplicationTaskProcessorCleanupJitterCoefficient:     dynamicconfig.ReplicationTaskProcessorCleanupJitterCoefficient.Get(dc),
 		ReplicationMultipleBatches:                           dynamicconfig.ReplicationMultipleBatches.Get(dc),
+		ReplicationWorkflowFilter:                            dynamicconfig.ReplicationWorkflowFilter.Get(dc),
 
 		ReplicationStreamSenderErrorRetryWait:               dynamicconfig.ReplicationStreamSenderErrorRetryWait.Get(dc),
 		ReplicationStreamSenderErrorRetryBackoffCoefficient: dynamicconfig.ReplicationStreamSenderErrorRetryBackoffCoefficient.Get(dc),
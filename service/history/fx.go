@@ -10,6 +10,7 @@ import (
 	"go.temporal.io/server/common"
 	commoncache "go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -17,6 +18,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -40,8 +42,10 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 	"go.uber.org/fx"
@@ -80,6 +84,7 @@ var Module = fx.Options(
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
 	fx.Provide(HistoryEngineFactoryProvider),
+	fx.Provide(DLQRedriverProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(ServerProvider),
 	fx.Provide(NewService),
@@ -132,6 +137,7 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 		taskQueueManager:             args.TaskQueueManager,
 		taskCategoryRegistry:         args.TaskCategoryRegistry,
 		dlqMetricsEmitter:            args.DLQMetricsEmitter,
+		dlqRedriver:                  args.DLQRedriver,
 		chasmEngine:                  args.ChasmEngine,
 		chasmRegistry:                args.ChasmRegistry,
 
@@ -144,6 +150,40 @@ func HandlerProvider(args NewHandlerArgs) *Handler {
 	return handler
 }
 
+func DLQRedriverProvider(
+	config *configs.Config,
+	historyTaskQueueManager persistence.HistoryTaskQueueManager,
+	historyClient resource.HistoryClient,
+	serializer serialization.Serializer,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) *queues.DLQRedriver {
+	return queues.NewDLQRedriver(
+		historyTaskQueueManager,
+		historyClient,
+		serializer,
+		taskCategoryRegistry,
+		namespaceRegistry,
+		clusterMetadata,
+		historyServiceResolver,
+		hostInfoProvider,
+		timeSource,
+		metricsHandler,
+		logger,
+		int(config.NumberOfShards),
+		config.TaskDLQRedriveCategories,
+		config.TaskDLQRedrivePolicies,
+		config.TaskDLQRedriveInterval,
+		config.TaskDLQRedriveOutcomeTimeout,
+	)
+}
+
 func HistoryEngineFactoryProvider(
 	params HistoryEngineFactoryParams,
 ) shard.EngineFactory {
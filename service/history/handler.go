@@ -54,6 +54,7 @@ import (
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -95,6 +96,7 @@ type (
 		taskQueueManager             persistence.HistoryTaskQueueManager
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
+		dlqRedriver                  *queues.DLQRedriver
 		chasmEngine                  chasm.Engine
 		chasmRegistry                *chasm.Registry
 
@@ -131,6 +133,7 @@ type (
 		TaskQueueManager             persistence.HistoryTaskQueueManager
 		TaskCategoryRegistry         tasks.TaskCategoryRegistry
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
+		DLQRedriver                  *queues.DLQRedriver
 		ChasmEngine                  chasm.Engine
 		ChasmRegistry                *chasm.Registry
 
@@ -175,6 +178,7 @@ func (h *Handler) Start() {
 	h.eventNotifier.Start()
 	h.controller.Start()
 	h.dlqMetricsEmitter.Start()
+	h.dlqRedriver.Start()
 }
 
 // Stop stops the handler
@@ -192,6 +196,7 @@ func (h *Handler) Stop() {
 	h.controller.Stop()
 	h.eventNotifier.Stop()
 	h.dlqMetricsEmitter.Stop()
+	h.dlqRedriver.Stop()
 }
 
 func (h *Handler) DeepHealthCheck(
@@ -1960,6 +1965,7 @@ func (h *Handler) StreamWorkflowReplicationMessages(
 		clientShardCount,
 		replication.NewClusterShardKey(clientClusterShardID.ClusterID, clientClusterShardID.ShardID),
 		replication.NewClusterShardKey(serverClusterShardID.ClusterID, serverClusterShardID.ShardID),
+		replication.DecodeStreamCapabilities(headers.NewGRPCHeaderGetter(server.Context())),
 		h.config,
 	)
 	streamSender.Start()
@@ -2140,6 +2146,27 @@ func (h *Handler) ListTasks(
 	return resp, nil
 }
 
+// RunQueueAction runs a mitigation action on a queue of a shard hosted by this instance
+func (h *Handler) RunQueueAction(
+	ctx context.Context,
+	request *historyservice.RunQueueActionRequest,
+) (*historyservice.RunQueueActionResponse, error) {
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.RunQueueAction(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 func (h *Handler) CompleteNexusOperation(ctx context.Context, request *historyservice.CompleteNexusOperationRequest) (*historyservice.CompleteNexusOperationResponse, error) {
 	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespace.ID(request.Completion.NamespaceId), request.Completion.WorkflowId)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -590,7 +591,7 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCompletedRequest,
 ) (*historyservice.RespondActivityTaskCompletedResponse, error) {
-	return respondactivitytaskcompleted.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+	return respondactivitytaskcompleted.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RespondActivityTaskFailed completes an activity task failure.
@@ -598,7 +599,7 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskFailedRequest,
 ) (*historyservice.RespondActivityTaskFailedResponse, error) {
-	return respondactivitytaskfailed.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+	return respondactivitytaskfailed.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RespondActivityTaskCanceled completes an activity task failure.
@@ -606,7 +607,7 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(
 	ctx context.Context,
 	req *historyservice.RespondActivityTaskCanceledRequest,
 ) (*historyservice.RespondActivityTaskCanceledResponse, error) {
-	return respondactivitytaskcanceled.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker)
+	return respondactivitytaskcanceled.Invoke(ctx, req, e.shardContext, e.workflowConsistencyChecker, e.matchingClient)
 }
 
 // RecordActivityTaskHeartbeat records an hearbeat for a task.
@@ -807,6 +808,7 @@ func (e *historyEngineImpl) ImportWorkflowExecution(
 		request.VersionHistory.Items,
 		historyEvents,
 		request.Token,
+		request.RestoredFromArchival,
 	)
 	if err != nil {
 		return nil, err
@@ -1069,6 +1071,31 @@ func (e *historyEngineImpl) ListTasks(
 	)
 }
 
+func (e *historyEngineImpl) RunQueueAction(
+	ctx context.Context,
+	request *historyservice.RunQueueActionRequest,
+) (_ *historyservice.RunQueueActionResponse, retError error) {
+	category, ok := e.taskCategoryRegistry.GetCategoryByID(int(request.GetCategory()))
+	if !ok {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid task category ID: %v", request.GetCategory())
+	}
+	queue, ok := e.queueProcessors[category]
+	if !ok {
+		return nil, serviceerror.NewInvalidArgumentf("No queue processor registered for task category: %v", category.Name())
+	}
+
+	actionTaken, err := queue.RunAction(ctx, &queues.ActionRequest{
+		Action:      request.GetAction(),
+		NamespaceID: request.GetNamespaceId(),
+		ReaderID:    request.GetReaderId(),
+		Threshold:   int(request.GetThreshold()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.RunQueueActionResponse{ActionTaken: actionTaken}, nil
+}
+
 // StateMachineEnvironment implements shard.Engine.
 func (e *historyEngineImpl) StateMachineEnvironment(
 	operationTag metrics.Tag,
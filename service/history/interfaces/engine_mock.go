@@ -847,6 +847,21 @@ func (mr *MockEngineMockRecorder) RespondWorkflowTaskFailed(ctx, request any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondWorkflowTaskFailed), ctx, request)
 }
 
+// RunQueueAction mocks base method.
+func (m *MockEngine) RunQueueAction(ctx context.Context, request *historyservice.RunQueueActionRequest) (*historyservice.RunQueueActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunQueueAction", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RunQueueActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunQueueAction indicates an expected call of RunQueueAction.
+func (mr *MockEngineMockRecorder) RunQueueAction(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunQueueAction", reflect.TypeOf((*MockEngine)(nil).RunQueueAction), ctx, request)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockEngine) ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ import (
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/replication/workflowfilter"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -53,6 +54,7 @@ type (
 
 		GetSearchAttributesProvider() searchattribute.Provider
 		GetSearchAttributesMapperProvider() searchattribute.MapperProvider
+		GetReplicationWorkflowFilter() *workflowfilter.Filter
 		GetArchivalMetadata() archiver.ArchivalMetadata
 
 		GetEngine(ctx context.Context) (Engine, error)
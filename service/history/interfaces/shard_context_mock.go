@@ -36,6 +36,7 @@ import (
 	configs "go.temporal.io/server/service/history/configs"
 	events "go.temporal.io/server/service/history/events"
 	hsm "go.temporal.io/server/service/history/hsm"
+	workflowfilter "go.temporal.io/server/service/history/replication/workflowfilter"
 	tasks "go.temporal.io/server/service/history/tasks"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -555,6 +556,20 @@ func (mr *MockShardContextMockRecorder) GetReplicationStatus(arg0 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockShardContext)(nil).GetReplicationStatus), arg0)
 }
 
+// GetReplicationWorkflowFilter mocks base method.
+func (m *MockShardContext) GetReplicationWorkflowFilter() *workflowfilter.Filter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationWorkflowFilter")
+	ret0, _ := ret[0].(*workflowfilter.Filter)
+	return ret0
+}
+
+// GetReplicationWorkflowFilter indicates an expected call of GetReplicationWorkflowFilter.
+func (mr *MockShardContextMockRecorder) GetReplicationWorkflowFilter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationWorkflowFilter", reflect.TypeOf((*MockShardContext)(nil).GetReplicationWorkflowFilter))
+}
+
 // GetReplicatorDLQAckLevel mocks base method.
 func (m *MockShardContext) GetReplicatorDLQAckLevel(sourceCluster string) int64 {
 	m.ctrl.T.Helper()
@@ -1358,6 +1373,20 @@ func (mr *MockControllableContextMockRecorder) GetReplicationStatus(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockControllableContext)(nil).GetReplicationStatus), arg0)
 }
 
+// GetReplicationWorkflowFilter mocks base method.
+func (m *MockControllableContext) GetReplicationWorkflowFilter() *workflowfilter.Filter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationWorkflowFilter")
+	ret0, _ := ret[0].(*workflowfilter.Filter)
+	return ret0
+}
+
+// GetReplicationWorkflowFilter indicates an expected call of GetReplicationWorkflowFilter.
+func (mr *MockControllableContextMockRecorder) GetReplicationWorkflowFilter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationWorkflowFilter", reflect.TypeOf((*MockControllableContext)(nil).GetReplicationWorkflowFilter))
+}
+
 // GetReplicatorDLQAckLevel mocks base method.
 func (m *MockControllableContext) GetReplicatorDLQAckLevel(sourceCluster string) int64 {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ import (
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -28,6 +29,7 @@ type (
 			versionHistoryItems []*historyspb.VersionHistoryItem,
 			events [][]*historypb.HistoryEvent,
 			token []byte,
+			restoredFromArchival bool,
 		) ([]byte, bool, error)
 	}
 
@@ -107,6 +109,7 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 	versionHistoryItems []*historyspb.VersionHistoryItem,
 	eventsSlice [][]*historypb.HistoryEvent,
 	token []byte,
+	restoredFromArchival bool,
 ) (_ []byte, _ bool, retError error) {
 	if len(eventsSlice) == 0 && len(token) == 0 {
 		return nil, false, serviceerror.NewInvalidArgument("ImportWorkflowExecution cannot import empty history events")
@@ -139,6 +142,7 @@ func (r *HistoryImporterImpl) ImportWorkflow(
 		ctx,
 		ndcWorkflow,
 		mutableStateSpec,
+		restoredFromArchival,
 	); err != nil {
 		return nil, false, err
 	}
@@ -319,12 +323,18 @@ func (r *HistoryImporterImpl) commit(
 	ctx context.Context,
 	memNDCWorkflow Workflow,
 	mutableStateSpec MutableStateInitializationSpec,
+	restoredFromArchival bool,
 ) (retError error) {
 	if mutableStateSpec.IsBrandNew {
 		return serviceerror.NewInvalidArgument("HistoryImporter::commit cannot create workflow without events")
 	}
 
 	if !mutableStateSpec.ExistsInDB {
+		if restoredFromArchival {
+			// restore time must be set before tasks are refreshed, so that retention is counted from now
+			// and the execution is not archived again
+			memNDCWorkflow.GetMutableState().GetExecutionInfo().RestoreTime = timestamppb.New(r.shardContext.GetTimeSource().Now())
+		}
 		// refresh tasks to be generated
 		if err := r.taskRefresher.Refresh(
 			ctx,
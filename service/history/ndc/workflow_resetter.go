@@ -223,6 +223,8 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	defer func() { resetWorkflow.GetReleaseFn()(retError) }()
 
 	resetMS := resetWorkflow.GetMutableState()
+	// The reset run replays the start event of the base run, so it is replicated if and only if the base run is.
+	resetMS.GetExecutionInfo().ReplicationLocalOnly = baseWorkflow.GetMutableState().GetExecutionInfo().GetReplicationLocalOnly()
 	if err := reapplyEventsFn(ctx, resetMS); err != nil {
 		return err
 	}
@@ -151,6 +151,8 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		requiredLabels                     []string
+		affinityKey                        string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -162,6 +164,7 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		requiredLabels                     []string
 	}
 )
 
@@ -184,6 +187,8 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		requiredLabels:                     activityInfo.RequiredLabels,
+		affinityKey:                        activityInfo.AffinityKey,
 	}, nil
 }
 
@@ -201,6 +206,8 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		requiredLabels:                     activityInfo.RequiredLabels,
+		affinityKey:                        activityInfo.AffinityKey,
 	}, nil
 }
 
@@ -217,6 +224,7 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		requiredLabels:                     mutableState.GetExecutionInfo().RequiredLabels,
 	}, nil
 }
 
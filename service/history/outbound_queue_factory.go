@@ -284,8 +284,10 @@ func (f *outboundQueueFactory) CreateQueue(
 			MonitorOptions: queues.MonitorOptions{
 				PendingTasksCriticalCount: f.Config.OutboundQueuePendingTaskCriticalCount,
 				// Shared configuration with other queues.
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				ReaderStuckCriticalAttempts:           f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:           f.Config.QueueCriticalSlicesCount,
+				NamespaceTaskLatencyCriticalThreshold: f.Config.QueueNamespaceTaskLatencyCriticalThreshold,
+				TaskAttemptsCriticalThreshold:         f.Config.QueueTaskAttemptsCriticalThreshold,
 			},
 			MaxPollRPS:                          f.Config.OutboundProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.OutboundProcessorMaxPollInterval,
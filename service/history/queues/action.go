@@ -1,5 +1,14 @@
 package queues
 
+const (
+	ActionNameQueuePendingTask = "queue-pending-task"
+	ActionNameReaderStuck      = "reader-stuck"
+	ActionNameSliceCount       = "slice-count"
+	ActionNameMoveGroup        = "move-group"
+	ActionNameSlicePredicate   = "slice-predicate"
+	ActionNameIsolateNamespace = "isolate-namespace"
+)
+
 type (
 	// Action is a set of operations that can be run on a ReaderGroup.
 	// It is created and run by Mitigator upon receiving an Alert.
@@ -7,4 +16,19 @@ type (
 		Name() string
 		Run(*ReaderGroup) (actionTaken bool)
 	}
+
+	// ActionRequest asks a Queue to run one of its Actions on demand,
+	// instead of waiting for the Monitor to raise the corresponding Alert.
+	ActionRequest struct {
+		// Action is the name of the Action to run, e.g. ActionNameIsolateNamespace.
+		Action string
+		// NamespaceID is the namespace to isolate for ActionNameIsolateNamespace.
+		NamespaceID string
+		// ReaderID is the stuck reader for ActionNameReaderStuck.
+		ReaderID int64
+		// Threshold is the critical pending task count for ActionNameQueuePendingTask,
+		// the critical slice count for ActionNameSliceCount and the min pending task
+		// count of a group for ActionNameMoveGroup.
+		Threshold int
+	}
 )
@@ -0,0 +1,83 @@
+package queues
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+var _ Action = (*actionIsolateNamespace)(nil)
+
+type (
+	// actionIsolateNamespace moves all slices of a namespace out of lower readers
+	// and into the last reader, so that a namespace with slow or repeatedly failing
+	// tasks won't hold back task loading and checkpointing for other namespaces.
+	actionIsolateNamespace struct {
+		namespaceID    string
+		maxReaderCount int
+		logger         log.Logger
+	}
+)
+
+func newIsolateNamespaceAction(
+	namespaceID string,
+	maxReaderCount int,
+	logger log.Logger,
+) *actionIsolateNamespace {
+	return &actionIsolateNamespace{
+		namespaceID:    namespaceID,
+		maxReaderCount: maxReaderCount,
+		logger:         logger,
+	}
+}
+
+func (a *actionIsolateNamespace) Name() string {
+	return ActionNameIsolateNamespace
+}
+
+func (a *actionIsolateNamespace) Run(readerGroup *ReaderGroup) bool {
+	isolationReaderID := isolationReaderID(a.maxReaderCount)
+	if isolationReaderID <= DefaultReaderId {
+		return false
+	}
+
+	predicateForSplit := tasks.NewNamespacePredicate([]string{a.namespaceID})
+
+	var slicesToMove []Slice
+	for readerID, reader := range readerGroup.Readers() {
+		if readerID >= isolationReaderID {
+			continue
+		}
+
+		reader.SplitSlices(func(s Slice) ([]Slice, bool) {
+			scope := s.Scope()
+			splitScope, _ := scope.SplitByPredicate(predicateForSplit)
+			if splitScope.IsEmpty() {
+				return nil, false
+			}
+
+			split, remain := s.SplitByPredicate(predicateForSplit)
+			slicesToMove = append(slicesToMove, split)
+			return []Slice{remain}, true
+		})
+	}
+
+	if len(slicesToMove) == 0 {
+		return false
+	}
+
+	a.logger.Info("Isolating namespace into its own queue reader",
+		tag.WorkflowNamespaceID(a.namespaceID),
+		tag.QueueReaderID(isolationReaderID),
+	)
+
+	isolationReader := readerGroup.GetOrCreateReader(isolationReaderID)
+	isolationReader.MergeSlices(slicesToMove...)
+	return true
+}
+
+// isolationReaderID returns the ID of the reader that namespaces get isolated into.
+// It is the last reader so that other actions never move those slices any further.
+func isolationReaderID(maxReaderCount int) int64 {
+	return int64(maxReaderCount) - 1
+}
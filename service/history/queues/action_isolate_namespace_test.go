@@ -0,0 +1,142 @@
+package queues
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	actionIsolateNamespaceSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		monitor     Monitor
+		readerGroup *ReaderGroup
+	}
+
+	sliceListReader struct {
+		Reader
+
+		slices []Slice
+	}
+)
+
+func TestActionIsolateNamespaceSuite(t *testing.T) {
+	s := new(actionIsolateNamespaceSuite)
+	suite.Run(t, s)
+}
+
+func (s *actionIsolateNamespaceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.monitor = newMonitor(tasks.CategoryTypeImmediate, clock.NewRealTimeSource(), &MonitorOptions{
+		PendingTasksCriticalCount:             dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:           dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:           dynamicconfig.GetIntPropertyFn(50),
+		NamespaceTaskLatencyCriticalThreshold: dynamicconfig.GetDurationPropertyFn(0),
+		TaskAttemptsCriticalThreshold:         dynamicconfig.GetIntPropertyFn(0),
+	})
+	s.readerGroup = NewReaderGroup(
+		func(_ int64, slices []Slice) Reader {
+			return &sliceListReader{slices: slices}
+		},
+	)
+}
+
+func (s *actionIsolateNamespaceSuite) TestRun_MoveNamespaceSlices() {
+	isolatedNamespaceID := uuid.NewString()
+	otherNamespaceID := uuid.NewString()
+
+	r := NewRandomRange()
+	defaultReader := s.readerGroup.NewReader(
+		DefaultReaderId,
+		s.newSlice(r, predicates.Universal[tasks.Task]()),
+	).(*sliceListReader)
+
+	action := newIsolateNamespaceAction(isolatedNamespaceID, 3, log.NewTestLogger())
+	s.Equal(ActionNameIsolateNamespace, action.Name())
+	s.True(action.Run(s.readerGroup))
+
+	isolationReader, ok := s.readerGroup.ReaderByID(2)
+	s.True(ok)
+	isolatedSlices := isolationReader.(*sliceListReader).slices
+	s.Len(isolatedSlices, 1)
+	s.Equal(r, isolatedSlices[0].Scope().Range)
+	s.True(isolatedSlices[0].Scope().Predicate.Equals(tasks.NewNamespacePredicate([]string{isolatedNamespaceID})))
+
+	s.Len(defaultReader.slices, 1)
+	remainScope := defaultReader.slices[0].Scope()
+	s.False(remainScope.Predicate.Test(tasks.NewFakeTask(
+		definition.NewWorkflowKey(isolatedNamespaceID, uuid.NewString(), uuid.NewString()),
+		tasks.CategoryTransfer,
+		r.InclusiveMin.FireTime,
+	)))
+	s.True(remainScope.Predicate.Test(tasks.NewFakeTask(
+		definition.NewWorkflowKey(otherNamespaceID, uuid.NewString(), uuid.NewString()),
+		tasks.CategoryTransfer,
+		r.InclusiveMin.FireTime,
+	)))
+
+	// namespace is already isolated, nothing left to move
+	s.False(action.Run(s.readerGroup))
+}
+
+func (s *actionIsolateNamespaceSuite) TestRun_NamespaceNotInReaders() {
+	s.readerGroup.NewReader(
+		DefaultReaderId,
+		s.newSlice(NewRandomRange(), tasks.NewNamespacePredicate([]string{uuid.NewString()})),
+	)
+
+	action := newIsolateNamespaceAction(uuid.NewString(), 3, log.NewTestLogger())
+	s.False(action.Run(s.readerGroup))
+
+	_, ok := s.readerGroup.ReaderByID(2)
+	s.False(ok)
+}
+
+func (s *actionIsolateNamespaceSuite) TestRun_SingleReader() {
+	namespaceID := uuid.NewString()
+	s.readerGroup.NewReader(
+		DefaultReaderId,
+		s.newSlice(NewRandomRange(), predicates.Universal[tasks.Task]()),
+	)
+
+	// with a single reader there's no other reader to isolate the namespace into
+	action := newIsolateNamespaceAction(namespaceID, 1, log.NewTestLogger())
+	s.False(action.Run(s.readerGroup))
+}
+
+func (s *actionIsolateNamespaceSuite) newSlice(
+	r Range,
+	predicate tasks.Predicate,
+) Slice {
+	return NewSlice(nil, nil, s.monitor, NewScope(r, predicate), GrouperNamespaceID{}, noPredicateSizeLimit)
+}
+
+func (r *sliceListReader) Start() {}
+
+func (r *sliceListReader) SplitSlices(splitter SliceSplitter) {
+	var slices []Slice
+	for _, slice := range r.slices {
+		remaining, split := splitter(slice)
+		if !split {
+			slices = append(slices, slice)
+			continue
+		}
+		slices = append(slices, remaining...)
+	}
+	r.slices = slices
+}
+
+func (r *sliceListReader) MergeSlices(slices ...Slice) {
+	r.slices = append(r.slices, slices...)
+}
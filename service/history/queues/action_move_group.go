@@ -30,7 +30,7 @@ func newMoveGroupAction(
 }
 
 func (a *actionMoveGroup) Name() string {
-	return "move-group"
+	return ActionNameMoveGroup
 }
 
 func (a *actionMoveGroup) Run(readerGroup *ReaderGroup) bool {
@@ -45,7 +45,7 @@ func newQueuePendingTaskAction(
 }
 
 func (a *actionQueuePendingTask) Name() string {
-	return "queue-pending-task"
+	return ActionNameQueuePendingTask
 }
 
 func (a *actionQueuePendingTask) Run(readerGroup *ReaderGroup) bool {
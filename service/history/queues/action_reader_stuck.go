@@ -26,7 +26,7 @@ func newReaderStuckAction(
 }
 
 func (a *actionReaderStuck) Name() string {
-	return "reader-stuck"
+	return ActionNameReaderStuck
 }
 
 func (a *actionReaderStuck) Run(readerGroup *ReaderGroup) bool {
@@ -31,7 +31,7 @@ func newSliceCountAction(
 }
 
 func (a *actionSliceCount) Name() string {
-	return "slice-count"
+	return ActionNameSliceCount
 }
 
 func (a *actionSliceCount) Run(readerGroup *ReaderGroup) (actionTaken bool) {
@@ -43,7 +43,7 @@ func newSlicePredicateAction(
 }
 
 func (a *slicePredicateAction) Name() string {
-	return "slice-predicate"
+	return ActionNameSlicePredicate
 }
 
 func (a *slicePredicateAction) Run(readerGroup *ReaderGroup) bool {
@@ -1,6 +1,8 @@
 package queues
 
 import (
+	"time"
+
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -11,6 +13,8 @@ type (
 		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
 		AlertAttributesReaderStuck           *AlertAttributesReaderStuck
 		AlertAttributesSliceCount            *AlertAttributesSlicesCount
+		AlertAttributesNamespaceTaskLatency  *AlertAttributesNamespaceTaskLatency
+		AlertAttributesTaskAttempts          *AlertAttributesTaskAttempts
 	}
 
 	AlertType int
@@ -29,6 +33,18 @@ type (
 		CurrentSliceCount  int
 		CriticalSliceCount int
 	}
+
+	AlertAttributesNamespaceTaskLatency struct {
+		NamespaceID     string
+		CurrentLatency  time.Duration
+		CriticalLatency time.Duration
+	}
+
+	AlertAttributesTaskAttempts struct {
+		NamespaceID      string
+		CurrentAttempts  int
+		CriticalAttempts int
+	}
 )
 
 const (
@@ -36,4 +52,6 @@ const (
 	AlertTypeQueuePendingTaskCount
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
+	AlertTypeNamespaceTaskLatency
+	AlertTypeTaskAttempts
 )
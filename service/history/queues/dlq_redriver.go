@@ -0,0 +1,562 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	dlqRedrivePageSize = 100
+	// dlqRedriveMaxMessages is the maximum number of messages read from a DLQ in one pass. When a DLQ has more
+	// messages, the messages read in a pass are moved to its tail so that the next pass reads the messages after them.
+	dlqRedriveMaxMessages = 1000
+	dlqRedrivePassTimeout = 5 * time.Minute
+)
+
+type (
+	// DLQRedriver automatically re-drives tasks from the history task DLQs of the current cluster according to the
+	// DLQ re-drive policies of their category and namespace. A task is re-driven by adding it back to its shard after
+	// an exponential backoff, and is permanently parked once it exhausts its re-drives.
+	//
+	// A re-driven task is removed from the DLQ right away. Since re-driven tasks get new task IDs, a task that fails
+	// again is sent to the DLQ as a new message. To keep the attempt history of a task, its attempts are appended to
+	// the re-drive log of the DLQ when it is re-driven, and a new message of the same task merges them. Log records
+	// are removed after the outcome timeout, after which a task that didn't fail again is considered successful.
+	//
+	// Since the DLQ can only be truncated, changed messages are re-enqueued and the prefix of the DLQ up to the last
+	// changed message is deleted. This changes the message IDs of the messages in the DLQ, so manual DLQ operations are
+	// rejected for the categories managed by the re-driver. A failure in between can duplicate messages, which at worst
+	// re-drives a task twice. Messages whose task can't be deserialized are re-enqueued as they are.
+	//
+	// Like the DLQMetricsEmitter, this only runs on the history host that owns shard 1.
+	DLQRedriver struct {
+		status     int32
+		shutdownCh chan struct{}
+
+		historyTaskQueueManager persistence.HistoryTaskQueueManager
+		historyClient           DLQRedriveClient
+		serializer              TaskSerializer
+		taskCategoryRegistry    tasks.TaskCategoryRegistry
+		namespaceRegistry       namespace.Registry
+		clusterMetadata         cluster.Metadata
+		historyServiceResolver  membership.ServiceResolver
+		hostInfoProvider        membership.HostInfoProvider
+		timeSource              clock.TimeSource
+		metricsHandler          metrics.Handler
+		logger                  log.Logger
+
+		numShards      int
+		categories     dynamicconfig.TypedPropertyFn[[]string]
+		policies       dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]dynamicconfig.DLQRedrivePolicy]
+		interval       dynamicconfig.DurationPropertyFn
+		outcomeTimeout dynamicconfig.DurationPropertyFn
+	}
+
+	// DLQRedriveClient is a subset of historyservice.HistoryServiceClient.
+	DLQRedriveClient interface {
+		AddTasks(
+			ctx context.Context,
+			request *historyservice.AddTasksRequest,
+			opts ...grpc.CallOption,
+		) (*historyservice.AddTasksResponse, error)
+	}
+
+	// TaskSerializer is a subset of serialization.Serializer.
+	TaskSerializer interface {
+		SerializeTask(task tasks.Task) (*commonpb.DataBlob, error)
+		DeserializeTask(category tasks.Category, blob *commonpb.DataBlob) (tasks.Task, error)
+	}
+
+	dlqRedriveMessage struct {
+		id      int64
+		payload *persistencespb.HistoryTask
+		// task and fingerprint are nil if the task of the message can't be deserialized.
+		task        tasks.Task
+		fingerprint *commonpb.DataBlob
+		// deleted is set when the message is removed from the DLQ.
+		deleted bool
+		// changed is set when the message is re-enqueued with a new state.
+		changed bool
+	}
+
+	// dlqRedriveLog is the content of the re-drive log of a DLQ. It maps the fingerprints of re-driven tasks to the
+	// states they were re-driven with, oldest first.
+	dlqRedriveLog struct {
+		records map[string][]*persistencespb.HistoryDLQTaskState
+		// lastExpiredID is the ID of the last record that expired, or -1 if none did.
+		lastExpiredID int64
+	}
+)
+
+// NewDLQRedriver returns a DLQRedriver for the history task DLQs of the current cluster.
+func NewDLQRedriver(
+	historyTaskQueueManager persistence.HistoryTaskQueueManager,
+	historyClient DLQRedriveClient,
+	serializer TaskSerializer,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	namespaceRegistry namespace.Registry,
+	clusterMetadata cluster.Metadata,
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+	numShards int,
+	categories dynamicconfig.TypedPropertyFn[[]string],
+	policies dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]dynamicconfig.DLQRedrivePolicy],
+	interval dynamicconfig.DurationPropertyFn,
+	outcomeTimeout dynamicconfig.DurationPropertyFn,
+) *DLQRedriver {
+	return &DLQRedriver{
+		status:                  common.DaemonStatusInitialized,
+		shutdownCh:              make(chan struct{}),
+		historyTaskQueueManager: historyTaskQueueManager,
+		historyClient:           historyClient,
+		serializer:              serializer,
+		taskCategoryRegistry:    taskCategoryRegistry,
+		namespaceRegistry:       namespaceRegistry,
+		clusterMetadata:         clusterMetadata,
+		historyServiceResolver:  historyServiceResolver,
+		hostInfoProvider:        hostInfoProvider,
+		timeSource:              timeSource,
+		metricsHandler:          metricsHandler,
+		logger:                  logger,
+		numShards:               numShards,
+		categories:              categories,
+		policies:                policies,
+		interval:                interval,
+		outcomeTimeout:          outcomeTimeout,
+	}
+}
+
+func (r *DLQRedriver) Start() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go r.redriveLoop()
+}
+
+func (r *DLQRedriver) Stop() {
+	if !atomic.CompareAndSwapInt32(&r.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(r.shutdownCh)
+}
+
+func (r *DLQRedriver) redriveLoop() {
+	timer := time.NewTimer(r.interval())
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-timer.C:
+			if r.shouldRedrive() {
+				r.redrive()
+			}
+			timer.Reset(r.interval())
+		}
+	}
+}
+
+func (r *DLQRedriver) redrive() {
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.SystemPreemptableCallerInfo),
+		dlqRedrivePassTimeout,
+	)
+	defer cancel()
+
+	categories := r.categories()
+	for _, category := range r.taskCategoryRegistry.GetCategories() {
+		if !slices.Contains(categories, category.Name()) {
+			continue
+		}
+		if err := r.redriveQueue(ctx, category); err != nil {
+			metrics.DLQRedriveFailures.With(r.metricsHandler).Record(1, metrics.TaskCategoryTag(category.Name()))
+			r.logger.Error("Failed to re-drive tasks from DLQ",
+				tag.String("task-category", category.Name()),
+				tag.Error(err),
+			)
+		}
+	}
+}
+
+// redriveQueue makes one pass over the DLQ of a task category.
+func (r *DLQRedriver) redriveQueue(ctx context.Context, category tasks.Category) error {
+	currentCluster := r.clusterMetadata.GetCurrentClusterName()
+	dlqKey := persistence.QueueKey{
+		QueueType:     persistence.QueueTypeHistoryDLQ,
+		Category:      category,
+		SourceCluster: currentCluster,
+		TargetCluster: currentCluster,
+	}
+	logKey := dlqKey
+	logKey.QueueType = persistence.QueueTypeHistoryDLQRedrive
+
+	now := r.timeSource.Now()
+	redriveLog, err := r.readRedriveLog(ctx, logKey, now)
+	if err != nil {
+		return err
+	}
+	messages, hasMore, err := r.readMessages(ctx, dlqKey)
+	if err != nil {
+		return err
+	}
+	mergeAttempts(messages, redriveLog)
+
+	for _, message := range messages {
+		state := message.payload.GetDlqState()
+		if message.task == nil || len(state.GetAttempts()) == 0 || state.GetParked() {
+			// Messages written before re-drive was supported have no attempts and are left for manual merging.
+			continue
+		}
+		r.redriveMessage(ctx, logKey, message, now)
+	}
+
+	if err := r.rewriteMessages(ctx, dlqKey, messages, hasMore); err != nil {
+		return err
+	}
+	if redriveLog.lastExpiredID < 0 {
+		return nil
+	}
+	_, err = r.historyTaskQueueManager.DeleteTasks(ctx, &persistence.DeleteTasksRequest{
+		QueueKey: logKey,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{
+			ID: redriveLog.lastExpiredID,
+		},
+	})
+	return err
+}
+
+// readRedriveLog reads the records of the re-drive log of a DLQ that haven't expired yet. Records are appended in
+// time order, so the expired records are a prefix of the log.
+func (r *DLQRedriver) readRedriveLog(
+	ctx context.Context,
+	logKey persistence.QueueKey,
+	now time.Time,
+) (*dlqRedriveLog, error) {
+	redriveLog := &dlqRedriveLog{
+		records:       make(map[string][]*persistencespb.HistoryDLQTaskState),
+		lastExpiredID: -1,
+	}
+	expired := true
+	var nextPageToken []byte
+	for {
+		resp, err := r.historyTaskQueueManager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
+			QueueKey:      logKey,
+			PageSize:      dlqRedrivePageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			if errors.As(err, new(*serviceerror.NotFound)) {
+				// The log is created lazily when the first task is re-driven.
+				return redriveLog, nil
+			}
+			return nil, err
+		}
+		for _, rawTask := range resp.Tasks {
+			state := rawTask.Payload.GetDlqState()
+			expired = expired && now.Sub(state.GetRedriveTime().AsTime()) >= r.outcomeTimeout()
+			if expired {
+				redriveLog.lastExpiredID = rawTask.MessageMetadata.ID
+				continue
+			}
+			fingerprint := string(rawTask.Payload.GetBlob().GetData())
+			redriveLog.records[fingerprint] = append(redriveLog.records[fingerprint], state)
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return redriveLog, nil
+		}
+	}
+}
+
+// readMessages reads the messages at the head of a DLQ, and returns whether there may be more messages after them.
+func (r *DLQRedriver) readMessages(
+	ctx context.Context,
+	dlqKey persistence.QueueKey,
+) ([]*dlqRedriveMessage, bool, error) {
+	var messages []*dlqRedriveMessage
+	var nextPageToken []byte
+	for len(messages) < dlqRedriveMaxMessages {
+		resp, err := r.historyTaskQueueManager.ReadRawTasks(ctx, &persistence.ReadRawTasksRequest{
+			QueueKey:      dlqKey,
+			PageSize:      dlqRedrivePageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			if errors.As(err, new(*serviceerror.NotFound)) {
+				// The DLQ is created lazily when the first task is written to it.
+				return messages, false, nil
+			}
+			return nil, false, err
+		}
+		for _, rawTask := range resp.Tasks {
+			messages = append(messages, r.newMessage(dlqKey.Category, rawTask))
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return messages, false, nil
+		}
+	}
+	return messages, true, nil
+}
+
+func (r *DLQRedriver) newMessage(category tasks.Category, rawTask persistence.RawHistoryTask) *dlqRedriveMessage {
+	message := &dlqRedriveMessage{
+		id:      rawTask.MessageMetadata.ID,
+		payload: rawTask.Payload,
+	}
+	task, fingerprint, err := r.deserializeTask(category, rawTask.Payload.GetBlob())
+	if err != nil {
+		r.logger.Warn("Failed to deserialize DLQ task for re-drive",
+			tag.DLQMessageID(rawTask.MessageMetadata.ID),
+			tag.Error(err),
+		)
+		return message
+	}
+	message.task = task
+	message.fingerprint = fingerprint
+	return message
+}
+
+// deserializeTask returns the task of a DLQ message and its fingerprint. A re-driven task gets a new task ID, and
+// may get a new visibility time, so they are not part of the fingerprint.
+func (r *DLQRedriver) deserializeTask(
+	category tasks.Category,
+	blob *commonpb.DataBlob,
+) (tasks.Task, *commonpb.DataBlob, error) {
+	task, err := r.serializer.DeserializeTask(category, blob)
+	if err != nil {
+		return nil, nil, err
+	}
+	fingerprintTask, err := r.serializer.DeserializeTask(category, blob)
+	if err != nil {
+		return nil, nil, err
+	}
+	fingerprintTask.SetTaskID(0)
+	fingerprintTask.SetVisibilityTime(time.Time{})
+	fingerprint, err := r.serializer.SerializeTask(fingerprintTask)
+	if err != nil {
+		return nil, nil, err
+	}
+	return task, fingerprint, nil
+}
+
+// mergeAttempts continues the attempt history of re-driven tasks that failed again. A new message has a single
+// attempt, and continues the latest record of its task that was re-driven before the attempt.
+func mergeAttempts(messages []*dlqRedriveMessage, redriveLog *dlqRedriveLog) {
+	for _, message := range messages {
+		state := message.payload.GetDlqState()
+		if message.task == nil || len(state.GetAttempts()) != 1 || state.GetParked() {
+			continue
+		}
+		enqueueTime := state.Attempts[0].GetEnqueueTime().AsTime()
+		records := redriveLog.records[string(message.fingerprint.GetData())]
+		for i := len(records) - 1; i >= 0; i-- {
+			if records[i].GetRedriveTime().AsTime().After(enqueueTime) {
+				continue
+			}
+			state.Attempts = slices.Concat(records[i].Attempts, state.Attempts)
+			message.changed = true
+			break
+		}
+	}
+}
+
+// redriveMessage re-drives the task of a message if it is due, or parks it if it exhausted its re-drives.
+func (r *DLQRedriver) redriveMessage(
+	ctx context.Context,
+	logKey persistence.QueueKey,
+	message *dlqRedriveMessage,
+	now time.Time,
+) {
+	category := logKey.Category
+	namespaceName, err := r.namespaceRegistry.GetNamespaceName(namespace.ID(message.task.GetNamespaceID()))
+	if err != nil {
+		return
+	}
+	policy, ok := findDLQRedrivePolicy(r.policies(namespaceName.String()), category.Name())
+	if !ok {
+		return
+	}
+
+	state := message.payload.DlqState
+	redrives := len(state.Attempts) - 1
+	if redrives >= policy.MaximumRedrives {
+		state.Parked = true
+		message.changed = true
+		metrics.DLQTasksParked.With(r.metricsHandler).Record(
+			1,
+			metrics.TaskCategoryTag(category.Name()),
+			metrics.NamespaceTag(namespaceName.String()),
+		)
+		r.logger.Error("DLQ task permanently parked after exhausting its re-drives",
+			tag.DLQMessageID(message.id),
+			tag.WorkflowNamespace(namespaceName.String()),
+			tag.WorkflowID(message.task.GetWorkflowID()),
+			tag.WorkflowRunID(message.task.GetRunID()),
+			tag.TaskType(message.task.GetType()),
+			tag.Attempt(int32(len(state.Attempts))),
+			tag.String("last-error", state.Attempts[redrives].GetError()),
+		)
+		return
+	}
+
+	lastAttempt := state.Attempts[redrives].GetEnqueueTime().AsTime()
+	if now.Before(lastAttempt.Add(dlqRedriveBackoff(policy, redrives))) {
+		return
+	}
+
+	// The attempts are logged before the task is re-driven, so that a failure of the re-driven task always finds them.
+	if err := r.appendRedriveRecord(ctx, logKey, message, now); err != nil {
+		metrics.DLQRedriveFailures.With(r.metricsHandler).Record(1, metrics.TaskCategoryTag(category.Name()))
+		r.logger.Warn("Failed to log DLQ task re-drive", tag.DLQMessageID(message.id), tag.Error(err))
+		return
+	}
+	_, err = r.historyClient.AddTasks(ctx, &historyservice.AddTasksRequest{
+		ShardId: int32(tasks.GetShardIDForTask(message.task, r.numShards)),
+		Tasks: []*historyservice.AddTasksRequest_Task{
+			{
+				CategoryId: int32(category.ID()),
+				Blob:       message.payload.Blob,
+			},
+		},
+	})
+	if err != nil {
+		metrics.DLQRedriveFailures.With(r.metricsHandler).Record(1, metrics.TaskCategoryTag(category.Name()))
+		r.logger.Warn("Failed to re-drive DLQ task", tag.DLQMessageID(message.id), tag.Error(err))
+		return
+	}
+	message.deleted = true
+	metrics.DLQRedrives.With(r.metricsHandler).Record(
+		1,
+		metrics.TaskCategoryTag(category.Name()),
+		metrics.NamespaceTag(namespaceName.String()),
+	)
+}
+
+func (r *DLQRedriver) appendRedriveRecord(
+	ctx context.Context,
+	logKey persistence.QueueKey,
+	message *dlqRedriveMessage,
+	now time.Time,
+) error {
+	_, err := r.historyTaskQueueManager.CreateQueue(ctx, &persistence.CreateQueueRequest{
+		QueueKey: logKey,
+	})
+	if err != nil && !errors.Is(err, persistence.ErrQueueAlreadyExists) {
+		return err
+	}
+	_, err = r.historyTaskQueueManager.EnqueueRawTask(ctx, &persistence.EnqueueRawTaskRequest{
+		QueueKey: logKey,
+		Task: &persistencespb.HistoryTask{
+			ShardId: message.payload.ShardId,
+			Blob:    message.fingerprint,
+			DlqState: &persistencespb.HistoryDLQTaskState{
+				Attempts:    message.payload.DlqState.Attempts,
+				RedriveTime: timestamppb.New(now),
+			},
+		},
+	})
+	return err
+}
+
+// rewriteMessages re-enqueues the messages up to the last deleted or changed one, and then deletes them from the
+// head of the DLQ. If there may be more messages after the ones read, all of them are moved to the tail of the DLQ.
+func (r *DLQRedriver) rewriteMessages(
+	ctx context.Context,
+	dlqKey persistence.QueueKey,
+	messages []*dlqRedriveMessage,
+	hasMore bool,
+) error {
+	last := -1
+	for i, message := range messages {
+		if hasMore || message.deleted || message.changed {
+			last = i
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+
+	for _, message := range messages[:last+1] {
+		if message.deleted {
+			continue
+		}
+		_, err := r.historyTaskQueueManager.EnqueueRawTask(ctx, &persistence.EnqueueRawTaskRequest{
+			QueueKey: dlqKey,
+			Task:     message.payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := r.historyTaskQueueManager.DeleteTasks(ctx, &persistence.DeleteTasksRequest{
+		QueueKey: dlqKey,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{
+			ID: messages[last].id,
+		},
+	})
+	return err
+}
+
+// shouldRedrive returns true only if this instance of history service is hosting shard 1.
+func (r *DLQRedriver) shouldRedrive() bool {
+	ownerInfo, err := r.historyServiceResolver.Lookup("1")
+	if err != nil {
+		r.logger.Error("Failed to get the history service hosting shard 1")
+		return false
+	}
+	return ownerInfo.Identity() == r.hostInfoProvider.HostInfo().Identity()
+}
+
+// findDLQRedrivePolicy returns the first policy matching a task category.
+func findDLQRedrivePolicy(
+	policies []dynamicconfig.DLQRedrivePolicy,
+	category string,
+) (dynamicconfig.DLQRedrivePolicy, bool) {
+	for _, policy := range policies {
+		if policy.Category == "" || policy.Category == category {
+			return policy, true
+		}
+	}
+	return dynamicconfig.DLQRedrivePolicy{}, false
+}
+
+// dlqRedriveBackoff returns the delay of a re-drive after the given number of re-drives.
+func dlqRedriveBackoff(policy dynamicconfig.DLQRedrivePolicy, redrives int) time.Duration {
+	coefficient := max(policy.BackoffCoefficient, 1)
+	backoff := float64(policy.InitialInterval) * math.Pow(coefficient, float64(redrives))
+	if policy.MaximumInterval > 0 && backoff > float64(policy.MaximumInterval) {
+		return policy.MaximumInterval
+	}
+	if backoff > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(backoff)
+}
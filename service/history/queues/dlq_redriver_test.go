@@ -0,0 +1,487 @@
+package queues
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/sql"
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/resolver"
+	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+const (
+	testDLQRedriveNumShards = 4
+)
+
+type (
+	dlqRedriverSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		ctx        context.Context
+		cancel     context.CancelFunc
+		controller *gomock.Controller
+
+		factory        *sql.Factory
+		manager        persistence.HistoryTaskQueueManager
+		dlqWriter      *DLQWriter
+		historyClient  *fakeDLQRedriveClient
+		timeSource     *clock.EventTimeSource
+		metricsHandler *metricstest.CaptureHandler
+		policies       []dynamicconfig.DLQRedrivePolicy
+		redriver       *DLQRedriver
+	}
+
+	fakeDLQRedriveClient struct {
+		requests []*historyservice.AddTasksRequest
+		err      error
+	}
+)
+
+func (c *fakeDLQRedriveClient) AddTasks(
+	_ context.Context,
+	request *historyservice.AddTasksRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.AddTasksResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.requests = append(c.requests, request)
+	return &historyservice.AddTasksResponse{}, nil
+}
+
+func TestDLQRedriverSuite(t *testing.T) {
+	suite.Run(t, new(dlqRedriverSuite))
+}
+
+func (s *dlqRedriverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ctx, s.cancel = context.WithTimeout(context.Background(), 30*time.Second)
+	s.controller = gomock.NewController(s.T())
+
+	logger := log.NewNoopLogger()
+	serializer := serialization.NewSerializer()
+	s.factory = sql.NewFactory(
+		config.SQL{
+			PluginName:        "sqlite",
+			DatabaseName:      uuid.NewString(),
+			ConnectAttributes: map[string]string{"mode": "memory", "cache": "private"},
+		},
+		resolver.NewNoopResolver(),
+		cluster.TestCurrentClusterName,
+		logger,
+		metrics.NoopMetricsHandler,
+		serializer,
+	)
+	queue, err := s.factory.NewQueueV2()
+	s.NoError(err)
+	s.manager = persistence.NewHistoryTaskQueueManager(queue, serializer)
+
+	namespaceRegistry := namespace.NewMockRegistry(s.controller)
+	namespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceName(tests.NamespaceID).Return(tests.Namespace, nil).AnyTimes()
+	clusterMetadata := cluster.NewMockMetadata(s.controller)
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.dlqWriter = NewDLQWriter(s.manager, metrics.NoopMetricsHandler, logger, namespaceRegistry, s.timeSource)
+	s.historyClient = &fakeDLQRedriveClient{}
+	s.metricsHandler = metricstest.NewCaptureHandler()
+	s.policies = []dynamicconfig.DLQRedrivePolicy{
+		{
+			Category:           tasks.CategoryTransfer.Name(),
+			InitialInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Hour,
+			MaximumRedrives:    2,
+		},
+	}
+	s.redriver = NewDLQRedriver(
+		s.manager,
+		s.historyClient,
+		serializer,
+		tasks.NewDefaultTaskCategoryRegistry(),
+		namespaceRegistry,
+		clusterMetadata,
+		membership.NewMockServiceResolver(s.controller),
+		membership.NewMockHostInfoProvider(s.controller),
+		s.timeSource,
+		s.metricsHandler,
+		logger,
+		testDLQRedriveNumShards,
+		dynamicconfig.GetTypedPropertyFn([]string{tasks.CategoryTransfer.Name(), tasks.CategoryTimer.Name()}),
+		func(namespace string) []dynamicconfig.DLQRedrivePolicy {
+			if namespace != tests.Namespace.String() {
+				return nil
+			}
+			return s.policies
+		},
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		dynamicconfig.GetDurationPropertyFn(time.Hour),
+	)
+}
+
+func (s *dlqRedriverSuite) TearDownTest() {
+	s.factory.Close()
+	s.cancel()
+}
+
+func (s *dlqRedriverSuite) TestRedrive_NotDue() {
+	s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(30 * time.Second)
+	s.redriver.redrive()
+
+	s.Empty(s.historyClient.requests)
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Equal(int64(persistence.FirstQueueMessageID), messages[0].MessageMetadata.ID)
+	s.Nil(messages[0].Payload.GetDlqState().GetRedriveTime())
+}
+
+func (s *dlqRedriverSuite) TestRedrive_Due() {
+	task := s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+
+	s.Len(s.historyClient.requests, 1)
+	request := s.historyClient.requests[0]
+	s.Equal(int32(tasks.GetShardIDForTask(task, testDLQRedriveNumShards)), request.ShardId)
+	s.Len(request.Tasks, 1)
+	s.Equal(int32(tasks.CategoryTransfer.ID()), request.Tasks[0].CategoryId)
+
+	// The re-driven task is removed from the DLQ and its attempts are kept in the re-drive log.
+	s.Empty(s.readMessages())
+	records := s.readRedriveLog()
+	s.Len(records, 1)
+	state := records[0].Payload.GetDlqState()
+	s.Len(state.GetAttempts(), 1)
+	s.Equal("task failed", state.GetAttempts()[0].GetError())
+	s.Equal(s.timeSource.Now().UnixNano(), state.GetRedriveTime().AsTime().UnixNano())
+
+	s.timeSource.Advance(10 * time.Minute)
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 1)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_RedriveLogExpired() {
+	s.writeTask(1, errors.New("task failed"))
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+	s.Len(s.readRedriveLog(), 1)
+
+	s.timeSource.Advance(2 * time.Hour)
+	s.redriver.redrive()
+	s.Empty(s.readRedriveLog())
+
+	// A failure after the outcome timeout starts a new attempt history.
+	s.writeTask(2, errors.New("task failed"))
+	s.redriver.redrive()
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Len(messages[0].Payload.GetDlqState().GetAttempts(), 1)
+	s.Equal(int64(persistence.FirstQueueMessageID+1), messages[0].MessageMetadata.ID)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_FailedAgain() {
+	s.writeTask(1, errors.New("first failure"))
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+
+	// The re-driven task has a new task ID when it fails again.
+	s.writeTask(2, errors.New("second failure"))
+	s.redriver.redrive()
+
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	state := messages[0].Payload.GetDlqState()
+	s.Len(state.GetAttempts(), 2)
+	s.Equal("first failure", state.GetAttempts()[0].GetError())
+	s.Equal("second failure", state.GetAttempts()[1].GetError())
+	s.Nil(state.GetRedriveTime())
+	s.Len(s.historyClient.requests, 1)
+
+	// The second re-drive backs off twice as long as the first one.
+	s.timeSource.Advance(90 * time.Second)
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 1)
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 2)
+	s.Empty(s.readMessages())
+	records := s.readRedriveLog()
+	s.Len(records, 2)
+	s.Len(records[1].Payload.GetDlqState().GetAttempts(), 2)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_Parked() {
+	capture := s.metricsHandler.StartCapture()
+	defer s.metricsHandler.StopCapture(capture)
+
+	for i := range 3 {
+		s.writeTask(int64(i+1), errors.New("task failed"))
+		s.timeSource.Advance(10 * time.Minute)
+		s.redriver.redrive()
+	}
+
+	s.Len(s.historyClient.requests, 2)
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	state := messages[0].Payload.GetDlqState()
+	s.Len(state.GetAttempts(), 3)
+	s.True(state.GetParked())
+	s.Nil(state.GetRedriveTime())
+	s.Len(capture.Snapshot()[metrics.DLQRedrives.Name()], 2)
+	s.Len(capture.Snapshot()[metrics.DLQTasksParked.Name()], 1)
+
+	// Parked tasks stay in the DLQ.
+	s.timeSource.Advance(2 * time.Hour)
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 2)
+	s.Len(s.readMessages(), 1)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_NoPolicy() {
+	s.policies = []dynamicconfig.DLQRedrivePolicy{
+		{Category: tasks.CategoryTimer.Name(), InitialInterval: time.Minute, MaximumRedrives: 1},
+	}
+	s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(time.Hour)
+	s.redriver.redrive()
+
+	s.Empty(s.historyClient.requests)
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Equal(int64(persistence.FirstQueueMessageID), messages[0].MessageMetadata.ID)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_CategoryNotManaged() {
+	s.redriver.categories = dynamicconfig.GetTypedPropertyFn([]string{tasks.CategoryTimer.Name()})
+	s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(time.Hour)
+	s.redriver.redrive()
+
+	s.Empty(s.historyClient.requests)
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Equal(int64(persistence.FirstQueueMessageID), messages[0].MessageMetadata.ID)
+}
+
+func (s *dlqRedriverSuite) TestRedrive_AddTasksFailed() {
+	capture := s.metricsHandler.StartCapture()
+	defer s.metricsHandler.StopCapture(capture)
+	s.historyClient.err = errors.New("unavailable")
+	s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Equal(int64(persistence.FirstQueueMessageID), messages[0].MessageMetadata.ID)
+	s.Len(capture.Snapshot()[metrics.DLQRedriveFailures.Name()], 1)
+
+	s.historyClient.err = nil
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 1)
+	s.Empty(s.readMessages())
+}
+
+func (s *dlqRedriverSuite) TestRedrive_UndeserializableMessageSkipped() {
+	_, err := s.manager.CreateQueue(s.ctx, &persistence.CreateQueueRequest{QueueKey: s.queueKey(persistence.QueueTypeHistoryDLQ)})
+	s.NoError(err)
+	invalidTask := &persistencespb.HistoryTask{
+		ShardId: 1,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         []byte("invalid task"),
+		},
+	}
+	_, err = s.manager.EnqueueRawTask(s.ctx, &persistence.EnqueueRawTaskRequest{
+		QueueKey: s.queueKey(persistence.QueueTypeHistoryDLQ),
+		Task:     invalidTask,
+	})
+	s.NoError(err)
+	s.writeTask(1, errors.New("task failed"))
+
+	s.timeSource.Advance(2 * time.Minute)
+	s.redriver.redrive()
+
+	// The task after the invalid one is re-driven, and the invalid one is kept as is.
+	s.Len(s.historyClient.requests, 1)
+	messages := s.readMessages()
+	s.Len(messages, 1)
+	s.Equal(invalidTask.Blob.Data, messages[0].Payload.GetBlob().GetData())
+}
+
+func (s *dlqRedriverSuite) TestRedrive_FullWindowRotated() {
+	_, err := s.manager.CreateQueue(s.ctx, &persistence.CreateQueueRequest{QueueKey: s.queueKey(persistence.QueueTypeHistoryDLQ)})
+	s.NoError(err)
+	for i := range dlqRedriveMaxMessages {
+		// Messages written before re-drive was supported have no attempts, and are never re-driven.
+		_, err := s.manager.EnqueueTask(s.ctx, &persistence.EnqueueTaskRequest{
+			QueueType:     persistence.QueueTypeHistoryDLQ,
+			SourceCluster: cluster.TestCurrentClusterName,
+			TargetCluster: cluster.TestCurrentClusterName,
+			Task:          s.newTask(int64(i + 1)),
+			SourceShardID: 1,
+		})
+		s.NoError(err)
+	}
+	s.writeTask(dlqRedriveMaxMessages+1, errors.New("task failed"))
+	s.timeSource.Advance(2 * time.Minute)
+
+	// The first pass only sees the old messages and moves them to the tail, so the second pass sees the new one.
+	s.redriver.redrive()
+	s.Empty(s.historyClient.requests)
+	s.redriver.redrive()
+	s.Len(s.historyClient.requests, 1)
+	s.Len(s.readMessages(), dlqRedriveMaxMessages)
+}
+
+func (s *dlqRedriverSuite) TestShouldRedrive() {
+	resolver := membership.NewMockServiceResolver(s.controller)
+	hostInfoProvider := membership.NewMockHostInfoProvider(s.controller)
+	s.redriver.historyServiceResolver = resolver
+	s.redriver.hostInfoProvider = hostInfoProvider
+	hostInfoProvider.EXPECT().HostInfo().Return(membership.NewHostInfoFromAddress("host-a")).AnyTimes()
+
+	resolver.EXPECT().Lookup("1").Return(membership.NewHostInfoFromAddress("host-a"), nil)
+	s.True(s.redriver.shouldRedrive())
+	resolver.EXPECT().Lookup("1").Return(membership.NewHostInfoFromAddress("host-b"), nil)
+	s.False(s.redriver.shouldRedrive())
+	resolver.EXPECT().Lookup("1").Return(nil, errors.New("no hosts"))
+	s.False(s.redriver.shouldRedrive())
+}
+
+func (s *dlqRedriverSuite) writeTask(taskID int64, cause error) tasks.Task {
+	task := s.newTask(taskID)
+	err := s.dlqWriter.WriteTaskToDLQ(
+		s.ctx,
+		cluster.TestCurrentClusterName,
+		cluster.TestCurrentClusterName,
+		tasks.GetShardIDForTask(task, testDLQRedriveNumShards),
+		task,
+		true,
+		cause,
+	)
+	s.NoError(err)
+	return task
+}
+
+func (s *dlqRedriverSuite) newTask(taskID int64) *tasks.WorkflowTask {
+	return &tasks.WorkflowTask{
+		WorkflowKey: definition.WorkflowKey{
+			NamespaceID: tests.NamespaceID.String(),
+			WorkflowID:  tests.WorkflowID,
+			RunID:       tests.RunID,
+		},
+		VisibilityTimestamp: s.timeSource.Now(),
+		TaskID:              taskID,
+		TaskQueue:           "test-task-queue",
+		ScheduledEventID:    5,
+	}
+}
+
+func (s *dlqRedriverSuite) queueKey(queueType persistence.QueueV2Type) persistence.QueueKey {
+	return persistence.QueueKey{
+		QueueType:     queueType,
+		Category:      tasks.CategoryTransfer,
+		SourceCluster: cluster.TestCurrentClusterName,
+		TargetCluster: cluster.TestCurrentClusterName,
+	}
+}
+
+func (s *dlqRedriverSuite) readMessages() []persistence.RawHistoryTask {
+	return s.readQueue(persistence.QueueTypeHistoryDLQ)
+}
+
+func (s *dlqRedriverSuite) readRedriveLog() []persistence.RawHistoryTask {
+	return s.readQueue(persistence.QueueTypeHistoryDLQRedrive)
+}
+
+func (s *dlqRedriverSuite) readQueue(queueType persistence.QueueV2Type) []persistence.RawHistoryTask {
+	var rawTasks []persistence.RawHistoryTask
+	var nextPageToken []byte
+	for {
+		resp, err := s.manager.ReadRawTasks(s.ctx, &persistence.ReadRawTasksRequest{
+			QueueKey:      s.queueKey(queueType),
+			PageSize:      dlqRedrivePageSize,
+			NextPageToken: nextPageToken,
+		})
+		if errors.As(err, new(*serviceerror.NotFound)) {
+			return nil
+		}
+		s.NoError(err)
+		rawTasks = append(rawTasks, resp.Tasks...)
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return rawTasks
+		}
+	}
+}
+
+func TestDLQRedriveBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := dynamicconfig.DLQRedrivePolicy{
+		InitialInterval:    time.Minute,
+		BackoffCoefficient: 3,
+		MaximumInterval:    time.Hour,
+	}
+	require.Equal(t, time.Minute, dlqRedriveBackoff(policy, 0))
+	require.Equal(t, 3*time.Minute, dlqRedriveBackoff(policy, 1))
+	require.Equal(t, 9*time.Minute, dlqRedriveBackoff(policy, 2))
+	require.Equal(t, time.Hour, dlqRedriveBackoff(policy, 10))
+
+	policy.BackoffCoefficient = 0
+	policy.MaximumInterval = 0
+	require.Equal(t, time.Minute, dlqRedriveBackoff(policy, 5))
+}
+
+func TestFindDLQRedrivePolicy(t *testing.T) {
+	t.Parallel()
+
+	policies := []dynamicconfig.DLQRedrivePolicy{
+		{Category: "timer", MaximumRedrives: 1},
+		{MaximumRedrives: 2},
+		{Category: "transfer", MaximumRedrives: 3},
+	}
+	policy, ok := findDLQRedrivePolicy(policies, "timer")
+	require.True(t, ok)
+	require.Equal(t, 1, policy.MaximumRedrives)
+	policy, ok = findDLQRedrivePolicy(policies, "transfer")
+	require.True(t, ok)
+	require.Equal(t, 2, policy.MaximumRedrives)
+	_, ok = findDLQRedrivePolicy(nil, "transfer")
+	require.False(t, ok)
+}
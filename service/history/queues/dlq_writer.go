@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"sync"
 
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -21,6 +24,7 @@ type (
 		metricsHandler    metrics.Handler
 		logger            log.SnTaggedLogger
 		namespaceRegistry namespace.Registry
+		timeSource        clock.TimeSource
 		enqueueMutex      sync.Map // map[persistence.QueueKey]*sync.Mutex for per-queue locking
 	}
 	// QueueWriter is a subset of persistence.HistoryTaskQueueManager.
@@ -47,22 +51,26 @@ func NewDLQWriter(
 	h metrics.Handler,
 	l log.SnTaggedLogger,
 	r namespace.Registry,
+	t clock.TimeSource,
 ) *DLQWriter {
 	return &DLQWriter{
 		dlqWriter:         w,
 		metricsHandler:    h,
 		logger:            l,
 		namespaceRegistry: r,
+		timeSource:        t,
 	}
 }
 
-// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist.
+// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist. The cause of the
+// failure, if known, is recorded in the attempt history of the DLQ message.
 func (q *DLQWriter) WriteTaskToDLQ(
 	ctx context.Context,
 	sourceCluster, targetCluster string,
 	sourceShardID int,
 	task tasks.Task,
 	isNamespaceActive bool,
+	cause error,
 ) error {
 	queueKey := persistence.QueueKey{
 		QueueType:     persistence.QueueTypeHistoryDLQ,
@@ -92,6 +100,7 @@ func (q *DLQWriter) WriteTaskToDLQ(
 			TargetCluster: queueKey.TargetCluster,
 			Task:          task,
 			SourceShardID: sourceShardID,
+			DLQState:      q.newDLQTaskState(cause),
 		})
 	}()
 	if err != nil {
@@ -129,6 +138,18 @@ func (q *DLQWriter) WriteTaskToDLQ(
 	return nil
 }
 
+func (q *DLQWriter) newDLQTaskState(cause error) *persistencespb.HistoryDLQTaskState {
+	attempt := &persistencespb.HistoryDLQTaskAttempt{
+		EnqueueTime: timestamppb.New(q.timeSource.Now()),
+	}
+	if cause != nil {
+		attempt.Error = cause.Error()
+	}
+	return &persistencespb.HistoryDLQTaskState{
+		Attempts: []*persistencespb.HistoryDLQTaskAttempt{attempt},
+	}
+}
+
 // getQueueMutex returns a per-queue mutex, creating it if it doesn't exist.
 // This provides process-level locking to serialize concurrent writes to the same queue.
 func (q *DLQWriter) getQueueMutex(queueKey persistence.QueueKey) *sync.Mutex {
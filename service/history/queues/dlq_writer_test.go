@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -54,7 +55,7 @@ func TestDLQWriter_ErrGetNamespaceName(t *testing.T) {
 	logger := &logRecorder{SnTaggedLogger: log.NewTestLogger()}
 	metricsHandler := metricstest.NewCaptureHandler()
 	capture := metricsHandler.StartCapture()
-	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry)
+	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry, clock.NewRealTimeSource())
 	task := &tasks.WorkflowTask{
 		WorkflowKey: definition.WorkflowKey{
 			NamespaceID: string(tests.NamespaceID),
@@ -69,6 +70,7 @@ func TestDLQWriter_ErrGetNamespaceName(t *testing.T) {
 		tasks.GetShardIDForTask(task, 100),
 		task,
 		true,
+		nil,
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
@@ -101,7 +103,8 @@ func TestDLQWriter_Ok(t *testing.T) {
 	logger := &logRecorder{SnTaggedLogger: log.NewTestLogger()}
 	metricsHandler := metricstest.NewCaptureHandler()
 	capture := metricsHandler.StartCapture()
-	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry)
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry, timeSource)
 	task := &tasks.WorkflowTask{
 		WorkflowKey: definition.WorkflowKey{
 			NamespaceID: string(tests.NamespaceID),
@@ -116,12 +119,16 @@ func TestDLQWriter_Ok(t *testing.T) {
 		tasks.GetShardIDForTask(task, 100),
 		task,
 		true,
+		errors.New("task failed"),
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
 	request := queueWriter.EnqueueTaskRequests[0]
 	expectedShardID := tasks.GetShardIDForTask(task, 100)
 	assert.Equal(t, expectedShardID, request.SourceShardID)
+	require.Len(t, request.DLQState.GetAttempts(), 1)
+	assert.Equal(t, "task failed", request.DLQState.GetAttempts()[0].GetError())
+	assert.True(t, timeSource.Now().Equal(request.DLQState.GetAttempts()[0].GetEnqueueTime().AsTime()))
 	assert.NotEmpty(t, logger.records)
 	assert.Contains(t, logger.records[0].msg, "Task enqueued to DLQ")
 	assert.Contains(t, logger.records[0].tags, tag.DLQMessageID(0))
@@ -148,7 +155,7 @@ func TestDLQWriter_ConcurrentWrites(t *testing.T) {
 	namespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(&namespace.Namespace{}, nil).AnyTimes()
 	logger := &logRecorder{SnTaggedLogger: log.NewTestLogger()}
 	metricsHandler := metricstest.NewCaptureHandler()
-	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry)
+	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry, clock.NewRealTimeSource())
 
 	const numConcurrentWrites = 50
 	var g errgroup.Group
@@ -200,6 +207,7 @@ func TestDLQWriter_ConcurrentWrites(t *testing.T) {
 				1, // same shard ID
 				task,
 				true,
+				nil,
 			)
 			require.NoError(t, err)
 			return nil
@@ -227,7 +235,7 @@ func TestDLQWriter_ConcurrentWritesDifferentQueues(t *testing.T) {
 	namespaceRegistry.EXPECT().GetNamespaceByID(gomock.Any()).Return(&namespace.Namespace{}, nil).AnyTimes()
 	logger := &logRecorder{SnTaggedLogger: log.NewTestLogger()}
 	metricsHandler := metricstest.NewCaptureHandler()
-	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry)
+	writer := queues.NewDLQWriter(queueWriter, metricsHandler, logger, namespaceRegistry, clock.NewRealTimeSource())
 
 	const numConcurrentWrites = 50
 	const numQueues = 5
@@ -273,6 +281,7 @@ func TestDLQWriter_ConcurrentWritesDifferentQueues(t *testing.T) {
 				1,
 				task,
 				true,
+				nil,
 			)
 			require.NoError(t, err)
 			return nil
@@ -47,6 +47,7 @@ type (
 		GetPriority() ctasks.Priority
 		GetScheduledTime() time.Time
 		SetScheduledTime(time.Time)
+		GetAttempt() int
 	}
 
 	Executor interface {
@@ -360,7 +361,9 @@ func (e *executableImpl) Execute() (retErr error) {
 		// namespace did a failover,
 		// reset task attempt since the execution logic used will change
 		// reset task priority since it changes between active/standby
+		e.Lock()
 		e.attempt = 1
+		e.Unlock()
 		e.priority = e.priorityAssigner.Assign(e)
 	}
 	e.lastActiveness = resp.ExecutedAsActive
@@ -381,6 +384,7 @@ func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 		tasks.GetShardIDForTask(e.Task, int(numShards)),
 		e.GetTask(),
 		e.lastActiveness,
+		e.terminalFailureCause,
 	)
 	if err != nil {
 		metrics.TaskDLQFailures.With(e.metricsHandler).Record(1)
@@ -445,6 +449,15 @@ func (e *executableImpl) isExpectedRetryableError(err error) (isRetryable bool,
 		}
 	}()
 
+	if common.IsTaskQueueBacklogLimitExceeded(err) {
+		// Matching rejects new tasks until the task queue backlog drains, retry with the
+		// resource exhausted backoff.
+		e.resourceExhaustedCount++
+		metrics.TaskThrottledCounter.With(e.metricsHandler).Record(
+			1, metrics.TaskQueueBacklogLimitCauseTag())
+		return true, err
+	}
+
 	var resourceExhaustedErr *serviceerror.ResourceExhausted
 	if errors.As(err, &resourceExhaustedErr) {
 		switch resourceExhaustedErr.Cause { //nolint:exhaustive
@@ -730,6 +743,13 @@ func (e *executableImpl) SetScheduledTime(t time.Time) {
 	e.scheduledTime = t
 }
 
+func (e *executableImpl) GetAttempt() int {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.attempt
+}
+
 // GetDestination returns the embedded task's destination if it exists. Defaults to an empty string.
 func (e *executableImpl) GetDestination() string {
 	if t, ok := e.Task.(tasks.HasDestination); ok {
@@ -754,9 +774,7 @@ func (e *executableImpl) shouldResubmitOnNack(err error) bool {
 		return false
 	}
 
-	if !errors.Is(err, consts.ErrResourceExhaustedBusyWorkflow) &&
-		common.IsResourceExhausted(err) &&
-		e.resourceExhaustedCount > resourceExhaustedResubmitMaxAttempts {
+	if isThrottledError(err) && e.resourceExhaustedCount > resourceExhaustedResubmitMaxAttempts {
 		return false
 	}
 
@@ -773,6 +791,15 @@ func (e *executableImpl) shouldResubmitOnNack(err error) bool {
 		err != consts.ErrNamespaceHandover
 }
 
+// isThrottledError returns true for resource exhausted errors, other than busy workflow, which are
+// retried with the slower resource exhausted backoff.
+func isThrottledError(err error) bool {
+	if errors.Is(err, consts.ErrResourceExhaustedBusyWorkflow) {
+		return false
+	}
+	return common.IsResourceExhausted(err) || common.IsTaskQueueBacklogLimitExceeded(err)
+}
+
 func (e *executableImpl) backoffDuration(
 	err error,
 ) time.Duration {
@@ -792,7 +819,7 @@ func (e *executableImpl) backoffDuration(
 	}
 
 	backoffDuration := reschedulePolicy.ComputeNextDelay(0, e.attempt, err)
-	if !errors.Is(err, consts.ErrResourceExhaustedBusyWorkflow) && common.IsResourceExhausted(err) {
+	if isThrottledError(err) {
 		// try a different reschedule policy to slow down retry
 		// upon system resource exhausted error and pick the longer backoff duration
 		backoffDuration = max(
@@ -805,7 +832,10 @@ func (e *executableImpl) backoffDuration(
 }
 
 func (e *executableImpl) incAttempt() {
+	// attempt is read by the queue when checking for tasks with too many attempts
+	e.Lock()
 	e.attempt++
+	e.Unlock()
 
 	if e.attempt > taskCriticalLogMetricAttempts {
 		metrics.TaskAttempt.With(e.metricsHandler).Record(int64(e.attempt))
@@ -95,6 +95,20 @@ func (mr *MockExecutableMockRecorder) Execute() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExecutable)(nil).Execute))
 }
 
+// GetAttempt mocks base method.
+func (m *MockExecutable) GetAttempt() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttempt")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetAttempt indicates an expected call of GetAttempt.
+func (mr *MockExecutableMockRecorder) GetAttempt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttempt", reflect.TypeOf((*MockExecutable)(nil).GetAttempt))
+}
+
 // GetCategory mocks base method.
 func (m *MockExecutable) GetCategory() tasks0.Category {
 	m.ctrl.T.Helper()
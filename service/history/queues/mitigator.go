@@ -3,6 +3,7 @@ package queues
 import (
 	"sync"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -15,9 +16,16 @@ type (
 	// Mitigator generates and runs an Action for resolving the given Alert
 	Mitigator interface {
 		Mitigate(Alert)
+		RunAction(*ActionRequest) (actionTaken bool, err error)
 	}
 
-	actionRunner func(Action, *ReaderGroup, metrics.Handler)
+	actionRunner func(Action, *ReaderGroup, metrics.Handler) bool
+
+	// alertActionFactory creates the Action for resolving an Alert.
+	alertActionFactory func(*mitigatorImpl, Alert) Action
+
+	// requestActionFactory creates the Action for an operator ActionRequest.
+	requestActionFactory func(*mitigatorImpl, *ActionRequest) (Action, error)
 
 	mitigatorImpl struct {
 		sync.Mutex
@@ -32,9 +40,129 @@ type (
 		// since we don't really want to run the action in Mitigator unit tests
 		actionRunner actionRunner
 		grouper      Grouper
+
+		alertActionFactories   map[AlertType]alertActionFactory
+		requestActionFactories map[string]requestActionFactory
 	}
 )
 
+// defaultAlertActionFactories maps each AlertType to the Action that resolves it.
+// Supporting a new AlertType only requires registering its Action here.
+var defaultAlertActionFactories = map[AlertType]alertActionFactory{
+	AlertTypeQueuePendingTaskCount: func(m *mitigatorImpl, alert Alert) Action {
+		return newQueuePendingTaskAction(
+			alert.AlertAttributesQueuePendingTaskCount,
+			m.monitor,
+			m.maxReaderCount(),
+			m.grouper,
+		)
+	},
+	AlertTypeReaderStuck: func(m *mitigatorImpl, alert Alert) Action {
+		return newReaderStuckAction(
+			alert.AlertAttributesReaderStuck,
+			m.logger,
+		)
+	},
+	AlertTypeSliceCount: func(m *mitigatorImpl, alert Alert) Action {
+		return newSliceCountAction(
+			alert.AlertAttributesSliceCount,
+			m.monitor,
+		)
+	},
+	AlertTypeNamespaceTaskLatency: func(m *mitigatorImpl, alert Alert) Action {
+		return newIsolateNamespaceAction(
+			alert.AlertAttributesNamespaceTaskLatency.NamespaceID,
+			m.maxReaderCount(),
+			m.logger,
+		)
+	},
+	AlertTypeTaskAttempts: func(m *mitigatorImpl, alert Alert) Action {
+		return newIsolateNamespaceAction(
+			alert.AlertAttributesTaskAttempts.NamespaceID,
+			m.maxReaderCount(),
+			m.logger,
+		)
+	},
+}
+
+// defaultRequestActionFactories maps each Action name to the Action an operator can run on demand.
+var defaultRequestActionFactories = map[string]requestActionFactory{
+	ActionNameQueuePendingTask: func(m *mitigatorImpl, request *ActionRequest) (Action, error) {
+		if request.Threshold <= 0 {
+			return nil, serviceerror.NewInvalidArgumentf("%v action requires a positive threshold", request.Action)
+		}
+		return newQueuePendingTaskAction(
+			&AlertAttributesQueuePendingTaskCount{
+				CurrentPendingTaskCount:   m.monitor.GetTotalPendingTaskCount(),
+				CiriticalPendingTaskCount: request.Threshold,
+			},
+			m.monitor,
+			m.maxReaderCount(),
+			m.grouper,
+		), nil
+	},
+	ActionNameReaderStuck: func(m *mitigatorImpl, request *ActionRequest) (Action, error) {
+		watermark, ok := m.monitor.GetReaderWatermark(request.ReaderID)
+		if !ok {
+			// watermark is only tracked for the default reader of scheduled queues,
+			// fallback to the beginning of the reader's first slice.
+			reader, ok := m.readerGroup.ReaderByID(request.ReaderID)
+			if !ok {
+				return nil, serviceerror.NewInvalidArgumentf("queue reader %v not found", request.ReaderID)
+			}
+			scopes := reader.Scopes()
+			if len(scopes) == 0 {
+				return nil, serviceerror.NewInvalidArgumentf("queue reader %v has no pending tasks", request.ReaderID)
+			}
+			watermark = scopes[0].Range.InclusiveMin
+		}
+		return newReaderStuckAction(
+			&AlertAttributesReaderStuck{
+				ReaderID:         request.ReaderID,
+				CurrentWatermark: watermark,
+			},
+			m.logger,
+		), nil
+	},
+	ActionNameSliceCount: func(m *mitigatorImpl, request *ActionRequest) (Action, error) {
+		if request.Threshold <= 0 {
+			return nil, serviceerror.NewInvalidArgumentf("%v action requires a positive threshold", request.Action)
+		}
+		return newSliceCountAction(
+			&AlertAttributesSlicesCount{
+				CurrentSliceCount:  m.monitor.GetTotalSliceCount(),
+				CriticalSliceCount: request.Threshold,
+			},
+			m.monitor,
+		), nil
+	},
+	ActionNameMoveGroup: func(m *mitigatorImpl, request *ActionRequest) (Action, error) {
+		if request.Threshold <= 0 {
+			return nil, serviceerror.NewInvalidArgumentf("%v action requires a positive threshold", request.Action)
+		}
+		return newMoveGroupAction(
+			m.maxReaderCount(),
+			m.grouper,
+			request.Threshold,
+			1,
+			m.logger,
+		), nil
+	},
+	ActionNameSlicePredicate: func(m *mitigatorImpl, _ *ActionRequest) (Action, error) {
+		return newSlicePredicateAction(m.monitor, m.maxReaderCount()), nil
+	},
+	ActionNameIsolateNamespace: func(m *mitigatorImpl, request *ActionRequest) (Action, error) {
+		if request.NamespaceID == "" {
+			return nil, serviceerror.NewInvalidArgumentf("%v action requires a namespace ID", request.Action)
+		}
+		return newIsolateNamespaceAction(
+			request.NamespaceID,
+			m.maxReaderCount(),
+			m.logger,
+		), nil
+	},
+}
+
 func newMitigator(
 	readerGroup *ReaderGroup,
 	monitor Monitor,
@@ -52,6 +180,9 @@ func newMitigator(
 
 		actionRunner: runAction,
 		grouper:      grouper,
+
+		alertActionFactories:   defaultAlertActionFactories,
+		requestActionFactories: defaultRequestActionFactories,
 	}
 }
 
@@ -59,32 +190,14 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 	m.Lock()
 	defer m.Unlock()
 
-	var action Action
-	switch alert.AlertType {
-	case AlertTypeQueuePendingTaskCount:
-		action = newQueuePendingTaskAction(
-			alert.AlertAttributesQueuePendingTaskCount,
-			m.monitor,
-			m.maxReaderCount(),
-			m.grouper,
-		)
-	case AlertTypeReaderStuck:
-		action = newReaderStuckAction(
-			alert.AlertAttributesReaderStuck,
-			m.logger,
-		)
-	case AlertTypeSliceCount:
-		action = newSliceCountAction(
-			alert.AlertAttributesSliceCount,
-			m.monitor,
-		)
-	default:
+	factory, ok := m.alertActionFactories[alert.AlertType]
+	if !ok {
 		m.logger.Error("Unknown queue alert type", tag.QueueAlert(alert))
 		return
 	}
 
 	m.actionRunner(
-		action,
+		factory(m, alert),
 		m.readerGroup,
 		m.metricsHandler,
 	)
@@ -92,15 +205,40 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 	m.monitor.ResolveAlert(alert.AlertType)
 }
 
+func (m *mitigatorImpl) RunAction(request *ActionRequest) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	factory, ok := m.requestActionFactories[request.Action]
+	if !ok {
+		return false, serviceerror.NewInvalidArgumentf("unknown queue action: %v", request.Action)
+	}
+
+	action, err := factory(m, request)
+	if err != nil {
+		return false, err
+	}
+
+	m.logger.Info("Running requested queue action",
+		tag.QueueAction(request),
+	)
+	return m.actionRunner(
+		action,
+		m.readerGroup,
+		m.metricsHandler,
+	), nil
+}
+
 func runAction(
 	action Action,
 	readerGroup *ReaderGroup,
 	metricsHandler metrics.Handler,
-) {
+) bool {
 	if !action.Run(readerGroup) {
-		return
+		return false
 	}
 
 	metricsHandler = metricsHandler.WithTags(metrics.QueueActionTag(action.Name()))
 	metrics.QueueActionCounter.With(metricsHandler).Record(1)
+	return true
 }
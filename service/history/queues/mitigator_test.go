@@ -2,9 +2,11 @@ package queues
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -87,6 +89,28 @@ func (s *mitigatorSuite) TestMitigate_ActionMatchAlert() {
 			},
 			expectedAction: &actionSliceCount{},
 		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeNamespaceTaskLatency,
+				AlertAttributesNamespaceTaskLatency: &AlertAttributesNamespaceTaskLatency{
+					NamespaceID:     "test-namespace-id",
+					CurrentLatency:  2 * time.Minute,
+					CriticalLatency: time.Minute,
+				},
+			},
+			expectedAction: &actionIsolateNamespace{},
+		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeTaskAttempts,
+				AlertAttributesTaskAttempts: &AlertAttributesTaskAttempts{
+					NamespaceID:      "test-namespace-id",
+					CurrentAttempts:  20,
+					CriticalAttempts: 10,
+				},
+			},
+			expectedAction: &actionIsolateNamespace{},
+		},
 	}
 
 	var actualAction Action
@@ -94,8 +118,9 @@ func (s *mitigatorSuite) TestMitigate_ActionMatchAlert() {
 		action Action,
 		_ *ReaderGroup,
 		_ metrics.Handler,
-	) {
+	) bool {
 		actualAction = action
+		return true
 	}
 
 	for _, tc := range testCases {
@@ -109,7 +134,8 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 		_ Action,
 		_ *ReaderGroup,
 		_ metrics.Handler,
-	) {
+	) bool {
+		return true
 	}
 
 	alert := Alert{
@@ -124,6 +150,115 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 	s.Equal(alert.AlertType, s.monitor.resolvedAlertType)
 }
 
+func (s *mitigatorSuite) TestRunAction_ActionMatchRequest() {
+	testCases := []struct {
+		request        *ActionRequest
+		expectedAction Action
+	}{
+		{
+			request: &ActionRequest{
+				Action:    ActionNameQueuePendingTask,
+				Threshold: 500,
+			},
+			expectedAction: &actionQueuePendingTask{},
+		},
+		{
+			request: &ActionRequest{
+				Action:    ActionNameSliceCount,
+				Threshold: 500,
+			},
+			expectedAction: &actionSliceCount{},
+		},
+		{
+			request: &ActionRequest{
+				Action:    ActionNameMoveGroup,
+				Threshold: 100,
+			},
+			expectedAction: &actionMoveGroup{},
+		},
+		{
+			request: &ActionRequest{
+				Action: ActionNameSlicePredicate,
+			},
+			expectedAction: &slicePredicateAction{},
+		},
+		{
+			request: &ActionRequest{
+				Action:      ActionNameIsolateNamespace,
+				NamespaceID: "test-namespace-id",
+			},
+			expectedAction: &actionIsolateNamespace{},
+		},
+	}
+
+	var actualAction Action
+	s.mitigator.actionRunner = func(
+		action Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+	) bool {
+		actualAction = action
+		return true
+	}
+
+	for _, tc := range testCases {
+		actionTaken, err := s.mitigator.RunAction(tc.request)
+		s.NoError(err)
+		s.True(actionTaken)
+		s.IsType(tc.expectedAction, actualAction)
+	}
+}
+
+func (s *mitigatorSuite) TestRunAction_InvalidRequest() {
+	s.mitigator.actionRunner = func(
+		_ Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+	) bool {
+		s.Fail("action should not be run for invalid requests")
+		return false
+	}
+
+	for _, request := range []*ActionRequest{
+		{Action: "unknown-action"},
+		{Action: ActionNameQueuePendingTask},
+		{Action: ActionNameSliceCount},
+		{Action: ActionNameMoveGroup},
+		{Action: ActionNameIsolateNamespace},
+	} {
+		actionTaken, err := s.mitigator.RunAction(request)
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+		s.False(actionTaken)
+	}
+}
+
+func (s *mitigatorSuite) TestRunAction_NoActionTaken() {
+	s.mitigator.actionRunner = func(
+		_ Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+	) bool {
+		return false
+	}
+
+	actionTaken, err := s.mitigator.RunAction(&ActionRequest{
+		Action:      ActionNameIsolateNamespace,
+		NamespaceID: "test-namespace-id",
+	})
+	s.NoError(err)
+	s.False(actionTaken)
+	s.Zero(s.monitor.resolvedAlertType)
+}
+
+func (m *testMonitor) GetTotalPendingTaskCount() int {
+	return 1000
+}
+
+func (m *testMonitor) GetTotalSliceCount() int {
+	return 1000
+}
+
 func (m *testMonitor) ResolveAlert(alertType AlertType) {
 	m.resolvedAlertType = alertType
 }
@@ -33,6 +33,9 @@ type (
 		GetSliceCount(readerID int64) int
 		SetSliceCount(readerID int64, count int)
 
+		SetNamespaceTaskLatency(namespaceID string, latency time.Duration)
+		SetNamespaceTaskAttempts(namespaceID string, attempts int)
+
 		RemoveSlice(slice Slice)
 		RemoveReader(readerID int64)
 
@@ -46,6 +49,9 @@ type (
 		PendingTasksCriticalCount   dynamicconfig.IntPropertyFn
 		ReaderStuckCriticalAttempts dynamicconfig.IntPropertyFn
 		SliceCountCriticalThreshold dynamicconfig.IntPropertyFn
+
+		NamespaceTaskLatencyCriticalThreshold dynamicconfig.DurationPropertyFn
+		TaskAttemptsCriticalThreshold         dynamicconfig.IntPropertyFn
 	}
 
 	monitorImpl struct {
@@ -232,6 +238,40 @@ func (m *monitorImpl) SetSliceCount(readerID int64, count int) {
 	}
 }
 
+func (m *monitorImpl) SetNamespaceTaskLatency(namespaceID string, latency time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalLatency := m.options.NamespaceTaskLatencyCriticalThreshold()
+	if criticalLatency > 0 && latency > criticalLatency {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeNamespaceTaskLatency,
+			AlertAttributesNamespaceTaskLatency: &AlertAttributesNamespaceTaskLatency{
+				NamespaceID:     namespaceID,
+				CurrentLatency:  latency,
+				CriticalLatency: criticalLatency,
+			},
+		})
+	}
+}
+
+func (m *monitorImpl) SetNamespaceTaskAttempts(namespaceID string, attempts int) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalAttempts := m.options.TaskAttemptsCriticalThreshold()
+	if criticalAttempts > 0 && attempts >= criticalAttempts {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeTaskAttempts,
+			AlertAttributesTaskAttempts: &AlertAttributesTaskAttempts{
+				NamespaceID:      namespaceID,
+				CurrentAttempts:  attempts,
+				CriticalAttempts: criticalAttempts,
+			},
+		})
+	}
+}
+
 func (m *monitorImpl) RemoveSlice(slice Slice) {
 	m.Lock()
 	defer m.Unlock()
@@ -37,9 +37,11 @@ func (s *monitorSuite) SetupTest() {
 		tasks.CategoryTypeScheduled,
 		s.mockTimeSource,
 		&MonitorOptions{
-			PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-			ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-			SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+			PendingTasksCriticalCount:             dynamicconfig.GetIntPropertyFn(1000),
+			ReaderStuckCriticalAttempts:           dynamicconfig.GetIntPropertyFn(5),
+			SliceCountCriticalThreshold:           dynamicconfig.GetIntPropertyFn(50),
+			NamespaceTaskLatencyCriticalThreshold: dynamicconfig.GetDurationPropertyFn(time.Minute),
+			TaskAttemptsCriticalThreshold:         dynamicconfig.GetIntPropertyFn(10),
 		},
 	)
 	s.alertCh = s.monitor.AlertCh()
@@ -187,6 +189,62 @@ func (s *monitorSuite) TestSliceCount() {
 	}, *alert)
 }
 
+func (s *monitorSuite) TestNamespaceTaskLatency() {
+	threshold := s.monitor.options.NamespaceTaskLatencyCriticalThreshold()
+
+	s.monitor.SetNamespaceTaskLatency("test-namespace-id", threshold/2)
+	select {
+	case <-s.alertCh:
+		s.Fail("should not trigger alert")
+	default:
+	}
+
+	s.monitor.SetNamespaceTaskLatency("test-namespace-id", threshold*2)
+	alert := <-s.alertCh
+	s.Equal(Alert{
+		AlertType: AlertTypeNamespaceTaskLatency,
+		AlertAttributesNamespaceTaskLatency: &AlertAttributesNamespaceTaskLatency{
+			NamespaceID:     "test-namespace-id",
+			CurrentLatency:  threshold * 2,
+			CriticalLatency: threshold,
+		},
+	}, *alert)
+
+	s.monitor.SetNamespaceTaskLatency("other-namespace-id", threshold*2)
+	select {
+	case <-s.alertCh:
+		s.Fail("should have only one outstanding namespace task latency alert")
+	default:
+	}
+}
+
+func (s *monitorSuite) TestNamespaceTaskAttempts() {
+	threshold := s.monitor.options.TaskAttemptsCriticalThreshold()
+
+	s.monitor.SetNamespaceTaskAttempts("test-namespace-id", threshold-1)
+	select {
+	case <-s.alertCh:
+		s.Fail("should not trigger alert")
+	default:
+	}
+
+	s.monitor.SetNamespaceTaskAttempts("test-namespace-id", threshold)
+	alert := <-s.alertCh
+	s.Equal(Alert{
+		AlertType: AlertTypeTaskAttempts,
+		AlertAttributesTaskAttempts: &AlertAttributesTaskAttempts{
+			NamespaceID:      "test-namespace-id",
+			CurrentAttempts:  threshold,
+			CriticalAttempts: threshold,
+		},
+	}, *alert)
+
+	s.monitor.ResolveAlert(alert.AlertType)
+	s.monitor.SetNamespaceTaskAttempts("other-namespace-id", threshold*2)
+	alert = <-s.alertCh
+	s.Equal("other-namespace-id", alert.AlertAttributesTaskAttempts.NamespaceID)
+}
+
 func (s *monitorSuite) TestResolveAlert() {
 	sliceCount := s.monitor.options.SliceCountCriticalThreshold() * 2
 
@@ -1,6 +1,8 @@
 package queues
 
 import (
+	"context"
+
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -11,6 +13,7 @@ type (
 		Category() tasks.Category
 		NotifyNewTasks(tasks []tasks.Task)
 		FailoverNamespace(namespaceID string)
+		RunAction(ctx context.Context, request *ActionRequest) (actionTaken bool, err error)
 		Start()
 		Stop()
 	}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -18,6 +19,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/predicates"
 	"go.temporal.io/server/common/quotas"
+	ctasks "go.temporal.io/server/common/tasks"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -46,6 +48,8 @@ const (
 	forceNewSliceDuration = 5 * time.Minute
 )
 
+var errQueueShutdown = serviceerror.NewUnavailable("queue is shutting down")
+
 type (
 	queueState struct {
 		readerScopes                 map[int64][]Scope
@@ -83,7 +87,18 @@ type (
 		checkpointRetrier backoff.Retrier
 		checkpointTimer   *time.Timer
 
-		alertCh <-chan *Alert
+		alertCh  <-chan *Alert
+		actionCh chan *pendingActionRequest
+	}
+
+	pendingActionRequest struct {
+		request  *ActionRequest
+		resultCh chan pendingActionResult
+	}
+
+	pendingActionResult struct {
+		actionTaken bool
+		err         error
 	}
 
 	Options struct {
@@ -224,7 +239,8 @@ func newQueueBase(
 			clock.NewRealTimeSource(),
 		),
 
-		alertCh: monitor.AlertCh(),
+		alertCh:  monitor.AlertCh(),
+		actionCh: make(chan *pendingActionRequest),
 	}
 }
 
@@ -255,6 +271,34 @@ func (p *queueBase) FailoverNamespace(
 	p.rescheduler.Reschedule(namespaceID)
 }
 
+// RunAction hands the request over to the queue's event loop and waits for the action to complete.
+func (p *queueBase) RunAction(
+	ctx context.Context,
+	request *ActionRequest,
+) (bool, error) {
+	pending := &pendingActionRequest{
+		request:  request,
+		resultCh: make(chan pendingActionResult, 1),
+	}
+
+	select {
+	case p.actionCh <- pending:
+	case <-p.shutdownCh:
+		return false, errQueueShutdown
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+
+	select {
+	case result := <-pending.resultCh:
+		return result.actionTaken, result.err
+	case <-p.shutdownCh:
+		return false, errQueueShutdown
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+}
+
 func (p *queueBase) processNewRange() {
 	newMaxKey := p.shard.GetQueueExclusiveHighReadWatermark(p.category)
 
@@ -292,6 +336,8 @@ func (p *queueBase) checkpoint() {
 		tasksCompleted += r.ShrinkSlices()
 	})
 
+	p.reportNamespaceTaskStats()
+
 	var checkpointAction Action
 	maxReaderCount := p.options.MaxReaderCount()
 	if taskCountBase := p.options.MoveGroupTaskCountBase(); taskCountBase > 0 {
@@ -350,6 +396,55 @@ func (p *queueBase) checkpoint() {
 	p.resetCheckpointTimer(err)
 }
 
+// reportNamespaceTaskStats reports the namespaces with the highest pending task latency and
+// the most task attempts to the monitor, which will raise an alert if they are above the critical threshold.
+// Tasks already in the isolation reader are skipped as there's nowhere else to move them.
+func (p *queueBase) reportNamespaceTaskStats() {
+	criticalLatency := p.options.NamespaceTaskLatencyCriticalThreshold()
+	criticalAttempts := p.options.TaskAttemptsCriticalThreshold()
+	if criticalLatency <= 0 && criticalAttempts <= 0 {
+		return
+	}
+
+	isolationReaderID := isolationReaderID(p.options.MaxReaderCount())
+	if isolationReaderID <= DefaultReaderId {
+		return
+	}
+
+	now := p.timeSource.Now()
+	var maxLatencyNamespaceID, maxAttemptsNamespaceID string
+	var maxLatency time.Duration
+	var maxAttempts int
+	p.readerGroup.ForEach(func(readerID int64, r Reader) {
+		if readerID >= isolationReaderID {
+			return
+		}
+
+		r.WalkSlices(func(s Slice) {
+			s.WalkExecutables(func(e Executable) {
+				if e.State() != ctasks.TaskStatePending {
+					return
+				}
+				if latency := now.Sub(e.GetVisibilityTime()); latency > maxLatency {
+					maxLatency = latency
+					maxLatencyNamespaceID = e.GetNamespaceID()
+				}
+				if attempts := e.GetAttempt(); attempts > maxAttempts {
+					maxAttempts = attempts
+					maxAttemptsNamespaceID = e.GetNamespaceID()
+				}
+			})
+		})
+	})
+
+	if maxLatencyNamespaceID != "" {
+		p.monitor.SetNamespaceTaskLatency(maxLatencyNamespaceID, maxLatency)
+	}
+	if maxAttemptsNamespaceID != "" {
+		p.monitor.SetNamespaceTaskAttempts(maxAttemptsNamespaceID, maxAttempts)
+	}
+}
+
 func (p *queueBase) updateShardRangeID() bool {
 	newRangeID := p.shard.GetRangeID()
 	if p.lastRangeID < newRangeID {
@@ -433,6 +528,20 @@ func (p *queueBase) handleAlert(alert *Alert) {
 	p.notifyReaders()
 }
 
+func (p *queueBase) handleActionRequest(pending *pendingActionRequest) {
+	actionTaken, err := p.mitigator.RunAction(pending.request)
+	if actionTaken {
+		// same as handleAlert, checkpoint the action taken & update reader progress
+		p.checkpoint()
+		p.notifyReaders()
+	}
+
+	pending.resultCh <- pendingActionResult{
+		actionTaken: actionTaken,
+		err:         err,
+	}
+}
+
 func (p *queueBase) notifyReaders() {
 	p.readerGroup.ForEach(func(_ int64, r Reader) {
 		r.Notify()
@@ -57,9 +57,11 @@ var testQueueOptions = Options{
 		MaxPredicateSize:     dynamicconfig.GetIntPropertyFn(0),
 	},
 	MonitorOptions: MonitorOptions{
-		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+		PendingTasksCriticalCount:             dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:           dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:           dynamicconfig.GetIntPropertyFn(50),
+		NamespaceTaskLatencyCriticalThreshold: dynamicconfig.GetDurationPropertyFn(time.Minute),
+		TaskAttemptsCriticalThreshold:         dynamicconfig.GetIntPropertyFn(10),
 	},
 	MaxPollRPS:                          dynamicconfig.GetIntPropertyFn(20),
 	MaxPollInterval:                     dynamicconfig.GetDurationPropertyFn(time.Minute * 5),
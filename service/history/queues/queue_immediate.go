@@ -149,6 +149,8 @@ func (p *immediateQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case pending := <-p.actionCh:
+			p.handleActionRequest(pending)
 		}
 	}
 }
@@ -10,6 +10,7 @@
 package queues
 
 import (
+	context "context"
 	reflect "reflect"
 
 	tasks "go.temporal.io/server/service/history/tasks"
@@ -78,6 +79,21 @@ func (mr *MockQueueMockRecorder) NotifyNewTasks(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockQueue)(nil).NotifyNewTasks), arg0)
 }
 
+// RunAction mocks base method.
+func (m *MockQueue) RunAction(ctx context.Context, request *ActionRequest) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAction", ctx, request)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAction indicates an expected call of RunAction.
+func (mr *MockQueueMockRecorder) RunAction(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAction", reflect.TypeOf((*MockQueue)(nil).RunAction), ctx, request)
+}
+
 // Start mocks base method.
 func (m *MockQueue) Start() {
 	m.ctrl.T.Helper()
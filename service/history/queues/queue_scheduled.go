@@ -196,6 +196,8 @@ func (p *scheduledQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case pending := <-p.actionCh:
+			p.handleActionRequest(pending)
 		}
 	}
 }
@@ -81,9 +81,11 @@ func (s *readerSuite) SetupTest() {
 		)
 	})
 	s.monitor = newMonitor(tasks.CategoryTypeScheduled, clock.NewRealTimeSource(), &MonitorOptions{
-		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+		PendingTasksCriticalCount:             dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:           dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:           dynamicconfig.GetIntPropertyFn(50),
+		NamespaceTaskLatencyCriticalThreshold: dynamicconfig.GetDurationPropertyFn(time.Minute),
+		TaskAttemptsCriticalThreshold:         dynamicconfig.GetIntPropertyFn(10),
 	})
 }
 
@@ -30,6 +30,7 @@ type (
 		SelectTasks(readerID int64, batchSize int) ([]Executable, error)
 		MoreTasks() bool
 		TaskStats() TaskStats
+		WalkExecutables(func(Executable))
 		Clear()
 	}
 
@@ -405,6 +406,12 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	}
 }
 
+func (s *SliceImpl) WalkExecutables(iterator func(Executable)) {
+	s.stateSanityCheck()
+
+	s.executableTracker.walk(iterator)
+}
+
 func (s *SliceImpl) Clear() {
 	s.stateSanityCheck()
 
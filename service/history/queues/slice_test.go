@@ -78,9 +78,11 @@ func (s *sliceSuite) SetupTest() {
 		)
 	})
 	s.monitor = newMonitor(tasks.CategoryTypeScheduled, clock.NewRealTimeSource(), &MonitorOptions{
-		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+		PendingTasksCriticalCount:             dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:           dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:           dynamicconfig.GetIntPropertyFn(50),
+		NamespaceTaskLatencyCriticalThreshold: dynamicconfig.GetDurationPropertyFn(time.Minute),
+		TaskAttemptsCriticalThreshold:         dynamicconfig.GetIntPropertyFn(10),
 	})
 }
 
@@ -1,7 +1,10 @@
 package queues
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -100,3 +103,7 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 
 func (q SpeculativeWorkflowTaskTimeoutQueue) FailoverNamespace(_ string) {
 }
+
+func (q SpeculativeWorkflowTaskTimeoutQueue) RunAction(_ context.Context, _ *ActionRequest) (bool, error) {
+	return false, serviceerror.NewUnimplemented("in-memory queue does not support queue actions")
+}
@@ -105,6 +105,12 @@ func (t *executableTracker) shrink() (tasks.Key, int) {
 	return minPendingTaskKey, tasksCompleted
 }
 
+func (t *executableTracker) walk(iterator func(Executable)) {
+	for _, executable := range t.pendingExecutables {
+		iterator(executable)
+	}
+}
+
 func (t *executableTracker) clear() {
 	for _, executable := range t.pendingExecutables {
 		executable.Cancel()
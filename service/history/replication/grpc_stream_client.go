@@ -7,6 +7,8 @@ import (
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/configs"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -14,16 +16,19 @@ type (
 	StreamBiDirectionStreamClientProvider struct {
 		clusterMetadata cluster.Metadata
 		clientBean      client.Bean
+		config          *configs.Config
 	}
 )
 
 func NewStreamBiDirectionStreamClientProvider(
 	clusterMetadata cluster.Metadata,
 	clientBean client.Bean,
+	config *configs.Config,
 ) *StreamBiDirectionStreamClientProvider {
 	return &StreamBiDirectionStreamClientProvider{
 		clusterMetadata: clusterMetadata,
 		clientBean:      clientBean,
+		config:          config,
 	}
 }
 
@@ -41,15 +46,21 @@ func (p *StreamBiDirectionStreamClientProvider) Get(
 	if err != nil {
 		return nil, err
 	}
-	ctx = metadata.NewOutgoingContext(ctx, history.EncodeClusterShardMD(
-		history.ClusterShardID{
-			ClusterID: clientShardKey.ClusterID,
-			ShardID:   clientShardKey.ShardID,
-		},
-		history.ClusterShardID{
-			ClusterID: serverShardKey.ClusterID,
-			ShardID:   serverShardKey.ShardID,
-		},
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Join(
+		history.EncodeClusterShardMD(
+			history.ClusterShardID{
+				ClusterID: clientShardKey.ClusterID,
+				ShardID:   clientShardKey.ShardID,
+			},
+			history.ClusterShardID{
+				ClusterID: serverShardKey.ClusterID,
+				ShardID:   serverShardKey.ShardID,
+			},
+		),
+		StreamCapabilities{
+			MaxBatchSize: max(p.config.ReplicationStreamReceiverMaxBatchSize(), 1),
+			Compression:  []serialization.CompressionType{serialization.CompressionTypeZstd, serialization.CompressionTypeSnappy},
+		}.Encode(),
 	))
 	return adminClient.StreamWorkflowReplicationMessages(ctx)
 }
@@ -0,0 +1,122 @@
+package replication
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/persistence/serialization"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	MetadataKeyStreamMaxBatchSize = "temporal-replication-stream-max-batch-size"
+	MetadataKeyStreamCompression  = "temporal-replication-stream-compression"
+)
+
+type (
+	// StreamCapabilities is what a stream receiver advertises to the sender when it opens the stream.
+	// Receivers that predate batching advertise nothing, and get one uncompressed task per message.
+	StreamCapabilities struct {
+		// MaxBatchSize is the maximum number of replication tasks the receiver accepts in a single message.
+		MaxBatchSize int
+		// Compression lists the codecs the receiver can decompress history blobs with.
+		Compression []serialization.CompressionType
+	}
+)
+
+// Encode returns the stream metadata advertising the capabilities.
+func (c StreamCapabilities) Encode() metadata.MD {
+	codecs := make([]string, 0, len(c.Compression))
+	for _, compression := range c.Compression {
+		codecs = append(codecs, compression.String())
+	}
+	return metadata.Pairs(
+		MetadataKeyStreamMaxBatchSize, strconv.Itoa(c.MaxBatchSize),
+		MetadataKeyStreamCompression, strings.Join(codecs, ","),
+	)
+}
+
+// DecodeStreamCapabilities reads the capabilities advertised by the stream receiver.
+// Missing or unknown values fall back to what every receiver supports.
+func DecodeStreamCapabilities(getter headers.HeaderGetter) StreamCapabilities {
+	capabilities := StreamCapabilities{MaxBatchSize: 1}
+	if maxBatchSize, err := strconv.Atoi(getter.Get(MetadataKeyStreamMaxBatchSize)); err == nil && maxBatchSize > 1 {
+		capabilities.MaxBatchSize = maxBatchSize
+	}
+	for _, name := range strings.Split(getter.Get(MetadataKeyStreamCompression), ",") {
+		compression, err := serialization.CompressionTypeFromString(strings.TrimSpace(name))
+		if err != nil || compression == serialization.CompressionTypeNone {
+			continue
+		}
+		capabilities.Compression = append(capabilities.Compression, compression)
+	}
+	return capabilities
+}
+
+// SupportsCompression reports whether the receiver can decompress blobs compressed with the given codec.
+func (c StreamCapabilities) SupportsCompression(compression serialization.CompressionType) bool {
+	return compression == serialization.CompressionTypeNone || slices.Contains(c.Compression, compression)
+}
+
+// compressReplicationTask compresses the history blobs of the task in place.
+func compressReplicationTask(task *replicationspb.ReplicationTask, compression serialization.CompressionType) error {
+	if compression == serialization.CompressionTypeNone {
+		return nil
+	}
+	return rewriteHistoryBlobs(task, func(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+		return serialization.CompressBlob(blob, compression)
+	})
+}
+
+// decompressReplicationTask restores plain proto3 history blobs of the task in place.
+func decompressReplicationTask(task *replicationspb.ReplicationTask) error {
+	return rewriteHistoryBlobs(task, serialization.DecompressBlob)
+}
+
+// rewriteHistoryBlobs replaces every history event blob carried by the task with the result of fn.
+func rewriteHistoryBlobs(
+	task *replicationspb.ReplicationTask,
+	fn func(*commonpb.DataBlob) (*commonpb.DataBlob, error),
+) error {
+	var err error
+	rewrite := func(blob *commonpb.DataBlob) *commonpb.DataBlob {
+		if err != nil || blob == nil {
+			return blob
+		}
+		var rewritten *commonpb.DataBlob
+		if rewritten, err = fn(blob); err != nil {
+			return blob
+		}
+		return rewritten
+	}
+	rewriteAll := func(blobs []*commonpb.DataBlob) {
+		for i := range blobs {
+			blobs[i] = rewrite(blobs[i])
+		}
+	}
+	rewriteNewRunInfo := func(newRunInfo *replicationspb.NewRunInfo) {
+		if newRunInfo != nil {
+			newRunInfo.EventBatch = rewrite(newRunInfo.EventBatch)
+		}
+	}
+
+	switch attr := task.GetAttributes().(type) {
+	case *replicationspb.ReplicationTask_HistoryTaskAttributes:
+		attr.HistoryTaskAttributes.Events = rewrite(attr.HistoryTaskAttributes.Events)
+		attr.HistoryTaskAttributes.NewRunEvents = rewrite(attr.HistoryTaskAttributes.NewRunEvents)
+		rewriteAll(attr.HistoryTaskAttributes.EventsBatches)
+	case *replicationspb.ReplicationTask_BackfillHistoryTaskAttributes:
+		rewriteAll(attr.BackfillHistoryTaskAttributes.EventBatches)
+		rewriteNewRunInfo(attr.BackfillHistoryTaskAttributes.NewRunInfo)
+	case *replicationspb.ReplicationTask_SyncVersionedTransitionTaskAttributes:
+		if artifact := attr.SyncVersionedTransitionTaskAttributes.VersionedTransitionArtifact; artifact != nil {
+			rewriteAll(artifact.EventBatches)
+			rewriteNewRunInfo(artifact.NewRunInfo)
+		}
+	}
+	return err
+}
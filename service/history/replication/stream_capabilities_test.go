@@ -0,0 +1,76 @@
+package replication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"google.golang.org/grpc/metadata"
+)
+
+type mdHeaderGetter metadata.MD
+
+func (g mdHeaderGetter) Get(key string) string {
+	if values := metadata.MD(g).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func TestStreamCapabilities_RoundTrip(t *testing.T) {
+	capabilities := StreamCapabilities{
+		MaxBatchSize: 50,
+		Compression:  []serialization.CompressionType{serialization.CompressionTypeZstd, serialization.CompressionTypeSnappy},
+	}
+	decoded := DecodeStreamCapabilities(mdHeaderGetter(capabilities.Encode()))
+	require.Equal(t, capabilities, decoded)
+	require.True(t, decoded.SupportsCompression(serialization.CompressionTypeZstd))
+	require.True(t, decoded.SupportsCompression(serialization.CompressionTypeNone))
+}
+
+func TestStreamCapabilities_LegacyReceiver(t *testing.T) {
+	decoded := DecodeStreamCapabilities(mdHeaderGetter(metadata.MD{}))
+	require.Equal(t, StreamCapabilities{MaxBatchSize: 1}, decoded)
+	require.False(t, decoded.SupportsCompression(serialization.CompressionTypeZstd))
+
+	decoded = DecodeStreamCapabilities(mdHeaderGetter(metadata.Pairs(
+		MetadataKeyStreamMaxBatchSize, "not-a-number",
+		MetadataKeyStreamCompression, "brotli,snappy",
+	)))
+	require.Equal(t, StreamCapabilities{
+		MaxBatchSize: 1,
+		Compression:  []serialization.CompressionType{serialization.CompressionTypeSnappy},
+	}, decoded)
+}
+
+func TestCompressReplicationTask_RoundTrip(t *testing.T) {
+	newBlob := func(b byte) *commonpb.DataBlob {
+		data := make([]byte, 256)
+		data[0] = b
+		return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}
+	}
+	task := &replicationspb.ReplicationTask{
+		Attributes: &replicationspb.ReplicationTask_SyncVersionedTransitionTaskAttributes{
+			SyncVersionedTransitionTaskAttributes: &replicationspb.SyncVersionedTransitionTaskAttributes{
+				VersionedTransitionArtifact: &replicationspb.VersionedTransitionArtifact{
+					EventBatches: []*commonpb.DataBlob{newBlob(1), newBlob(2)},
+					NewRunInfo:   &replicationspb.NewRunInfo{RunId: "run", EventBatch: newBlob(3)},
+				},
+			},
+		},
+	}
+	artifact := task.GetSyncVersionedTransitionTaskAttributes().VersionedTransitionArtifact
+
+	require.NoError(t, compressReplicationTask(task, serialization.CompressionTypeSnappy))
+	for _, blob := range append(artifact.EventBatches, artifact.NewRunInfo.EventBatch) {
+		require.True(t, serialization.IsCompressedBlob(blob))
+	}
+
+	require.NoError(t, decompressReplicationTask(task))
+	require.Equal(t, newBlob(1).Data, artifact.EventBatches[0].Data)
+	require.Equal(t, newBlob(2).Data, artifact.EventBatches[1].Data)
+	require.Equal(t, newBlob(3).Data, artifact.NewRunInfo.EventBatch.Data)
+}
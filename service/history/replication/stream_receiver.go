@@ -54,6 +54,10 @@ type (
 
 		slowSubmissionMu         sync.RWMutex
 		slowSubmissionTimestamps map[enumsspb.TaskPriority]time.Time
+
+		// size of the last message received per task tracker, lets flow control leave room for a whole batch
+		highPriorityBatchSize atomic.Int64
+		lowPriorityBatchSize  atomic.Int64
 	}
 )
 
@@ -107,12 +111,14 @@ func NewStreamReceiver(
 		return &FlowControlSignal{
 			taskTrackingCount:  highPriorityTaskTracker.Size(),
 			lastSlowSubmission: receiver.getLastSlowSubmissionTimestamp(enumsspb.TASK_PRIORITY_HIGH),
+			batchSize:          int(receiver.highPriorityBatchSize.Load()),
 		}
 	}
 	taskTrackerMap[enumsspb.TASK_PRIORITY_LOW] = func() *FlowControlSignal {
 		return &FlowControlSignal{
 			taskTrackingCount:  lowPriorityTaskTracker.Size(),
 			lastSlowSubmission: receiver.getLastSlowSubmissionTimestamp(enumsspb.TASK_PRIORITY_LOW),
+			batchSize:          int(receiver.lowPriorityBatchSize.Load()),
 		}
 	}
 	receiver.flowController = NewReceiverFlowControl(taskTrackerMap, processToolBox.Config)
@@ -341,9 +347,14 @@ func (r *StreamReceiverImpl) processMessages(
 		}
 
 		if err = ValidateTasksHaveSamePriority(priority, messages.ReplicationTasks...); err != nil {
-			// This should not happen because source side is sending task 1 by 1. Validate here just in case.
+			// This should not happen because source side batches tasks of a single priority loop. Validate here just in case.
 			return NewStreamError("ReplicationTask priority check failed", err)
 		}
+		for _, task := range messages.ReplicationTasks {
+			if err := decompressReplicationTask(task); err != nil {
+				return NewStreamError("ReplicationTask decompression failed", err)
+			}
+		}
 
 		convertedTasks := r.taskConverter.Convert(
 			clusterName,
@@ -358,6 +369,9 @@ func (r *StreamReceiverImpl) processMessages(
 			// Todo: Change to write Tasks to DLQ. As resend task will not help here
 			return NewStreamError("ReplicationTask wrong priority", err)
 		}
+		if len(messages.ReplicationTasks) > 0 {
+			r.recordBatchSize(priority, len(messages.ReplicationTasks))
+		}
 
 		submissionThreshold := r.Config.ReplicationReceiverSlowSubmissionLatencyThreshold()
 
@@ -399,6 +413,15 @@ func (r *StreamReceiverImpl) recordSlowSubmission(priority enumsspb.TaskPriority
 	r.slowSubmissionTimestamps[priority] = ts
 }
 
+func (r *StreamReceiverImpl) recordBatchSize(priority enumsspb.TaskPriority, size int) {
+	switch priority {
+	case enumsspb.TASK_PRIORITY_UNSPECIFIED, enumsspb.TASK_PRIORITY_HIGH:
+		r.highPriorityBatchSize.Store(int64(size))
+	case enumsspb.TASK_PRIORITY_LOW:
+		r.lowPriorityBatchSize.Store(int64(size))
+	}
+}
+
 func (r *StreamReceiverImpl) getTaskTracker(priority enumsspb.TaskPriority) (ExecutableTaskTracker, error) {
 	switch priority {
 	case enumsspb.TASK_PRIORITY_UNSPECIFIED, enumsspb.TASK_PRIORITY_HIGH:
@@ -515,5 +538,6 @@ func (p *streamClientProvider) Get(
 	return NewStreamBiDirectionStreamClientProvider(
 		p.processToolBox.ClusterMetadata,
 		p.processToolBox.ClientBean,
+		p.processToolBox.Config,
 	).Get(ctx, p.clientShardKey, p.serverShardKey)
 }
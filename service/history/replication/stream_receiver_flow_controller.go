@@ -16,6 +16,8 @@ type (
 	FlowControlSignal struct {
 		taskTrackingCount  int
 		lastSlowSubmission time.Time
+		// batchSize is the number of tasks in the last message received from the sender
+		batchSize int
 	}
 
 	// FlowControlInfo holds the flow control command and an optional cause string for logging when command is PAUSE.
@@ -44,6 +46,11 @@ func (s *streamReceiverFlowControllerImpl) GetFlowControlInfo(priority enumsspb.
 	if signal, ok := s.signalsProvider[priority]; ok {
 		signalData := signal()
 		limit := s.config.ReplicationReceiverMaxOutstandingTaskCount()
+		if signalData.batchSize > 1 {
+			// The sender may already have a whole batch in flight when it sees the pause,
+			// leave room for it so that the limit still holds.
+			limit = max(limit-(signalData.batchSize-1), 0)
+		}
 
 		if signalData.taskTrackingCount > limit {
 			return FlowControlInfo{
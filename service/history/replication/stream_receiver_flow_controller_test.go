@@ -119,6 +119,34 @@ func (f *flowControlTestSuite) TestBoundaryCondition() {
 	f.NotEmpty(actual.Cause)
 }
 
+func (f *flowControlTestSuite) TestBatchLeavesRoomForNextBatch() {
+	signals := map[enumsspb.TaskPriority]FlowControlSignalProvider{
+		enumsspb.TASK_PRIORITY_LOW: func() *FlowControlSignal {
+			return &FlowControlSignal{
+				taskTrackingCount: f.maxOutStandingTasks - 20,
+				batchSize:         20,
+			}
+		},
+	}
+	f.controller = NewReceiverFlowControl(signals, f.config)
+
+	actual := f.controller.GetFlowControlInfo(enumsspb.TASK_PRIORITY_LOW)
+	f.Equal(enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_RESUME, actual.Command)
+
+	signals[enumsspb.TASK_PRIORITY_LOW] = func() *FlowControlSignal {
+		return &FlowControlSignal{
+			taskTrackingCount: f.maxOutStandingTasks - 18,
+			batchSize:         20,
+		}
+	}
+	f.controller = NewReceiverFlowControl(signals, f.config)
+
+	// another batch of 20 would overshoot the limit
+	actual = f.controller.GetFlowControlInfo(enumsspb.TASK_PRIORITY_LOW)
+	f.Equal(enumsspb.REPLICATION_FLOW_CONTROL_COMMAND_PAUSE, actual.Command)
+	f.NotEmpty(actual.Cause)
+}
+
 func (f *flowControlTestSuite) TestSubmitLatency() {
 	f.config.EnableReplicationReceiverSlowSubmissionFlowControl = func() bool {
 		return true
@@ -25,12 +25,14 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -63,6 +65,7 @@ type (
 		flowController          SenderFlowController
 		sendLock                sync.Mutex
 		ssRateLimiter           ServerSchedulerRateLimiter
+		capabilities            StreamCapabilities
 	}
 )
 
@@ -76,6 +79,7 @@ func NewStreamSender(
 	clientClusterShardCount int32,
 	clientShardKey ClusterShardKey,
 	serverShardKey ClusterShardKey,
+	capabilities StreamCapabilities,
 	config *configs.Config,
 ) *StreamSenderImpl {
 	logger := log.With(
@@ -103,6 +107,7 @@ func NewStreamSender(
 		isTieredStackEnabled:    config.EnableReplicationTaskTieredProcessing(),
 		flowController:          NewSenderFlowController(config, logger),
 		ssRateLimiter:           ssRateLimiter,
+		capabilities:            capabilities,
 	}
 }
 
@@ -499,7 +504,22 @@ func (s *StreamSenderImpl) sendTasks(
 	if err != nil {
 		return err
 	}
+	maxBatchSize, maxBatchBytes, compression := s.batchSettings()
+	var batch []*replicationspb.ReplicationTask
+	batchBytes := 0
 	skipCount := 0
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := s.sendBatch(priority, batch); err != nil {
+			return err
+		}
+		batch = nil
+		batchBytes = 0
+		skipCount = 0
+		return nil
+	}
 Loop:
 	for iter.HasNext() {
 		if s.shutdownChan.IsShutdown() {
@@ -516,6 +536,10 @@ Loop:
 		// so it will not ACK back to sender, sender will not update the ACK level.
 		// i.e. in tiered stack, if no low priority task in queue, we should still send watermark info to receiver to let it update ACK level.
 		if skipCount > TaskMaxSkipCount {
+			// tasks still in the batch are below the watermark, they must reach the receiver first
+			if err := flush(); err != nil {
+				return err
+			}
 			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
 				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
 					Messages: &replicationspb.WorkflowReplicationMessages{
@@ -565,13 +589,8 @@ Loop:
 				return nil
 			}
 			task.Priority = priority
-			if s.isTieredStackEnabled {
-				if err := s.flowController.Wait(s.server.Context(), priority); err != nil {
-					if errors.Is(err, context.Canceled) {
-						return err
-					}
-					// continue to send task if wait operation times out.
-				}
+			if err := compressReplicationTask(task, compression); err != nil {
+				return err
 			}
 			if s.config.ReplicationEnableRateLimit() && task.Priority == enumsspb.TASK_PRIORITY_LOW {
 				nsName, err := s.shardContext.GetNamespaceRegistry().GetNamespaceName(
@@ -594,25 +613,17 @@ Loop:
 				}
 				metrics.ReplicationRateLimitLatency.With(s.metrics).Record(time.Since(rlStartTime), metrics.OperationTag(TaskOperationTag(task)))
 			}
-			if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
-				Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
-					Messages: &replicationspb.WorkflowReplicationMessages{
-						ReplicationTasks:           []*replicationspb.ReplicationTask{task},
-						ExclusiveHighWatermark:     task.SourceTaskId + 1,
-						ExclusiveHighWatermarkTime: task.VisibilityTime,
-						Priority:                   priority,
-					},
-				},
-			}); err != nil {
-				return err
+			taskBytes := proto.Size(task)
+			if len(batch) > 0 && batchBytes+taskBytes > maxBatchBytes {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			batch = append(batch, task)
+			batchBytes += taskBytes
+			if len(batch) >= maxBatchSize {
+				return flush()
 			}
-			skipCount = 0
-			metrics.ReplicationTasksSend.With(s.metrics).Record(
-				int64(1),
-				metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
-				metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
-				metrics.OperationTag(TaskOperationTag(task)),
-			)
 			return nil
 		}
 
@@ -648,6 +659,9 @@ Loop:
 			return fmt.Errorf("failed to send task: %v, cause: %w", item, err)
 		}
 	}
+	if err := flush(); err != nil {
+		return err
+	}
 	return s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
 		Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
 			Messages: &replicationspb.WorkflowReplicationMessages{
@@ -660,6 +674,55 @@ Loop:
 	})
 }
 
+// sendBatch sends the tasks in a single message. The watermark of the message is the one of the last task,
+// so the tasks must be in task ID order.
+func (s *StreamSenderImpl) sendBatch(
+	priority enumsspb.TaskPriority,
+	batch []*replicationspb.ReplicationTask,
+) error {
+	if s.isTieredStackEnabled {
+		if err := s.flowController.Wait(s.server.Context(), priority, len(batch)); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return err
+			}
+			// continue to send tasks if wait operation times out.
+		}
+	}
+	lastTask := batch[len(batch)-1]
+	if err := s.sendToStream(&historyservice.StreamWorkflowReplicationMessagesResponse{
+		Attributes: &historyservice.StreamWorkflowReplicationMessagesResponse_Messages{
+			Messages: &replicationspb.WorkflowReplicationMessages{
+				ReplicationTasks:           batch,
+				ExclusiveHighWatermark:     lastTask.SourceTaskId + 1,
+				ExclusiveHighWatermarkTime: lastTask.VisibilityTime,
+				Priority:                   priority,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	for _, task := range batch {
+		metrics.ReplicationTasksSend.With(s.metrics).Record(
+			int64(1),
+			metrics.FromClusterIDTag(s.serverShardKey.ClusterID),
+			metrics.ToClusterIDTag(s.clientShardKey.ClusterID),
+			metrics.OperationTag(TaskOperationTag(task)),
+		)
+	}
+	return nil
+}
+
+// batchSettings returns the batch limits and the codec for the stream, capped by what the receiver supports.
+func (s *StreamSenderImpl) batchSettings() (maxBatchSize int, maxBatchBytes int, compression serialization.CompressionType) {
+	maxBatchSize = max(min(s.config.ReplicationStreamSenderMaxBatchSize(), s.capabilities.MaxBatchSize), 1)
+	maxBatchBytes = s.config.ReplicationStreamSenderMaxBatchBytes()
+	compression, err := serialization.CompressionTypeFromString(s.config.ReplicationStreamCompression())
+	if err != nil || !s.capabilities.SupportsCompression(compression) {
+		compression = serialization.CompressionTypeNone
+	}
+	return maxBatchSize, maxBatchBytes, compression
+}
+
 func (s *StreamSenderImpl) sendToStream(payload *historyservice.StreamWorkflowReplicationMessagesResponse) error {
 	s.sendLock.Lock()
 	defer s.sendLock.Unlock()
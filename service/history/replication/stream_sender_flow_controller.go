@@ -24,8 +24,8 @@ type (
 		rateLimiter quotas.RateLimiter // todo: consider using a shared rate limiter across shard for better resource allocation
 	}
 	SenderFlowController interface {
-		// Wait will block go routine until the sender is allowed to send a task
-		Wait(ctx context.Context, priority enumsspb.TaskPriority) error
+		// Wait will block go routine until the sender is allowed to send a message carrying taskCount tasks
+		Wait(ctx context.Context, priority enumsspb.TaskPriority, taskCount int) error
 		RefreshReceiverFlowControlInfo(syncState *replicationspb.SyncReplicationState)
 	}
 	SenderFlowControllerImpl struct {
@@ -86,15 +86,19 @@ func (s *SenderFlowControllerImpl) setState(state *flowControlState, flowControl
 	}
 }
 
-func (s *SenderFlowControllerImpl) Wait(ctx context.Context, priority enumsspb.TaskPriority) error {
+func (s *SenderFlowControllerImpl) Wait(ctx context.Context, priority enumsspb.TaskPriority, taskCount int) error {
 	state, ok := s.flowControlStates[priority]
 	waitForRateLimiter := func(rateLimiter quotas.RateLimiter) error {
 		childCtx, cancel := context.WithTimeout(ctx, 2*time.Minute) // to avoid infinite wait
 		defer cancel()
-		err := rateLimiter.Wait(childCtx)
-		if err != nil {
-			s.logger.Error("error waiting for rate limiter", tag.Error(err))
-			return err
+		// a batch may need more tokens than the burst allows in one go, take them in chunks
+		for remaining := max(taskCount, 1); remaining > 0; {
+			tokens := min(remaining, max(rateLimiter.Burst(), 1))
+			if err := rateLimiter.WaitN(childCtx, tokens); err != nil {
+				s.logger.Error("error waiting for rate limiter", tag.Error(err))
+				return err
+			}
+			remaining -= tokens
 		}
 		return nil
 	}
@@ -55,15 +55,15 @@ func (mr *MockSenderFlowControllerMockRecorder) RefreshReceiverFlowControlInfo(s
 }
 
 // Wait mocks base method.
-func (m *MockSenderFlowController) Wait(ctx context.Context, priority enums.TaskPriority) error {
+func (m *MockSenderFlowController) Wait(ctx context.Context, priority enums.TaskPriority, taskCount int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Wait", ctx, priority)
+	ret := m.ctrl.Call(m, "Wait", ctx, priority, taskCount)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Wait indicates an expected call of Wait.
-func (mr *MockSenderFlowControllerMockRecorder) Wait(ctx, priority any) *gomock.Call {
+func (mr *MockSenderFlowControllerMockRecorder) Wait(ctx, priority, taskCount any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockSenderFlowController)(nil).Wait), ctx, priority)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockSenderFlowController)(nil).Wait), ctx, priority, taskCount)
 }
@@ -50,14 +50,15 @@ func (s *senderFlowControllerSuite) TestWait_HighPriority() {
 	state := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_HIGH]
 	state.rateLimiter = s.mockRateLimiter
 
-	s.mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(nil)
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 1).Return(nil)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
-		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH)
+		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH, 1)
 		s.NoError(err)
 	}()
 
@@ -68,14 +69,15 @@ func (s *senderFlowControllerSuite) TestWait_Error() {
 	state := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_HIGH]
 	state.rateLimiter = s.mockRateLimiter
 
-	s.mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(context.Canceled)
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 1).Return(context.Canceled)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
-		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH)
+		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH, 1)
 		s.Error(err)
 	}()
 
@@ -86,31 +88,49 @@ func (s *senderFlowControllerSuite) TestWait_LowPriority() {
 	state := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_LOW]
 	state.rateLimiter = s.mockRateLimiter
 
-	s.mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(nil)
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 1).Return(nil)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
-		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_LOW)
+		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_LOW, 1)
 		s.NoError(err)
 	}()
 
 	wg.Wait()
 }
 
+func (s *senderFlowControllerSuite) TestWait_Batch() {
+	state := s.senderFlowCtrlImpl.flowControlStates[enumsspb.TASK_PRIORITY_HIGH]
+	state.rateLimiter = s.mockRateLimiter
+
+	// 25 tasks with a burst of 10 are taken in chunks
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	gomock.InOrder(
+		s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 10).Return(nil),
+		s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 10).Return(nil),
+		s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 5).Return(nil),
+	)
+
+	err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH, 25)
+	s.NoError(err)
+}
+
 func (s *senderFlowControllerSuite) TestWait_DefaultPriority() {
 	s.senderFlowCtrlImpl.defaultRateLimiter = s.mockRateLimiter
 
-	s.mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(nil)
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 1).Return(nil)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
-		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_UNSPECIFIED)
+		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_UNSPECIFIED, 1)
 		s.NoError(err)
 	}()
 
@@ -142,14 +162,15 @@ func (s *senderFlowControllerSuite) TestPauseToResume() {
 	state.mu.Lock()
 	state.resume = false
 	state.mu.Unlock()
-	s.mockRateLimiter.EXPECT().Wait(gomock.Any()).Return(nil)
+	s.mockRateLimiter.EXPECT().Burst().Return(10).AnyTimes()
+	s.mockRateLimiter.EXPECT().WaitN(gomock.Any(), 1).Return(nil)
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 
 	go func() {
 		defer wg.Done()
-		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH)
+		err := s.senderFlowCtrlImpl.Wait(context.Background(), enumsspb.TASK_PRIORITY_HIGH, 1)
 		s.NoError(err)
 	}()
 
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -22,6 +24,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/quotas"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
@@ -90,6 +93,7 @@ func (s *streamSenderSuite) SetupTest() {
 		2,
 		s.clientShardKey,
 		s.serverShardKey,
+		StreamCapabilities{},
 		s.config,
 	)
 	s.senderFlowController = NewMockSenderFlowController(s.controller)
@@ -834,6 +838,157 @@ func (s *streamSenderSuite) TestSendTasks_WithTasks() {
 	s.NoError(err)
 }
 
+func (s *streamSenderSuite) TestSendTasks_Batched() {
+	s.streamSender.isTieredStackEnabled = false
+	s.streamSender.capabilities = StreamCapabilities{
+		MaxBatchSize: 2,
+		Compression:  []serialization.CompressionType{serialization.CompressionTypeZstd},
+	}
+	s.config.ReplicationStreamSenderMaxBatchSize = dynamicconfig.GetIntPropertyFn(10)
+	s.config.ReplicationStreamCompression = dynamicconfig.GetStringPropertyFn("zstd")
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+	eventsData := make([]byte, 1024) // zeros compress well
+
+	var items []tasks.Task
+	var replicationTasks []*replicationspb.ReplicationTask
+	for i := 0; i < 3; i++ {
+		item := tasks.NewMockTask(s.controller)
+		item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+		item.EXPECT().GetWorkflowID().Return("1").AnyTimes()
+		item.EXPECT().GetVisibilityTime().Return(time.Now().UTC()).AnyTimes()
+		item.EXPECT().GetType().Return(enumsspb.TASK_TYPE_REPLICATION_HISTORY).AnyTimes()
+		task := &replicationspb.ReplicationTask{
+			TaskType:       enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK,
+			SourceTaskId:   beginInclusiveWatermark + int64(i),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+			Attributes: &replicationspb.ReplicationTask_HistoryTaskAttributes{
+				HistoryTaskAttributes: &replicationspb.HistoryTaskAttributes{
+					Events: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: eventsData},
+				},
+			},
+		}
+		s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID, enumsspb.TASK_PRIORITY_UNSPECIFIED).Return(task, nil)
+		items = append(items, item)
+		replicationTasks = append(replicationTasks, task)
+	}
+
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			return items, nil, nil
+		},
+	), nil)
+
+	var sent []*replicationspb.WorkflowReplicationMessages
+	s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+		sent = append(sent, resp.GetMessages())
+		return nil
+	}).Times(3)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_UNSPECIFIED,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+
+	// the receiver caps the batch size
+	s.Equal(replicationTasks[:2], sent[0].ReplicationTasks)
+	s.Equal(replicationTasks[1].SourceTaskId+1, sent[0].ExclusiveHighWatermark)
+	s.Equal(replicationTasks[1].VisibilityTime, sent[0].ExclusiveHighWatermarkTime)
+	s.Equal(replicationTasks[2:], sent[1].ReplicationTasks)
+	s.Equal(replicationTasks[2].SourceTaskId+1, sent[1].ExclusiveHighWatermark)
+	s.Empty(sent[2].ReplicationTasks)
+	s.Equal(endExclusiveWatermark, sent[2].ExclusiveHighWatermark)
+
+	for _, task := range replicationTasks {
+		events := task.GetHistoryTaskAttributes().Events
+		s.True(serialization.IsCompressedBlob(events))
+		s.Less(len(events.Data), len(eventsData))
+		s.NoError(decompressReplicationTask(task))
+		s.Equal(eventsData, task.GetHistoryTaskAttributes().Events.Data)
+	}
+}
+
+func (s *streamSenderSuite) TestSendTasks_BatchByteBudget() {
+	s.streamSender.isTieredStackEnabled = false
+	s.streamSender.capabilities = StreamCapabilities{MaxBatchSize: 10}
+	s.config.ReplicationStreamSenderMaxBatchSize = dynamicconfig.GetIntPropertyFn(10)
+	s.config.ReplicationStreamSenderMaxBatchBytes = dynamicconfig.GetIntPropertyFn(1500)
+	// compression is not advertised by the receiver
+	s.config.ReplicationStreamCompression = dynamicconfig.GetStringPropertyFn("zstd")
+	beginInclusiveWatermark := rand.Int63()
+	endExclusiveWatermark := beginInclusiveWatermark + 100
+
+	var items []tasks.Task
+	for i := 0; i < 3; i++ {
+		item := tasks.NewMockTask(s.controller)
+		item.EXPECT().GetNamespaceID().Return("1").AnyTimes()
+		item.EXPECT().GetWorkflowID().Return("1").AnyTimes()
+		item.EXPECT().GetVisibilityTime().Return(time.Now().UTC()).AnyTimes()
+		item.EXPECT().GetType().Return(enumsspb.TASK_TYPE_REPLICATION_HISTORY).AnyTimes()
+		s.taskConverter.EXPECT().Convert(item, s.clientShardKey.ClusterID, enumsspb.TASK_PRIORITY_UNSPECIFIED).Return(&replicationspb.ReplicationTask{
+			TaskType:       enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK,
+			SourceTaskId:   beginInclusiveWatermark + int64(i),
+			VisibilityTime: timestamppb.New(time.Unix(0, rand.Int63())),
+			Attributes: &replicationspb.ReplicationTask_HistoryTaskAttributes{
+				HistoryTaskAttributes: &replicationspb.HistoryTaskAttributes{
+					Events: &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: make([]byte, 1024)},
+				},
+			},
+		}, nil)
+		items = append(items, item)
+	}
+
+	mockRegistry := namespace.NewMockRegistry(s.controller)
+	mockRegistry.EXPECT().GetNamespaceByID(namespace.ID("1")).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{
+			Clusters: []string{"source_cluster", "target_cluster"},
+		}, 100), nil).AnyTimes()
+	s.shardContext.EXPECT().GetNamespaceRegistry().Return(mockRegistry).AnyTimes()
+	s.historyEngine.EXPECT().GetReplicationTasksIter(
+		gomock.Any(),
+		string(s.clientShardKey.ClusterID),
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	).Return(collection.NewPagingIterator[tasks.Task](
+		func(paginationToken []byte) ([]tasks.Task, []byte, error) {
+			return items, nil, nil
+		},
+	), nil)
+
+	var sent []*replicationspb.WorkflowReplicationMessages
+	s.server.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *historyservice.StreamWorkflowReplicationMessagesResponse) error {
+		sent = append(sent, resp.GetMessages())
+		return nil
+	}).Times(4)
+
+	err := s.streamSender.sendTasks(
+		enumsspb.TASK_PRIORITY_UNSPECIFIED,
+		beginInclusiveWatermark,
+		endExclusiveWatermark,
+	)
+	s.NoError(err)
+
+	// each task fits the budget on its own, but not two of them
+	for _, messages := range sent[:3] {
+		s.Len(messages.ReplicationTasks, 1)
+		s.False(serialization.IsCompressedBlob(messages.ReplicationTasks[0].GetHistoryTaskAttributes().Events))
+	}
+	s.Equal(endExclusiveWatermark, sent[3].ExclusiveHighWatermark)
+}
+
 func (s *streamSenderSuite) TestSendTasks_TieredStack_HighPriority() {
 	s.streamSender.isTieredStackEnabled = true
 	beginInclusiveWatermark := rand.Int63()
@@ -876,7 +1031,7 @@ func (s *streamSenderSuite) TestSendTasks_TieredStack_HighPriority() {
 			return []tasks.Task{item0, item1, item2}, nil, nil
 		},
 	)
-	s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_HIGH).Return(nil).Times(1)
+	s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_HIGH, 1).Return(nil).Times(1)
 	s.historyEngine.EXPECT().GetReplicationTasksIter(
 		gomock.Any(),
 		string(s.clientShardKey.ClusterID),
@@ -960,7 +1115,7 @@ func (s *streamSenderSuite) TestSendTasks_TieredStack_LowPriority() {
 			return []tasks.Task{item0, item1, item2}, nil, nil
 		},
 	)
-	s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_LOW).Return(nil).Times(2)
+	s.senderFlowController.EXPECT().Wait(gomock.Any(), enumsspb.TASK_PRIORITY_LOW, 1).Return(nil).Times(2)
 	s.historyEngine.EXPECT().GetReplicationTasksIter(
 		gomock.Any(),
 		string(s.clientShardKey.ClusterID),
@@ -0,0 +1,125 @@
+// Package workflowfilter decides which executions of a global namespace are replicated to other clusters.
+package workflowfilter
+
+import (
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/searchattribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Filter evaluates the ReplicationWorkflowFilter of a namespace against new executions.
+	// A nil *Filter replicates every execution.
+	Filter struct {
+		filterQuery      dynamicconfig.StringPropertyFnWithNamespaceFilter
+		saProvider       searchattribute.Provider
+		saMapperProvider searchattribute.MapperProvider
+		indexName        string
+		logger           log.Logger
+
+		sync.RWMutex
+		filters map[namespace.Name]*parsedFilter
+	}
+
+	// Execution holds the attributes of a new execution the filter is evaluated against.
+	Execution struct {
+		WorkflowID   string
+		RunID        string
+		WorkflowType string
+		StartTime    *timestamppb.Timestamp
+		// SearchAttributes are keyed by field name, the way history stores them.
+		SearchAttributes *commonpb.SearchAttributes
+	}
+
+	parsedFilter struct {
+		query  string
+		filter *archiver.VisibilityQueryFilter
+	}
+)
+
+// NewFilter creates a Filter. indexName is the visibility index whose search attributes the filters may use.
+func NewFilter(
+	filterQuery dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	saProvider searchattribute.Provider,
+	saMapperProvider searchattribute.MapperProvider,
+	indexName string,
+	logger log.Logger,
+) *Filter {
+	return &Filter{
+		filterQuery:      filterQuery,
+		saProvider:       saProvider,
+		saMapperProvider: saMapperProvider,
+		indexName:        indexName,
+		logger:           logger,
+		filters:          make(map[namespace.Name]*parsedFilter),
+	}
+}
+
+// Match reports whether the execution should be replicated to the other clusters of the namespace.
+// Executions of namespaces without a filter, or with a filter that doesn't parse, always match.
+func (f *Filter) Match(nsName namespace.Name, execution Execution) bool {
+	if f == nil {
+		return true
+	}
+	query := f.filterQuery(nsName.String())
+	if query == "" {
+		return true
+	}
+
+	filter, err := f.getFilter(nsName, query)
+	if err != nil {
+		// Keep replicating rather than silently dropping executions because of a typo in the filter.
+		f.logger.Warn("Invalid replication workflow filter, replicating all executions of the namespace",
+			tag.WorkflowNamespace(nsName.String()),
+			tag.Value(query),
+			tag.Error(err),
+		)
+		return true
+	}
+	return filter.Match(&workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: execution.WorkflowID,
+			RunId:      execution.RunID,
+		},
+		Type:             &commonpb.WorkflowType{Name: execution.WorkflowType},
+		StartTime:        execution.StartTime,
+		Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		SearchAttributes: execution.SearchAttributes,
+	})
+}
+
+func (f *Filter) getFilter(nsName namespace.Name, query string) (*archiver.VisibilityQueryFilter, error) {
+	f.RLock()
+	parsed, ok := f.filters[nsName]
+	f.RUnlock()
+	if ok && parsed.query == query {
+		return parsed.filter, nil
+	}
+
+	saTypeMap, err := f.saProvider.GetSearchAttributes(f.indexName, false)
+	if err != nil {
+		return nil, err
+	}
+	saMapper, err := f.saMapperProvider.GetMapper(nsName)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := archiver.NewNamespaceVisibilityQueryFilter(query, nsName, saTypeMap, saMapper)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.filters[nsName] = &parsedFilter{query: query, filter: filter}
+	return filter, nil
+}
@@ -0,0 +1,77 @@
+package workflowfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const testNamespace = namespace.Name("test-namespace")
+
+func newTestFilter(query string) *Filter {
+	return NewFilter(
+		dynamicconfig.GetStringPropertyFnFilteredByNamespace(query),
+		searchattribute.NewTestProvider(),
+		searchattribute.NewTestMapperProvider(&searchattribute.TestMapper{}),
+		"",
+		log.NewTestLogger(),
+	)
+}
+
+func TestMatch_NoFilter(t *testing.T) {
+	var nilFilter *Filter
+	require.True(t, nilFilter.Match(testNamespace, Execution{WorkflowType: "Ephemeral"}))
+	require.True(t, newTestFilter("").Match(testNamespace, Execution{WorkflowType: "Ephemeral"}))
+}
+
+func TestMatch_WorkflowType(t *testing.T) {
+	filter := newTestFilter("WorkflowType IN ('Order', 'Payment')")
+
+	require.True(t, filter.Match(testNamespace, Execution{WorkflowID: "wid", WorkflowType: "Order"}))
+	require.True(t, filter.Match(testNamespace, Execution{WorkflowID: "wid", WorkflowType: "Payment"}))
+	require.False(t, filter.Match(testNamespace, Execution{WorkflowID: "wid", WorkflowType: "Ephemeral"}))
+}
+
+func TestMatch_CustomSearchAttributeAlias(t *testing.T) {
+	filter := newTestFilter("AliasForKeyword01 = 'replicated'")
+
+	require.True(t, filter.Match(testNamespace, Execution{
+		WorkflowType: "Order",
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"Keyword01": payload.EncodeString("replicated"),
+		}},
+	}))
+	require.False(t, filter.Match(testNamespace, Execution{
+		WorkflowType: "Order",
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"Keyword01": payload.EncodeString("local"),
+		}},
+	}))
+	require.False(t, filter.Match(testNamespace, Execution{WorkflowType: "Order"}))
+}
+
+func TestMatch_InvalidFilterReplicates(t *testing.T) {
+	filter := newTestFilter("UnknownField = 'x'")
+
+	require.True(t, filter.Match(testNamespace, Execution{WorkflowType: "Order"}))
+	require.Empty(t, filter.filters)
+}
+
+func TestMatch_FilterChange(t *testing.T) {
+	query := "WorkflowType = 'Order'"
+	filter := newTestFilter("")
+	filter.filterQuery = func(string) string { return query }
+
+	require.True(t, filter.Match(testNamespace, Execution{WorkflowType: "Order"}))
+	require.False(t, filter.Match(testNamespace, Execution{WorkflowType: "Payment"}))
+
+	query = "WorkflowType = 'Payment'"
+	require.False(t, filter.Match(testNamespace, Execution{WorkflowType: "Order"}))
+	require.True(t, filter.Match(testNamespace, Execution{WorkflowType: "Payment"}))
+}
@@ -52,6 +52,7 @@ import (
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/replication/workflowfilter"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/vclock"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -106,6 +107,7 @@ type (
 		namespaceRegistry       namespace.Registry
 		saProvider              searchattribute.Provider
 		saMapperProvider        searchattribute.MapperProvider
+		replicationFilter       *workflowfilter.Filter
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
 		hostInfoProvider        membership.HostInfoProvider
@@ -2092,6 +2094,7 @@ func newContext(
 	}
 
 	taggedLogger := log.With(logger, tag.ShardID(shardID), tag.Address(hostIdentity))
+	visibilityStoreConfig := persistenceConfig.GetVisibilityStoreConfig()
 	shardContext := &ContextImpl{
 		state:                   contextStateInitialized,
 		shardID:                 shardID,
@@ -2113,18 +2116,25 @@ func newContext(
 		namespaceRegistry:       namespaceRegistry,
 		saProvider:              saProvider,
 		saMapperProvider:        saMapperProvider,
-		clusterMetadata:         clusterMetadata,
-		archivalMetadata:        archivalMetadata,
-		hostInfoProvider:        hostInfoProvider,
-		taskCategoryRegistry:    taskCategoryRegistry,
-		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
-		lifecycleCtx:            lifecycleCtx,
-		lifecycleCancel:         lifecycleCancel,
-		engineFuture:            future.NewFuture[historyi.Engine](),
-		queueMetricEmitter:      sync.Once{},
-		ioSemaphore:             locks.NewPrioritySemaphore(ioConcurrency),
-		stateMachineRegistry:    stateMachineRegistry,
-		chasmRegistry:           chasmRegistry,
+		replicationFilter: workflowfilter.NewFilter(
+			historyConfig.ReplicationWorkflowFilter,
+			saProvider,
+			saMapperProvider,
+			visibilityStoreConfig.GetIndexName(),
+			throttledLogger,
+		),
+		clusterMetadata:      clusterMetadata,
+		archivalMetadata:     archivalMetadata,
+		hostInfoProvider:     hostInfoProvider,
+		taskCategoryRegistry: taskCategoryRegistry,
+		handoverNamespaces:   make(map[namespace.Name]*namespaceHandOverInfo),
+		lifecycleCtx:         lifecycleCtx,
+		lifecycleCancel:      lifecycleCancel,
+		engineFuture:         future.NewFuture[historyi.Engine](),
+		queueMetricEmitter:   sync.Once{},
+		ioSemaphore:          locks.NewPrioritySemaphore(ioConcurrency),
+		stateMachineRegistry: stateMachineRegistry,
+		chasmRegistry:        chasmRegistry,
 	}
 	shardContext.taskKeyManager = newTaskKeyManager(
 		shardContext.taskCategoryRegistry,
@@ -2215,6 +2225,10 @@ func (s *ContextImpl) GetSearchAttributesMapperProvider() searchattribute.Mapper
 	return s.saMapperProvider
 }
 
+func (s *ContextImpl) GetReplicationWorkflowFilter() *workflowfilter.Filter {
+	return s.replicationFilter
+}
+
 func (s *ContextImpl) GetClusterMetadata() cluster.Metadata {
 	return s.clusterMetadata
 }
@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/service/history/events"
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/replication/workflowfilter"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/mock/gomock"
 )
@@ -214,6 +215,11 @@ func (s *ContextTest) SetClusterMetadata(metadata cluster.Metadata) {
 	s.clusterMetadata = metadata
 }
 
+// SetReplicationWorkflowFilterForTesting sets the replication workflow filter. Only used by tests.
+func (s *ContextTest) SetReplicationWorkflowFilterForTesting(filter *workflowfilter.Filter) {
+	s.replicationFilter = filter
+}
+
 // StopForTest calls FinishStop(). In general only the controller
 // should call that, but integration tests need to do it also to clean up any
 // background acquireShard goroutines that may exist.
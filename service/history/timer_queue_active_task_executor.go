@@ -24,6 +24,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/priorities"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -223,6 +224,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 	referenceTime := t.Now()
 	updateMutableState := false
 	scheduleWorkflowTask := false
+	var releaseSlotRequests []*matchingservice.ReleaseConcurrencySlotRequest
 
 	// Need to clear activity heartbeat timer task mask for new activity timer task creation.
 	// NOTE: LastHeartbeatTimeoutVisibilityInSeconds is for deduping heartbeat timer creation as it's possible
@@ -262,19 +264,29 @@ Loop:
 
 		updateMutableState = updateMutableState || result.shouldUpdateMutableState
 		scheduleWorkflowTask = scheduleWorkflowTask || result.shouldScheduleWorkflowTask
-
+		if result.releaseSlotRequest != nil {
+			releaseSlotRequests = append(releaseSlotRequests, result.releaseSlotRequest)
+		}
 	}
 
 	if !updateMutableState {
 		release(nil) // so mutable state is not unloaded from cache
 		return errNoTimerFired
 	}
-	return t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask)
+	if err := t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask); err != nil {
+		return err
+	}
+	for _, request := range releaseSlotRequests {
+		api.ReleaseConcurrencySlot(ctx, t.matchingRawClient, t.logger, request)
+	}
+	return nil
 }
 
 type processingActivityTimeoutResult struct {
 	shouldUpdateMutableState   bool
 	shouldScheduleWorkflowTask bool
+	// set if the timed out attempt held a task queue concurrency slot
+	releaseSlotRequest *matchingservice.ReleaseConcurrencySlotRequest
 }
 
 func (t *timerQueueActiveTaskExecutor) processSingleActivityTimeoutTask(
@@ -298,10 +310,12 @@ func (t *timerQueueActiveTaskExecutor) processSingleActivityTimeoutTask(
 
 	failureMsg := fmt.Sprintf(common.FailureReasonActivityTimeout, timerSequenceID.TimerType.String())
 	timeoutFailure := failure.NewTimeoutFailure(failureMsg, timerSequenceID.TimerType)
+	releaseSlotRequest := api.NewReleaseConcurrencySlotRequest(mutableState, ai)
 	retryState, err := mutableState.RetryActivity(ai, timeoutFailure)
 	if err != nil {
 		return result, nil
 	}
+	result.releaseSlotRequest = releaseSlotRequest
 
 	workflow.RecordActivityCompletionMetrics(
 		t.shardContext,
@@ -603,6 +617,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		VersionDirective:       directive,
 		Stamp:                  task.Stamp,
 		Priority:               priority,
+		RequiredLabels:         activityInfo.RequiredLabels,
+		AffinityKey:            activityInfo.AffinityKey,
 	})
 	if err != nil {
 		return err
@@ -179,9 +179,11 @@ func (f *timerQueueFactory) CreateQueue(
 				MaxPredicateSize:     f.Config.QueueMaxPredicateSize,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:             f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:           f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:           f.Config.QueueCriticalSlicesCount,
+				NamespaceTaskLatencyCriticalThreshold: f.Config.QueueNamespaceTaskLatencyCriticalThreshold,
+				TaskAttemptsCriticalThreshold:         f.Config.QueueTaskAttemptsCriticalThreshold,
 			},
 			MaxPollRPS:                          f.Config.TimerProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TimerProcessorMaxPollInterval,
@@ -718,6 +718,8 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), activityTask.TaskID),
 		VersionDirective:       pushActivityInfo.versionDirective,
 		Stamp:                  activityTask.Stamp,
+		RequiredLabels:         pushActivityInfo.requiredLabels,
+		AffinityKey:            pushActivityInfo.affinityKey,
 	})
 
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	clockspb "go.temporal.io/server/api/clock/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/chasm"
@@ -36,6 +37,7 @@ import (
 	"go.temporal.io/server/common/sdk"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
@@ -245,13 +247,15 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	requiredLabels := ai.RequiredLabels
+	affinityKey := ai.AffinityKey
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, requiredLabels, affinityKey, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -298,6 +302,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	requiredLabels := mutableState.GetExecutionInfo().RequiredLabels
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -311,6 +316,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		requiredLabels,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -334,6 +340,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			requiredLabels,
 			historyi.TransactionPolicyActive,
 		)
 	}
@@ -412,11 +419,24 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 		return err
 	}
 
+	// Activities that were still running when the workflow closed (completed, terminated, reset or
+	// timed out) will never report that their attempt ended.
+	var releaseSlotRequests []*matchingservice.ReleaseConcurrencySlotRequest
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if request := api.NewReleaseConcurrencySlotRequest(mutableState, ai); request != nil {
+			releaseSlotRequests = append(releaseSlotRequests, request)
+		}
+	}
+
 	// NOTE: do not access anything related mutable state after this lock release.
 	// Release lock immediately since mutable state is not needed
 	// and the rest of logic is RPC calls, which can take time.
 	release(nil)
 
+	for _, request := range releaseSlotRequests {
+		api.ReleaseConcurrencySlot(ctx, t.matchingRawClient, t.logger, request)
+	}
+
 	// Communicate the result to parent execution if this is Child Workflow execution
 	if replyToParentWorkflow {
 		_, err := t.historyRawClient.RecordChildExecutionCompleted(ctx, &historyservice.RecordChildExecutionCompletedRequest{
@@ -23,6 +23,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	workflowspb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/archiver"
@@ -952,6 +953,75 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParen
 	s.NoError(resp.ExecutionErr)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_ReleasesConcurrencySlots() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.NewString(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.NoError(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.NewString())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	slotPartition := &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     taskQueueName,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: 1},
+	}
+	// started activity holding a slot, and one that's only scheduled
+	startedEvent, startedAi := addActivityTaskScheduledEvent(mutableState, event.GetEventId(), "activity-1", "some random activity type", taskQueueName, &commonpb.Payloads{}, 10*time.Second, 10*time.Second, 10*time.Second, 10*time.Second)
+	_, _ = addActivityTaskScheduledEvent(mutableState, event.GetEventId(), "activity-2", "some random activity type", taskQueueName, &commonpb.Payloads{}, 10*time.Second, 10*time.Second, 10*time.Second, 10*time.Second)
+	addActivityTaskStartedEvent(mutableState, startedEvent.GetEventId(), "some random identity")
+	startedAi.ConcurrencySlotPartition = slotPartition
+
+	taskID := s.mustGenerateTaskID()
+	event = addCompleteWorkflowEvent(mutableState, event.GetEventId(), nil)
+
+	transferTask := &tasks.CloseExecutionTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              taskID,
+		VisibilityTimestamp: time.Now().UTC(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().ReleaseConcurrencySlot(gomock.Any(), protomock.Eq(&matchingservice.ReleaseConcurrencySlotRequest{
+		NamespaceId:        s.namespaceID.String(),
+		TaskQueuePartition: slotPartition,
+		WorkflowId:         execution.GetWorkflowId(),
+		RunId:              execution.GetRunId(),
+		ScheduledEventId:   startedEvent.GetEventId(),
+		Attempt:            startedAi.GetAttempt(),
+	}), gomock.Any()).Return(&matchingservice.ReleaseConcurrencySlotResponse{}, nil)
+
+	resp := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(resp.ExecutionErr)
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) TestProcessCloseExecution_NoParent_HasFewChildren() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -172,9 +172,11 @@ func (f *transferQueueFactory) CreateQueue(
 				MaxPredicateSize:     f.Config.QueueMaxPredicateSize,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:             f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:           f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:           f.Config.QueueCriticalSlicesCount,
+				NamespaceTaskLatencyCriticalThreshold: f.Config.QueueNamespaceTaskLatencyCriticalThreshold,
+				TaskAttemptsCriticalThreshold:         f.Config.QueueTaskAttemptsCriticalThreshold,
 			},
 			MaxPollRPS:                          f.Config.TransferProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TransferProcessorMaxPollInterval,
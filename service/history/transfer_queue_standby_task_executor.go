@@ -595,6 +595,8 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.requiredLabels,
+		pushActivityInfo.affinityKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -617,6 +619,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.requiredLabels,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -59,6 +59,8 @@ import (
 	"go.temporal.io/server/common/searchattribute/sadefs"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/softassert"
+	"go.temporal.io/server/common/taskaffinity"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
@@ -69,6 +71,7 @@ import (
 	"go.temporal.io/server/service/history/historybuilder"
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/replication/workflowfilter"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/workflow/update"
 	"google.golang.org/protobuf/proto"
@@ -2703,6 +2706,21 @@ func (ms *MutableStateImpl) AddWorkflowExecutionStartedEventWithOptions(
 		return nil, err
 	}
 
+	// The replication filter is only evaluated once, against the start attributes, so that an execution is either
+	// replicated from its first event on or never.
+	if ms.generateReplicationTask() {
+		ms.executionInfo.ReplicationLocalOnly = !ms.shard.GetReplicationWorkflowFilter().Match(
+			ms.namespaceEntry.Name(),
+			workflowfilter.Execution{
+				WorkflowID:       execution.GetWorkflowId(),
+				RunID:            execution.GetRunId(),
+				WorkflowType:     ms.executionInfo.WorkflowTypeName,
+				StartTime:        ms.executionState.StartTime,
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: ms.executionInfo.SearchAttributes},
+			},
+		)
+	}
+
 	// TODO merge active & passive task generation
 	var err error
 	ms.executionInfo.WorkflowExecutionTimerTaskStatus, err = ms.taskGenerator.GenerateWorkflowStartTasks(
@@ -2787,6 +2805,11 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionStartedEvent(
 	ms.executionInfo.WorkflowTaskTimeout = timestamp.DurationFromSeconds(0)
 
 	ms.executionInfo.CronSchedule = event.GetCronSchedule()
+	requiredLabels, err := tasklabels.FromHeader(event.GetHeader())
+	if err != nil {
+		return err
+	}
+	ms.executionInfo.RequiredLabels = requiredLabels
 
 	if event.ParentWorkflowExecution != nil {
 		ms.executionInfo.ParentNamespaceId = event.GetParentWorkflowNamespaceId()
@@ -3919,6 +3942,10 @@ func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
 
 	scheduledEventID := event.GetEventId()
 	scheduleToCloseTimeout := attributes.GetScheduleToCloseTimeout()
+	requiredLabels, err := tasklabels.FromHeader(attributes.GetHeader())
+	if err != nil {
+		return nil, err
+	}
 
 	ai := &persistencespb.ActivityInfo{
 		Version:                 event.GetVersion(),
@@ -3943,6 +3970,8 @@ func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
 		Attempt:                 1,
 		ActivityType:            attributes.GetActivityType(),
 		Priority:                attributes.Priority,
+		RequiredLabels:          requiredLabels,
+		AffinityKey:             taskaffinity.FromHeader(attributes.GetHeader()),
 	}
 
 	if attributes.UseWorkflowBuildId {
@@ -8248,6 +8277,9 @@ func (ms *MutableStateImpl) closeTransactionCollapseVisibilityTasks() {
 }
 
 func (ms *MutableStateImpl) generateReplicationTask() bool {
+	if ms.executionInfo.GetReplicationLocalOnly() {
+		return false
+	}
 	return len(ms.namespaceEntry.ClusterNames(ms.GetWorkflowKey().WorkflowID)) > 1
 }
 
@@ -58,6 +58,7 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
 	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/replication/workflowfilter"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
@@ -4266,6 +4267,49 @@ func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_HistoryT
 	}
 }
 
+func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_LocalOnly() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+	s.mockShard.SetReplicationWorkflowFilterForTesting(workflowfilter.NewFilter(
+		dynamicconfig.GetStringPropertyFnFilteredByNamespace("WorkflowType = 'Replicated'"),
+		searchattribute.NewTestProvider(),
+		searchattribute.NewTestMapperProvider(nil),
+		"",
+		s.logger,
+	))
+
+	testCases := []struct {
+		workflowType      string
+		expectedLocalOnly bool
+	}{
+		{workflowType: "Replicated", expectedLocalOnly: false},
+		{workflowType: "Ephemeral", expectedLocalOnly: true},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.workflowType, func() {
+			ms := NewMutableState(s.mockShard, s.mockEventsCache, s.logger, s.namespaceEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+			_, err := ms.AddWorkflowExecutionStartedEvent(
+				&commonpb.WorkflowExecution{
+					WorkflowId: tests.WorkflowID,
+					RunId:      tests.RunID,
+				},
+				&historyservice.StartWorkflowExecutionRequest{
+					StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+						WorkflowType: &commonpb.WorkflowType{Name: tc.workflowType},
+					},
+				},
+			)
+			s.NoError(err)
+			s.Equal(tc.expectedLocalOnly, ms.GetExecutionInfo().GetReplicationLocalOnly())
+
+			_, err = ms.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+			s.NoError(err)
+			snapshot, _, err := ms.CloseTransactionAsSnapshot(context.Background(), historyi.TransactionPolicyActive)
+			s.NoError(err)
+			s.Equal(tc.expectedLocalOnly, len(snapshot.Tasks[tasks.CategoryReplication]) == 0)
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestCloseTransactionPrepareReplicationTasks_SyncVersionedTransitionTask() {
 	if s.replicationMultipleBatches == true {
 		return
@@ -354,6 +354,11 @@ func (r *TaskGeneratorImpl) GenerateDeleteHistoryEventTask(closeTime time.Time)
 		return err
 	}
 
+	// retention of an execution restored from the archival store is counted from the restore time
+	if restoreTime := r.mutableState.GetExecutionInfo().GetRestoreTime(); restoreTime != nil && restoreTime.AsTime().After(closeTime) {
+		closeTime = restoreTime.AsTime()
+	}
+
 	retentionJitterDuration := backoff.FullJitter(r.config.RetentionTimerJitterDuration())
 	deleteTime := closeTime.Add(retention).Add(retentionJitterDuration)
 	r.mutableState.AddTasks(&tasks.DeleteHistoryEventTask{
@@ -908,6 +913,10 @@ func (r *TaskGeneratorImpl) getTargetNamespaceID(
 // archivalEnabled returns true if archival is enabled for either history or visibility.
 // For both history and visibility, we check that archival is enabled for both the cluster and the namespace.
 func (r *TaskGeneratorImpl) archivalEnabled() bool {
+	if r.mutableState.GetExecutionInfo().GetRestoreTime() != nil {
+		// execution was restored from the archival store, there is nothing new to archive
+		return false
+	}
 	namespaceEntry := r.mutableState.GetNamespaceEntry()
 	return r.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() &&
 		namespaceEntry.HistoryArchivalState().State == enumspb.ARCHIVAL_STATE_ENABLED ||
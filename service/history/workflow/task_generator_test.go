@@ -53,6 +53,7 @@ type testParams struct {
 	HistoryArchivalEnabledInNamespace    bool
 	VisibilityArchivalEnabledForCluster  bool
 	VisibilityArchivalEnabledInNamespace bool
+	RestoreTime                          time.Time
 
 	ExpectCloseExecutionVisibilityTask              bool
 	ExpectArchiveExecutionTask                      bool
@@ -152,6 +153,16 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				p.HistoryArchivalEnabledInNamespace = false
 				p.VisibilityArchivalEnabledInNamespace = false
 
+				p.ExpectCloseExecutionVisibilityTask = true
+				p.ExpectDeleteHistoryEventTask = true
+				p.ExpectArchiveExecutionTask = false
+			},
+		},
+		{
+			Name: "restored from archival skips archival",
+			ConfigFn: func(p *testParams) {
+				p.RestoreTime = time.Unix(0, 0).Add(30 * 24 * time.Hour)
+
 				p.ExpectCloseExecutionVisibilityTask = true
 				p.ExpectDeleteHistoryEventTask = true
 				p.ExpectArchiveExecutionTask = false
@@ -222,9 +233,13 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 			mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
 			mutableState.EXPECT().GetCloseVersion().Return(int64(0), nil).AnyTimes()
 			mutableState.EXPECT().GetExecutionInfo().DoAndReturn(func() *persistencespb.WorkflowExecutionInfo {
-				return &persistencespb.WorkflowExecutionInfo{
+				executionInfo := &persistencespb.WorkflowExecutionInfo{
 					NamespaceId: namespaceEntry.ID().String(),
 				}
+				if !p.RestoreTime.IsZero() {
+					executionInfo.RestoreTime = timestamppb.New(p.RestoreTime)
+				}
+				return executionInfo
 			}).AnyTimes()
 			mutableState.EXPECT().GetWorkflowKey().Return(definition.NewWorkflowKey(
 				namespaceEntry.ID().String(), tests.WorkflowID, tests.RunID,
@@ -303,9 +318,13 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				assert.Equal(t, deleteHistoryEventTask.NamespaceID, namespaceEntry.ID().String())
 				assert.Equal(t, deleteHistoryEventTask.WorkflowID, tests.WorkflowID)
 				assert.Equal(t, deleteHistoryEventTask.RunID, tests.RunID)
-				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, closeTime.Add(p.Retention))
+				retentionStart := closeTime
+				if !p.RestoreTime.IsZero() {
+					retentionStart = p.RestoreTime
+				}
+				assert.GreaterOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp, retentionStart.Add(p.Retention))
 				assert.LessOrEqual(t, deleteHistoryEventTask.VisibilityTimestamp,
-					closeTime.Add(p.Retention).Add(retentionTimerDelay*2))
+					retentionStart.Add(p.Retention).Add(retentionTimerDelay*2))
 			} else {
 				assert.Nil(t, deleteHistoryEventTask)
 			}
@@ -296,6 +296,7 @@ func persistFirstWorkflowEvents(
 		namespaceID,
 		execution,
 		&persistence.AppendHistoryNodesRequest{
+			NamespaceID:       namespaceID.String(),
 			IsNewBranch:       true,
 			Info:              persistence.BuildHistoryGarbageCleanupInfo(namespaceID.String(), workflowID, runID),
 			BranchToken:       branchToken,
@@ -333,6 +334,7 @@ func persistNonFirstWorkflowEvents(
 		namespaceID,
 		&execution,
 		&persistence.AppendHistoryNodesRequest{
+			NamespaceID:       namespaceID.String(),
 			IsNewBranch:       false,
 			BranchToken:       branchToken,
 			Events:            events,
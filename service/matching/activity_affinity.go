@@ -0,0 +1,78 @@
+package matching
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/cache"
+)
+
+const activityAffinityCacheSize = 10000
+
+type (
+	// activityAffinity remembers which worker last received an activity task with each affinity
+	// key in a physical queue. The matcher holds back later tasks with the same key for that
+	// worker as long as it's still polling, for up to the affinity fallback timeout since the task
+	// was created. After that any worker can receive them.
+	//
+	// Only root partitions keep an activityAffinity: the matching client adds tasks with an
+	// affinity key to the root partition, and the other partitions forward their idle polls there.
+	// The root has no parent to forward to, so a held back task stays until its deadline. The
+	// classic matcher doesn't support worker affinity, it drops the key when the task is added.
+	activityAffinity struct {
+		config        *taskQueueConfig
+		pollerHistory *pollerHistory
+		// affinity key -> pollerIdentity
+		workers cache.Cache
+	}
+)
+
+func newActivityAffinity(config *taskQueueConfig, pollerHistory *pollerHistory) *activityAffinity {
+	return &activityAffinity{
+		config:        config,
+		pollerHistory: pollerHistory,
+		workers: cache.New(activityAffinityCacheSize, &cache.Options{
+			TTL: max(1, config.PollerHistoryTTL()), // ensure TTL is never zero (which would disable TTL)
+		}),
+	}
+}
+
+// holdBack returns true if task should not be given to poller yet because it's waiting for the
+// worker that handled its affinity key before. In that case, it also returns the time after which
+// the task can be given to any worker.
+func (a *activityAffinity) holdBack(task *internalTask, poller *waitingPoller, now time.Time) (bool, time.Time) {
+	if a == nil || !receivesAffinityTask(task, poller) {
+		return false, time.Time{}
+	}
+	fallbackTimeout := a.config.AffinityFallbackTimeout()
+	if fallbackTimeout <= 0 {
+		return false, time.Time{}
+	}
+	preferred, ok := a.workers.Get(task.affinityKey()).(pollerIdentity)
+	if !ok || preferred == poller.identity {
+		return false, time.Time{}
+	}
+	if a.pollerHistory.history.Get(preferred) == nil {
+		// The preferred worker hasn't polled recently, don't wait for it.
+		return false, time.Time{}
+	}
+	deadline := task.event.Data.GetCreateTime().AsTime().Add(fallbackTimeout)
+	if !now.Before(deadline) {
+		return false, time.Time{}
+	}
+	return true, deadline
+}
+
+// record remembers that poller received task.
+func (a *activityAffinity) record(task *internalTask, poller *waitingPoller) {
+	if a == nil || !receivesAffinityTask(task, poller) {
+		return
+	}
+	a.workers.Put(task.affinityKey(), poller.identity)
+}
+
+// receivesAffinityTask returns true if task has an affinity key and matching it with poller
+// dispatches it to a worker.
+func receivesAffinityTask(task *internalTask, poller *waitingPoller) bool {
+	return task.affinityKey() != "" && !task.isPollForwarder() &&
+		poller.identity != "" && !poller.isTaskForwarder && !poller.isTaskValidator
+}
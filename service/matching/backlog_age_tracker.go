@@ -48,6 +48,15 @@ func (b backlogAgeTracker) oldestTime() time.Time {
 	return time.Unix(0, k.(int64)) // nolint:revive
 }
 
+// agedLevels returns the number of priority levels a task created at createTime has gained
+// by now, if it gains one level per interval.
+func agedLevels(createTime, now time.Time, interval time.Duration) priorityKey {
+	if interval <= 0 || createTime.IsZero() || !now.After(createTime) {
+		return 0
+	}
+	return priorityKey(now.Sub(createTime) / interval)
+}
+
 // minNonZeroTime returns the minimum time of a and b, ignoring zero times.
 // If both a and b are zero, it returns zero.
 func minNonZeroTime(a, b time.Time) time.Time {
@@ -0,0 +1,128 @@
+package matching
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// backlogLimitCheckInterval is how long the backlog limiter reuses a computed backlog before
+// looking at the physical queues again.
+const backlogLimitCheckInterval = time.Second
+
+type (
+	// backlogLimiter rejects new tasks while the backlog of a partition is over its share of the
+	// backlog limit of the task queue. The count limit is split between write partitions, the age
+	// limit applies to every partition as is.
+	backlogLimiter struct {
+		mu sync.Mutex
+
+		// Dependencies
+		config         *taskQueueConfig
+		partitionID    int
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+		backlogStats   func() (count int64, age time.Duration)
+
+		lastCheck time.Time
+		status    *taskqueuespb.BacklogLimitStatus
+	}
+)
+
+func newBacklogLimiter(
+	config *taskQueueConfig,
+	partition tqid.Partition,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+	backlogStats func() (int64, time.Duration),
+) *backlogLimiter {
+	partitionID := 0
+	if p, ok := partition.(*tqid.NormalPartition); ok {
+		partitionID = p.PartitionId()
+	}
+	return &backlogLimiter{
+		config:         config,
+		partitionID:    partitionID,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		backlogStats:   backlogStats,
+	}
+}
+
+// checkAdd returns a TaskQueueBacklogLimitExceeded error if new tasks should be rejected.
+func (l *backlogLimiter) checkAdd() error {
+	if l == nil {
+		return nil
+	}
+	maxCount, maxAge := l.limits()
+	if maxCount == 0 && maxAge == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	status := l.refreshLocked(maxCount, maxAge, false)
+	l.mu.Unlock()
+
+	if !status.GetExceeded() {
+		return nil
+	}
+	metrics.BacklogLimitRejectedTasksCounter.With(l.metricsHandler).Record(1)
+	return serviceerrors.NewTaskQueueBacklogLimitExceeded(fmt.Sprintf(
+		"task queue backlog is over its limit (backlog count %d, limit %d; backlog age %v, limit %v)",
+		status.GetBacklogCount(), status.GetMaxBacklogCount(),
+		status.GetBacklogAge().AsDuration(), status.GetMaxBacklogAge().AsDuration(),
+	))
+}
+
+// Status returns the current state of the backlog limit, or nil for partitions without one.
+func (l *backlogLimiter) Status() *taskqueuespb.BacklogLimitStatus {
+	if l == nil {
+		return nil
+	}
+	maxCount, maxAge := l.limits()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.refreshLocked(maxCount, maxAge, true)
+}
+
+func (l *backlogLimiter) limits() (int64, time.Duration) {
+	numPartitions := max(l.config.NumWritePartitions(), 1)
+	maxCount := partitionShare(l.config.BacklogLimitCount(), numPartitions, l.partitionID)
+	return int64(maxCount), max(l.config.BacklogLimitAge(), 0)
+}
+
+func (l *backlogLimiter) refreshLocked(maxCount int64, maxAge time.Duration, force bool) *taskqueuespb.BacklogLimitStatus {
+	now := l.timeSource.Now()
+	if !force && l.status != nil &&
+		l.status.GetMaxBacklogCount() == maxCount &&
+		l.status.GetMaxBacklogAge().AsDuration() == maxAge &&
+		now.Sub(l.lastCheck) < backlogLimitCheckInterval {
+		return l.status
+	}
+
+	count, age := l.backlogStats()
+	exceeded := (maxCount > 0 && count >= maxCount) || (maxAge > 0 && age >= maxAge)
+	l.lastCheck = now
+	l.status = &taskqueuespb.BacklogLimitStatus{
+		MaxBacklogCount: maxCount,
+		MaxBacklogAge:   durationpb.New(maxAge),
+		BacklogCount:    count,
+		BacklogAge:      durationpb.New(age),
+		Exceeded:        exceeded,
+	}
+
+	gaugeValue := 0.0
+	if exceeded {
+		gaugeValue = 1
+	}
+	metrics.BacklogLimitExceededGauge.With(l.metricsHandler).Record(gaugeValue)
+	return l.status
+}
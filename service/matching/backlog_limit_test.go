@@ -0,0 +1,104 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tqid"
+)
+
+type testBacklog struct {
+	count int64
+	age   time.Duration
+	calls int
+}
+
+func (b *testBacklog) stats() (int64, time.Duration) {
+	b.calls++
+	return b.count, b.age
+}
+
+func newTestBacklogLimiter(t *testing.T, maxCount int, maxAge time.Duration) (*backlogLimiter, *testBacklog, *clock.EventTimeSource) {
+	f, err := tqid.NewTaskQueueFamily(namespaceID, "tq")
+	require.NoError(t, err)
+	partition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).NormalPartition(1)
+
+	config := &taskQueueConfig{
+		NumWritePartitions: func() int { return 2 },
+		BacklogLimitCount:  func() int { return maxCount },
+		BacklogLimitAge:    func() time.Duration { return maxAge },
+	}
+	backlog := &testBacklog{}
+	ts := clock.NewEventTimeSource().Update(time.Now())
+	l := newBacklogLimiter(config, partition, ts, metrics.NoopMetricsHandler, backlog.stats)
+	return l, backlog, ts
+}
+
+func TestBacklogLimiter_NoLimit(t *testing.T) {
+	t.Parallel()
+	l, backlog, _ := newTestBacklogLimiter(t, 0, 0)
+	backlog.count = 1000
+	backlog.age = time.Hour
+	require.NoError(t, l.checkAdd())
+	require.Zero(t, backlog.calls)
+	require.False(t, l.Status().GetExceeded())
+
+	var nilLimiter *backlogLimiter
+	require.NoError(t, nilLimiter.checkAdd())
+	require.Nil(t, nilLimiter.Status())
+}
+
+func TestBacklogLimiter_Count(t *testing.T) {
+	t.Parallel()
+	// The limit is split between the two write partitions.
+	l, backlog, ts := newTestBacklogLimiter(t, 10, 0)
+	backlog.count = 4
+	require.NoError(t, l.checkAdd())
+	require.EqualValues(t, 5, l.Status().GetMaxBacklogCount())
+
+	backlog.count = 5
+	ts.Advance(backlogLimitCheckInterval)
+	err := l.checkAdd()
+	var limitErr *serviceerrors.TaskQueueBacklogLimitExceeded
+	require.ErrorAs(t, err, &limitErr)
+	require.True(t, l.Status().GetExceeded())
+}
+
+func TestBacklogLimiter_Age(t *testing.T) {
+	t.Parallel()
+	l, backlog, ts := newTestBacklogLimiter(t, 0, time.Minute)
+	backlog.count = 1000
+	backlog.age = 30 * time.Second
+	require.NoError(t, l.checkAdd())
+
+	backlog.age = 2 * time.Minute
+	ts.Advance(backlogLimitCheckInterval)
+	require.Error(t, l.checkAdd())
+
+	status := l.Status()
+	require.True(t, status.GetExceeded())
+	require.Equal(t, 2*time.Minute, status.GetBacklogAge().AsDuration())
+	require.Equal(t, time.Minute, status.GetMaxBacklogAge().AsDuration())
+}
+
+func TestBacklogLimiter_CachesBacklog(t *testing.T) {
+	t.Parallel()
+	l, backlog, ts := newTestBacklogLimiter(t, 10, 0)
+	backlog.count = 100
+	require.Error(t, l.checkAdd())
+	require.Equal(t, 1, backlog.calls)
+
+	// The backlog is not looked at again until the check interval has passed.
+	backlog.count = 0
+	require.Error(t, l.checkAdd())
+	require.Equal(t, 1, backlog.calls)
+
+	ts.Advance(backlogLimitCheckInterval)
+	require.NoError(t, l.checkAdd())
+	require.Equal(t, 2, backlog.calls)
+}
@@ -46,6 +46,9 @@ type (
 		BacklogCountHint() int64
 		BacklogStatus() *taskqueuepb.TaskQueueStatus
 		BacklogStatsByPriority() map[int32]*taskqueuepb.TaskQueueStats
+		// BacklogStatsByLabelSet returns backlog stats of tasks with required labels, keyed by
+		// label set. Tasks without required labels are not included.
+		BacklogStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats
 		InternalStatus() []*taskqueuespb.InternalTaskQueueStatus
 		// FinalGC does a final gc pass before unloading.
 		// Used when unloading a draining queue that won't be reloaded.
@@ -207,6 +210,11 @@ func (c *backlogManagerImpl) BacklogStatsByPriority() map[int32]*taskqueuepb.Tas
 	}
 }
 
+func (c *backlogManagerImpl) BacklogStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats {
+	// The classic backlog doesn't keep tasks with different labels apart.
+	return nil
+}
+
 func (c *backlogManagerImpl) InternalStatus() []*taskqueuespb.InternalTaskQueueStatus {
 	currentTaskIDBlock := c.taskWriter.getCurrentTaskIDBlock()
 	return []*taskqueuespb.InternalTaskQueueStatus{
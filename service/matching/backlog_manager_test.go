@@ -355,6 +355,63 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_NotIncrementedBySp
 		"backlog count should not be incremented")
 }
 
+func (s *BacklogManagerTestSuite) TestBacklogStatsByLabelSet() {
+	if !s.newMatcher {
+		s.T().Skip("label set subqueues are for priority + fairness backlog manager only")
+	}
+	s.cfgcli.OverrideValue(dynamicconfig.MatchingMaxLabelSetSubqueues.Key(), 2)
+
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	for _, labels := range [][]string{{"gpu"}, {"gpu", "us"}, {"gpu"}, {"eu"}, nil} {
+		s.NoError(s.blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime:     timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime:     timestamp.TimeNowPtrUtc(),
+			RequiredLabels: labels,
+		}))
+	}
+
+	// "eu" is over the limit of label set subqueues and shares the subqueue without labels
+	stats := s.blm.BacklogStatsByLabelSet()
+	s.Len(stats, 2)
+	s.Equal(int64(2), stats["gpu"].GetApproximateBacklogCount())
+	s.Equal(int64(1), stats["gpu,us"].GetApproximateBacklogCount())
+	s.Equal(int64(5), totalApproximateBacklogCount(s.blm))
+}
+
+func (s *BacklogManagerTestSuite) TestScanTasks() {
+	s.blm.Start()
+	defer s.blm.Stop()
+	s.NoError(s.blm.WaitUntilInitialized(context.Background()))
+
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).Return(nil).AnyTimes()
+	for i := range 5 {
+		s.NoError(s.blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime: timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime: timestamp.TimeNowPtrUtc(),
+			WorkflowId: fmt.Sprint(i),
+		}))
+	}
+
+	var workflowIDs []string
+	s.NoError(s.blm.getDB().ScanTasks(context.Background(), s.fairness, 2, func(task *persistencespb.AllocatedTaskInfo) bool {
+		workflowIDs = append(workflowIDs, task.GetData().GetWorkflowId())
+		return true
+	}))
+	s.ElementsMatch([]string{"0", "1", "2", "3", "4"}, workflowIDs)
+
+	// the scan stops when visit returns false
+	visited := 0
+	s.NoError(s.blm.getDB().ScanTasks(context.Background(), s.fairness, 2, func(task *persistencespb.AllocatedTaskInfo) bool {
+		visited++
+		return visited < 3
+	}))
+	s.Equal(3, visited)
+}
+
 func totalApproximateBacklogCount(c backlogManager) (total int64) {
 	for _, stats := range c.BacklogStatsByPriority() {
 		total += stats.ApproximateBacklogCount
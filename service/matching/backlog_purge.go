@@ -0,0 +1,482 @@
+package matching
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxBacklogPurges bounds the number of purges kept in the user data of a task queue type.
+	// Expired purges are evicted first, oldest first.
+	maxBacklogPurges = 20
+
+	backlogPurgeActionReschedule = "reschedule"
+	backlogPurgeActionMove       = "move"
+)
+
+var errTooManyBacklogPurges = serviceerror.NewFailedPrecondition("too many active backlog purges, cancel one first")
+
+// validateBacklogPurge checks a purge requested through the admin API before it's stored.
+func validateBacklogPurge(
+	purge *persistencespb.TaskQueueBacklogPurge,
+	namespaceID string,
+	taskQueueType enumspb.TaskQueueType,
+	now time.Time,
+) error {
+	switch taskQueueType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		// Dropping a workflow task would leave the workflow without a way to make progress.
+		if purge.GetDestinationTaskQueue() == "" {
+			return serviceerror.NewInvalidArgument("workflow tasks can only be moved, not dropped")
+		}
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+	default:
+		return serviceerror.NewInvalidArgument("backlog purges are only supported for workflow and activity task queues")
+	}
+	if dest := purge.GetDestinationTaskQueue(); dest != "" {
+		if _, err := tqid.NormalPartitionFromRpcName(dest, namespaceID, taskQueueType); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid destination task queue: %v", err)
+		}
+	}
+	if purge.GetCreatedBefore() == nil {
+		return serviceerror.NewInvalidArgument("created before time must be set")
+	}
+	if !purge.GetExpireTime().AsTime().After(now) {
+		return serviceerror.NewInvalidArgument("expire time must be in the future")
+	}
+	return nil
+}
+
+// addBacklogPurge returns purges with purge added, evicting expired purges if there are too many.
+func addBacklogPurge(
+	purges []*persistencespb.TaskQueueBacklogPurge,
+	purge *persistencespb.TaskQueueBacklogPurge,
+	now time.Time,
+) ([]*persistencespb.TaskQueueBacklogPurge, error) {
+	purges = slices.Clone(purges)
+	for len(purges) >= maxBacklogPurges {
+		oldest := -1
+		for i, p := range purges {
+			if isBacklogPurgeActive(p, now) {
+				continue
+			}
+			if oldest < 0 || p.GetExpireTime().AsTime().Before(purges[oldest].GetExpireTime().AsTime()) {
+				oldest = i
+			}
+		}
+		if oldest < 0 {
+			return nil, errTooManyBacklogPurges
+		}
+		purges = slices.Delete(purges, oldest, oldest+1)
+	}
+	return append(purges, purge), nil
+}
+
+// cancelBacklogPurge expires the purge with the given ID. It stays in the list as an audit entry.
+func cancelBacklogPurge(
+	purges []*persistencespb.TaskQueueBacklogPurge,
+	id string,
+	now time.Time,
+) error {
+	for _, p := range purges {
+		if p.GetId() != id {
+			continue
+		}
+		if isBacklogPurgeActive(p, now) {
+			p.ExpireTime = timestamppb.New(now)
+		}
+		return nil
+	}
+	return serviceerror.NewNotFoundf("backlog purge %s not found", id)
+}
+
+func isBacklogPurgeActive(purge *persistencespb.TaskQueueBacklogPurge, now time.Time) bool {
+	return purge.GetExpireTime().AsTime().After(now)
+}
+
+// backlogPurgeMatches returns true if the task was created before the purge was requested and
+// matches its filter.
+func backlogPurgeMatches(purge *persistencespb.TaskQueueBacklogPurge, data *persistencespb.TaskInfo) bool {
+	if !data.GetCreateTime().AsTime().Before(purge.GetCreatedBefore().AsTime()) {
+		return false
+	}
+	if purge.GetWorkflowId() != "" && purge.GetWorkflowId() != data.GetWorkflowId() {
+		return false
+	}
+	if purge.GetRunId() != "" && purge.GetRunId() != data.GetRunId() {
+		return false
+	}
+	if purge.GetFairnessKey() != "" && purge.GetFairnessKey() != data.GetPriority().GetFairnessKey() {
+		return false
+	}
+	if purge.GetPriorityKey() != 0 && purge.GetPriorityKey() != data.GetPriority().GetPriorityKey() {
+		return false
+	}
+	if buildID := purge.GetBuildId(); buildID != "" {
+		directive := data.GetVersionDirective()
+		if buildID != directive.GetAssignedBuildId() && buildID != directive.GetDeploymentVersion().GetBuildId() {
+			return false
+		}
+	}
+	return true
+}
+
+type (
+	// backlogPurger applies the backlog purges of a normal partition.
+	//
+	// When it sees a purge for the first time, it scans the backlog of every loaded physical queue
+	// in the database and drops or moves the tasks that match, no matter how far behind the task
+	// readers are. The task readers ack those tasks when they get to them, including the ones that
+	// were already loaded into the matcher, and task GC then deletes them. Tasks that a task
+	// reader gets to before the scan does are purged by the task reader while the purge is active.
+	//
+	// Tasks are purged at least once. A task that was moved can be moved again if the partition is
+	// reloaded before task GC deleted it, in which case history rejects the copy that is started
+	// last.
+	backlogPurger struct {
+		pm *taskQueuePartitionManagerImpl
+
+		lock sync.Mutex
+		// scans that were started
+		scans map[backlogPurgeScanKey]struct{}
+		// tasks that are being purged (false) or were purged by a scan (true)
+		tasks map[purgedTaskKey]bool
+	}
+
+	backlogPurgeScanKey struct {
+		purgeID string
+		queue   string // persistence name of the physical queue
+	}
+
+	purgedTaskKey struct {
+		queue  string // persistence name of the physical queue
+		taskID int64
+	}
+)
+
+var errBacklogPurgeInProgress = serviceerror.NewUnavailable("backlog task is being purged")
+
+func newBacklogPurger(pm *taskQueuePartitionManagerImpl) *backlogPurger {
+	return &backlogPurger{
+		pm:    pm,
+		scans: make(map[backlogPurgeScanKey]struct{}),
+		tasks: make(map[purgedTaskKey]bool),
+	}
+}
+
+// backlogPurgeApplies returns true if the purge drops or moves the task.
+func backlogPurgeApplies(purge *persistencespb.TaskQueueBacklogPurge, data *persistencespb.TaskInfo) bool {
+	if !backlogPurgeMatches(purge, data) {
+		return false
+	}
+	// Standalone activities don't have a workflow to reschedule them in, leave them alone.
+	return purge.GetDestinationTaskQueue() != "" || len(data.GetComponentRef()) == 0
+}
+
+func (p *backlogPurger) purges() []*persistencespb.TaskQueueBacklogPurge {
+	userData, _, err := p.pm.userDataManager.GetUserData()
+	if err != nil {
+		return nil
+	}
+	return userData.GetData().GetPerType()[int32(p.pm.partition.TaskType())].GetBacklogPurges()
+}
+
+// StartScans starts scanning the backlog of the physical queue for the active purges that it
+// wasn't scanned for yet.
+func (p *backlogPurger) StartScans(pq physicalTaskQueueManager) {
+	if p == nil {
+		return
+	}
+	now := p.pm.engine.timeSource.Now()
+	for _, purge := range p.purges() {
+		if !isBacklogPurgeActive(purge, now) {
+			continue
+		}
+		key := backlogPurgeScanKey{purgeID: purge.GetId(), queue: pq.QueueKey().PersistenceName()}
+		p.lock.Lock()
+		_, started := p.scans[key]
+		p.scans[key] = struct{}{}
+		p.lock.Unlock()
+		if !started {
+			p.pm.goroGroup.Go(func(ctx context.Context) error {
+				p.scan(ctx, pq, purge, key)
+				return nil
+			})
+		}
+	}
+}
+
+func (p *backlogPurger) scan(
+	ctx context.Context,
+	pq physicalTaskQueueManager,
+	purge *persistencespb.TaskQueueBacklogPurge,
+	scanKey backlogPurgeScanKey,
+) {
+	var purged, failed int
+	err := pq.ScanBacklog(func(task *persistencespb.AllocatedTaskInfo) bool {
+		// The scan goes on after the purge expired, it only matches tasks created before it anyway.
+		if !backlogPurgeApplies(purge, task.GetData()) {
+			return true
+		}
+		key := purgedTaskKey{queue: scanKey.queue, taskID: task.GetTaskId()}
+		if !p.claim(key) {
+			return true // the task reader got to it first
+		}
+		if err := p.apply(ctx, purge, task); err != nil {
+			// Left to the task reader.
+			p.unclaim(key)
+			failed++
+			return ctx.Err() == nil
+		}
+		p.lock.Lock()
+		p.tasks[key] = true
+		p.lock.Unlock()
+		purged++
+		return true
+	})
+	if err != nil {
+		// Scan again when the queue is loaded again.
+		p.lock.Lock()
+		delete(p.scans, scanKey)
+		p.lock.Unlock()
+	}
+
+	p.pm.logger.Info("backlog purge scan finished",
+		tag.NewStringTag("backlog-purge-id", purge.GetId()),
+		tag.NewStringTag("queue", scanKey.queue),
+		tag.NewInt("purged", purged),
+		tag.NewInt("failed", failed),
+		tag.Error(err))
+
+	// Have the matcher send back the tasks it holds, so that the ones that were purged are acked
+	// instead of dispatched.
+	pq.UserDataChanged()
+}
+
+// claim marks the task as being purged. Returns false if it's being purged or was purged already.
+func (p *backlogPurger) claim(key purgedTaskKey) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.tasks[key]; ok {
+		return false
+	}
+	p.tasks[key] = false
+	return true
+}
+
+func (p *backlogPurger) unclaim(key purgedTaskKey) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.tasks, key)
+}
+
+// PurgeSpooledTask is called by the task readers with a task read from the backlog. It acks the
+// task if a scan purged it, or drops or moves it if an active purge matches it. It returns true
+// if the task was handled. If it returns an error, the caller should retry the task later.
+func (p *backlogPurger) PurgeSpooledTask(ctx context.Context, task *internalTask, backlogQueue *PhysicalTaskQueueKey) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+	key := purgedTaskKey{queue: backlogQueue.PersistenceName(), taskID: task.event.GetTaskId()}
+	p.lock.Lock()
+	purgedByScan, found := p.tasks[key]
+	if purgedByScan {
+		delete(p.tasks, key)
+	}
+	p.lock.Unlock()
+	if found {
+		if !purgedByScan {
+			return false, errBacklogPurgeInProgress
+		}
+		// The task is valid, but it's gone from this queue now.
+		task.finish(nil, true)
+		return true, nil
+	}
+
+	now := p.pm.engine.timeSource.Now()
+	var purge *persistencespb.TaskQueueBacklogPurge
+	for _, pu := range p.purges() {
+		if isBacklogPurgeActive(pu, now) && backlogPurgeApplies(pu, task.event.GetData()) {
+			purge = pu
+			break
+		}
+	}
+	if purge == nil {
+		return false, nil
+	}
+	if !p.claim(key) {
+		return false, errBacklogPurgeInProgress
+	}
+	err := p.apply(ctx, purge, task.event.AllocatedTaskInfo)
+	p.unclaim(key)
+	if err != nil {
+		// Make sure that task readers retry the task instead of dropping it on a non-retryable error.
+		return false, serviceerror.NewUnavailablef("backlog purge %s failed: %v", purge.GetId(), err)
+	}
+	task.finish(nil, true)
+	return true, nil
+}
+
+// apply moves the task to the destination of the purge, or reschedules its activity. Every task
+// that is purged is logged as an audit trail.
+func (p *backlogPurger) apply(
+	ctx context.Context,
+	purge *persistencespb.TaskQueueBacklogPurge,
+	task *persistencespb.AllocatedTaskInfo,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, ioTimeout)
+	defer cancel()
+
+	action := backlogPurgeActionMove
+	var err error
+	if purge.GetDestinationTaskQueue() != "" {
+		err = p.moveTask(ctx, purge, task.GetData())
+	} else {
+		action = backlogPurgeActionReschedule
+		err = p.rescheduleActivity(ctx, purge, task.GetData())
+	}
+	if err != nil {
+		p.pm.throttledLogger.Warn("failed to purge backlog task",
+			tag.NewStringTag("backlog-purge-id", purge.GetId()),
+			tag.TaskID(task.GetTaskId()),
+			tag.Error(err))
+		return err
+	}
+
+	p.pm.logger.Info("purged backlog task",
+		tag.NewStringTag("backlog-purge-id", purge.GetId()),
+		tag.NewStringTag("backlog-purge-action", action),
+		tag.NewStringTag("backlog-purge-identity", purge.GetMetadata().GetUpdateIdentity()),
+		tag.TaskID(task.GetTaskId()),
+		tag.WorkflowID(task.GetData().GetWorkflowId()),
+		tag.WorkflowRunID(task.GetData().GetRunId()),
+		tag.WorkflowScheduledEventID(task.GetData().GetScheduledEventId()))
+	metrics.BacklogPurgedTasksCounter.With(p.pm.metricsHandler).Record(1, metrics.StringTag("action", action))
+	return nil
+}
+
+// moveTask adds a copy of the task to the destination of the purge. The copy is a new task
+// there, so it's not matched by the purge again.
+func (p *backlogPurger) moveTask(
+	ctx context.Context,
+	purge *persistencespb.TaskQueueBacklogPurge,
+	data *persistencespb.TaskInfo,
+) error {
+	var scheduleToStartTimeout *durationpb.Duration
+	if expiry := data.GetExpiryTime(); expiry != nil && expiry.AsTime().Unix() > 0 {
+		remaining := expiry.AsTime().Sub(p.pm.engine.timeSource.Now())
+		if remaining <= 0 {
+			return nil // expired anyway
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+	execution := &commonpb.WorkflowExecution{WorkflowId: data.GetWorkflowId(), RunId: data.GetRunId()}
+	destination := &taskqueuepb.TaskQueue{
+		Name: purge.GetDestinationTaskQueue(),
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	var err error
+	switch p.pm.partition.TaskType() {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = p.pm.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			Clock:                  data.GetClock(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+			RequiredLabels:         data.GetRequiredLabels(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		var sourceNamespaceID string
+		if data.GetNamespaceId() != p.pm.partition.NamespaceId() {
+			sourceNamespaceID = data.GetNamespaceId()
+		}
+		_, err = p.pm.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            p.pm.partition.NamespaceId(),
+			SourceNamespaceId:      sourceNamespaceID,
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			Clock:                  data.GetClock(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+			ComponentRef:           data.GetComponentRef(),
+			RequiredLabels:         data.GetRequiredLabels(),
+		})
+	default:
+		return errInvalidTaskQueueType
+	}
+	return err
+}
+
+// rescheduleActivity resets the activity of a task that is dropped, so that history schedules it
+// again with a new task, on its first attempt. Its timeouts are not extended. Otherwise the
+// activity would stay scheduled without a task until it times out, or forever if it has no
+// schedule-to-close timeout. Nothing needs to be done if the task is stale.
+func (p *backlogPurger) rescheduleActivity(
+	ctx context.Context,
+	purge *persistencespb.TaskQueueBacklogPurge,
+	data *persistencespb.TaskInfo,
+) error {
+	execution := &commonpb.WorkflowExecution{WorkflowId: data.GetWorkflowId(), RunId: data.GetRunId()}
+	resp, err := p.pm.engine.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     data.GetNamespaceId(),
+		Execution:       execution,
+		SkipForceReload: true,
+	})
+	if common.IsNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	state := resp.GetCacheMutableState()
+	if state == nil {
+		state = resp.GetDatabaseMutableState()
+	}
+	activity, ok := state.GetActivityInfos()[data.GetScheduledEventId()]
+	if !ok || activity.GetPaused() || activity.GetStamp() != data.GetStamp() ||
+		activity.GetStartedEventId() != common.EmptyEventID {
+		return nil
+	}
+
+	// The reset moves the stamp of the activity forward, so this task is rejected if it's
+	// dispatched anyway.
+	_, err = p.pm.engine.historyClient.ResetActivity(ctx, &historyservice.ResetActivityRequest{
+		NamespaceId: data.GetNamespaceId(),
+		FrontendRequest: &workflowservice.ResetActivityRequest{
+			Namespace:  p.pm.ns.Name().String(),
+			Execution:  execution,
+			Identity:   purge.GetMetadata().GetUpdateIdentity(),
+			Activity:   &workflowservice.ResetActivityRequest_Id{Id: activity.GetActivityId()},
+			KeepPaused: true,
+		},
+	})
+	if common.IsNotFoundError(err) {
+		return nil
+	}
+	return err
+}
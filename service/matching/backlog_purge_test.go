@@ -0,0 +1,412 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tqid"
+	"go.temporal.io/server/common/worker_versioning"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type backlogPurgeTest struct {
+	pm             *taskQueuePartitionManagerImpl
+	historyClient  *historyservicemock.MockHistoryServiceClient
+	matchingClient *matchingservicemock.MockMatchingServiceClient
+	queue          *PhysicalTaskQueueKey
+	now            time.Time
+}
+
+func newBacklogPurgeTest(
+	t *testing.T,
+	taskType enumspb.TaskQueueType,
+	purges ...*persistencespb.TaskQueueBacklogPurge,
+) *backlogPurgeTest {
+	controller := gomock.NewController(t)
+	f, err := tqid.NewTaskQueueFamily(namespaceID, "tq")
+	require.NoError(t, err)
+	now := time.Now()
+
+	historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	userDataMgr := &mockUserDataManager{
+		data: &persistencespb.VersionedTaskQueueUserData{
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(taskType): {BacklogPurges: purges},
+				},
+			},
+		},
+	}
+	logger := log.NewNoopLogger()
+	b := &backlogPurgeTest{
+		pm: &taskQueuePartitionManagerImpl{
+			engine: &matchingEngineImpl{
+				historyClient: historyClient,
+				timeSource:    clock.NewEventTimeSource().Update(now),
+			},
+			partition:       f.TaskQueue(taskType).NormalPartition(1),
+			ns:              namespace.NewLocalNamespaceForTest(&persistencespb.NamespaceInfo{Name: namespaceName}, nil, ""),
+			userDataManager: userDataMgr,
+			logger:          logger,
+			throttledLogger: logger,
+			matchingClient:  matchingClient,
+			metricsHandler:  metrics.NoopMetricsHandler,
+		},
+		historyClient:  historyClient,
+		matchingClient: matchingClient,
+		now:            now,
+	}
+	b.pm.backlogPurger = newBacklogPurger(b.pm)
+	b.queue = UnversionedQueueKey(b.pm.partition)
+	return b
+}
+
+// newTask returns a backlog task created a minute ago and a pointer to the result it
+// finishes with.
+func (b *backlogPurgeTest) newTask(fairnessKey string) (*internalTask, **taskResponse) {
+	res := new(*taskResponse)
+	task := newInternalTaskFromBacklog(b.newTaskInfo(42, fairnessKey), func(_ *internalTask, r taskResponse) {
+		*res = &r
+	})
+	return task, res
+}
+
+func (b *backlogPurgeTest) newTaskInfo(taskID int64, fairnessKey string) *persistencespb.AllocatedTaskInfo {
+	return &persistencespb.AllocatedTaskInfo{
+		TaskId: taskID,
+		Data: &persistencespb.TaskInfo{
+			NamespaceId:      namespaceID,
+			WorkflowId:       "wf",
+			RunId:            "run",
+			ScheduledEventId: 5,
+			Stamp:            2,
+			CreateTime:       timestamppb.New(b.now.Add(-time.Minute)),
+			ExpiryTime:       timestamppb.New(b.now.Add(time.Hour)),
+			Priority:         &commonpb.Priority{FairnessKey: fairnessKey},
+		},
+	}
+}
+
+// newScannedQueue returns a physical queue with the given tasks in its backlog.
+func (b *backlogPurgeTest) newScannedQueue(t *testing.T, tasks ...*persistencespb.AllocatedTaskInfo) *MockphysicalTaskQueueManager {
+	pq := NewMockphysicalTaskQueueManager(gomock.NewController(t))
+	pq.EXPECT().QueueKey().Return(b.queue).AnyTimes()
+	pq.EXPECT().ScanBacklog(gomock.Any()).DoAndReturn(func(visit func(*persistencespb.AllocatedTaskInfo) bool) error {
+		for _, task := range tasks {
+			if !visit(task) {
+				break
+			}
+		}
+		return nil
+	})
+	// buffered tasks are re-evaluated after the scan
+	pq.EXPECT().UserDataChanged()
+	return pq
+}
+
+func newTestBacklogPurge(now time.Time, destination string) *persistencespb.TaskQueueBacklogPurge {
+	return &persistencespb.TaskQueueBacklogPurge{
+		Id:                   "purge",
+		FairnessKey:          "bad",
+		CreatedBefore:        timestamppb.New(now),
+		DestinationTaskQueue: destination,
+		ExpireTime:           timestamppb.New(now.Add(time.Hour)),
+	}
+}
+
+func TestBacklogPurgeMatches(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	data := &persistencespb.TaskInfo{
+		WorkflowId:       "wf",
+		RunId:            "run",
+		CreateTime:       timestamppb.New(now.Add(-time.Minute)),
+		Priority:         &commonpb.Priority{FairnessKey: "key", PriorityKey: 2},
+		VersionDirective: worker_versioning.MakeBuildIdDirective("build"),
+	}
+
+	for _, tc := range []struct {
+		name    string
+		purge   *persistencespb.TaskQueueBacklogPurge
+		matches bool
+	}{
+		{"empty filter", &persistencespb.TaskQueueBacklogPurge{}, true},
+		{"all fields", &persistencespb.TaskQueueBacklogPurge{
+			WorkflowId: "wf", RunId: "run", FairnessKey: "key", PriorityKey: 2, BuildId: "build",
+		}, true},
+		{"workflow id", &persistencespb.TaskQueueBacklogPurge{WorkflowId: "other"}, false},
+		{"run id", &persistencespb.TaskQueueBacklogPurge{RunId: "other"}, false},
+		{"fairness key", &persistencespb.TaskQueueBacklogPurge{FairnessKey: "other"}, false},
+		{"priority key", &persistencespb.TaskQueueBacklogPurge{PriorityKey: 3}, false},
+		{"build id", &persistencespb.TaskQueueBacklogPurge{BuildId: "other"}, false},
+		{"created later", &persistencespb.TaskQueueBacklogPurge{CreatedBefore: timestamppb.New(now.Add(-time.Hour))}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.purge.CreatedBefore == nil {
+				tc.purge.CreatedBefore = timestamppb.New(now)
+			}
+			require.Equal(t, tc.matches, backlogPurgeMatches(tc.purge, data))
+		})
+	}
+}
+
+func TestAddBacklogPurge(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	var purges []*persistencespb.TaskQueueBacklogPurge
+	for i := range maxBacklogPurges {
+		// the first two purges have expired, the second one earlier
+		expireTime := now.Add(time.Hour)
+		if i < 2 {
+			expireTime = now.Add(-time.Duration(i+1) * time.Minute)
+		}
+		var err error
+		purges, err = addBacklogPurge(purges, &persistencespb.TaskQueueBacklogPurge{
+			Id:         string(rune('a' + i)),
+			ExpireTime: timestamppb.New(expireTime),
+		}, now)
+		require.NoError(t, err)
+	}
+	require.Len(t, purges, maxBacklogPurges)
+
+	purges, err := addBacklogPurge(purges, newTestBacklogPurge(now, ""), now)
+	require.NoError(t, err)
+	require.Len(t, purges, maxBacklogPurges)
+	require.Equal(t, "a", purges[0].GetId())
+	require.Equal(t, "c", purges[1].GetId())
+	require.Equal(t, "purge", purges[maxBacklogPurges-1].GetId())
+
+	purges, err = addBacklogPurge(purges, newTestBacklogPurge(now, ""), now)
+	require.NoError(t, err)
+	require.Equal(t, "c", purges[0].GetId())
+
+	// only active purges left
+	_, err = addBacklogPurge(purges, newTestBacklogPurge(now, ""), now)
+	require.ErrorIs(t, err, errTooManyBacklogPurges)
+}
+
+func TestCancelBacklogPurge(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	purges := []*persistencespb.TaskQueueBacklogPurge{newTestBacklogPurge(now, "")}
+
+	require.NoError(t, cancelBacklogPurge(purges, "purge", now))
+	require.False(t, isBacklogPurgeActive(purges[0], now))
+
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, cancelBacklogPurge(purges, "other", now), &notFound)
+}
+
+func TestValidateBacklogPurge(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	var invalid *serviceerror.InvalidArgument
+
+	require.NoError(t, validateBacklogPurge(newTestBacklogPurge(now, ""), namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, now))
+	require.NoError(t, validateBacklogPurge(newTestBacklogPurge(now, "/_sys/other/3"), namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, now))
+
+	// workflow tasks can't be dropped
+	require.ErrorAs(t, validateBacklogPurge(newTestBacklogPurge(now, ""), namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, now), &invalid)
+	require.ErrorAs(t, validateBacklogPurge(newTestBacklogPurge(now, ""), namespaceID, enumspb.TASK_QUEUE_TYPE_NEXUS, now), &invalid)
+	require.ErrorAs(t, validateBacklogPurge(newTestBacklogPurge(now, "/_sys/other/x"), namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, now), &invalid)
+
+	expired := newTestBacklogPurge(now, "")
+	expired.ExpireTime = timestamppb.New(now)
+	require.ErrorAs(t, validateBacklogPurge(expired, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, now), &invalid)
+}
+
+func TestPurgeSpooledTask_NoMatch(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), ""))
+	task, res := b.newTask("good")
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.False(t, purged)
+	require.Nil(t, *res)
+}
+
+func TestPurgeSpooledTask_Expired(t *testing.T) {
+	t.Parallel()
+	purge := newTestBacklogPurge(time.Now(), "")
+	purge.ExpireTime = timestamppb.New(time.Now().Add(-time.Second))
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, purge)
+	task, _ := b.newTask("bad")
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.False(t, purged)
+}
+
+func TestPurgeSpooledTask_Move(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), "/_sys/other/2"))
+	task, res := b.newTask("bad")
+
+	b.matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddActivityTaskRequest, _ ...any) (*matchingservice.AddActivityTaskResponse, error) {
+			require.Equal(t, "/_sys/other/2", req.GetTaskQueue().GetName())
+			require.Equal(t, "wf", req.GetExecution().GetWorkflowId())
+			require.Equal(t, int64(5), req.GetScheduledEventId())
+			require.Equal(t, int32(2), req.GetStamp())
+			require.Equal(t, "bad", req.GetPriority().GetFairnessKey())
+			require.Nil(t, req.GetForwardInfo())
+			require.InDelta(t, time.Hour, req.GetScheduleToStartTimeout().AsDuration(), float64(time.Second))
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		})
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.NotNil(t, *res)
+	require.NoError(t, (*res).startErr)
+}
+
+func TestPurgeSpooledTask_MoveFailed(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_WORKFLOW, newTestBacklogPurge(time.Now(), "other"))
+	task, res := b.newTask("bad")
+
+	b.matchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewInvalidArgument("bad request"))
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	// the task readers must retry the task, not drop it
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+	require.False(t, purged)
+	require.Nil(t, *res)
+}
+
+func TestPurgeSpooledTask_DropReschedulesActivity(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), ""))
+	task, res := b.newTask("bad")
+
+	b.historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		CacheMutableState: &persistencespb.WorkflowMutableState{
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ActivityId: "act", ScheduledEventId: 5, Stamp: 2, StartedEventId: common.EmptyEventID},
+			},
+		},
+	}, nil)
+	b.historyClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.ResetActivityRequest, _ ...any) (*historyservice.ResetActivityResponse, error) {
+			require.Equal(t, namespaceID, req.GetNamespaceId())
+			require.Equal(t, "run", req.GetFrontendRequest().GetExecution().GetRunId())
+			require.Equal(t, "act", req.GetFrontendRequest().GetId())
+			require.True(t, req.GetFrontendRequest().GetKeepPaused())
+			return &historyservice.ResetActivityResponse{}, nil
+		})
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.NotNil(t, *res)
+}
+
+func TestPurgeSpooledTask_DropStaleTask(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), ""))
+	task, res := b.newTask("bad")
+
+	// the activity was rescheduled with a new stamp, so the task is stale and is dropped as is
+	b.historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ActivityId: "act", ScheduledEventId: 5, Stamp: 3, StartedEventId: common.EmptyEventID},
+			},
+		},
+	}, nil)
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.NotNil(t, *res)
+}
+
+func TestPurgeSpooledTask_DropWorkflowGone(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), ""))
+	task, res := b.newTask("bad")
+
+	b.historyClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.NotNil(t, *res)
+}
+
+func TestPurgeSpooledTask_InProgress(t *testing.T) {
+	t.Parallel()
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, newTestBacklogPurge(time.Now(), "other"))
+	task, res := b.newTask("bad")
+
+	// a scan is moving the task, the task reader has to retry it later
+	require.True(t, b.pm.backlogPurger.claim(purgedTaskKey{queue: b.queue.PersistenceName(), taskID: 42}))
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.ErrorIs(t, err, errBacklogPurgeInProgress)
+	require.False(t, purged)
+	require.Nil(t, *res)
+}
+
+func TestBacklogPurgeScan(t *testing.T) {
+	t.Parallel()
+	purge := newTestBacklogPurge(time.Now(), "other")
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, purge)
+	pq := b.newScannedQueue(t, b.newTaskInfo(41, "bad"), b.newTaskInfo(42, "good"), b.newTaskInfo(43, "bad"))
+
+	b.matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(&matchingservice.AddActivityTaskResponse{}, nil)
+	b.matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("busy"))
+	b.pm.backlogPurger.StartScans(pq)
+	b.pm.backlogPurger.StartScans(pq) // scanned only once
+	b.pm.goroGroup.Wait()
+
+	// the moved task is acked when it's read, without moving it again
+	task, res := newInternalTaskFromBacklog(b.newTaskInfo(41, "bad"), nil), new(*taskResponse)
+	task.event.completionFunc = func(_ *internalTask, r taskResponse) { *res = &r }
+	purged, err := b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.NotNil(t, *res)
+
+	// the task that failed to move is moved when it's read
+	b.matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(&matchingservice.AddActivityTaskResponse{}, nil)
+	task = newInternalTaskFromBacklog(b.newTaskInfo(43, "bad"), func(*internalTask, taskResponse) {})
+	purged, err = b.pm.backlogPurger.PurgeSpooledTask(context.Background(), task, b.queue)
+	require.NoError(t, err)
+	require.True(t, purged)
+	require.Empty(t, b.pm.backlogPurger.tasks)
+}
+
+func TestBacklogPurgeScan_ContinuesAfterExpiry(t *testing.T) {
+	t.Parallel()
+	purge := newTestBacklogPurge(time.Now(), "other")
+	b := newBacklogPurgeTest(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, purge)
+	pq := b.newScannedQueue(t, b.newTaskInfo(41, "bad"))
+
+	// the purge expires while the scan is running, the scan still moves all the tasks that match
+	purge.ExpireTime = timestamppb.New(time.Now().Add(-time.Second))
+	b.matchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(&matchingservice.AddActivityTaskResponse{}, nil)
+	b.pm.backlogPurger.scan(context.Background(), pq, purge, backlogPurgeScanKey{purgeID: purge.GetId(), queue: b.queue.PersistenceName()})
+	require.Len(t, b.pm.backlogPurger.tasks, 1)
+}
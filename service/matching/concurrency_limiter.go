@@ -0,0 +1,373 @@
+package matching
+
+import (
+	"sync"
+	"time"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+)
+
+type (
+	// concurrencyLimiter enforces a partition's share of the concurrency limit of its task queue.
+	// A task takes a slot when it's matched with a poller and gives it back when history reports
+	// that its attempt ended, when it can't be started, or when the slot's lease expires.
+	//
+	// Limits that are too small to split between the partitions are enforced by the root
+	// partition alone. The other partitions then don't dispatch tasks, they forward them (and
+	// their polls) to the root.
+	//
+	// Slots are only kept in memory and are not rebuilt when a partition is reloaded: tasks that
+	// are still running stop counting against the limit, so the limit is best-effort until they
+	// finish.
+	concurrencyLimiter struct {
+		mu sync.Mutex
+
+		// Dependencies
+		userDataManager userDataManager
+		config          *taskQueueConfig
+		partition       tqid.Partition
+		partitionID     int
+		timeSource      clock.TimeSource
+		metricsHandler  metrics.Handler
+
+		limit       int  // per-partition share of the task queue limit, or 0 for no limit
+		perKeyLimit int  // per-partition share of the fairness key limit, or 0 for no limit
+		forwardOnly bool // the limit is enforced by the root partition, don't dispatch here
+
+		slots         map[concurrencySlotKey]*concurrencySlot
+		inFlightByKey map[string]int
+		expiryTimer   resettableTimer
+
+		// listeners are called (without mu held) when slots become available
+		listeners      map[int]func()
+		nextListenerID int
+		stopped        bool
+	}
+
+	concurrencySlotKey struct {
+		runID            string
+		scheduledEventID int64
+	}
+
+	// concurrencySlot is held by an in-flight task.
+	concurrencySlot struct {
+		limiter     *concurrencyLimiter
+		key         concurrencySlotKey
+		fairnessKey string
+		attempt     int32 // zero until the task is started
+		expiry      time.Time
+	}
+)
+
+func newConcurrencyLimiter(
+	userDataManager userDataManager,
+	config *taskQueueConfig,
+	partition tqid.Partition,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+) *concurrencyLimiter {
+	partitionID := 0
+	if p, ok := partition.(*tqid.NormalPartition); ok {
+		partitionID = p.PartitionId()
+	}
+	return &concurrencyLimiter{
+		userDataManager: userDataManager,
+		config:          config,
+		partition:       partition,
+		partitionID:     partitionID,
+		timeSource:      timeSource,
+		metricsHandler:  metricsHandler,
+		slots:           make(map[concurrencySlotKey]*concurrencySlot),
+		inFlightByKey:   make(map[string]int),
+		listeners:       make(map[int]func()),
+	}
+}
+
+// UserDataChanged applies the concurrency limit from the latest user data.
+func (l *concurrencyLimiter) UserDataChanged() {
+	if l == nil {
+		return
+	}
+	userData, _, err := l.userDataManager.GetUserData()
+	if err != nil {
+		return
+	}
+	cfg := userData.GetData().GetPerType()[int32(l.partition.TaskType())].GetConcurrencyLimit()
+
+	maxInFlight, perKeyMaxInFlight := int(cfg.GetMaxInFlight()), int(cfg.GetFairnessKeyMaxInFlight())
+	numPartitions := max(l.config.NumReadPartitions(), 1)
+	var limit, perKeyLimit int
+	var forwardOnly bool
+	if (maxInFlight > 0 && maxInFlight < numPartitions) || (perKeyMaxInFlight > 0 && perKeyMaxInFlight < numPartitions) {
+		// Some partitions would get no share at all, so the root enforces the whole limit.
+		if l.partitionID == 0 {
+			limit, perKeyLimit = maxInFlight, perKeyMaxInFlight
+		} else {
+			forwardOnly = true
+		}
+	} else {
+		limit = partitionShare(maxInFlight, numPartitions, l.partitionID)
+		perKeyLimit = partitionShare(perKeyMaxInFlight, numPartitions, l.partitionID)
+	}
+
+	l.mu.Lock()
+	changed := limit != l.limit || perKeyLimit != l.perKeyLimit || forwardOnly != l.forwardOnly
+	l.limit, l.perKeyLimit, l.forwardOnly = limit, perKeyLimit, forwardOnly
+	l.mu.Unlock()
+
+	if changed {
+		l.notifyListeners()
+	}
+}
+
+// partitionShare splits limit between partitions so that the shares add up to limit. limit
+// must be at least numPartitions for every partition to get a share.
+func partitionShare(limit, numPartitions, partitionID int) int {
+	if limit <= 0 {
+		return 0
+	}
+	share := limit / numPartitions
+	if partitionID < limit%numPartitions {
+		share++
+	}
+	return share
+}
+
+// needsConcurrencySlot returns true if matching task with poller dispatches the task to a worker.
+func needsConcurrencySlot(task *internalTask, poller *waitingPoller) bool {
+	return task.event != nil && !task.isPollForwarder() &&
+		!poller.isTaskForwarder && !poller.isTaskValidator
+}
+
+// allows returns true if task can take a slot now.
+func (l *concurrencyLimiter) allows(task *internalTask) bool {
+	if l == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.forwardOnly {
+		return false
+	}
+	if l.limit > 0 && len(l.slots) >= l.limit {
+		return false
+	}
+	if l.perKeyLimit > 0 && l.inFlightByKey[task.getPriority().GetFairnessKey()] >= l.perKeyLimit {
+		return false
+	}
+	return true
+}
+
+// isForwardOnly returns true if the root partition enforces the limit, and this partition
+// forwards its tasks and polls instead of dispatching them.
+func (l *concurrencyLimiter) isForwardOnly() bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.forwardOnly
+}
+
+// acquire takes a slot for task. Returns nil if the task queue has no limit.
+func (l *concurrencyLimiter) acquire(task *internalTask) *concurrencySlot {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit == 0 && l.perKeyLimit == 0 {
+		return nil
+	}
+	slot := &concurrencySlot{
+		limiter: l,
+		key: concurrencySlotKey{
+			runID:            task.event.Data.GetRunId(),
+			scheduledEventID: task.event.Data.GetScheduledEventId(),
+		},
+		fairnessKey: task.getPriority().GetFairnessKey(),
+		expiry:      l.timeSource.Now().Add(l.config.ConcurrencySlotLease()),
+	}
+	// A new attempt of the same activity can be dispatched before history has released the
+	// slot of the previous one. It takes over that slot.
+	if old, ok := l.slots[slot.key]; ok {
+		l.removeLocked(old)
+	}
+	l.slots[slot.key] = slot
+	l.inFlightByKey[slot.fairnessKey]++
+	l.updateLocked()
+	return slot
+}
+
+// release gives back the slot if the task wasn't started.
+func (s *concurrencySlot) release() {
+	l := s.limiter
+	l.mu.Lock()
+	released := l.slots[s.key] == s
+	if released {
+		l.removeLocked(s)
+		l.updateLocked()
+	}
+	l.mu.Unlock()
+
+	if released {
+		l.notifyListeners()
+	}
+}
+
+// partitionProto returns the partition that holds the slot, for history to release it.
+func (s *concurrencySlot) partitionProto() *taskqueuespb.TaskQueuePartition {
+	l := s.limiter
+	return &taskqueuespb.TaskQueuePartition{
+		TaskQueue:     l.partition.TaskQueue().Name(),
+		TaskQueueType: l.partition.TaskType(),
+		PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(l.partitionID)},
+	}
+}
+
+// confirm records that the task was started as the given attempt. The slot is held until
+// history releases it, or until the start-to-close timeout (or the default lease, if there's
+// no timeout) passes.
+func (s *concurrencySlot) confirm(attempt int32, startToCloseTimeout time.Duration) {
+	l := s.limiter
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.slots[s.key] != s {
+		return
+	}
+	if startToCloseTimeout <= 0 {
+		startToCloseTimeout = l.config.ConcurrencySlotLease()
+	}
+	s.attempt = attempt
+	s.expiry = l.timeSource.Now().Add(startToCloseTimeout)
+	l.updateLocked()
+}
+
+// releaseAttempt releases the slot held by the given activity attempt, if there is one. Slots
+// of tasks that haven't been started yet are left alone, since they belong to a later attempt.
+func (l *concurrencyLimiter) releaseAttempt(runID string, scheduledEventID int64, attempt int32) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	slot, ok := l.slots[concurrencySlotKey{runID: runID, scheduledEventID: scheduledEventID}]
+	released := ok && slot.attempt != 0 && slot.attempt == attempt
+	if released {
+		l.removeLocked(slot)
+		l.updateLocked()
+	}
+	l.mu.Unlock()
+
+	if released {
+		l.notifyListeners()
+	}
+	return released
+}
+
+// inFlight returns the number of slots in use.
+func (l *concurrencyLimiter) inFlight() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.slots)
+}
+
+// addListener registers f to be called when slots become available. Returns a function that
+// removes it.
+func (l *concurrencyLimiter) addListener(f func()) func() {
+	if l == nil {
+		return func() {}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	id := l.nextListenerID
+	l.nextListenerID++
+	l.listeners[id] = f
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.listeners, id)
+	}
+}
+
+func (l *concurrencyLimiter) Stop() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopped = true
+	l.expiryTimer.unset()
+	clear(l.listeners)
+}
+
+func (l *concurrencyLimiter) expireSlots() {
+	l.mu.Lock()
+	now := l.timeSource.Now()
+	var expired bool
+	for _, slot := range l.slots {
+		if !slot.expiry.After(now) {
+			l.removeLocked(slot)
+			expired = true
+		}
+	}
+	l.updateLocked()
+	l.mu.Unlock()
+
+	if expired {
+		l.notifyListeners()
+	}
+}
+
+// call with mu held
+func (l *concurrencyLimiter) removeLocked(slot *concurrencySlot) {
+	delete(l.slots, slot.key)
+	if l.inFlightByKey[slot.fairnessKey] <= 1 {
+		delete(l.inFlightByKey, slot.fairnessKey)
+	} else {
+		l.inFlightByKey[slot.fairnessKey]--
+	}
+}
+
+// updateLocked reports the number of slots in use and arranges for the next expiry.
+// call with mu held
+func (l *concurrencyLimiter) updateLocked() {
+	metrics.ConcurrencySlotsInUseGauge.With(l.metricsHandler).Record(float64(len(l.slots)))
+
+	if l.stopped || len(l.slots) == 0 {
+		l.expiryTimer.unset()
+		return
+	}
+	var next time.Time
+	for _, slot := range l.slots {
+		if next.IsZero() || slot.expiry.Before(next) {
+			next = slot.expiry
+		}
+	}
+	// make sure the timer fires even if the earliest slot expires right now
+	delay := max(next.Sub(l.timeSource.Now()), time.Millisecond)
+	l.expiryTimer.set(l.timeSource, l.expireSlots, delay)
+}
+
+func (l *concurrencyLimiter) notifyListeners() {
+	l.mu.Lock()
+	listeners := make([]func(), 0, len(l.listeners))
+	for _, f := range l.listeners {
+		listeners = append(listeners, f)
+	}
+	l.mu.Unlock()
+
+	for _, f := range listeners {
+		f()
+	}
+}
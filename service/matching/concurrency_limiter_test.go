@@ -0,0 +1,198 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+)
+
+func newTestConcurrencyLimiter(t *testing.T, maxInFlight, perKeyMaxInFlight int32) (*concurrencyLimiter, *clock.EventTimeSource) {
+	f, err := tqid.NewTaskQueueFamily(namespaceID, "tq")
+	require.NoError(t, err)
+	partition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition()
+
+	config := &taskQueueConfig{
+		NumReadPartitions:    func() int { return 1 },
+		ConcurrencySlotLease: func() time.Duration { return time.Minute },
+	}
+	userDataMgr := &mockUserDataManager{
+		data: &persistencespb.VersionedTaskQueueUserData{
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+						ConcurrencyLimit: &persistencespb.TaskQueueConcurrencyLimit{
+							MaxInFlight:            maxInFlight,
+							FairnessKeyMaxInFlight: perKeyMaxInFlight,
+						},
+					},
+				},
+			},
+		},
+	}
+	ts := clock.NewEventTimeSource().Update(time.Now())
+	ts.UseAsyncTimers(true)
+	l := newConcurrencyLimiter(userDataMgr, config, partition, ts, metrics.NoopMetricsHandler)
+	l.UserDataChanged()
+	t.Cleanup(l.Stop)
+	return l, ts
+}
+
+func newConcurrencyTestTask(runID string, fairnessKey string) *internalTask {
+	return newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+		Data: &persistencespb.TaskInfo{
+			RunId:            runID,
+			ScheduledEventId: 5,
+			Priority:         &commonpb.Priority{FairnessKey: fairnessKey},
+		},
+	}, nil)
+}
+
+func TestPartitionShare(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, 0, partitionShare(0, 4, 0))
+	require.Equal(t, 3, partitionShare(10, 4, 0))
+	require.Equal(t, 3, partitionShare(10, 4, 1))
+	require.Equal(t, 2, partitionShare(10, 4, 2))
+	require.Equal(t, 2, partitionShare(10, 4, 3))
+	require.Equal(t, 1, partitionShare(4, 4, 3))
+}
+
+func TestConcurrencyLimiter_SmallLimitEnforcedByRoot(t *testing.T) {
+	t.Parallel()
+	f, err := tqid.NewTaskQueueFamily(namespaceID, "tq")
+	require.NoError(t, err)
+	config := &taskQueueConfig{
+		NumReadPartitions:    func() int { return 4 },
+		ConcurrencySlotLease: func() time.Duration { return time.Minute },
+	}
+	userDataMgr := &mockUserDataManager{
+		data: &persistencespb.VersionedTaskQueueUserData{
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+						ConcurrencyLimit: &persistencespb.TaskQueueConcurrencyLimit{MaxInFlight: 2},
+					},
+				},
+			},
+		},
+	}
+	newLimiter := func(partitionID int) *concurrencyLimiter {
+		partition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).NormalPartition(partitionID)
+		l := newConcurrencyLimiter(userDataMgr, config, partition, clock.NewRealTimeSource(), metrics.NoopMetricsHandler)
+		l.UserDataChanged()
+		t.Cleanup(l.Stop)
+		return l
+	}
+
+	// the root takes the whole limit
+	root := newLimiter(0)
+	require.False(t, root.isForwardOnly())
+	require.NotNil(t, root.acquire(newConcurrencyTestTask("run1", "")))
+	require.NotNil(t, root.acquire(newConcurrencyTestTask("run2", "")))
+	require.False(t, root.allows(newConcurrencyTestTask("run3", "")))
+
+	// other partitions only forward
+	child := newLimiter(3)
+	require.True(t, child.isForwardOnly())
+	require.False(t, child.allows(newConcurrencyTestTask("run4", "")))
+
+	// once the limit is large enough, it's split again
+	userDataMgr.data.Data.PerType[int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY)].ConcurrencyLimit.MaxInFlight = 4
+	child.UserDataChanged()
+	require.False(t, child.isForwardOnly())
+	require.True(t, child.allows(newConcurrencyTestTask("run4", "")))
+}
+
+func TestConcurrencyLimiter_NoLimit(t *testing.T) {
+	t.Parallel()
+	l, _ := newTestConcurrencyLimiter(t, 0, 0)
+	task := newConcurrencyTestTask("run1", "")
+	require.True(t, l.allows(task))
+	require.Nil(t, l.acquire(task))
+	require.Equal(t, 0, l.inFlight())
+
+	var nilLimiter *concurrencyLimiter
+	require.True(t, nilLimiter.allows(task))
+	require.Nil(t, nilLimiter.acquire(task))
+}
+
+func TestConcurrencyLimiter_AcquireAndRelease(t *testing.T) {
+	t.Parallel()
+	l, _ := newTestConcurrencyLimiter(t, 2, 0)
+	var notified int
+	l.addListener(func() { notified++ })
+
+	slot1 := l.acquire(newConcurrencyTestTask("run1", ""))
+	require.NotNil(t, slot1)
+	slot2 := l.acquire(newConcurrencyTestTask("run2", ""))
+	require.NotNil(t, slot2)
+	require.False(t, l.allows(newConcurrencyTestTask("run3", "")))
+
+	// a task that couldn't be started gives its slot back
+	slot1.release()
+	require.Equal(t, 1, notified)
+	require.True(t, l.allows(newConcurrencyTestTask("run3", "")))
+
+	// a started task keeps its slot until history releases its attempt
+	slot2.confirm(1, time.Hour)
+	require.False(t, l.releaseAttempt("run2", 5, 2))
+	require.Equal(t, 1, l.inFlight())
+	require.True(t, l.releaseAttempt("run2", 5, 1))
+	require.Equal(t, 0, l.inFlight())
+	require.Equal(t, 2, notified)
+}
+
+func TestConcurrencyLimiter_RetryTakesOverSlot(t *testing.T) {
+	t.Parallel()
+	l, _ := newTestConcurrencyLimiter(t, 1, 0)
+
+	slot := l.acquire(newConcurrencyTestTask("run1", ""))
+	slot.confirm(1, time.Hour)
+
+	// the retry is dispatched before history releases the first attempt
+	retry := l.acquire(newConcurrencyTestTask("run1", ""))
+	require.Equal(t, 1, l.inFlight())
+
+	// the late release of the first attempt doesn't free the retry's slot
+	require.False(t, l.releaseAttempt("run1", 5, 1))
+	slot.release()
+	require.Equal(t, 1, l.inFlight())
+
+	retry.confirm(2, time.Hour)
+	require.True(t, l.releaseAttempt("run1", 5, 2))
+	require.Equal(t, 0, l.inFlight())
+}
+
+func TestConcurrencyLimiter_PerKeyLimit(t *testing.T) {
+	t.Parallel()
+	l, _ := newTestConcurrencyLimiter(t, 0, 1)
+
+	require.NotNil(t, l.acquire(newConcurrencyTestTask("run1", "a")))
+	require.False(t, l.allows(newConcurrencyTestTask("run2", "a")))
+	require.True(t, l.allows(newConcurrencyTestTask("run2", "b")))
+}
+
+func TestConcurrencyLimiter_Expiry(t *testing.T) {
+	t.Parallel()
+	l, ts := newTestConcurrencyLimiter(t, 1, 0)
+
+	// unconfirmed slots expire after the lease
+	l.acquire(newConcurrencyTestTask("run1", ""))
+	ts.Advance(time.Minute)
+	require.Eventually(t, func() bool { return l.inFlight() == 0 }, time.Second, time.Millisecond)
+
+	// confirmed slots expire after the start-to-close timeout
+	slot := l.acquire(newConcurrencyTestTask("run2", ""))
+	slot.confirm(1, 10*time.Minute)
+	ts.Advance(5 * time.Minute)
+	require.Equal(t, 1, l.inFlight())
+	ts.Advance(5 * time.Minute)
+	require.Eventually(t, func() bool { return l.inFlight() == 0 }, time.Second, time.Millisecond)
+}
@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/matching/counter"
@@ -52,6 +53,14 @@ type (
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueFilter
 		NumTaskqueueReadPartitionsSub            dynamicconfig.TypedSubscribableWithTaskQueueFilter[int]
+		EnablePartitionAutoScaling               dynamicconfig.BoolPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingInterval             dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMinPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMaxPartitions        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingTargetRate           dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingBacklogPerPartition  dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingMaxForwardedRatio    dynamicconfig.FloatPropertyFnWithTaskQueueFilter
+		PartitionAutoScalingScaleDownDelay       dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByTaskQueue              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByPartition              dynamicconfig.BoolPropertyFnWithTaskQueueFilter
 		BreakdownMetricsByBuildID                dynamicconfig.BoolPropertyFnWithTaskQueueFilter
@@ -87,6 +96,13 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		PriorityAgingInterval                    dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		MaxLabelSetSubqueues                     dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		ConcurrencySlotLease                     dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		BacklogLimitCount                        dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		BacklogLimitAge                          dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		AffinityFallbackTimeout                  dynamicconfig.DurationPropertyFnWithTaskQueueFilter
+		SharedTaskQueues                         dynamicconfig.TypedPropertyFn[[]sharedtaskqueue.Binding]
 
 		RateLimiterRefreshInterval    time.Duration
 		FairnessKeyRateLimitCacheSize dynamicconfig.IntPropertyFnWithTaskQueueFilter
@@ -166,6 +182,12 @@ type (
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             priorityKey
 		DefaultPriorityKey         priorityKey
+		PriorityAgingInterval      func() time.Duration
+		MaxLabelSetSubqueues       func() int
+		ConcurrencySlotLease       func() time.Duration
+		BacklogLimitCount          func() int
+		BacklogLimitAge            func() time.Duration
+		AffinityFallbackTimeout    func() time.Duration
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -180,6 +202,16 @@ type (
 		NumReadPartitions               func() int
 		NumReadPartitionsSub            func(func(int)) (int, func())
 
+		// Partition auto-scaling
+		EnablePartitionAutoScaling              func() bool
+		PartitionAutoScalingInterval            func() time.Duration
+		PartitionAutoScalingMinPartitions       func() int
+		PartitionAutoScalingMaxPartitions       func() int
+		PartitionAutoScalingTargetRate          func() float64
+		PartitionAutoScalingBacklogPerPartition func() int
+		PartitionAutoScalingMaxForwardedRatio   func() float64
+		PartitionAutoScalingScaleDownDelay      func() time.Duration
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		AdminNamespaceToPartitionRateSub      func(func(float64)) (float64, func())
@@ -286,6 +318,14 @@ func NewConfig(
 		NumTaskqueueWritePartitions:              dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(dc),
 		NumTaskqueueReadPartitions:               dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(dc),
 		NumTaskqueueReadPartitionsSub:            dynamicconfig.MatchingNumTaskqueueReadPartitions.Subscribe(dc),
+		EnablePartitionAutoScaling:               dynamicconfig.MatchingEnablePartitionAutoScaling.Get(dc),
+		PartitionAutoScalingInterval:             dynamicconfig.MatchingPartitionAutoScalingInterval.Get(dc),
+		PartitionAutoScalingMinPartitions:        dynamicconfig.MatchingPartitionAutoScalingMinPartitions.Get(dc),
+		PartitionAutoScalingMaxPartitions:        dynamicconfig.MatchingPartitionAutoScalingMaxPartitions.Get(dc),
+		PartitionAutoScalingTargetRate:           dynamicconfig.MatchingPartitionAutoScalingTargetRatePerPartition.Get(dc),
+		PartitionAutoScalingBacklogPerPartition:  dynamicconfig.MatchingPartitionAutoScalingBacklogPerPartition.Get(dc),
+		PartitionAutoScalingMaxForwardedRatio:    dynamicconfig.MatchingPartitionAutoScalingMaxForwardedRatio.Get(dc),
+		PartitionAutoScalingScaleDownDelay:       dynamicconfig.MatchingPartitionAutoScalingScaleDownDelay.Get(dc),
 		BreakdownMetricsByTaskQueue:              dynamicconfig.MetricsBreakdownByTaskQueue.Get(dc),
 		BreakdownMetricsByPartition:              dynamicconfig.MetricsBreakdownByPartition.Get(dc),
 		BreakdownMetricsByBuildID:                dynamicconfig.MetricsBreakdownByBuildID.Get(dc),
@@ -323,6 +363,13 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		PriorityAgingInterval:                    dynamicconfig.MatchingPriorityAgingInterval.Get(dc),
+		MaxLabelSetSubqueues:                     dynamicconfig.MatchingMaxLabelSetSubqueues.Get(dc),
+		ConcurrencySlotLease:                     dynamicconfig.MatchingConcurrencySlotLease.Get(dc),
+		BacklogLimitCount:                        dynamicconfig.MatchingBacklogLimitCount.Get(dc),
+		BacklogLimitAge:                          dynamicconfig.MatchingBacklogLimitAge.Get(dc),
+		AffinityFallbackTimeout:                  dynamicconfig.MatchingActivityAffinityFallbackTimeout.Get(dc),
+		SharedTaskQueues:                         sharedtaskqueue.Bindings.Get(dc),
 		RateLimiterRefreshInterval:               time.Minute,
 		FairnessKeyRateLimitCacheSize:            dynamicconfig.MatchingFairnessKeyRateLimitCacheSize.Get(dc),
 		MaxFairnessKeyWeightOverrides:            dynamicconfig.MatchingMaxFairnessKeyWeightOverrides.Get(dc),
@@ -420,6 +467,24 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		TaskDeleteInterval: func() time.Duration {
 			return config.TaskDeleteInterval(ns.String(), taskQueueName, taskType)
 		},
+		PriorityAgingInterval: func() time.Duration {
+			return config.PriorityAgingInterval(ns.String(), taskQueueName, taskType)
+		},
+		MaxLabelSetSubqueues: func() int {
+			return config.MaxLabelSetSubqueues(ns.String(), taskQueueName, taskType)
+		},
+		ConcurrencySlotLease: func() time.Duration {
+			return config.ConcurrencySlotLease(ns.String(), taskQueueName, taskType)
+		},
+		BacklogLimitCount: func() int {
+			return config.BacklogLimitCount(ns.String(), taskQueueName, taskType)
+		},
+		BacklogLimitAge: func() time.Duration {
+			return config.BacklogLimitAge(ns.String(), taskQueueName, taskType)
+		},
+		AffinityFallbackTimeout: func() time.Duration {
+			return config.AffinityFallbackTimeout(ns.String(), taskQueueName, taskType)
+		},
 		PriorityLevels:             priorityLevels,
 		DefaultPriorityKey:         defaultPriorityKey,
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
@@ -442,6 +507,30 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitionsSub: func(cb func(int)) (int, func()) {
 			return config.NumTaskqueueReadPartitionsSub(ns.String(), taskQueueName, taskType, cb)
 		},
+		EnablePartitionAutoScaling: func() bool {
+			return config.EnablePartitionAutoScaling(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingInterval: func() time.Duration {
+			return config.PartitionAutoScalingInterval(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingMinPartitions: func() int {
+			return max(1, config.PartitionAutoScalingMinPartitions(ns.String(), taskQueueName, taskType))
+		},
+		PartitionAutoScalingMaxPartitions: func() int {
+			return max(1, config.PartitionAutoScalingMaxPartitions(ns.String(), taskQueueName, taskType))
+		},
+		PartitionAutoScalingTargetRate: func() float64 {
+			return config.PartitionAutoScalingTargetRate(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingBacklogPerPartition: func() int {
+			return config.PartitionAutoScalingBacklogPerPartition(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingMaxForwardedRatio: func() float64 {
+			return config.PartitionAutoScalingMaxForwardedRatio(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalingScaleDownDelay: func() time.Duration {
+			return config.PartitionAutoScalingScaleDownDelay(ns.String(), taskQueueName, taskType)
+		},
 		BreakdownMetricsByTaskQueue: func() bool {
 			return config.BreakdownMetricsByTaskQueue(ns.String(), taskQueueName, taskType)
 		},
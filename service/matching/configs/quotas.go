@@ -51,7 +51,10 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig":                  1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker":                         1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessState":                    1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePartitionCounts":         1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ReleaseConcurrencySlot":                 1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/CheckTaskQueueVersionMembership":        1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/GetTaskQueueStats":                      1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
@@ -620,6 +620,50 @@ func (db *taskQueueDB) GetFairTasks(
 	})
 }
 
+// ScanTasks calls visit with the tasks above the ack level of every subqueue, one subqueue after
+// the other, until visit returns false. Tasks written while the scan is running may be skipped.
+func (db *taskQueueDB) ScanTasks(
+	ctx context.Context,
+	fair bool,
+	batchSize int,
+	visit func(*persistencespb.AllocatedTaskInfo) bool,
+) error {
+	db.Lock()
+	subqueues := db.cloneSubqueues()
+	maxReadLevels := make([]int64, len(db.subqueues))
+	for i, s := range db.subqueues {
+		maxReadLevels[i] = s.maxReadLevel
+	}
+	db.Unlock()
+
+	for i := range subqueues {
+		subqueue := subqueueIndex(i)
+		ackLevel, fairAckLevel := subqueues[i].AckLevel, fairLevelFromProto(subqueues[i].FairAckLevel)
+		for {
+			var res *persistence.GetTasksResponse
+			var err error
+			if fair {
+				res, err = db.GetFairTasks(ctx, subqueue, fairAckLevel.max(fairLevel{pass: 1, id: 0}).inc(), batchSize)
+			} else {
+				res, err = db.GetTasks(ctx, subqueue, ackLevel+1, maxReadLevels[i]+1, batchSize)
+			}
+			if err != nil {
+				return err
+			}
+			for _, task := range res.Tasks {
+				if !visit(task) {
+					return nil
+				}
+				ackLevel, fairAckLevel = task.GetTaskId(), fairLevelFromAllocatedTask(task)
+			}
+			if len(res.Tasks) < batchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
 // CompleteTasksLessThan deletes of tasks less than the given taskID. Limit is
 // the upper bound of number of tasks that can be deleted by this method. It may
 // or may not be honored
@@ -746,9 +790,11 @@ func (db *taskQueueDB) emitBacklogGaugesLocked() {
 	var totalLag int64
 	var oldestTime time.Time
 	counts := make(map[int32]int64)
+	oldestTimes := make(map[int32]time.Time)
 	for _, s := range db.subqueues {
 		counts[s.Key.Priority] += s.ApproximateBacklogCount
 		oldestTime = minNonZeroTime(oldestTime, s.oldestTime)
+		oldestTimes[s.Key.Priority] = minNonZeroTime(oldestTimes[s.Key.Priority], s.oldestTime)
 		// note: this metric is only an estimation for the lag.
 		// taskID in DB may not be continuous, especially when task list ownership changes.
 		if s.FairMaxReadLevel != nil && s.FairAckLevel != nil {
@@ -763,6 +809,13 @@ func (db *taskQueueDB) emitBacklogGaugesLocked() {
 	for priority, count := range counts {
 		metrics.ApproximateBacklogCount.With(db.metricsHandler).Record(float64(count), metrics.MatchingTaskPriorityTag(priority))
 	}
+	for priority, t := range oldestTimes {
+		var age time.Duration
+		if !t.IsZero() {
+			age = time.Since(t)
+		}
+		metrics.ApproximateBacklogAgePerPriority.With(db.metricsHandler).Record(age.Seconds(), metrics.MatchingTaskPriorityTag(priority))
+	}
 	if oldestTime.IsZero() {
 		metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(0)
 	} else {
@@ -839,6 +892,7 @@ func (db *taskQueueDB) emitZeroBacklogGauges() {
 
 	for k := range priorities {
 		metrics.ApproximateBacklogCount.With(db.metricsHandler).Record(0, metrics.MatchingTaskPriorityTag(k))
+		metrics.ApproximateBacklogAgePerPriority.With(db.metricsHandler).Record(0, metrics.MatchingTaskPriorityTag(k))
 	}
 	metrics.ApproximateBacklogAgeSeconds.With(db.metricsHandler).Record(0)
 	metrics.TaskLagPerTaskQueueGauge.With(db.metricsHandler).Record(0)
@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/softassert"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/service/matching/counter"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -35,8 +36,9 @@ type (
 
 		subqueueLock        sync.Mutex
 		subqueues           []*fairTaskReader // subqueue index -> fairTaskReader
-		subqueuesByPriority map[priorityKey]subqueueIndex
+		subqueuesByKey      map[subqueueKey]subqueueIndex
 		priorityBySubqueue  map[subqueueIndex]priorityKey
+		labelSetsBySubqueue map[subqueueIndex]string
 
 		logger           log.Logger
 		throttledLogger  log.ThrottledLogger
@@ -73,8 +75,9 @@ func newFairBacklogManager(
 		tqCtx:               tqCtx,
 		isDraining:          isDraining,
 		db:                  newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler, isDraining),
-		subqueuesByPriority: make(map[priorityKey]subqueueIndex),
+		subqueuesByKey:      make(map[subqueueKey]subqueueIndex),
 		priorityBySubqueue:  make(map[subqueueIndex]priorityKey),
+		labelSetsBySubqueue: make(map[subqueueIndex]string),
 		matchingClient:      matchingClient,
 		metricsHandler:      metricsHandler,
 		logger:              logger,
@@ -166,26 +169,42 @@ func (c *fairBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.
 			r.Start()
 			c.subqueues = append(c.subqueues, r)
 		}
-		c.subqueuesByPriority[priorityKey(subqueues[i].Key.Priority)] = subqueueIdx
-		c.priorityBySubqueue[subqueueIdx] = priorityKey(subqueues[i].Key.Priority)
+		key := subqueueKeyFromPersistence(subqueues[i].Key)
+		c.subqueuesByKey[key] = subqueueIdx
+		c.priorityBySubqueue[subqueueIdx] = key.priority
+		c.labelSetsBySubqueue[subqueueIdx] = key.labels
 	}
 }
 
-func (c *fairBacklogManagerImpl) getSubqueueForPriority(priority priorityKey) subqueueIndex {
-	priority = c.config.clipPriority(priority)
+func (c *fairBacklogManagerImpl) getSubqueue(priority priorityKey, requiredLabels []string) subqueueIndex {
+	key := subqueueKey{
+		priority: c.config.clipPriority(priority),
+		labels:   tasklabels.Key(requiredLabels),
+	}
 
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
 
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
+	if key.labels != "" && countLabeledSubqueues(c.labelSetsBySubqueue) >= c.config.MaxLabelSetSubqueues() {
+		// Too many distinct label sets: share the subqueue without labels. Tasks in it still
+		// only match pollers that have their labels.
+		key.labels = ""
+		requiredLabels = nil
+		if i, ok := c.subqueuesByKey[key]; ok {
+			return i
+		}
+	}
+
 	// We need to allocate a new subqueue. Note this is doing io under subqueueLock,
 	// but we want to serialize these updates.
 	// TODO(pri): maybe we can improve that
 	subqueues, err := c.db.AllocateSubqueue(c.tqCtx, &persistencespb.SubqueueKey{
-		Priority: int32(priority),
+		Priority:       int32(key.priority),
+		RequiredLabels: requiredLabels,
 	})
 	if err != nil {
 		c.signalIfFatal(err)
@@ -196,9 +215,9 @@ func (c *fairBacklogManagerImpl) getSubqueueForPriority(priority priorityKey) su
 
 	c.loadSubqueuesLocked(subqueues)
 
-	// After AllocateSubqueue added a subqueue for this priority, and we merged the result into
+	// After AllocateSubqueue added a subqueue for this key, and we merged the result into
 	// our state with loadSubqueuesLocked, this lookup should now find a subqueue.
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -226,7 +245,7 @@ func (c *fairBacklogManagerImpl) periodicSync() {
 }
 
 func (c *fairBacklogManagerImpl) SpoolTask(taskInfo *persistencespb.TaskInfo) error {
-	subqueue := c.getSubqueueForPriority(priorityKey(taskInfo.Priority.GetPriorityKey()))
+	subqueue := c.getSubqueue(priorityKey(taskInfo.Priority.GetPriorityKey()), taskInfo.RequiredLabels)
 	err := c.taskWriter.appendTask(subqueue, taskInfo)
 	c.signalIfFatal(err)
 	return err
@@ -305,6 +324,36 @@ func (c *fairBacklogManagerImpl) BacklogStatsByPriority() map[int32]*taskqueuepb
 	return result
 }
 
+func (c *fairBacklogManagerImpl) BacklogStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats {
+	c.subqueueLock.Lock()
+	defer c.subqueueLock.Unlock()
+
+	result := make(map[string]*taskqueuepb.TaskQueueStats)
+	backlogCounts := c.db.getApproximateBacklogCountsBySubqueue()
+	for subqueueIdx, labels := range c.labelSetsBySubqueue {
+		if labels == "" {
+			continue
+		}
+
+		// There is one subqueue per priority for the same label set.
+		stats := result[labels]
+		if stats == nil {
+			stats = &taskqueuepb.TaskQueueStats{ApproximateBacklogAge: durationpb.New(0)}
+			result[labels] = stats
+		}
+		stats.ApproximateBacklogCount += backlogCounts[subqueueIdx]
+
+		oldestBacklogTime := c.subqueues[subqueueIdx].getOldestBacklogTime()
+		if !oldestBacklogTime.IsZero() {
+			oldestBacklogAge := time.Since(oldestBacklogTime)
+			if oldestBacklogAge > stats.ApproximateBacklogAge.AsDuration() {
+				stats.ApproximateBacklogAge = durationpb.New(oldestBacklogAge)
+			}
+		}
+	}
+	return result
+}
+
 func (c *fairBacklogManagerImpl) BacklogStatus() *taskqueuepb.TaskQueueStatus {
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
@@ -132,13 +132,15 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
+				RequiredLabels:         task.event.Data.GetRequiredLabels(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = fwdr.client.AddActivityTask(
 			ctx, &matchingservice.AddActivityTaskRequest{
-				NamespaceId: task.event.Data.GetNamespaceId(),
-				Execution:   task.workflowExecution(),
+				NamespaceId:       fwdr.partition.NamespaceId(),
+				SourceNamespaceId: sharedTaskQueueSourceNamespaceID(fwdr.partition, task),
+				Execution:         task.workflowExecution(),
 				TaskQueue: &taskqueuepb.TaskQueue{
 					Name: target.RpcName(),
 					Kind: fwdr.partition.Kind(),
@@ -151,6 +153,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
 				ComponentRef:           task.event.Data.GetComponentRef(),
+				RequiredLabels:         task.event.Data.GetRequiredLabels(),
+				AffinityKey:            task.event.Data.GetAffinityKey(),
 			},
 		)
 	default:
@@ -157,7 +157,9 @@ func (t *ForwarderTestSuite) TestForwardActivityTask() {
 	t.NotNil(request)
 	t.Equal(mustParent(t.partition, 20).RpcName(), request.TaskQueue.GetName())
 	t.Equal(t.partition.Kind(), request.TaskQueue.GetKind())
-	t.Equal(taskInfo.Data.GetNamespaceId(), request.GetNamespaceId())
+	// The task is of another namespace than the partition, as tasks of shared task queues are.
+	t.Equal(t.partition.NamespaceId(), request.GetNamespaceId())
+	t.Equal(taskInfo.Data.GetNamespaceId(), request.GetSourceNamespaceId())
 	t.Equal(taskInfo.Data.GetWorkflowId(), request.GetExecution().GetWorkflowId())
 	t.Equal(taskInfo.Data.GetRunId(), request.GetExecution().GetRunId())
 	t.Equal(taskInfo.Data.GetScheduledEventId(), request.GetScheduledEventId())
@@ -185,7 +187,9 @@ func (t *ForwarderTestSuite) TestForwardActivityTask_WithBuildId() {
 	t.NotNil(request)
 	t.Equal(mustParent(t.partition, 20).RpcName(), request.TaskQueue.GetName())
 	t.Equal(t.partition.Kind(), request.TaskQueue.GetKind())
-	t.Equal(taskInfo.Data.GetNamespaceId(), request.GetNamespaceId())
+	// The task is of another namespace than the partition, as tasks of shared task queues are.
+	t.Equal(t.partition.NamespaceId(), request.GetNamespaceId())
+	t.Equal(taskInfo.Data.GetNamespaceId(), request.GetSourceNamespaceId())
 	t.Equal(taskInfo.Data.GetWorkflowId(), request.GetExecution().GetWorkflowId())
 	t.Equal(taskInfo.Data.GetRunId(), request.GetExecution().GetRunId())
 	t.Equal(taskInfo.Data.GetScheduledEventId(), request.GetScheduledEventId())
@@ -10,6 +10,7 @@ import (
 	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -173,7 +174,10 @@ func (h *Handler) AddActivityTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddActivityTaskResponse{AssignedBuildId: assignedBuildId}, err
+	return &matchingservice.AddActivityTaskResponse{
+		AssignedBuildId: assignedBuildId,
+		PartitionCounts: h.partitionCountsHint(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetForwardInfo() != nil),
+	}, err
 }
 
 // AddWorkflowTask - adds a workflow task.
@@ -198,7 +202,10 @@ func (h *Handler) AddWorkflowTask(
 	if syncMatch {
 		metrics.SyncMatchLatencyPerTaskQueue.With(opMetrics).Record(time.Since(startT))
 	}
-	return &matchingservice.AddWorkflowTaskResponse{AssignedBuildId: assignedBuildId}, err
+	return &matchingservice.AddWorkflowTaskResponse{
+		AssignedBuildId: assignedBuildId,
+		PartitionCounts: h.partitionCountsHint(ctx, request.GetNamespaceId(), request.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardInfo() != nil),
+	}, err
 }
 
 // PollActivityTaskQueue - long poll for an activity task.
@@ -226,7 +233,18 @@ func (h *Handler) PollActivityTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollActivityTaskQueue(ctx, request, opMetrics)
+	if err == nil {
+		hint := h.partitionCountsHint(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.GetForwardedSource() != "")
+		if hint != nil {
+			if resp == emptyPollActivityTaskQueueResponse {
+				// don't modify the shared empty response
+				resp = &matchingservice.PollActivityTaskQueueResponse{}
+			}
+			resp.PartitionCounts = hint
+		}
+	}
+	return resp, err
 }
 
 // PollWorkflowTaskQueue - long poll for a workflow task.
@@ -254,7 +272,33 @@ func (h *Handler) PollWorkflowTaskQueue(
 		return nil, err
 	}
 
-	return h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	resp, err := h.engine.PollWorkflowTaskQueue(ctx, request, opMetrics)
+	if err == nil {
+		hint := h.partitionCountsHint(ctx, request.GetNamespaceId(), request.GetPollRequest().GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.GetForwardedSource() != "")
+		if hint != nil {
+			if resp == emptyPollWorkflowTaskQueueResponse {
+				// don't modify the shared empty response
+				resp = &matchingservice.PollWorkflowTaskQueueResponseWithRawHistory{}
+			}
+			resp.PartitionCounts = hint
+		}
+	}
+	return resp, err
+}
+
+// partitionCountsHint returns the auto-scaled partition counts that matching clients use to pick
+// partitions for subsequent calls. Forwarded calls come from other partitions and get no hint.
+func (h *Handler) partitionCountsHint(
+	ctx context.Context,
+	namespaceID string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+	forwarded bool,
+) *persistencespb.TaskQueuePartitionCounts {
+	if forwarded {
+		return nil
+	}
+	return h.engine.PartitionCountsHint(ctx, namespaceID, taskQueue, taskType)
 }
 
 // QueryWorkflow queries a given workflow synchronously and return the query result.
@@ -350,6 +394,15 @@ func (h *Handler) DescribeTaskQueuePartition(
 	return h.engine.DescribeTaskQueuePartition(ctx, request)
 }
 
+// GetTaskQueueStats returns stats of a task queue aggregated across its partitions.
+func (h *Handler) GetTaskQueueStats(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueStatsRequest,
+) (_ *matchingservice.GetTaskQueueStatsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetTaskQueueStats(ctx, request)
+}
+
 // ListTaskQueuePartitions returns information about partitions for a taskQueue
 func (h *Handler) ListTaskQueuePartitions(
 	ctx context.Context,
@@ -598,6 +651,18 @@ func (h *Handler) UpdateFairnessState(
 	return h.engine.UpdateFairnessState(ctx, request)
 }
 
+func (h *Handler) UpdateTaskQueuePartitionCounts(
+	ctx context.Context, request *matchingservice.UpdateTaskQueuePartitionCountsRequest,
+) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+	return h.engine.UpdateTaskQueuePartitionCounts(ctx, request)
+}
+
+func (h *Handler) ReleaseConcurrencySlot(
+	ctx context.Context, request *matchingservice.ReleaseConcurrencySlotRequest,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	return h.engine.ReleaseConcurrencySlot(ctx, request)
+}
+
 func (h *Handler) namespaceName(id namespace.ID) namespace.Name {
 	entry, err := h.namespaceRegistry.GetNamespaceByID(id)
 	if err != nil {
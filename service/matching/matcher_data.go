@@ -11,6 +11,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/softassert"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/common/util"
 )
 
@@ -202,17 +203,52 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 	heap.Init(t)
 }
 
+// agePriorities raises the effective priority of tasks that are allowed to age by one level for
+// each interval they have spent in the backlog. It returns true if any task's priority changed,
+// in which case the heap must be re-established.
+func (t *taskPQ) agePriorities(now time.Time, interval time.Duration) bool {
+	// Only local backlog tasks age, so if the oldest one hasn't aged yet, nothing has.
+	if agedLevels(t.ages.oldestTime(), now, interval) == 0 {
+		return false
+	}
+
+	changed := false
+	for _, task := range t.heap {
+		if task.maxAgingBoost <= 0 {
+			continue
+		}
+		createTime := task.event.Data.GetCreateTime()
+		if createTime == nil {
+			continue
+		}
+		boost := min(agedLevels(createTime.AsTime(), now, interval), task.maxAgingBoost)
+		if boost != task.agingBoost {
+			task.effectivePriority -= effectivePriorityFactor * (boost - task.agingBoost)
+			task.agingBoost = boost
+			changed = true
+		}
+	}
+	return changed
+}
+
 type matcherData struct {
-	config           *taskQueueConfig
-	logger           log.Logger
-	timeSource       clock.TimeSource
-	canForward       bool
-	rateLimitManager *rateLimitManager
+	config             *taskQueueConfig
+	logger             log.Logger
+	timeSource         clock.TimeSource
+	canForward         bool
+	rateLimitManager   *rateLimitManager
+	concurrencyLimiter *concurrencyLimiter // nil if the task queue type doesn't support concurrency limits
+	affinity           *activityAffinity   // nil if the task queue type doesn't support worker affinity
 
 	lock sync.Mutex // covers everything below, and all fields in any waitableMatchResult
 
 	rateLimitTimer         resettableTimer
 	reconsiderForwardTimer resettableTimer
+	affinityTimer          resettableTimer
+
+	// earliest time at which a task held back for worker affinity can go to any worker, as found
+	// by the last call to findMatch, or zero
+	affinityDeadline time.Time
 
 	// waiting pollers and tasks
 	// invariant: all pollers and tasks in these data structures have matchResult == nil
@@ -224,13 +260,23 @@ type matcherData struct {
 	stopped bool // if true, reject new tasks
 }
 
-func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock.TimeSource, canForward bool, rateLimitManager *rateLimitManager) matcherData {
+func newMatcherData(
+	config *taskQueueConfig,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+	canForward bool,
+	rateLimitManager *rateLimitManager,
+	concurrencyLimiter *concurrencyLimiter,
+	affinity *activityAffinity,
+) matcherData {
 	return matcherData{
-		config:           config,
-		logger:           logger,
-		timeSource:       timeSource,
-		canForward:       canForward,
-		rateLimitManager: rateLimitManager,
+		config:             config,
+		logger:             logger,
+		timeSource:         timeSource,
+		canForward:         canForward,
+		rateLimitManager:   rateLimitManager,
+		concurrencyLimiter: concurrencyLimiter,
+		affinity:           affinity,
 		tasks: taskPQ{
 			ages: newBacklogAgeTracker(),
 		},
@@ -258,6 +304,18 @@ func (d *matcherData) EnqueueTaskNoWait(task *internalTask) error {
 	return nil
 }
 
+// AgeTasks applies priority aging to waiting tasks. Aged tasks may now sort ahead of others, or
+// be eligible for pollers with a minimum priority, so this also looks for new matches.
+func (d *matcherData) AgeTasks(interval time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.tasks.agePriorities(d.timeSource.Now(), interval) {
+		heap.Init(&d.tasks)
+		d.findAndWakeMatches()
+	}
+}
+
 func (d *matcherData) RemoveTask(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -407,6 +465,9 @@ func (d *matcherData) ReprocessTasks(pred func(*internalTask) bool) []*internalT
 // call with lock held
 // nolint:revive // will improve later
 func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPoller) {
+	now := d.timeSource.Now()
+	d.affinityDeadline = time.Time{}
+
 	// TODO(pri): optimize so it's not O(d*n) worst case
 	// TODO(pri): this iterates over heap as slice, which isn't quite correct, but okay for now
 	for _, task := range d.tasks.heap {
@@ -416,6 +477,10 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 			continue
 		}
 
+		// Tasks over the concurrency limit can still be forwarded or validated, just not
+		// dispatched to workers.
+		belowConcurrencyLimit := task.event == nil || d.concurrencyLimiter.allows(task)
+
 		for _, poller := range d.pollers.heap {
 			// can't match cases:
 			if poller.queryOnly && !task.isQuery() && !task.isPollForwarder() {
@@ -431,6 +496,18 @@ func (d *matcherData) findMatch(allowForwarding bool) (*internalTask, *waitingPo
 				// Also note: this condition will be false for draining tasks since we artifically boost
 				// their priority above "1". that's inaccurate but it's just a temporary situation.
 				continue
+			} else if !poller.isTaskForwarder && !poller.isTaskValidator &&
+				!tasklabels.Satisfies(poller.labels(), task.requiredLabels()) {
+				// Task forwarders pass the task on to a partition that may have a poller with the
+				// right labels, and validators don't hand tasks to workers.
+				continue
+			} else if !belowConcurrencyLimit && needsConcurrencySlot(task, poller) {
+				continue
+			} else if holdBack, deadline := d.affinity.holdBack(task, poller, now); holdBack {
+				if d.affinityDeadline.IsZero() || deadline.Before(d.affinityDeadline) {
+					d.affinityDeadline = deadline
+				}
+				continue
 			}
 
 			return task, poller
@@ -463,7 +540,10 @@ func (d *matcherData) allowForwarding() (allowForwarding bool) {
 	// true to false and not the other way, so that's safe. But it is possible
 	// that we no longer have recent polls. So we need to ensure that match() is
 	// called again in that case, using reconsiderForwardTimer.
-	if d.isBacklogNegligible() {
+	//
+	// Partitions that leave dispatching to the root because of a concurrency limit can only
+	// make progress by forwarding.
+	if d.isBacklogNegligible() || d.concurrencyLimiter.isForwardOnly() {
 		d.reconsiderForwardTimer.unset()
 		return true
 	}
@@ -482,6 +562,13 @@ func (d *matcherData) findAndWakeMatches() {
 	for {
 		// search for highest priority match
 		task, poller := d.findMatch(allowForwarding)
+		// if tasks are held back for worker affinity, look again when the first one can go to
+		// any worker
+		if d.affinityDeadline.IsZero() {
+			d.affinityTimer.unset()
+		} else {
+			d.affinityTimer.set(d.timeSource, d.rematchAfterTimer, d.affinityDeadline.Sub(d.timeSource.Now()))
+		}
 		if task == nil || poller == nil {
 			// no more current matches, stop rate limit timer if was running
 			d.rateLimitTimer.unset()
@@ -502,6 +589,10 @@ func (d *matcherData) findAndWakeMatches() {
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
 		d.rateLimitManager.consumeTokens(now, task, 1)
 		task.recycleToken = d.recycleToken
+		if needsConcurrencySlot(task, poller) {
+			task.concurrencySlot = d.concurrencyLimiter.acquire(task)
+		}
+		d.affinity.record(task, poller)
 
 		res := &matchResult{task: task, poller: poller}
 		task.wake(d.logger, res)
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/testing/testlogger"
@@ -49,7 +50,7 @@ func (s *MatcherDataSuite) SetupTest() {
 	s.ts = clock.NewEventTimeSource().Update(time.Now())
 	s.ts.UseAsyncTimers(true)
 	rateLimitManager := newRateLimitManager(&mockUserDataManager{}, cfg, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
-	s.md = newMatcherData(cfg, logger, s.ts, true, rateLimitManager)
+	s.md = newMatcherData(cfg, logger, s.ts, true, rateLimitManager, nil, nil)
 }
 
 func (s *MatcherDataSuite) now() time.Time {
@@ -428,6 +429,57 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) TestPriorityAging() {
+	newAgingTask := func(id int64, age time.Duration, pri int32) *internalTask {
+		t := s.newBacklogTaskWithPriority(id, age, nil, &commonpb.Priority{PriorityKey: pri})
+		t.maxAgingBoost = priorityKey(pri) - 1
+		return t
+	}
+	// t3 has waited long enough to gain two levels, which ties it with t2. Ties are broken by
+	// task id, so it goes first.
+	t1 := newAgingTask(4, 0, 1)
+	t2 := newAgingTask(5, 0, 2)
+	t3 := newAgingTask(3, 25*time.Second, 4)
+	t4 := newAgingTask(6, 0, 5)
+
+	s.md.EnqueueTaskNoWait(t4)
+	s.md.EnqueueTaskNoWait(t3)
+	s.md.EnqueueTaskNoWait(t2)
+	s.md.EnqueueTaskNoWait(t1)
+
+	s.md.AgeTasks(10 * time.Second)
+	s.Equal(priorityKey(2), t3.agingBoost)
+	s.Equal(effectivePriorityFactor*priorityKey(2), t3.effectivePriority)
+
+	s.Equal(t1, s.pollFakeTime(time.Second).task)
+	s.Equal(t3, s.pollFakeTime(time.Second).task)
+	s.Equal(t2, s.pollFakeTime(time.Second).task)
+
+	// aging stops at the highest priority level
+	s.ts.Advance(time.Hour)
+	s.md.AgeTasks(10 * time.Second)
+	s.Equal(priorityKey(4), t4.agingBoost)
+	s.Equal(effectivePriorityFactor*priorityKey(1), t4.effectivePriority)
+	s.Equal(t4, s.pollFakeTime(time.Second).task)
+}
+
+func (s *MatcherDataSuite) TestPriorityAgingMinPriority() {
+	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 3})
+	t1.maxAgingBoost = 2
+
+	s.md.EnqueueTaskNoWait(t1)
+
+	res := s.pollWithMinPriority(20*time.Millisecond, 2)
+	s.Require().Error(res.ctxErr)
+
+	// once aged, the task can be given to a poller that only wants higher priorities
+	s.ts.Advance(15 * time.Second)
+	s.md.AgeTasks(10 * time.Second)
+	res = s.pollWithMinPriority(20*time.Millisecond, 2)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
@@ -590,6 +642,149 @@ func (s *MatcherDataSuite) TestMinPriorityZeroMatchesAll() {
 	s.Equal(t2, res.task)
 }
 
+func (s *MatcherDataSuite) TestLabels() {
+	pollWithLabels := func(labels ...string) *matchResult {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		return s.pollContext(ctx, &pollMetadata{
+			conditions: &matchingservice.PollConditions{Labels: labels},
+		})
+	}
+
+	t1 := s.newBacklogTask(1, 0, nil)
+	t1.event.Data.RequiredLabels = []string{"gpu"}
+	t2 := s.newBacklogTask(2, 0, nil)
+	t2.event.Data.RequiredLabels = []string{"gpu", "us"}
+	t3 := s.newBacklogTask(3, 0, nil)
+
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+	s.md.EnqueueTaskNoWait(t3)
+
+	// a poller without labels skips tasks that require labels
+	res := s.pollFakeTime(time.Second)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t3, res.task)
+
+	// a poller with some of the labels only gets tasks it has all labels for
+	res = pollWithLabels("gpu", "eu")
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+	res = pollWithLabels("gpu", "eu")
+	s.Require().Error(res.ctxErr)
+
+	res = pollWithLabels("gpu", "us", "x")
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t2, res.task)
+}
+
+func (s *MatcherDataSuite) TestConcurrencyLimit() {
+	f, err := tqid.NewTaskQueueFamily("nsid", "tq")
+	s.Require().NoError(err)
+	s.md.concurrencyLimiter = newConcurrencyLimiter(
+		&mockUserDataManager{data: &persistencespb.VersionedTaskQueueUserData{
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+						ConcurrencyLimit: &persistencespb.TaskQueueConcurrencyLimit{MaxInFlight: 1},
+					},
+				},
+			},
+		}},
+		s.md.config,
+		f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition(),
+		s.ts,
+		metrics.NoopMetricsHandler,
+	)
+	s.md.concurrencyLimiter.UserDataChanged()
+	defer s.md.concurrencyLimiter.addListener(s.md.rematchAfterTimer)()
+	defer s.md.concurrencyLimiter.Stop()
+
+	t1 := s.newBacklogTask(1, 0, nil)
+	t1.event.Data.RunId = "run1"
+	t2 := s.newBacklogTask(2, 0, nil)
+	t2.event.Data.RunId = "run2"
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+
+	res := s.pollFakeTime(time.Second)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t1, res.task)
+	s.Require().NotNil(t1.concurrencySlot)
+
+	// the second task waits for the first one's slot
+	s.Nil(s.pollImmediately(nil))
+
+	// the slot is given back when the first task can't be started
+	go func() {
+		s.waitForPollers(1)
+		t1.finish(nil, false)
+	}()
+	res = s.pollRealTime(time.Second)
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t2, res.task)
+}
+
+func (s *MatcherDataSuite) TestAffinity() {
+	history := newPollerHistory(time.Minute)
+	s.md.affinity = newActivityAffinity(s.md.config, history)
+	history.updatePollerInfo("worker-a", &pollMetadata{})
+	history.updatePollerInfo("worker-b", &pollMetadata{})
+
+	pollAs := func(identity pollerIdentity) *matchResult {
+		return s.md.MatchPollerImmediately(&waitingPoller{
+			startTime:    s.now(),
+			pollMetadata: &pollMetadata{},
+			identity:     identity,
+		})
+	}
+	newAffinityTask := func(id int64, key string) *internalTask {
+		t := s.newBacklogTask(id, 0, nil)
+		t.event.Data.AffinityKey = key
+		s.Require().NoError(s.md.EnqueueTaskNoWait(t))
+		return t
+	}
+
+	// the first task with a key goes to any worker
+	t1 := newAffinityTask(1, "key")
+	res := pollAs("worker-a")
+	s.Require().NotNil(res)
+	s.Equal(t1, res.task)
+
+	// later tasks with the same key wait for that worker
+	t2 := newAffinityTask(2, "key")
+	s.Nil(pollAs("worker-b"))
+	res = pollAs("worker-a")
+	s.Require().NotNil(res)
+	s.Equal(t2, res.task)
+
+	// other workers can take them after the fallback timeout
+	t3 := newAffinityTask(3, "key")
+	resC := make(chan *matchResult, 1)
+	go func() {
+		ctx, cancel := clock.ContextWithTimeout(context.Background(), time.Minute, s.ts)
+		defer cancel()
+		resC <- s.md.EnqueuePollerAndWait([]context.Context{ctx}, &waitingPoller{
+			startTime:    s.now(),
+			forwardCtx:   ctx,
+			pollMetadata: &pollMetadata{},
+			identity:     "worker-b",
+		})
+	}()
+	s.waitForPollers(1)
+	s.ts.Advance(s.md.config.AffinityFallbackTimeout())
+	res = <-resC
+	s.Require().NoError(res.ctxErr)
+	s.Equal(t3, res.task)
+
+	// tasks don't wait for a worker that stopped polling
+	t4 := newAffinityTask(4, "key")
+	history.history.Delete(pollerIdentity("worker-b"))
+	res = pollAs("worker-a")
+	s.Require().NotNil(res)
+	s.Equal(t4, res.task)
+}
+
 func (s *MatcherDataSuite) TestMatchPollerImmediately() {
 	// Add tasks at different priorities
 	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 1})
@@ -769,7 +964,7 @@ func FuzzMatcherData(f *testing.F) {
 		ts.UseAsyncTimers(true)
 		logger := log.NewNoopLogger()
 		rateLimitManager := newRateLimitManager(&mockUserDataManager{}, cfg, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
-		md := newMatcherData(cfg, logger, ts, true, rateLimitManager)
+		md := newMatcherData(cfg, logger, ts, true, rateLimitManager, nil, nil)
 
 		next := func() int {
 			if len(tape) == 0 {
@@ -2,6 +2,7 @@ package matching
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/stream_batcher"
+	"go.temporal.io/server/common/tasklabels"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/testhooks"
 	"go.temporal.io/server/common/tqid"
@@ -533,6 +535,15 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	} else if sticky && !stickyWorkerAvailable(pm) {
 		return "", false, serviceerrors.NewStickyWorkerUnavailable()
 	}
+	requiredLabels, err := normalizeRequiredLabels(pm, addRequest.GetRequiredLabels())
+	if err != nil {
+		return "", false, err
+	}
+	if addRequest.ForwardInfo == nil {
+		if err := pm.GetBacklogLimiter().checkAdd(); err != nil {
+			return "", false, err
+		}
+	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *timestamppb.Timestamp
@@ -552,6 +563,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		RequiredLabels:   requiredLabels,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -569,10 +581,35 @@ func (e *matchingEngineImpl) AddActivityTask(
 	if err != nil {
 		return "", false, err
 	}
+	// Tasks of task queues bound to a shared task queue are added to the shared task queue instead.
+	if binding, ok := e.sharedTaskQueueBinding(addRequest, partition); ok {
+		buildId, err := e.addActivityTaskToSharedTaskQueue(ctx, addRequest, binding)
+		return buildId, false, err
+	}
 	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseTask)
 	if err != nil {
 		return "", false, err
 	}
+	requiredLabels, err := normalizeRequiredLabels(pm, addRequest.GetRequiredLabels())
+	if err != nil {
+		return "", false, err
+	}
+	// Forwarded tasks were already checked against the backlog limit of the source partition.
+	if addRequest.ForwardInfo == nil {
+		if err := pm.GetBacklogLimiter().checkAdd(); err != nil {
+			return "", false, err
+		}
+	}
+
+	affinityKey := addRequest.GetAffinityKey()
+	if affinityKey != "" && !pm.GetConfig().NewMatcher {
+		// The classic matcher can't hold tasks back for a worker. Drop the key rather than store
+		// a task that looks like it has worker affinity.
+		e.throttledLogger.Warn("Worker affinity requires the new matcher, dropping affinity key",
+			tag.WorkflowNamespaceID(addRequest.GetNamespaceId()),
+			tag.WorkflowTaskQueueName(partition.RpcName()))
+		affinityKey = ""
+	}
 
 	var expirationTime *timestamppb.Timestamp
 	now := time.Now().UTC()
@@ -581,7 +618,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		expirationTime = timestamppb.New(now.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
-		NamespaceId:      addRequest.NamespaceId,
+		// Tasks of shared task queues keep the namespace of their workflow.
+		NamespaceId:      cmp.Or(addRequest.GetSourceNamespaceId(), addRequest.GetNamespaceId()),
 		RunId:            addRequest.Execution.GetRunId(),
 		WorkflowId:       addRequest.Execution.GetWorkflowId(),
 		ScheduledEventId: addRequest.GetScheduledEventId(),
@@ -592,6 +630,8 @@ func (e *matchingEngineImpl) AddActivityTask(
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
 		ComponentRef:     addRequest.ComponentRef,
+		RequiredLabels:   requiredLabels,
+		AffinityKey:      affinityKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -600,6 +640,20 @@ func (e *matchingEngineImpl) AddActivityTask(
 	})
 }
 
+// normalizeRequiredLabels normalizes the labels a new task requires of workers. Only the new
+// matcher checks the labels of pollers, so tasks that require labels are rejected on the classic
+// matcher instead of going to any worker.
+func normalizeRequiredLabels(pm taskQueuePartitionManager, labels []string) ([]string, error) {
+	labels, err := tasklabels.Normalize(labels)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) > 0 && !pm.GetConfig().NewMatcher {
+		return nil, serviceerror.NewFailedPrecondition("tasks with required labels need the new matcher, which is not enabled for this task queue")
+	}
+	return labels, nil
+}
+
 // PollWorkflowTaskQueue tries to get the workflow task using exponential backoff.
 func (e *matchingEngineImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
@@ -1056,7 +1110,11 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil, true)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		if task.concurrencySlot != nil {
+			startToCloseTimeout := resp.GetScheduledEvent().GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeout()
+			task.concurrencySlot.confirm(resp.GetAttempt(), startToCloseTimeout.AsDuration())
+		}
+		return e.createPollActivityTaskQueueResponse(task, resp, partition, opMetrics), nil
 	}
 }
 
@@ -1235,8 +1293,8 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 				buildId       string
 				taskQueueType enumspb.TaskQueueType
 			}
-			numPartitions := max(tqConfig.NumWritePartitions(), tqConfig.NumReadPartitions())
 			for _, taskQueueType := range req.TaskQueueTypes {
+				numPartitions := e.partitionCount(req.Namespace, req.TaskQueue.Name, taskQueueType, userData)
 				for i := 0; i < numPartitions; i++ {
 					partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
 						NamespaceId: request.GetNamespaceId(),
@@ -1547,6 +1605,54 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	return pm.Describe(ctx, buildIds, request.GetVersions().GetAllActive(), request.GetReportStats(), request.GetReportPollers(), request.GetReportInternalTaskQueueStatus())
 }
 
+func (e *matchingEngineImpl) GetTaskQueueStats(
+	ctx context.Context,
+	request *matchingservice.GetTaskQueueStatsRequest,
+) (*matchingservice.GetTaskQueueStatsResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	if !request.GetPartitionOnly() && (!partition.IsRoot() || partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY) {
+		return nil, serviceerror.NewInvalidArgument("GetTaskQueueStats must be called on the root partition of a normal task queue")
+	}
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseDescribe)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetPartitionOnly() {
+		return pm.GetStatsSnapshot(), nil
+	}
+
+	if cached := pm.GetCache(taskQueueStatsCacheKey); cached != nil {
+		//revive:disable-next-line:unchecked-type-assertion
+		return cached.(*matchingservice.GetTaskQueueStatsResponse), nil
+	}
+
+	aggregator := newTaskQueueStatsAggregator(partition.TaskType())
+	aggregator.add(pm.GetStatsSnapshot())
+	for i := 1; i < pm.PartitionCount(); i++ {
+		partitionResp, err := e.matchingRawClient.GetTaskQueueStats(ctx, &matchingservice.GetTaskQueueStatsRequest{
+			NamespaceId: request.GetNamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     partition.TaskQueue().Name(),
+				TaskQueueType: partition.TaskType(),
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			PartitionOnly: true,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			// Report the partitions that could be reached rather than failing.
+			aggregator.addFailed()
+			continue
+		}
+		aggregator.add(partitionResp)
+	}
+	resp := aggregator.result(e.timeSource.Now())
+	pm.PutCache(taskQueueStatsCacheKey, resp)
+	return resp, nil
+}
+
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
 	buildIds := make(map[string]bool)
 	if versions != nil {
@@ -2373,16 +2479,14 @@ func (e *matchingEngineImpl) CheckTaskQueueUserDataPropagation(ctx context.Conte
 		return nil, err
 	}
 
+	userData, _, err := pm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
 	nsName := pm.Namespace().Name().String()
 	tqName := rootPartition.TaskQueue().Name()
-	wfPartitions := max(
-		e.config.NumTaskqueueReadPartitions(nsName, tqName, enumspb.TASK_QUEUE_TYPE_WORKFLOW),
-		e.config.NumTaskqueueWritePartitions(nsName, tqName, enumspb.TASK_QUEUE_TYPE_WORKFLOW),
-	)
-	actPartitions := max(
-		e.config.NumTaskqueueReadPartitions(nsName, tqName, enumspb.TASK_QUEUE_TYPE_ACTIVITY),
-		e.config.NumTaskqueueWritePartitions(nsName, tqName, enumspb.TASK_QUEUE_TYPE_ACTIVITY),
-	)
+	wfPartitions := e.partitionCount(nsName, tqName, enumspb.TASK_QUEUE_TYPE_WORKFLOW, userData.GetData())
+	actPartitions := e.partitionCount(nsName, tqName, enumspb.TASK_QUEUE_TYPE_ACTIVITY, userData.GetData())
 
 	err = pm.GetUserDataManager().CheckTaskQueueUserDataPropagation(ctx, req.Version, wfPartitions, actPartitions)
 	if err != nil {
@@ -2391,6 +2495,24 @@ func (e *matchingEngineImpl) CheckTaskQueueUserDataPropagation(ctx context.Conte
 	return &matchingservice.CheckTaskQueueUserDataPropagationResponse{}, nil
 }
 
+// partitionCount returns the number of partitions of a task queue that may hold tasks or pollers, using the
+// counts chosen by partition auto-scaling when it's enabled and falling back to dynamic config.
+func (e *matchingEngineImpl) partitionCount(
+	nsName string,
+	tqName string,
+	taskType enumspb.TaskQueueType,
+	userData *persistencespb.TaskQueueUserData,
+) int {
+	counts := userData.GetPerType()[int32(taskType)].GetPartitionCounts()
+	if e.config.EnablePartitionAutoScaling(nsName, tqName, taskType) && counts.GetWritePartitions() > 0 {
+		return int(max(counts.GetReadPartitions(), counts.GetWritePartitions()))
+	}
+	return max(
+		e.config.NumTaskqueueReadPartitions(nsName, tqName, taskType),
+		e.config.NumTaskqueueWritePartitions(nsName, tqName, taskType),
+	)
+}
+
 // nexusResult is container for a response or error.
 // Only one field may be set at a time.
 type nexusResult struct {
@@ -3020,6 +3142,7 @@ func (e *matchingEngineImpl) convertPollWorkflowTaskQueueResponse(
 func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 	task *internalTask,
 	historyResponse *historyservice.RecordActivityTaskStartedResponse,
+	partition tqid.Partition,
 	metricsHandler metrics.Handler,
 ) *matchingservice.PollActivityTaskQueueResponse {
 	scheduledEvent := historyResponse.ScheduledEvent
@@ -3048,6 +3171,10 @@ func (e *matchingEngineImpl) createPollActivityTaskQueueResponse(
 		historyResponse.GetStartVersion(),
 		task.event.GetData().GetComponentRef(),
 	)
+	if sharedTaskQueueSourceNamespaceID(partition, task) != "" {
+		// The token lets the frontend accept completions of the task from the shared namespace.
+		taskToken.SharedTaskQueue = partition.TaskQueue().Name()
+	}
 	serializedToken, _ := e.tokenSerializer.Serialize(taskToken)
 
 	// This is here to ensure that this field is never nil as expected by the TS SDK.
@@ -3211,6 +3338,9 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		TaskDispatchRevisionNumber: task.taskDispatchRevisionNumber,
 		ComponentRef:               task.event.Data.GetComponentRef(),
 	}
+	if task.concurrencySlot != nil {
+		recordStartedRequest.ConcurrencySlotPartition = task.concurrencySlot.partitionProto()
+	}
 
 	return e.historyClient.RecordActivityTaskStarted(ctx, recordStartedRequest)
 }
@@ -3329,6 +3459,28 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 		return nil, err
 	}
 	taskQueueType := request.UpdateTaskqueueConfig.GetTaskQueueType()
+	if cl := request.GetUpdateConcurrencyLimit(); cl != nil {
+		if taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+			return nil, serviceerror.NewInvalidArgument("concurrency limits are only supported for activity task queues")
+		} else if cl.GetMaxInFlight() < 0 || cl.GetFairnessKeyMaxInFlight() < 0 {
+			return nil, serviceerror.NewInvalidArgument("concurrency limits must not be negative")
+		}
+	}
+	if cl := request.GetUpdateConcurrencyLimit(); cl.GetMaxInFlight() > 0 || cl.GetFairnessKeyMaxInFlight() > 0 {
+		// Only the new matcher enforces concurrency limits, don't accept a limit that would be ignored.
+		pm, _, err := e.getTaskQueuePartitionManager(ctx,
+			taskQueueFamily.TaskQueue(taskQueueType).RootPartition(), true, loadCauseOtherWrite)
+		if err != nil {
+			return nil, err
+		} else if !pm.GetConfig().NewMatcher {
+			return nil, serviceerror.NewFailedPrecondition("concurrency limits require the new matcher, which is not enabled for this task queue")
+		}
+	}
+	if purge := request.GetAddBacklogPurge(); purge != nil {
+		if err := validateBacklogPurge(purge, request.GetNamespaceId(), taskQueueType, e.timeSource.Now()); err != nil {
+			return nil, err
+		}
+	}
 	// Get the partition manager for the root workflow partition of the task queue family.
 	// Configuration updates are applied here and eventually propagate,
 	// to all partitions and associated activity task queues of the same task queue family.
@@ -3346,8 +3498,11 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 		// If no update is requested, return the current config.
 		return &matchingservice.UpdateTaskQueueConfigResponse{
 			UpdatedTaskqueueConfig: tqud.GetData().GetPerType()[int32(taskQueueType)].GetConfig(),
+			ConcurrencyLimit:       tqud.GetData().GetPerType()[int32(taskQueueType)].GetConcurrencyLimit(),
+			BacklogPurges:          tqud.GetData().GetPerType()[int32(taskQueueType)].GetBacklogPurges(),
 		}, nil
 	}
+	var addedPurge *persistencespb.TaskQueueBacklogPurge
 	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueueConfig"}
 	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
 		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
@@ -3389,6 +3544,41 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 				}
 			}
 
+			// Concurrency Limit
+			if cl := request.GetUpdateConcurrencyLimit(); cl != nil {
+				data.PerType[int32(taskQueueType)].ConcurrencyLimit = &persistencespb.TaskQueueConcurrencyLimit{
+					MaxInFlight:            cl.GetMaxInFlight(),
+					FairnessKeyMaxInFlight: cl.GetFairnessKeyMaxInFlight(),
+					Metadata: &taskqueuepb.ConfigMetadata{
+						Reason:         cl.GetMetadata().GetReason(),
+						UpdateTime:     protoTs,
+						UpdateIdentity: updateIdentity,
+					},
+				}
+			}
+
+			// Backlog Purges
+			typedData := data.PerType[int32(taskQueueType)]
+			if id := request.GetCancelBacklogPurgeId(); id != "" {
+				if err := cancelBacklogPurge(typedData.BacklogPurges, id, e.timeSource.Now()); err != nil {
+					return nil, false, err
+				}
+			}
+			if purge := request.GetAddBacklogPurge(); purge != nil {
+				purge = common.CloneProto(purge)
+				purge.Id = uuid.NewString()
+				purge.Metadata = &taskqueuepb.ConfigMetadata{
+					Reason:         purge.GetMetadata().GetReason(),
+					UpdateTime:     protoTs,
+					UpdateIdentity: updateIdentity,
+				}
+				typedData.BacklogPurges, err = addBacklogPurge(typedData.BacklogPurges, purge, e.timeSource.Now())
+				if err != nil {
+					return nil, false, err
+				}
+				addedPurge = purge
+			}
+
 			// Update the clock on TaskQueueUserData to enforce LWW on config updates
 			data.Clock = now
 			return data, true, nil
@@ -3397,12 +3587,34 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(
 	if err != nil {
 		return nil, err
 	}
+	// Backlog purges are logged as an audit trail, together with every task they purge.
+	identity := request.GetUpdateTaskqueueConfig().GetIdentity()
+	if id := request.GetCancelBacklogPurgeId(); id != "" {
+		e.logger.Info("cancelled backlog purge",
+			tag.WorkflowNamespaceID(request.GetNamespaceId()),
+			tag.WorkflowTaskQueueName(taskQueueFamily.Name()),
+			tag.WorkflowTaskQueueType(taskQueueType),
+			tag.NewStringTag("backlog-purge-id", id),
+			tag.NewStringTag("backlog-purge-identity", identity))
+	}
+	if addedPurge != nil {
+		e.logger.Info("added backlog purge",
+			tag.WorkflowNamespaceID(request.GetNamespaceId()),
+			tag.WorkflowTaskQueueName(taskQueueFamily.Name()),
+			tag.WorkflowTaskQueueType(taskQueueType),
+			tag.NewStringTag("backlog-purge-id", addedPurge.GetId()),
+			tag.NewStringTag("backlog-purge-identity", identity),
+			tag.NewStringTag("backlog-purge-reason", addedPurge.GetMetadata().GetReason()),
+			tag.NewAnyTag("backlog-purge", addedPurge))
+	}
 	userData, _, err := tqm.GetUserDataManager().GetUserData()
 	if err != nil {
 		return nil, err
 	}
 	return &matchingservice.UpdateTaskQueueConfigResponse{
 		UpdatedTaskqueueConfig: userData.GetData().GetPerType()[int32(taskQueueType)].GetConfig(),
+		ConcurrencyLimit:       userData.GetData().GetPerType()[int32(taskQueueType)].GetConcurrencyLimit(),
+		BacklogPurges:          userData.GetData().GetPerType()[int32(taskQueueType)].GetBacklogPurges(),
 	}, nil
 }
 
@@ -3446,6 +3658,89 @@ func (e *matchingEngineImpl) UpdateFairnessState(
 	return &matchingservice.UpdateFairnessStateResponse{}, nil
 }
 
+func (e *matchingEngineImpl) UpdateTaskQueuePartitionCounts(
+	ctx context.Context,
+	req *matchingservice.UpdateTaskQueuePartitionCountsRequest,
+) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+	counts := req.GetPartitionCounts()
+	if counts.GetWritePartitions() <= 0 || counts.GetReadPartitions() < counts.GetWritePartitions() {
+		return nil, serviceerror.NewInvalidArgument("write partitions must be positive and not greater than read partitions")
+	}
+
+	partition, err := tqid.NormalPartitionFromRpcName(req.GetTaskQueue(), req.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	updateFn := func(old *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		data := old
+		if data != nil {
+			data = common.CloneProto(old)
+		} else {
+			data = &persistencespb.TaskQueueUserData{}
+		}
+		if data.PerType == nil {
+			data.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		typ := int32(req.GetTaskQueueType())
+		perType := data.PerType[typ]
+		if perType == nil {
+			data.PerType[typ] = &persistencespb.TaskQueueTypeUserData{}
+			perType = data.PerType[typ]
+		}
+		perType.PartitionCounts = counts
+		// Partition counts reflect local load and are not replicated to other clusters.
+		return data, false, nil
+	}
+	_, err = pm.GetUserDataManager().UpdateUserData(ctx, UserDataUpdateOptions{Source: "Matching partition auto-scaling"}, updateFn)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueuePartitionCountsResponse{}, nil
+}
+
+func (e *matchingEngineImpl) ReleaseConcurrencySlot(
+	ctx context.Context,
+	req *matchingservice.ReleaseConcurrencySlotRequest,
+) (*matchingservice.ReleaseConcurrencySlotResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), req.GetNamespaceId())
+	// Slots of shared task queues are held in the partition of the shared namespace.
+	if sharedNamespaceID := e.sharedTaskQueueNamespaceID(req.GetNamespaceId(), partition); sharedNamespaceID != "" {
+		partition = tqid.PartitionFromPartitionProto(req.GetTaskQueuePartition(), sharedNamespaceID)
+	}
+	// Slots only live in memory. If the partition isn't loaded, there's nothing to release.
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	} else if pm == nil {
+		return &matchingservice.ReleaseConcurrencySlotResponse{}, nil
+	}
+	pm.GetConcurrencyLimiter().releaseAttempt(req.GetRunId(), req.GetScheduledEventId(), req.GetAttempt())
+	return &matchingservice.ReleaseConcurrencySlotResponse{}, nil
+}
+
+func (e *matchingEngineImpl) PartitionCountsHint(
+	ctx context.Context,
+	namespaceID string,
+	taskQueue *taskqueuepb.TaskQueue,
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	partition, err := tqid.PartitionFromProto(taskQueue, namespaceID, taskType)
+	if err != nil || partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return nil
+	}
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, false, loadCauseOtherRead)
+	if err != nil || pm == nil {
+		return nil
+	}
+	return pm.PartitionCountsHint()
+}
+
 // migrateOldFormatVersions moves versions present in the given deployment from the
 // deprecated old-format slice into the new per-deployment map.
 //
@@ -3,7 +3,10 @@ package matching
 import (
 	"context"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/metrics"
 )
 
@@ -21,6 +24,7 @@ type (
 		CancelOutstandingPoll(ctx context.Context, request *matchingservice.CancelOutstandingPollRequest) error
 		DescribeTaskQueue(ctx context.Context, request *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error)
 		DescribeTaskQueuePartition(ctx context.Context, request *matchingservice.DescribeTaskQueuePartitionRequest) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		GetTaskQueueStats(ctx context.Context, request *matchingservice.GetTaskQueueStatsRequest) (*matchingservice.GetTaskQueueStatsResponse, error)
 		ListTaskQueuePartitions(ctx context.Context, request *matchingservice.ListTaskQueuePartitionsRequest) (*matchingservice.ListTaskQueuePartitionsResponse, error)
 		UpdateWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error)
 		GetWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error)
@@ -48,5 +52,9 @@ type (
 		DescribeVersionedTaskQueues(ctx context.Context, request *matchingservice.DescribeVersionedTaskQueuesRequest) (*matchingservice.DescribeVersionedTaskQueuesResponse, error)
 		UpdateTaskQueueConfig(ctx context.Context, request *matchingservice.UpdateTaskQueueConfigRequest) (*matchingservice.UpdateTaskQueueConfigResponse, error)
 		UpdateFairnessState(ctx context.Context, request *matchingservice.UpdateFairnessStateRequest) (*matchingservice.UpdateFairnessStateResponse, error)
+		UpdateTaskQueuePartitionCounts(ctx context.Context, request *matchingservice.UpdateTaskQueuePartitionCountsRequest) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error)
+		ReleaseConcurrencySlot(ctx context.Context, request *matchingservice.ReleaseConcurrencySlotRequest) (*matchingservice.ReleaseConcurrencySlotResponse, error)
+		// PartitionCountsHint returns the auto-scaled partition counts of a loaded task queue partition, if any.
+		PartitionCountsHint(ctx context.Context, namespaceID string, taskQueue *taskqueuepb.TaskQueue, taskType enumspb.TaskQueueType) *persistencespb.TaskQueuePartitionCounts
 	}
 )
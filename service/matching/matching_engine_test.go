@@ -63,6 +63,7 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/sharedtaskqueue"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/common/testing/testlogger"
@@ -783,6 +784,157 @@ func (s *matchingEngineSuite) TestAddWorkflowTasksForwarded() {
 	s.AddTasksTest(enumspb.TASK_QUEUE_TYPE_WORKFLOW, true)
 }
 
+func (s *matchingEngineSuite) TestAddActivityTask_SharedTaskQueueRedirect() {
+	s.matchingEngine.config.SharedTaskQueues = dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding{{
+		Namespace:       s.ns.Name().String(),
+		TaskQueue:       "source-tq",
+		SharedNamespace: "shared-ns",
+		SharedTaskQueue: "shared-tq",
+	}})
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(namespace.Name("shared-ns")).Return(namespace.ID("shared-ns-id"), nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddActivityTaskRequest, _ ...grpc.CallOption) (*matchingservice.AddActivityTaskResponse, error) {
+			s.Equal("shared-ns-id", req.GetNamespaceId())
+			s.Equal(s.ns.ID().String(), req.GetSourceNamespaceId())
+			s.Equal("shared-tq", req.GetTaskQueue().GetName())
+			s.Equal(enumspb.TASK_QUEUE_KIND_NORMAL, req.GetTaskQueue().GetKind())
+			s.Equal(s.ns.Name().String(), req.GetPriority().GetFairnessKey())
+			s.EqualValues(3, req.GetPriority().GetPriorityKey())
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		})
+
+	// The task queue name of a non-root partition is mapped to the task queue of the binding.
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            s.ns.ID().String(),
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: "/_sys/source-tq/2", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		Priority:               &commonpb.Priority{PriorityKey: 3},
+	})
+	s.NoError(err)
+	// The bound task queue is not loaded.
+	s.Empty(s.matchingEngine.getTaskQueuePartitions(10))
+}
+
+func (s *matchingEngineSuite) TestAddActivityTask_SharedTaskQueueKeepsSourceNamespace() {
+	s.matchingEngine.config.SharedTaskQueues = dynamicconfig.GetTypedPropertyFn([]sharedtaskqueue.Binding{{
+		Namespace:       s.ns.Name().String(),
+		TaskQueue:       "shared-tq",
+		SharedNamespace: "shared-ns",
+		SharedTaskQueue: "other-tq",
+	}})
+	namespaceID := s.ns.ID().String()
+	sourceNamespaceID := uuid.NewString()
+
+	// Redirected tasks are not redirected again, even if the shared task queue is bound itself.
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceID,
+		SourceNamespaceId:      sourceNamespaceID,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: "shared-tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+
+	dbq := newUnversionedRootQueueKey(namespaceID, "shared-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	s.Equal(1, s.taskManager.getTaskCount(dbq))
+	tlm := s.taskManager.getQueueDataByKey(dbq)
+	tlm.Lock()
+	defer tlm.Unlock()
+	it := tlm.tasks.Iterator()
+	s.True(it.Next())
+	s.Equal(sourceNamespaceID, it.Value().(*persistencespb.AllocatedTaskInfo).GetData().GetNamespaceId())
+}
+
+func (s *matchingEngineSuite) TestAddActivityTask_AffinityKey() {
+	namespaceID := s.ns.ID().String()
+	_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceID,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              &taskqueuepb.TaskQueue{Name: "affinity-tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		AffinityKey:            "key",
+	})
+	s.NoError(err)
+
+	dbq := newUnversionedRootQueueKey(namespaceID, "affinity-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	s.Equal(1, s.taskManager.getTaskCount(dbq))
+	tlm := s.taskManager.getQueueDataByKey(dbq)
+	tlm.Lock()
+	defer tlm.Unlock()
+	it := tlm.tasks.Iterator()
+	s.True(it.Next())
+	affinityKey := it.Value().(*persistencespb.AllocatedTaskInfo).GetData().GetAffinityKey()
+	if s.newMatcher {
+		s.Equal("key", affinityKey)
+	} else {
+		// the classic matcher doesn't support worker affinity
+		s.Empty(affinityKey)
+	}
+}
+
+func (s *matchingEngineSuite) TestAddActivityTask_RequiredLabels() {
+	namespaceID := s.ns.ID().String()
+	addTask := func(labels []string) error {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceID,
+			Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewString(), WorkflowId: "workflow1"},
+			ScheduledEventId:       5,
+			TaskQueue:              &taskqueuepb.TaskQueue{Name: "labels-tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+			RequiredLabels:         labels,
+		})
+		return err
+	}
+
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(addTask([]string{"a,b"}), &invalidArgument)
+
+	err := addTask([]string{"gpu"})
+	if !s.newMatcher {
+		// the classic matcher doesn't check the labels of pollers
+		var failedPrecondition *serviceerror.FailedPrecondition
+		s.ErrorAs(err, &failedPrecondition)
+		return
+	}
+	s.NoError(err)
+	dbq := newUnversionedRootQueueKey(namespaceID, "labels-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	s.Equal(1, s.taskManager.getTaskCount(dbq))
+}
+
+func (s *matchingEngineSuite) TestCreatePollActivityTaskQueueResponse_SharedTaskQueueToken() {
+	sourceNamespaceID := uuid.NewString()
+	prtn := newRootPartition(s.ns.ID().String(), "shared-tq", enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	historyResponse := &historyservice.RecordActivityTaskStartedResponse{
+		ScheduledEvent: &historypb.HistoryEvent{
+			Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+				ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{ActivityId: "activity"},
+			},
+		},
+	}
+	newTask := func(namespaceID string) *internalTask {
+		return newInternalTaskFromBacklog(&persistencespb.AllocatedTaskInfo{
+			Data: &persistencespb.TaskInfo{NamespaceId: namespaceID, WorkflowId: "wf", RunId: uuid.NewString()},
+		}, nil)
+	}
+
+	// A task of a source namespace carries the shared task queue in its token.
+	resp := s.matchingEngine.createPollActivityTaskQueueResponse(newTask(sourceNamespaceID), historyResponse, prtn, metrics.NoopMetricsHandler)
+	token, err := s.matchingEngine.tokenSerializer.Deserialize(resp.TaskToken)
+	s.NoError(err)
+	s.Equal(sourceNamespaceID, token.GetNamespaceId())
+	s.Equal("shared-tq", token.GetSharedTaskQueue())
+
+	// A task of the namespace of the task queue doesn't.
+	resp = s.matchingEngine.createPollActivityTaskQueueResponse(newTask(s.ns.ID().String()), historyResponse, prtn, metrics.NoopMetricsHandler)
+	token, err = s.matchingEngine.tokenSerializer.Deserialize(resp.TaskToken)
+	s.NoError(err)
+	s.Empty(token.GetSharedTaskQueue())
+}
+
 func (s *matchingEngineSuite) TestAddWorkflowAutoEnable() {
 	tv := testvars.New(s.T()).WithNamespaceID(s.ns.ID())
 	req := &matchingservice.UpdateFairnessStateRequest{
@@ -2732,6 +2884,27 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_LongPoll_Closes() {
 	s.Nil(res.UserData)
 }
 
+func (s *matchingEngineSuite) TestUpdateConcurrencyLimit() {
+	request := &matchingservice.UpdateTaskQueueConfigRequest{
+		NamespaceId: uuid.NewString(),
+		UpdateTaskqueueConfig: &workflowservice.UpdateTaskQueueConfigRequest{
+			TaskQueue:     "concurrency-limited",
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			Identity:      "admin",
+		},
+		UpdateConcurrencyLimit: &persistencespb.TaskQueueConcurrencyLimit{MaxInFlight: 10},
+	}
+	resp, err := s.matchingEngine.UpdateTaskQueueConfig(context.Background(), request)
+	if !s.newMatcher {
+		// the classic matcher doesn't enforce concurrency limits
+		var failedPrecondition *serviceerror.FailedPrecondition
+		s.ErrorAs(err, &failedPrecondition)
+		return
+	}
+	s.NoError(err)
+	s.Equal(int32(10), resp.GetConcurrencyLimit().GetMaxInFlight())
+}
+
 func (s *matchingEngineSuite) TestUpdateUserData_FailsOnKnownVersionMismatch() {
 	namespaceID := namespace.ID(uuid.NewString())
 	tq := "tupac"
@@ -4833,6 +5006,8 @@ type testQueueData struct {
 	info     *persistencespb.TaskQueueInfo
 	tasks    treemap.Map
 	userData *persistencespb.VersionedTaskQueueUserData
+	// subqueue of each task that isn't in subqueue zero
+	subqueues map[fairLevel]int
 
 	testQueuePersistenceStats
 }
@@ -4846,7 +5021,7 @@ type testQueuePersistenceStats struct {
 }
 
 func newTestQueueData() *testQueueData {
-	return &testQueueData{tasks: *newFairLevelTreeMap()}
+	return &testQueueData{tasks: *newFairLevelTreeMap(), subqueues: make(map[fairLevel]int)}
 }
 
 func (q *testQueueData) String() string {
@@ -4978,13 +5153,18 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	keys := tlm.tasks.Keys()
 	for _, key := range keys {
 		level := key.(fairLevel)
+		if tlm.subqueues[level] != request.Subqueue {
+			continue
+		}
 		if m.fairness {
 			if level.less(fairLevel{pass: request.ExclusiveMaxPass, id: request.ExclusiveMaxTaskID}) {
 				tlm.tasks.Remove(level)
+				delete(tlm.subqueues, level)
 			}
 		} else {
 			if level.id < request.ExclusiveMaxTaskID {
 				tlm.tasks.Remove(level)
+				delete(tlm.subqueues, level)
 			}
 		}
 	}
@@ -5078,8 +5258,12 @@ func (m *testTaskManager) CreateTasks(
 	}
 
 	// Then insert all tasks if no errors
-	for _, task := range request.Tasks {
-		tlm.tasks.Put(fairLevelFromAllocatedTask(task), common.CloneProto(task))
+	for i, task := range request.Tasks {
+		level := fairLevelFromAllocatedTask(task)
+		tlm.tasks.Put(level, common.CloneProto(task))
+		if len(request.Subqueues) > 0 && request.Subqueues[i] != 0 {
+			tlm.subqueues[level] = request.Subqueues[i]
+		}
 		tlm.createTaskCount++
 	}
 
@@ -5118,6 +5302,9 @@ func (m *testTaskManager) GetTasks(
 	it := tlm.tasks.Iterator()
 	for it.Next() && len(tasks) < request.PageSize {
 		level := it.Key().(fairLevel)
+		if tlm.subqueues[level] != request.Subqueue {
+			continue
+		}
 		if m.fairness {
 			if level.less(fairLevel{pass: request.InclusiveMinPass, id: request.InclusiveMinTaskID}) {
 				continue
@@ -0,0 +1,312 @@
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+	"go.temporal.io/server/common/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// partitionScaler grows and shrinks the number of partitions of a normal task queue based on the
+	// load observed by its root partition. Decisions are stored in task queue user data so that all
+	// matching nodes (and, through response hints, matching clients) agree on the partition counts.
+	//
+	// Growing changes the read and write counts together. Shrinking is done in two steps: the write
+	// count is lowered first, and the read count follows only once the partitions being removed have
+	// drained their backlog. Clients and child partitions may keep writing to the removed partitions
+	// until they pick up the new write count, so they are checked only after the scale-down delay, and
+	// must be found empty on two evaluations in a row.
+	partitionScaler struct {
+		partition       *tqid.NormalPartition
+		config          *taskQueueConfig
+		userDataManager userDataManager
+		matchingClient  matchingservice.MatchingServiceClient
+		timeSource      clock.TimeSource
+		logger          log.Logger
+		metricsHandler  metrics.Handler
+		// returns the backlog size of the root partition
+		backlogCount func() int64
+
+		addTracker          *taskTracker // tasks added directly to the root partition
+		forwardedAddTracker *taskTracker // tasks forwarded to the root partition by child partitions
+		pollTracker         *taskTracker // polls received directly by the root partition
+
+		// when the partitions being removed were first found drained, zero if they weren't
+		drainedAt time.Time
+	}
+
+	// partitionLoad is the load observed by the root partition over the last taskTracker interval.
+	partitionLoad struct {
+		addRate          float64
+		forwardedAddRate float64
+		pollRate         float64
+		backlog          int64
+	}
+)
+
+func newPartitionScaler(
+	partition *tqid.NormalPartition,
+	config *taskQueueConfig,
+	userDataManager userDataManager,
+	matchingClient matchingservice.MatchingServiceClient,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	backlogCount func() int64,
+) *partitionScaler {
+	return &partitionScaler{
+		partition:           partition,
+		config:              config,
+		userDataManager:     userDataManager,
+		matchingClient:      matchingClient,
+		timeSource:          timeSource,
+		logger:              logger,
+		metricsHandler:      metricsHandler,
+		backlogCount:        backlogCount,
+		addTracker:          newTaskTracker(timeSource),
+		forwardedAddTracker: newTaskTracker(timeSource),
+		pollTracker:         newTaskTracker(timeSource),
+	}
+}
+
+func (s *partitionScaler) recordAdd(forwarded bool) {
+	if forwarded {
+		s.forwardedAddTracker.incrementTaskCount()
+	} else {
+		s.addTracker.incrementTaskCount()
+	}
+}
+
+func (s *partitionScaler) recordPoll() {
+	s.pollTracker.incrementTaskCount()
+}
+
+func (s *partitionScaler) run(ctx context.Context) error {
+	for {
+		interval := s.config.PartitionAutoScalingInterval()
+		if interval <= 0 || !s.config.EnablePartitionAutoScaling() {
+			if err := util.InterruptibleSleep(ctx, time.Minute); err != nil {
+				return err
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff.Jitter(interval, 0.05)):
+			if err := s.evaluate(ctx); err != nil {
+				s.logger.Warn("Failed to evaluate task queue partition count", tag.Error(err))
+			}
+		}
+	}
+}
+
+// evaluate makes one scaling decision and persists it if the partition counts need to change.
+func (s *partitionScaler) evaluate(ctx context.Context) error {
+	userData, _, err := s.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	counts := userData.GetData().GetPerType()[int32(s.partition.TaskType())].GetPartitionCounts()
+
+	// These already reflect counts stored in user data, falling back to dynamic config.
+	write := s.config.NumWritePartitions()
+	read := max(s.config.NumReadPartitions(), write)
+	metrics.AutoScaledWritePartitionsGauge.With(s.metricsHandler).Record(float64(write))
+	metrics.AutoScaledReadPartitionsGauge.With(s.metricsHandler).Record(float64(read))
+
+	lastChange := counts.GetUpdateTime().AsTime()
+	if s.drainedAt.Before(lastChange) {
+		// the partition counts changed since the drain was observed
+		s.drainedAt = time.Time{}
+	}
+
+	desired := s.desiredPartitionCount(write, s.currentLoad())
+	switch {
+	case desired > write:
+		s.drainedAt = time.Time{}
+		return s.setPartitionCounts(ctx, desired, max(read, desired))
+	case read > write:
+		// A previous scale-down is in progress; only drop the read partitions once they are empty.
+		if s.timeSource.Now().Sub(lastChange) < s.config.PartitionAutoScalingScaleDownDelay() {
+			return nil
+		}
+		drained, err := s.isDrained(ctx, write, read)
+		if err != nil || !drained {
+			s.drainedAt = time.Time{}
+			return err
+		}
+		now := s.timeSource.Now()
+		if s.drainedAt.IsZero() {
+			// A late write may still be in flight, check again on the next evaluation.
+			s.drainedAt = now
+			return nil
+		}
+		if now.Sub(s.drainedAt) < s.config.PartitionAutoScalingInterval() {
+			return nil
+		}
+		s.drainedAt = time.Time{}
+		return s.setPartitionCounts(ctx, write, write)
+	case desired < write:
+		if s.timeSource.Now().Sub(lastChange) < s.config.PartitionAutoScalingScaleDownDelay() {
+			return nil
+		}
+		return s.setPartitionCounts(ctx, desired, read)
+	}
+	return nil
+}
+
+func (s *partitionScaler) currentLoad() partitionLoad {
+	return partitionLoad{
+		addRate:          float64(s.addTracker.rate()),
+		forwardedAddRate: float64(s.forwardedAddTracker.rate()),
+		pollRate:         float64(s.pollTracker.rate()),
+		backlog:          s.backlogCount(),
+	}
+}
+
+// desiredPartitionCount returns the number of partitions the task queue should have given the load
+// seen by the root partition while the task queue had current write partitions.
+func (s *partitionScaler) desiredPartitionCount(current int, load partitionLoad) int {
+	// Clients spread calls uniformly over the partitions, so the root partition receives about
+	// 1/current of the direct traffic.
+	desired := current
+	if target := s.config.PartitionAutoScalingTargetRate(); target > 0 {
+		totalRate := max(load.addRate, load.pollRate) * float64(current)
+		desired = int(math.Ceil(totalRate / target))
+	}
+
+	// Child partitions forward tasks to the root when they have no local poller to match them with.
+	// If that's the case for most tasks, the queue does not have enough pollers for its partitions
+	// and adding more would make it worse.
+	var forwardedRatio float64
+	if childRate := load.addRate * float64(current-1); childRate > 0 {
+		forwardedRatio = load.forwardedAddRate / childRate
+	}
+	if forwardedRatio > s.config.PartitionAutoScalingMaxForwardedRatio() {
+		desired = min(desired, current)
+	} else if threshold := s.config.PartitionAutoScalingBacklogPerPartition(); threshold > 0 && load.backlog > int64(threshold) {
+		desired = max(desired, current+1)
+	}
+
+	minPartitions := s.config.PartitionAutoScalingMinPartitions()
+	maxPartitions := max(minPartitions, s.config.PartitionAutoScalingMaxPartitions())
+	return min(max(desired, minPartitions), maxPartitions)
+}
+
+// isDrained returns true if partitions [from, to) have no backlog.
+func (s *partitionScaler) isDrained(ctx context.Context, from, to int) (bool, error) {
+	for i := from; i < to; i++ {
+		resp, err := s.matchingClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+			NamespaceId: s.partition.NamespaceId(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     s.partition.TaskQueue().Name(),
+				TaskQueueType: s.partition.TaskType(),
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			Versions: &taskqueuepb.TaskQueueVersionSelection{
+				Unversioned: true,
+				AllActive:   true,
+			},
+			ReportStats: true,
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, info := range resp.GetVersionsInfoInternal() {
+			if info.GetPhysicalTaskQueueInfo().GetTaskQueueStats().GetApproximateBacklogCount() > 0 {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func (s *partitionScaler) setPartitionCounts(ctx context.Context, write, read int) error {
+	s.logger.Info("Changing task queue partition counts",
+		tag.NewInt("write-partitions", write),
+		tag.NewInt("read-partitions", read))
+	_, err := s.matchingClient.UpdateTaskQueuePartitionCounts(ctx, &matchingservice.UpdateTaskQueuePartitionCountsRequest{
+		NamespaceId:   s.partition.NamespaceId(),
+		TaskQueue:     s.partition.RpcName(),
+		TaskQueueType: s.partition.TaskType(),
+		PartitionCounts: &persistencespb.TaskQueuePartitionCounts{
+			WritePartitions: int32(write),
+			ReadPartitions:  int32(read),
+			UpdateTime:      timestamppb.New(s.timeSource.Now()),
+		},
+	})
+	return err
+}
+
+// autoScaledPartitionCounts returns the partition counts stored in user data by the partition
+// auto-scaler, or nil if there are none.
+func autoScaledPartitionCounts(
+	userDataManager userDataManager,
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	userData, _, err := userDataManager.GetUserData()
+	if err != nil {
+		return nil
+	}
+	counts := userData.GetData().GetPerType()[int32(taskType)].GetPartitionCounts()
+	if counts.GetWritePartitions() <= 0 {
+		return nil
+	}
+	return counts
+}
+
+// useAutoScaledPartitionCounts makes the partition count settings of a normal task queue partition
+// follow the counts chosen by the partition auto-scaler while auto-scaling is enabled. Dynamic config
+// values are used until the auto-scaler has made its first decision.
+func useAutoScaledPartitionCounts(
+	config *taskQueueConfig,
+	userDataManager userDataManager,
+	taskType enumspb.TaskQueueType,
+) {
+	numWritePartitions := config.NumWritePartitions
+	numReadPartitions := config.NumReadPartitions
+	numReadPartitionsSub := config.NumReadPartitionsSub
+
+	getCounts := func() *persistencespb.TaskQueuePartitionCounts {
+		if !config.EnablePartitionAutoScaling() {
+			return nil
+		}
+		return autoScaledPartitionCounts(userDataManager, taskType)
+	}
+	readPartitions := func(dcValue int) int {
+		if counts := getCounts(); counts != nil {
+			return int(max(counts.GetReadPartitions(), counts.GetWritePartitions()))
+		}
+		return dcValue
+	}
+
+	config.NumWritePartitions = func() int {
+		if counts := getCounts(); counts != nil {
+			return int(counts.GetWritePartitions())
+		}
+		return numWritePartitions()
+	}
+	config.NumReadPartitions = func() int {
+		return readPartitions(numReadPartitions())
+	}
+	config.NumReadPartitionsSub = func(cb func(int)) (int, func()) {
+		v, cancel := numReadPartitionsSub(func(v int) { cb(readPartitions(v)) })
+		return readPartitions(v), cancel
+	}
+}
@@ -0,0 +1,249 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type partitionScalerTestEnv struct {
+	scaler         *partitionScaler
+	userDataMgr    *mockUserDataManager
+	matchingClient *matchingservicemock.MockMatchingServiceClient
+	timeSource     *clock.EventTimeSource
+	backlog        int64
+}
+
+func newPartitionScalerTestEnv(t *testing.T, dcWritePartitions int) *partitionScalerTestEnv {
+	f, err := tqid.NewTaskQueueFamily(namespaceID, "tq")
+	require.NoError(t, err)
+	partition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY).RootPartition()
+
+	config := &taskQueueConfig{
+		NumWritePartitions:                      func() int { return dcWritePartitions },
+		NumReadPartitions:                       func() int { return dcWritePartitions },
+		NumReadPartitionsSub:                    func(func(int)) (int, func()) { return dcWritePartitions, func() {} },
+		EnablePartitionAutoScaling:              func() bool { return true },
+		PartitionAutoScalingInterval:            func() time.Duration { return time.Minute },
+		PartitionAutoScalingMinPartitions:       func() int { return 1 },
+		PartitionAutoScalingMaxPartitions:       func() int { return 8 },
+		PartitionAutoScalingTargetRate:          func() float64 { return 100 },
+		PartitionAutoScalingBacklogPerPartition: func() int { return 1000 },
+		PartitionAutoScalingMaxForwardedRatio:   func() float64 { return 0.5 },
+		PartitionAutoScalingScaleDownDelay:      func() time.Duration { return 10 * time.Minute },
+	}
+
+	env := &partitionScalerTestEnv{
+		userDataMgr:    &mockUserDataManager{},
+		matchingClient: matchingservicemock.NewMockMatchingServiceClient(gomock.NewController(t)),
+		timeSource:     clock.NewEventTimeSource().Update(time.Now()),
+	}
+	useAutoScaledPartitionCounts(config, env.userDataMgr, partition.TaskType())
+	env.scaler = newPartitionScaler(
+		partition,
+		config,
+		env.userDataMgr,
+		env.matchingClient,
+		env.timeSource,
+		log.NewNoopLogger(),
+		metrics.NoopMetricsHandler,
+		func() int64 { return env.backlog },
+	)
+	return env
+}
+
+// expectUpdate applies the requested partition counts to user data, like the workflow root
+// partition would.
+func (e *partitionScalerTestEnv) expectUpdate(t *testing.T, write, read int32) {
+	e.matchingClient.EXPECT().UpdateTaskQueuePartitionCounts(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.UpdateTaskQueuePartitionCountsRequest, _ ...grpc.CallOption) (*matchingservice.UpdateTaskQueuePartitionCountsResponse, error) {
+			require.Equal(t, write, req.GetPartitionCounts().GetWritePartitions())
+			require.Equal(t, read, req.GetPartitionCounts().GetReadPartitions())
+			e.setPartitionCounts(req.GetPartitionCounts())
+			return &matchingservice.UpdateTaskQueuePartitionCountsResponse{}, nil
+		})
+}
+
+func (e *partitionScalerTestEnv) setPartitionCounts(counts *persistencespb.TaskQueuePartitionCounts) {
+	e.userDataMgr.Lock()
+	defer e.userDataMgr.Unlock()
+	e.userDataMgr.data = &persistencespb.VersionedTaskQueueUserData{
+		Data: &persistencespb.TaskQueueUserData{
+			PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+				int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {PartitionCounts: counts},
+			},
+		},
+		Version: e.userDataMgr.data.GetVersion() + 1,
+	}
+}
+
+func (e *partitionScalerTestEnv) expectBacklog(t *testing.T, partitionID int32, backlog int64) {
+	e.matchingClient.EXPECT().DescribeTaskQueuePartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.DescribeTaskQueuePartitionRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+			require.Equal(t, partitionID, req.GetTaskQueuePartition().GetNormalPartitionId())
+			return &matchingservice.DescribeTaskQueuePartitionResponse{
+				VersionsInfoInternal: map[string]*taskqueuespb.TaskQueueVersionInfoInternal{
+					"": {PhysicalTaskQueueInfo: &taskqueuespb.PhysicalTaskQueueInfo{
+						TaskQueueStats: &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: backlog},
+					}},
+				},
+			}, nil
+		})
+}
+
+func TestPartitionScaler_DesiredPartitionCount(t *testing.T) {
+	t.Parallel()
+	s := newPartitionScalerTestEnv(t, 4).scaler
+
+	testCases := []struct {
+		name     string
+		current  int
+		load     partitionLoad
+		expected int
+	}{
+		{name: "idle", current: 4, load: partitionLoad{}, expected: 1},
+		{name: "at target", current: 4, load: partitionLoad{addRate: 100}, expected: 4},
+		{name: "poll rate counts", current: 4, load: partitionLoad{addRate: 10, pollRate: 150}, expected: 6},
+		{name: "over target", current: 4, load: partitionLoad{addRate: 150}, expected: 6},
+		{name: "clamped to max", current: 4, load: partitionLoad{addRate: 1000}, expected: 8},
+		{name: "backlog grows by one", current: 2, load: partitionLoad{addRate: 10, backlog: 5000}, expected: 3},
+		{
+			name:     "mostly forwarded does not grow",
+			current:  4,
+			load:     partitionLoad{addRate: 150, forwardedAddRate: 300, backlog: 5000},
+			expected: 4,
+		},
+		{
+			name:     "mostly forwarded can shrink",
+			current:  4,
+			load:     partitionLoad{addRate: 10, forwardedAddRate: 20},
+			expected: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, s.desiredPartitionCount(tc.current, tc.load))
+		})
+	}
+}
+
+func TestPartitionScaler_Grow(t *testing.T) {
+	t.Parallel()
+	env := newPartitionScalerTestEnv(t, 2)
+	env.backlog = 5000
+
+	// grows from the dynamic config count
+	env.expectUpdate(t, 3, 3)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 3, env.scaler.config.NumWritePartitions())
+	require.Equal(t, 3, env.scaler.config.NumReadPartitions())
+
+	// and then from the count in user data
+	env.expectUpdate(t, 4, 4)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 4, env.scaler.config.NumWritePartitions())
+}
+
+func TestPartitionScaler_Shrink(t *testing.T) {
+	t.Parallel()
+	env := newPartitionScalerTestEnv(t, 1)
+	env.setPartitionCounts(&persistencespb.TaskQueuePartitionCounts{
+		WritePartitions: 3,
+		ReadPartitions:  3,
+		UpdateTime:      timestamppb.New(env.timeSource.Now()),
+	})
+
+	// nothing happens within the scale-down delay
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+
+	// write partitions go first
+	env.timeSource.Update(env.timeSource.Now().Add(11 * time.Minute))
+	env.expectUpdate(t, 1, 3)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 1, env.scaler.config.NumWritePartitions())
+	require.Equal(t, 3, env.scaler.config.NumReadPartitions())
+
+	// removed partitions are not checked within the scale-down delay, clients may still write to them
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 3, env.scaler.config.NumReadPartitions())
+
+	// read partitions stay while the removed partitions have backlog
+	env.timeSource.Update(env.timeSource.Now().Add(11 * time.Minute))
+	env.expectBacklog(t, 1, 0)
+	env.expectBacklog(t, 2, 10)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 3, env.scaler.config.NumReadPartitions())
+
+	// and follow once they are found drained twice
+	env.expectBacklog(t, 1, 0)
+	env.expectBacklog(t, 2, 0)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 3, env.scaler.config.NumReadPartitions())
+
+	env.timeSource.Update(env.timeSource.Now().Add(time.Minute))
+	env.expectBacklog(t, 1, 0)
+	env.expectBacklog(t, 2, 0)
+	env.expectUpdate(t, 1, 1)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 1, env.scaler.config.NumReadPartitions())
+}
+
+func TestPartitionScaler_ShrinkLateWrite(t *testing.T) {
+	t.Parallel()
+	env := newPartitionScalerTestEnv(t, 1)
+	env.setPartitionCounts(&persistencespb.TaskQueuePartitionCounts{
+		WritePartitions: 1,
+		ReadPartitions:  2,
+		UpdateTime:      timestamppb.New(env.timeSource.Now()),
+	})
+	env.timeSource.Update(env.timeSource.Now().Add(11 * time.Minute))
+
+	// the removed partition is empty at first
+	env.expectBacklog(t, 1, 0)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+
+	// but a client that hadn't picked up the new write count added a task to it
+	env.timeSource.Update(env.timeSource.Now().Add(time.Minute))
+	env.expectBacklog(t, 1, 1)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 2, env.scaler.config.NumReadPartitions())
+
+	// once it drained, the drain has to be confirmed again
+	env.timeSource.Update(env.timeSource.Now().Add(time.Minute))
+	env.expectBacklog(t, 1, 0)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 2, env.scaler.config.NumReadPartitions())
+
+	env.timeSource.Update(env.timeSource.Now().Add(time.Minute))
+	env.expectBacklog(t, 1, 0)
+	env.expectUpdate(t, 1, 1)
+	require.NoError(t, env.scaler.evaluate(context.Background()))
+	require.Equal(t, 1, env.scaler.config.NumReadPartitions())
+}
+
+func TestPartitionScaler_Disabled(t *testing.T) {
+	t.Parallel()
+	env := newPartitionScalerTestEnv(t, 2)
+	env.setPartitionCounts(&persistencespb.TaskQueuePartitionCounts{WritePartitions: 5, ReadPartitions: 6})
+	require.Equal(t, 5, env.scaler.config.NumWritePartitions())
+	require.Equal(t, 6, env.scaler.config.NumReadPartitions())
+
+	env.scaler.config.EnablePartitionAutoScaling = func() bool { return false }
+	require.Equal(t, 2, env.scaler.config.NumWritePartitions())
+	require.Equal(t, 2, env.scaler.config.NumReadPartitions())
+}
@@ -163,6 +163,13 @@ func newPhysicalTaskQueueManager(
 
 	pqMgr.pollerHistory = newPollerHistory(partitionMgr.config.PollerHistoryTTL())
 
+	var affinity *activityAffinity
+	// Tasks with an affinity key are added to the root partition, which never forwards them, so
+	// only the root holds them back.
+	if queue.TaskType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY && queue.Partition().IsRoot() {
+		affinity = newActivityAffinity(config, pqMgr.pollerHistory)
+	}
+
 	pqMgr.liveness = newLiveness(
 		clock.NewRealTimeSource(),
 		config.MaxTaskQueueIdleTime,
@@ -212,6 +219,8 @@ func newPhysicalTaskQueueManager(
 			pqMgr.logger,
 			newFairMetricsHandler(taggedMetricsHandler),
 			partitionMgr.rateLimitManager,
+			partitionMgr.concurrencyLimiter,
+			affinity,
 			pqMgr.MarkAlive,
 		)
 		pqMgr.matcher = pqMgr.priMatcher
@@ -251,6 +260,8 @@ func newPhysicalTaskQueueManager(
 			pqMgr.logger,
 			newPriMetricsHandler(taggedMetricsHandler),
 			partitionMgr.rateLimitManager,
+			partitionMgr.concurrencyLimiter,
+			affinity,
 			pqMgr.MarkAlive,
 		)
 		pqMgr.matcher = pqMgr.priMatcher
@@ -569,6 +580,13 @@ func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
 	c.matcher.ReprocessAllTasks()
 }
 
+func (c *physicalTaskQueueManagerImpl) ScanBacklog(visit func(*persistencespb.AllocatedTaskInfo) bool) error {
+	if err := c.backlogMgr.WaitUntilInitialized(c.tqCtx); err != nil {
+		return err
+	}
+	return c.backlogMgr.getDB().ScanTasks(c.tqCtx, c.config.EnableFairness, c.config.GetTasksBatchSize(), visit)
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -678,6 +696,19 @@ func (c *physicalTaskQueueManagerImpl) GetStatsByPriority(includeRates bool) map
 	return stats
 }
 
+func (c *physicalTaskQueueManagerImpl) GetStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats {
+	stats := c.backlogMgr.BacklogStatsByLabelSet()
+	if m := c.getDrainBacklogMgr(); m != nil {
+		for labels, tqs := range m.BacklogStatsByLabelSet() {
+			if stats == nil {
+				stats = make(map[string]*taskqueuepb.TaskQueueStats)
+			}
+			mergeStats(util.GetOrSetNew(stats, labels), tqs)
+		}
+	}
+	return stats
+}
+
 func (c *physicalTaskQueueManagerImpl) GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus {
 	status := c.backlogMgr.InternalStatus()
 	if m := c.getDrainBacklogMgr(); m != nil {
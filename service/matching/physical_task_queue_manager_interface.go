@@ -42,6 +42,9 @@ type (
 		AddSpooledTask(task *internalTask) error
 		AddSpooledTaskToMatcher(task *internalTask) error
 		UserDataChanged()
+		// ScanBacklog calls visit with the backlog tasks in the database that have not been acked,
+		// until visit returns false. It doesn't include the backlog that is being drained.
+		ScanBacklog(visit func(*persistencespb.AllocatedTaskInfo) bool) error
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskId string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
@@ -54,6 +57,8 @@ type (
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStatsByPriority(includeRates bool) map[int32]*taskqueuepb.TaskQueueStats
+		// GetStatsByLabelSet returns backlog stats of tasks with required labels, keyed by label set.
+		GetStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats
 		GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus
 		UnloadFromPartitionManager(unloadCause)
 		QueueKey() *PhysicalTaskQueueKey
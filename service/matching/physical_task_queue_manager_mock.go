@@ -171,6 +171,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetInternalTaskQueueStatus()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalTaskQueueStatus", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetInternalTaskQueueStatus))
 }
 
+// GetStatsByLabelSet mocks base method.
+func (m *MockphysicalTaskQueueManager) GetStatsByLabelSet() map[string]*taskqueue.TaskQueueStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsByLabelSet")
+	ret0, _ := ret[0].(map[string]*taskqueue.TaskQueueStats)
+	return ret0
+}
+
+// GetStatsByLabelSet indicates an expected call of GetStatsByLabelSet.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetStatsByLabelSet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsByLabelSet", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetStatsByLabelSet))
+}
+
 // GetStatsByPriority mocks base method.
 func (m *MockphysicalTaskQueueManager) GetStatsByPriority(includeRates bool) map[int32]*taskqueue.TaskQueueStats {
 	m.ctrl.T.Helper()
@@ -294,6 +308,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) ReprocessRedirectedTasksAfte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprocessRedirectedTasksAfterStop", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ReprocessRedirectedTasksAfterStop))
 }
 
+// ScanBacklog mocks base method.
+func (m *MockphysicalTaskQueueManager) ScanBacklog(visit func(*persistence.AllocatedTaskInfo) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanBacklog", visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanBacklog indicates an expected call of ScanBacklog.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) ScanBacklog(visit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanBacklog", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).ScanBacklog), visit)
+}
+
 // SetupDraining mocks base method.
 func (m *MockphysicalTaskQueueManager) SetupDraining() {
 	m.ctrl.T.Helper()
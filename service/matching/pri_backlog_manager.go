@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/softassert"
+	"go.temporal.io/server/common/tasklabels"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -54,8 +55,9 @@ type (
 
 		subqueueLock        sync.Mutex
 		subqueues           []*priTaskReader // subqueue index -> fairTaskReader
-		subqueuesByPriority map[priorityKey]subqueueIndex
+		subqueuesByKey      map[subqueueKey]subqueueIndex
 		priorityBySubqueue  map[subqueueIndex]priorityKey
+		labelSetsBySubqueue map[subqueueIndex]string
 
 		logger           log.Logger
 		throttledLogger  log.ThrottledLogger
@@ -68,6 +70,13 @@ type (
 	}
 
 	priorityKey int32
+
+	// subqueueKey identifies the backlog subqueue of a task: tasks with different priorities or
+	// required labels go to different subqueues so that they don't block each other.
+	subqueueKey struct {
+		priority priorityKey
+		labels   string // tasklabels.Key of the required labels
+	}
 )
 
 var _ backlogManager = (*priBacklogManagerImpl)(nil)
@@ -89,8 +98,9 @@ func newPriBacklogManager(
 		tqCtx:               tqCtx,
 		isDraining:          isDraining,
 		db:                  newTaskQueueDB(config, taskManager, pqMgr.QueueKey(), logger, metricsHandler, isDraining),
-		subqueuesByPriority: make(map[priorityKey]subqueueIndex),
+		subqueuesByKey:      make(map[subqueueKey]subqueueIndex),
 		priorityBySubqueue:  make(map[subqueueIndex]priorityKey),
+		labelSetsBySubqueue: make(map[subqueueIndex]string),
 		matchingClient:      matchingClient,
 		metricsHandler:      metricsHandler,
 		logger:              logger,
@@ -181,26 +191,58 @@ func (c *priBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.S
 			r.Start()
 			c.subqueues = append(c.subqueues, r)
 		}
-		c.subqueuesByPriority[priorityKey(subqueues[i].Key.Priority)] = subqueueIndex(i)
-		c.priorityBySubqueue[subqueueIndex(i)] = priorityKey(subqueues[i].Key.Priority)
+		key := subqueueKeyFromPersistence(subqueues[i].Key)
+		c.subqueuesByKey[key] = subqueueIndex(i)
+		c.priorityBySubqueue[subqueueIndex(i)] = key.priority
+		c.labelSetsBySubqueue[subqueueIndex(i)] = key.labels
+	}
+}
+
+func subqueueKeyFromPersistence(key *persistencespb.SubqueueKey) subqueueKey {
+	return subqueueKey{
+		priority: priorityKey(key.GetPriority()),
+		labels:   tasklabels.Key(key.GetRequiredLabels()),
+	}
+}
+
+func countLabeledSubqueues(labelSetsBySubqueue map[subqueueIndex]string) (count int) {
+	for _, labels := range labelSetsBySubqueue {
+		if labels != "" {
+			count++
+		}
 	}
+	return
 }
 
-func (c *priBacklogManagerImpl) getSubqueueForPriority(priority priorityKey) subqueueIndex {
-	priority = c.config.clipPriority(priority)
+func (c *priBacklogManagerImpl) getSubqueue(priority priorityKey, requiredLabels []string) subqueueIndex {
+	key := subqueueKey{
+		priority: c.config.clipPriority(priority),
+		labels:   tasklabels.Key(requiredLabels),
+	}
 
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
 
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
+	if key.labels != "" && countLabeledSubqueues(c.labelSetsBySubqueue) >= c.config.MaxLabelSetSubqueues() {
+		// Too many distinct label sets: share the subqueue without labels. Tasks in it still
+		// only match pollers that have their labels.
+		key.labels = ""
+		requiredLabels = nil
+		if i, ok := c.subqueuesByKey[key]; ok {
+			return i
+		}
+	}
+
 	// We need to allocate a new subqueue. Note this is doing io under subqueueLock,
 	// but we want to serialize these updates.
 	// TODO(pri): maybe we can improve that
 	subqueues, err := c.db.AllocateSubqueue(c.tqCtx, &persistencespb.SubqueueKey{
-		Priority: int32(priority),
+		Priority:       int32(key.priority),
+		RequiredLabels: requiredLabels,
 	})
 	if err != nil {
 		c.signalIfFatal(err)
@@ -211,9 +253,9 @@ func (c *priBacklogManagerImpl) getSubqueueForPriority(priority priorityKey) sub
 
 	c.loadSubqueuesLocked(subqueues)
 
-	// After AllocateSubqueue added a subqueue for this priority, and we merged the result into
+	// After AllocateSubqueue added a subqueue for this key, and we merged the result into
 	// our state with loadSubqueuesLocked, this lookup should now find a subqueue.
-	if i, ok := c.subqueuesByPriority[priority]; ok {
+	if i, ok := c.subqueuesByKey[key]; ok {
 		return i
 	}
 
@@ -241,7 +283,7 @@ func (c *priBacklogManagerImpl) periodicSync() {
 }
 
 func (c *priBacklogManagerImpl) SpoolTask(taskInfo *persistencespb.TaskInfo) error {
-	subqueue := c.getSubqueueForPriority(priorityKey(taskInfo.Priority.GetPriorityKey()))
+	subqueue := c.getSubqueue(priorityKey(taskInfo.Priority.GetPriorityKey()), taskInfo.RequiredLabels)
 	err := c.taskWriter.appendTask(subqueue, taskInfo)
 	c.signalIfFatal(err)
 	return err
@@ -303,6 +345,36 @@ func (c *priBacklogManagerImpl) BacklogStatsByPriority() map[int32]*taskqueuepb.
 	return result
 }
 
+func (c *priBacklogManagerImpl) BacklogStatsByLabelSet() map[string]*taskqueuepb.TaskQueueStats {
+	c.subqueueLock.Lock()
+	defer c.subqueueLock.Unlock()
+
+	result := make(map[string]*taskqueuepb.TaskQueueStats)
+	backlogCounts := c.db.getApproximateBacklogCountsBySubqueue()
+	for subqueueIdx, labels := range c.labelSetsBySubqueue {
+		if labels == "" {
+			continue
+		}
+
+		// There is one subqueue per priority for the same label set.
+		stats := result[labels]
+		if stats == nil {
+			stats = &taskqueuepb.TaskQueueStats{ApproximateBacklogAge: durationpb.New(0)}
+			result[labels] = stats
+		}
+		stats.ApproximateBacklogCount += backlogCounts[subqueueIdx]
+
+		oldestBacklogTime := c.subqueues[subqueueIdx].getOldestBacklogTime()
+		if !oldestBacklogTime.IsZero() {
+			oldestBacklogAge := time.Since(oldestBacklogTime)
+			if oldestBacklogAge > stats.ApproximateBacklogAge.AsDuration() {
+				stats.ApproximateBacklogAge = durationpb.New(oldestBacklogAge)
+			}
+		}
+	}
+	return result
+}
+
 func (c *priBacklogManagerImpl) BacklogStatus() *taskqueuepb.TaskQueueStatus {
 	c.subqueueLock.Lock()
 	defer c.subqueueLock.Unlock()
@@ -431,6 +503,8 @@ func (c *priBacklogManagerImpl) FinalGC() {
 
 func (c *priBacklogManagerImpl) setPriority(task *internalTask) {
 	c.config.setDefaultPriority(task)
+	// backlog tasks may age up to the highest priority level
+	task.maxAgingBoost = max(0, task.effectivePriority/effectivePriorityFactor-1)
 	if c.isDraining {
 		// draining goes before active backlog so we're guaranteed to finish migration
 		task.effectivePriority -= effectivePriorityFactor * maxPriorityLevels
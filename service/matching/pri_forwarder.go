@@ -90,13 +90,15 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				VersionDirective:       task.event.Data.GetVersionDirective(),
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
+				RequiredLabels:         task.event.Data.GetRequiredLabels(),
 			},
 		)
 	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
 		_, err = f.client.AddActivityTask(
 			ctx, &matchingservice.AddActivityTaskRequest{
-				NamespaceId: task.event.Data.GetNamespaceId(),
-				Execution:   task.workflowExecution(),
+				NamespaceId:       f.partition.NamespaceId(),
+				SourceNamespaceId: sharedTaskQueueSourceNamespaceID(f.partition, task),
+				Execution:         task.workflowExecution(),
 				TaskQueue: &taskqueuepb.TaskQueue{
 					Name: target.RpcName(),
 					Kind: f.partition.Kind(),
@@ -109,6 +111,8 @@ func (f *priForwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 				Stamp:                  task.event.Data.GetStamp(),
 				Priority:               task.event.Data.GetPriority(),
 				ComponentRef:           task.event.Data.GetComponentRef(),
+				RequiredLabels:         task.event.Data.GetRequiredLabels(),
+				AffinityKey:            task.event.Data.GetAffinityKey(),
 			},
 		)
 	default:
@@ -44,6 +44,9 @@ type priTaskMatcher struct {
 	logger           log.Logger
 	markAlive        func() // function to mark the physical task queue alive
 
+	// removes the listener that rematches tasks when concurrency slots are released
+	removeConcurrencyListener func()
+
 	priorityBacklogForwarders *goro.KeyedSet[remotePriorityBacklog]
 }
 
@@ -55,6 +58,7 @@ type waitingPoller struct {
 	queryOnly       bool            // if true, poller can be given only query task, otherwise any task
 	isTaskForwarder bool
 	isTaskValidator bool
+	identity        pollerIdentity // identity of the worker, empty for task forwarders and validators
 }
 
 type matchResult struct {
@@ -101,11 +105,13 @@ func newPriTaskMatcher(
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 	rateLimitManager *rateLimitManager,
+	concurrencyLimiter *concurrencyLimiter,
+	affinity *activityAffinity,
 	markAlive func(),
 ) *priTaskMatcher {
 	tm := &priTaskMatcher{
 		config:                    config,
-		data:                      newMatcherData(config, logger, clock.NewRealTimeSource(), fwdr != nil, rateLimitManager),
+		data:                      newMatcherData(config, logger, clock.NewRealTimeSource(), fwdr != nil, rateLimitManager, concurrencyLimiter, affinity),
 		tqCtx:                     tqCtx,
 		logger:                    logger,
 		metricsHandler:            metricsHandler,
@@ -128,6 +134,10 @@ func (tm *priTaskMatcher) Start() {
 	retrier := backoff.NewRetrier(policy, clock.NewRealTimeSource())
 	lim := quotas.NewDefaultOutgoingRateLimiter(tm.config.ForwarderMaxRatePerSecond)
 
+	tm.removeConcurrencyListener = tm.data.concurrencyLimiter.addListener(tm.data.rematchAfterTimer)
+
+	go tm.ageTasks()
+
 	if tm.fwdr == nil {
 		// Root/sticky doesn't forward. But it does need something to validate tasks.
 		go tm.validateTasksOnRoot(retrier)
@@ -152,9 +162,33 @@ func (tm *priTaskMatcher) Start() {
 	}
 }
 
+// ageTasks periodically raises the priority of waiting backlog tasks so that low-priority tasks
+// are not starved by a steady stream of higher-priority ones.
+func (tm *priTaskMatcher) ageTasks() {
+	for {
+		interval := tm.config.PriorityAgingInterval()
+		// Check a few times per interval so tasks don't wait much longer than the interval
+		// before gaining a level.
+		wait := time.Minute
+		if interval > 0 {
+			wait = max(interval/4, time.Second)
+		}
+		if util.InterruptibleSleep(tm.tqCtx, wait) != nil {
+			return
+		}
+		if interval > 0 {
+			tm.data.AgeTasks(interval)
+		}
+	}
+}
+
 func (tm *priTaskMatcher) Stop() {
 	tm.data.Stop()
 
+	if tm.removeConcurrencyListener != nil {
+		tm.removeConcurrencyListener()
+	}
+
 	tm.priorityBacklogForwarders.Sync(nil, nil)
 
 	// When we're stopping, sync tasks and pollers will be cancelled by tqCtx being canceled.
@@ -322,6 +356,7 @@ func (tm *priTaskMatcher) forwardPolls(
 			pmCopy.conditions = &matchingservice.PollConditions{
 				MinPriority: int32(targetPriority),
 				NoWait:      true,
+				Labels:      meta.conditions.GetLabels(),
 			}
 			meta = &pmCopy
 		}
@@ -603,11 +638,13 @@ func (tm *priTaskMatcher) poll(
 		}
 	}()
 
+	identity, _ := ctx.Value(identityKey).(string)
 	poller := &waitingPoller{
 		startTime:    start,
 		queryOnly:    queryOnly,
 		forwardCtx:   ctx,
 		pollMetadata: pollMetadata,
+		identity:     pollerIdentity(identity),
 	}
 
 	var res *matchResult
@@ -680,6 +717,13 @@ func getInvalidTaskTag(task *internalTask) metrics.Tag {
 	return metrics.TaskInvalidTag
 }
 
+func (p *waitingPoller) labels() []string {
+	if p.pollMetadata == nil {
+		return nil
+	}
+	return p.pollMetadata.conditions.GetLabels()
+}
+
 func (p *waitingPoller) minPriority() priorityKey {
 	if p.pollMetadata == nil || p.pollMetadata.conditions == nil {
 		return 0
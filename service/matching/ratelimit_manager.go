@@ -209,6 +209,8 @@ func (r *rateLimitManager) GetRateLimiter() quotas.RateLimiter {
 func (r *rateLimitManager) UserDataChanged() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	// The read partition count is kept in user data when partition auto-scaling is enabled.
+	r.numReadPartitions = max(r.config.NumReadPartitions(), 1)
 	// Fetch the latest user data and update the API-configured RPS.
 	r.trySetRPSFromUserDataLocked()
 	r.computeAndApplyRateLimitLocked()
@@ -0,0 +1,97 @@
+package matching
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/sharedtaskqueue"
+	"go.temporal.io/server/common/tqid"
+)
+
+// sharedTaskQueueBinding returns the binding of the task queue of an activity task added by
+// history. Tasks that were already redirected or forwarded are not redirected again.
+func (e *matchingEngineImpl) sharedTaskQueueBinding(
+	addRequest *matchingservice.AddActivityTaskRequest,
+	partition tqid.Partition,
+) (sharedtaskqueue.Binding, bool) {
+	bindings := e.config.SharedTaskQueues()
+	if len(bindings) == 0 ||
+		addRequest.GetSourceNamespaceId() != "" ||
+		addRequest.GetForwardInfo() != nil ||
+		partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return sharedtaskqueue.Binding{}, false
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(addRequest.GetNamespaceId()))
+	if err != nil {
+		// The namespace is validated when the partition is loaded.
+		return sharedtaskqueue.Binding{}, false
+	}
+	return sharedtaskqueue.Find(bindings, nsName.String(), partition.TaskQueue().Name())
+}
+
+// addActivityTaskToSharedTaskQueue adds an activity task to the shared task queue its task queue is
+// bound to. The task keeps the namespace of its workflow, and its fairness key is set to that
+// namespace so that the shared task queue dispatches tasks fairly across source namespaces.
+func (e *matchingEngineImpl) addActivityTaskToSharedTaskQueue(
+	ctx context.Context,
+	addRequest *matchingservice.AddActivityTaskRequest,
+	binding sharedtaskqueue.Binding,
+) (string, error) {
+	sharedNamespaceID, err := e.namespaceRegistry.GetNamespaceID(namespace.Name(binding.SharedNamespace))
+	if err != nil {
+		return "", err
+	}
+	request := common.CloneProto(addRequest)
+	request.NamespaceId = sharedNamespaceID.String()
+	request.SourceNamespaceId = addRequest.GetNamespaceId()
+	request.TaskQueue = &taskqueuepb.TaskQueue{
+		Name: binding.SharedTaskQueue,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	if request.Priority == nil {
+		request.Priority = &commonpb.Priority{}
+	}
+	request.Priority.FairnessKey = binding.Namespace
+	resp, err := e.matchingRawClient.AddActivityTask(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	return resp.GetAssignedBuildId(), nil
+}
+
+// sharedTaskQueueNamespaceID returns the shared namespace of a partition of a shared task queue
+// that a task of the given namespace was dispatched from, or an empty string if there is none.
+func (e *matchingEngineImpl) sharedTaskQueueNamespaceID(namespaceID string, partition tqid.Partition) string {
+	bindings := e.config.SharedTaskQueues()
+	if len(bindings) == 0 || partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL {
+		return ""
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+	if err != nil {
+		return ""
+	}
+	binding, ok := sharedtaskqueue.FindShared(bindings, nsName.String(), partition.TaskQueue().Name())
+	if !ok {
+		return ""
+	}
+	sharedNamespaceID, err := e.namespaceRegistry.GetNamespaceID(namespace.Name(binding.SharedNamespace))
+	if err != nil {
+		return ""
+	}
+	return sharedNamespaceID.String()
+}
+
+// sharedTaskQueueSourceNamespaceID returns the namespace of a task of a shared task queue to be
+// set as source namespace when the task is added to another partition, or an empty string if the
+// task is of the namespace of its partition.
+func sharedTaskQueueSourceNamespaceID(partition tqid.Partition, task *internalTask) string {
+	if namespaceID := task.event.Data.GetNamespaceId(); namespaceID != partition.NamespaceId() {
+		return namespaceID
+	}
+	return ""
+}
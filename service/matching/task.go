@@ -69,6 +69,9 @@ type (
 		pollerScalingDecision *taskqueuepb.PollerScalingDecision
 		recycleToken          func(*internalTask)
 		removeFromMatcher     atomic.Pointer[func()]
+		// concurrencySlot is set if the task took a slot of the task queue concurrency limit
+		// when it was matched.
+		concurrencySlot *concurrencySlot
 		// taskDispatchRevisionNumber represents the revision number used by the task and is
 		// max(taskDirectiveRevisionNumber, routingConfigRevisionNumber) for the task.
 		taskDispatchRevisionNumber    int64
@@ -84,6 +87,11 @@ type (
 		// The scale of effectivePriority is 10× the normal scale to allow inserting forwards
 		// in between priority levels.
 		effectivePriority priorityKey
+		// agingBoost is the number of levels effectivePriority has been raised by priority
+		// aging. maxAgingBoost is the most it may be raised, so that aging never moves a task
+		// past the highest priority level. Tasks with maxAgingBoost == 0 do not age.
+		agingBoost        priorityKey
+		maxAgingBoost     priorityKey
 		pollForwarderType pollForwarderType
 	}
 
@@ -206,6 +214,22 @@ func (task *internalTask) isPollForwarder() bool {
 	return task.pollForwarderType != notPollForwarder
 }
 
+// requiredLabels returns the labels a poller must have to receive this task.
+func (task *internalTask) requiredLabels() []string {
+	if task.event == nil {
+		return nil
+	}
+	return task.event.Data.GetRequiredLabels()
+}
+
+// affinityKey returns the key of the worker affinity of this task, or an empty string.
+func (task *internalTask) affinityKey() string {
+	if task.event == nil {
+		return ""
+	}
+	return task.event.Data.GetAffinityKey()
+}
+
 // isQuery returns true if the underlying task is a query task
 func (task *internalTask) isQuery() bool {
 	return task.query != nil
@@ -322,7 +346,8 @@ func (task *internalTask) setEvicted() {
 // If the task took a rate limit token and didn't "use" it by actually dispatching the task,
 // finish will be called with wasValid=false and task.recycleToken=clockedRateLimiter.RecycleToken,
 // so finish will call the rate limiter's RecycleToken to give the unused token back to any process
-// that is waiting on the token, if one exists.
+// that is waiting on the token, if one exists. Likewise, a concurrency slot held by the task is
+// released.
 func (task *internalTask) finish(err error, wasValid bool) {
 	res := taskResponse{startErr: err}
 	task.finishInternal(res, wasValid)
@@ -338,6 +363,9 @@ func (task *internalTask) finishInternal(res taskResponse, wasValid bool) {
 	if !wasValid && task.recycleToken != nil {
 		task.recycleToken(task)
 	}
+	if !wasValid && task.concurrencySlot != nil {
+		task.concurrencySlot.release()
+	}
 
 	switch {
 	case task.responseC != nil:
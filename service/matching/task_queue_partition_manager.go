@@ -87,10 +87,21 @@ type (
 
 		// rateLimitManager is used to manage the rate limit for task queues.
 		rateLimitManager *rateLimitManager
+		// concurrencyLimiter limits the number of in-flight tasks. Only set for normal activity
+		// partitions.
+		concurrencyLimiter *concurrencyLimiter
+		// backlogLimiter rejects new tasks when the backlog is over its limit. Only set for normal
+		// partitions.
+		backlogLimiter *backlogLimiter
+		// backlogPurger applies backlog purges. Only set for normal partitions.
+		backlogPurger *backlogPurger
 
 		// loadTime tracks when this partition manager was started, used to prevent
 		// false positives in the no-recent-poller metric for newly loaded queues
 		loadTime time.Time
+
+		// partitionScaler is non-nil only for root partitions of normal task queues.
+		partitionScaler *partitionScaler
 	}
 )
 
@@ -114,6 +125,9 @@ func newTaskQueuePartitionManager(
 	metricsHandler metrics.Handler,
 	userDataManager userDataManager,
 ) (*taskQueuePartitionManagerImpl, error) {
+	if partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL {
+		useAutoScaledPartitionCounts(tqConfig, userDataManager, partition.TaskType())
+	}
 	rateLimitManager := newRateLimitManager(
 		userDataManager,
 		tqConfig,
@@ -135,11 +149,32 @@ func newTaskQueuePartitionManager(
 	}
 	pm.initCtx, pm.initCancel = context.WithCancel(context.Background())
 
+	if partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		pm.concurrencyLimiter = newConcurrencyLimiter(userDataManager, tqConfig, partition, e.timeSource, metricsHandler)
+	}
+
+	if partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL {
+		pm.backlogLimiter = newBacklogLimiter(tqConfig, partition, e.timeSource, metricsHandler, pm.backlogStats)
+		pm.backlogPurger = newBacklogPurger(pm)
+	}
+
 	if pm.partition.IsRoot() {
 		pm.cache = cache.New(10000, &cache.Options{
 			TTL: max(1, tqConfig.TaskQueueInfoByBuildIdTTL())}, // ensure TTL is never zero (which would disable TTL)
 		)
 	}
+	if normalPartition, ok := partition.(*tqid.NormalPartition); ok && normalPartition.IsRoot() {
+		pm.partitionScaler = newPartitionScaler(
+			normalPartition,
+			tqConfig,
+			userDataManager,
+			e.matchingRawClient,
+			e.timeSource,
+			logger,
+			metricsHandler,
+			pm.approximateBacklogCount,
+		)
+	}
 
 	return pm, nil
 }
@@ -197,7 +232,11 @@ func (pm *taskQueuePartitionManagerImpl) initialize() (retErr error) {
 	}
 	pm.defaultQueueFuture.Set(defaultQ, nil)
 	defaultQ.Start()
+	pm.backlogPurger.StartScans(defaultQ)
 	pm.goroGroup.Go(pm.updateEphemeralData)
+	if pm.partitionScaler != nil {
+		pm.goroGroup.Go(pm.partitionScaler.run)
+	}
 	return nil
 }
 
@@ -243,8 +282,9 @@ func (pm *taskQueuePartitionManagerImpl) Stop(unloadCause unloadCause) {
 	// Then, stop user data manager to wrap up any reads/writes.
 	pm.userDataManager.Stop()
 
-	// Finally, stop rate limit manager (used by queues and using user data manager).
+	// Finally, stop rate limit manager and concurrency limiter (used by queues and using user data manager).
 	pm.rateLimitManager.Stop()
+	pm.concurrencyLimiter.Stop()
 
 	pm.engine.updateTaskQueuePartitionGauge(pm.Namespace(), pm.partition, -1)
 
@@ -255,6 +295,14 @@ func (pm *taskQueuePartitionManagerImpl) GetRateLimitManager() *rateLimitManager
 	return pm.rateLimitManager
 }
 
+func (pm *taskQueuePartitionManagerImpl) GetConcurrencyLimiter() *concurrencyLimiter {
+	return pm.concurrencyLimiter
+}
+
+func (pm *taskQueuePartitionManagerImpl) GetBacklogLimiter() *backlogLimiter {
+	return pm.backlogLimiter
+}
+
 func (pm *taskQueuePartitionManagerImpl) Namespace() *namespace.Namespace {
 	return pm.ns
 }
@@ -313,6 +361,9 @@ func (pm *taskQueuePartitionManagerImpl) AddTask(
 	directive := params.taskInfo.GetVersionDirective()
 
 	pm.autoEnableIfNeeded(ctx, params)
+	if pm.partitionScaler != nil {
+		pm.partitionScaler.recordAdd(params.forwardInfo != nil)
+	}
 	// spoolQueue will be nil iff task is forwarded.
 reredirectTask:
 	spoolQueue, syncMatchQueue, _, taskDispatchRevisionNumber, targetVersion, err := pm.getPhysicalQueuesForAdd(ctx, directive, params.forwardInfo, params.taskInfo.GetRunId(), params.taskInfo.GetWorkflowId(), false)
@@ -413,6 +464,9 @@ func (pm *taskQueuePartitionManagerImpl) PollTask(
 	if dbq == nil {
 		return nil, false, errDefaultQueueNotInit
 	}
+	if pm.partitionScaler != nil && pollMetadata.forwardedFrom == "" {
+		pm.partitionScaler.recordPoll()
+	}
 	versionSetUsed := false
 	deployment, err := worker_versioning.DeploymentFromCapabilities(pollMetadata.workerVersionCapabilities, pollMetadata.deploymentOptions)
 	if err != nil {
@@ -564,6 +618,9 @@ func (pm *taskQueuePartitionManagerImpl) ProcessSpooledTask(
 	}
 	// Redirect and re-resolve if we're blocked in matcher and user data changes.
 	for {
+		if purged, err := pm.backlogPurger.PurgeSpooledTask(ctx, task, backlogQueue); purged || err != nil {
+			return err
+		}
 		newBacklogQueue, syncMatchQueue, userDataChanged, taskDispatchRevisionNumber, targetVersion, err := pm.getPhysicalQueuesForAdd(ctx,
 			directive,
 			nil,
@@ -617,6 +674,9 @@ func (pm *taskQueuePartitionManagerImpl) AddSpooledTask(
 	task *internalTask,
 	backlogQueue *PhysicalTaskQueueKey,
 ) error {
+	if purged, err := pm.backlogPurger.PurgeSpooledTask(ctx, task, backlogQueue); purged || err != nil {
+		return err
+	}
 	taskInfo := task.event.GetData()
 	// This task came from taskReader so task.event is always set here.
 	directive := taskInfo.GetVersionDirective()
@@ -1031,6 +1091,8 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 
 			vInfo.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKey = adjustedStatsByPriority
 			vInfo.PhysicalTaskQueueInfo.TaskQueueStats = aggregateStats(adjustedStatsByPriority)
+			// Not adjusted for versioning: these are the backlogs of this physical queue only.
+			vInfo.PhysicalTaskQueueInfo.TaskQueueStatsByLabelSet = physicalQueue.GetStatsByLabelSet()
 		}
 		if internalTaskQueueStatus {
 			vInfo.PhysicalTaskQueueInfo.InternalTaskQueueStatus = physicalQueue.GetInternalTaskQueueStatus()
@@ -1049,9 +1111,13 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 		physicalQueue.MarkAlive() // Count Describe for liveness
 	}
 
-	return &matchingservice.DescribeTaskQueuePartitionResponse{
+	resp := &matchingservice.DescribeTaskQueuePartitionResponse{
 		VersionsInfoInternal: versionsInfo,
-	}, nil
+	}
+	if reportStats {
+		resp.BacklogLimitStatus = pm.backlogLimiter.Status()
+	}
+	return resp, nil
 }
 
 func (pm *taskQueuePartitionManagerImpl) updateEphemeralData(ctx context.Context) error {
@@ -1328,6 +1394,98 @@ func (pm *taskQueuePartitionManagerImpl) PartitionCount() int {
 	return max(pm.config.NumWritePartitions(), pm.config.NumReadPartitions())
 }
 
+// PartitionCountsHint returns the partition counts chosen by the partition auto-scaler, for matching
+// clients to use when picking partitions. Returns nil if auto-scaling is disabled or has not made a decision.
+func (pm *taskQueuePartitionManagerImpl) PartitionCountsHint() *persistencespb.TaskQueuePartitionCounts {
+	if pm.partition.Kind() != enumspb.TASK_QUEUE_KIND_NORMAL || !pm.config.EnablePartitionAutoScaling() {
+		return nil
+	}
+	counts := autoScaledPartitionCounts(pm.userDataManager, pm.partition.TaskType())
+	if counts == nil {
+		return nil
+	}
+	return &persistencespb.TaskQueuePartitionCounts{
+		WritePartitions: counts.GetWritePartitions(),
+		ReadPartitions:  max(counts.GetReadPartitions(), counts.GetWritePartitions()),
+	}
+}
+
+// approximateBacklogCount returns the backlog size of the unversioned queue of this partition.
+func (pm *taskQueuePartitionManagerImpl) approximateBacklogCount() int64 {
+	dbq, err := pm.defaultQueueFuture.GetIfReady()
+	if err != nil || dbq == nil {
+		return 0
+	}
+	var total int64
+	for _, stats := range dbq.GetStatsByPriority(false) {
+		total += stats.GetApproximateBacklogCount()
+	}
+	return total
+}
+
+// backlogStats returns the backlog size of all the physical queues of this partition and the age
+// of the oldest backlogged task.
+func (pm *taskQueuePartitionManagerImpl) backlogStats() (int64, time.Duration) {
+	var count int64
+	var age time.Duration
+	for _, q := range pm.loadedPhysicalQueues() {
+		for _, stats := range q.GetStatsByPriority(false) {
+			count += stats.GetApproximateBacklogCount()
+			age = max(age, stats.GetApproximateBacklogAge().AsDuration())
+		}
+	}
+	return count, age
+}
+
+// GetStatsSnapshot returns the backlog, rate and poller stats of all the physical queues of this
+// partition.
+func (pm *taskQueuePartitionManagerImpl) GetStatsSnapshot() *matchingservice.GetTaskQueueStatsResponse {
+	stats := &taskqueuepb.TaskQueueStats{ApproximateBacklogAge: durationpb.New(0)}
+	pollerCounts := make(map[string]int32)
+	pollerIdentities := make(map[string]*matchingservice.GetTaskQueueStatsResponse_PollerIdentities)
+	for _, q := range pm.loadedPhysicalQueues() {
+		for _, priorityStats := range q.GetStatsByPriority(true) {
+			mergeStats(stats, priorityStats)
+		}
+		pollers := q.GetAllPollerInfo()
+		if len(pollers) == 0 {
+			continue
+		}
+		buildID := statsBuildID(q.QueueKey().Version())
+		identities := util.GetOrSetNew(pollerIdentities, buildID)
+		for _, poller := range pollers {
+			identities.Identities = append(identities.Identities, poller.GetIdentity())
+		}
+		pollerCounts[buildID] += int32(len(pollers))
+	}
+	return &matchingservice.GetTaskQueueStatsResponse{
+		Stats: &taskqueuespb.TaskQueueStatsSnapshot{
+			TaskQueueType:        pm.partition.TaskType(),
+			Stats:                stats,
+			PollerCountByBuildId: pollerCounts,
+			PartitionCount:       1,
+			CollectTime:          timestamppb.New(pm.engine.timeSource.Now()),
+		},
+		PollerIdentitiesByBuildId: pollerIdentities,
+	}
+}
+
+// loadedPhysicalQueues returns the default queue and the loaded versioned queues of this
+// partition. Returns nil if the default queue is not loaded yet.
+func (pm *taskQueuePartitionManagerImpl) loadedPhysicalQueues() []physicalTaskQueueManager {
+	dbq, err := pm.defaultQueueFuture.GetIfReady()
+	if err != nil || dbq == nil {
+		return nil
+	}
+	queues := []physicalTaskQueueManager{dbq}
+	pm.versionedQueuesLock.RLock()
+	for _, vq := range pm.versionedQueues {
+		queues = append(queues, vq)
+	}
+	pm.versionedQueuesLock.RUnlock()
+	return queues
+}
+
 func (pm *taskQueuePartitionManagerImpl) LongPollExpirationInterval() time.Duration {
 	return pm.config.LongPollExpirationInterval()
 }
@@ -1502,6 +1660,7 @@ func (pm *taskQueuePartitionManagerImpl) getVersionedQueueNoWait(
 
 		if !ok {
 			vq.Start()
+			pm.backlogPurger.StartScans(vq)
 		}
 	}
 	return vq, nil
@@ -1890,6 +2049,7 @@ func (pm *taskQueuePartitionManagerImpl) getPerTypeUserData() (*persistencespb.T
 func (pm *taskQueuePartitionManagerImpl) userDataChanged(to *persistencespb.VersionedTaskQueueUserData) {
 	// Update rateLimits if any change is userData.
 	pm.rateLimitManager.UserDataChanged()
+	pm.concurrencyLimiter.UserDataChanged()
 
 	// Do not use defaultQueue() because that treats
 	// not being ready as an error, which is expected during bringup here.
@@ -1909,10 +2069,12 @@ func (pm *taskQueuePartitionManagerImpl) userDataChanged(to *persistencespb.Vers
 	// Notify all queues so they can re-evaluate their backlog.
 	pm.versionedQueuesLock.RLock()
 	for _, vq := range pm.versionedQueues {
+		pm.backlogPurger.StartScans(vq)
 		go vq.UserDataChanged()
 	}
 	pm.versionedQueuesLock.RUnlock()
 
 	// Do this one in this goroutine.
+	pm.backlogPurger.StartScans(defaultQ)
 	defaultQ.UserDataChanged()
 }
@@ -8,6 +8,7 @@ import (
 
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
 )
@@ -59,10 +60,18 @@ type (
 		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
 		Partition() tqid.Partition
 		PartitionCount() int
+		// PartitionCountsHint returns the partition counts chosen by partition auto-scaling, if any
+		PartitionCountsHint() *persistencespb.TaskQueuePartitionCounts
 		LongPollExpirationInterval() time.Duration
 		PutCache(key any, value any)
 		GetCache(key any) any
 		GetRateLimitManager() *rateLimitManager
+		// GetConcurrencyLimiter returns nil for partitions that don't support concurrency limits.
+		GetConcurrencyLimiter() *concurrencyLimiter
+		// GetBacklogLimiter returns nil for partitions that don't support backlog limits.
+		GetBacklogLimiter() *backlogLimiter
+		// GetStatsSnapshot returns the stats of this partition only.
+		GetStatsSnapshot() *matchingservice.GetTaskQueueStatsResponse
 		GetConfig() *taskQueueConfig
 	}
 )
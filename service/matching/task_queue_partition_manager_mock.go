@@ -16,6 +16,7 @@ import (
 
 	taskqueue "go.temporal.io/api/taskqueue/v1"
 	matchingservice "go.temporal.io/server/api/matchingservice/v1"
+	persistence "go.temporal.io/server/api/persistence/v1"
 	namespace "go.temporal.io/server/common/namespace"
 	tqid "go.temporal.io/server/common/tqid"
 	gomock "go.uber.org/mock/gomock"
@@ -134,6 +135,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetAllPollerInfo() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetAllPollerInfo))
 }
 
+// GetBacklogLimiter mocks base method.
+func (m *MocktaskQueuePartitionManager) GetBacklogLimiter() *backlogLimiter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklogLimiter")
+	ret0, _ := ret[0].(*backlogLimiter)
+	return ret0
+}
+
+// GetBacklogLimiter indicates an expected call of GetBacklogLimiter.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) GetBacklogLimiter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklogLimiter", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetBacklogLimiter))
+}
+
 // GetCache mocks base method.
 func (m *MocktaskQueuePartitionManager) GetCache(key any) any {
 	m.ctrl.T.Helper()
@@ -148,6 +163,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetCache(key any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCache", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetCache), key)
 }
 
+// GetConcurrencyLimiter mocks base method.
+func (m *MocktaskQueuePartitionManager) GetConcurrencyLimiter() *concurrencyLimiter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConcurrencyLimiter")
+	ret0, _ := ret[0].(*concurrencyLimiter)
+	return ret0
+}
+
+// GetConcurrencyLimiter indicates an expected call of GetConcurrencyLimiter.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) GetConcurrencyLimiter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcurrencyLimiter", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetConcurrencyLimiter))
+}
+
 // GetConfig mocks base method.
 func (m *MocktaskQueuePartitionManager) GetConfig() *taskQueueConfig {
 	m.ctrl.T.Helper()
@@ -176,6 +205,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) GetRateLimitManager() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitManager", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetRateLimitManager))
 }
 
+// GetStatsSnapshot mocks base method.
+func (m *MocktaskQueuePartitionManager) GetStatsSnapshot() *matchingservice.GetTaskQueueStatsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsSnapshot")
+	ret0, _ := ret[0].(*matchingservice.GetTaskQueueStatsResponse)
+	return ret0
+}
+
+// GetStatsSnapshot indicates an expected call of GetStatsSnapshot.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) GetStatsSnapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsSnapshot", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).GetStatsSnapshot))
+}
+
 // GetUserDataManager mocks base method.
 func (m *MocktaskQueuePartitionManager) GetUserDataManager() userDataManager {
 	m.ctrl.T.Helper()
@@ -301,6 +344,20 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) PartitionCount() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionCount", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PartitionCount))
 }
 
+// PartitionCountsHint mocks base method.
+func (m *MocktaskQueuePartitionManager) PartitionCountsHint() *persistence.TaskQueuePartitionCounts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartitionCountsHint")
+	ret0, _ := ret[0].(*persistence.TaskQueuePartitionCounts)
+	return ret0
+}
+
+// PartitionCountsHint indicates an expected call of PartitionCountsHint.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) PartitionCountsHint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionCountsHint", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).PartitionCountsHint))
+}
+
 // PollTask mocks base method.
 func (m *MocktaskQueuePartitionManager) PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, bool, error) {
 	m.ctrl.T.Helper()
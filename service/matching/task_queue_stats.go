@@ -0,0 +1,76 @@
+package matching
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// taskQueueStatsCacheKey is the root partition cache key of the aggregated task queue stats.
+const taskQueueStatsCacheKey = "tq_stats"
+
+type (
+	// taskQueueStatsAggregator merges the stats of the partitions of a task queue. Pollers are
+	// counted by identity, so that workers that polled several partitions are counted once.
+	taskQueueStatsAggregator struct {
+		snapshot *taskqueuespb.TaskQueueStatsSnapshot
+		// build ID -> poller identities
+		pollers map[string]map[string]struct{}
+	}
+)
+
+func newTaskQueueStatsAggregator(taskType enumspb.TaskQueueType) *taskQueueStatsAggregator {
+	return &taskQueueStatsAggregator{
+		snapshot: &taskqueuespb.TaskQueueStatsSnapshot{
+			TaskQueueType:        taskType,
+			Stats:                &taskqueuepb.TaskQueueStats{ApproximateBacklogAge: durationpb.New(0)},
+			PollerCountByBuildId: make(map[string]int32),
+		},
+		pollers: make(map[string]map[string]struct{}),
+	}
+}
+
+// add merges the stats of one partition.
+func (a *taskQueueStatsAggregator) add(partition *matchingservice.GetTaskQueueStatsResponse) {
+	a.snapshot.PartitionCount++
+	if stats := partition.GetStats().GetStats(); stats != nil {
+		mergeStats(a.snapshot.Stats, stats)
+	}
+	for buildID, identities := range partition.GetPollerIdentitiesByBuildId() {
+		seen, ok := a.pollers[buildID]
+		if !ok {
+			seen = make(map[string]struct{})
+			a.pollers[buildID] = seen
+		}
+		for _, identity := range identities.GetIdentities() {
+			seen[identity] = struct{}{}
+		}
+	}
+}
+
+// addFailed records a partition whose stats could not be collected.
+func (a *taskQueueStatsAggregator) addFailed() {
+	a.snapshot.FailedPartitionCount++
+}
+
+func (a *taskQueueStatsAggregator) result(now time.Time) *matchingservice.GetTaskQueueStatsResponse {
+	for buildID, identities := range a.pollers {
+		a.snapshot.PollerCountByBuildId[buildID] = int32(len(identities))
+	}
+	a.snapshot.CollectTime = timestamppb.New(now)
+	return &matchingservice.GetTaskQueueStatsResponse{Stats: a.snapshot}
+}
+
+// statsBuildID returns the key that pollers of a physical queue are counted under.
+func statsBuildID(version PhysicalTaskQueueVersion) string {
+	if !version.IsVersioned() {
+		return worker_versioning.UnversionedVersionId
+	}
+	return version.MetricsTagValue()
+}
@@ -0,0 +1,77 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/worker_versioning"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func partitionStats(
+	count int64,
+	age time.Duration,
+	addRate float32,
+	pollers map[string][]string,
+) *matchingservice.GetTaskQueueStatsResponse {
+	identities := make(map[string]*matchingservice.GetTaskQueueStatsResponse_PollerIdentities)
+	for buildID, ids := range pollers {
+		identities[buildID] = &matchingservice.GetTaskQueueStatsResponse_PollerIdentities{Identities: ids}
+	}
+	return &matchingservice.GetTaskQueueStatsResponse{
+		Stats: &taskqueuespb.TaskQueueStatsSnapshot{
+			Stats: &taskqueuepb.TaskQueueStats{
+				ApproximateBacklogCount: count,
+				ApproximateBacklogAge:   durationpb.New(age),
+				TasksAddRate:            addRate,
+				TasksDispatchRate:       addRate / 2,
+			},
+			PartitionCount: 1,
+		},
+		PollerIdentitiesByBuildId: identities,
+	}
+}
+
+func TestTaskQueueStatsAggregator(t *testing.T) {
+	t.Parallel()
+	a := newTaskQueueStatsAggregator(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	a.add(partitionStats(10, time.Minute, 4, map[string][]string{
+		worker_versioning.UnversionedVersionId: {"worker-a", "worker-b"},
+		"v1":                                   {"worker-c"},
+	}))
+	a.add(partitionStats(5, time.Hour, 2, map[string][]string{
+		worker_versioning.UnversionedVersionId: {"worker-b", "worker-d"},
+	}))
+	a.addFailed()
+
+	now := time.Now()
+	snapshot := a.result(now).GetStats()
+	require.Equal(t, enumspb.TASK_QUEUE_TYPE_ACTIVITY, snapshot.GetTaskQueueType())
+	require.EqualValues(t, 2, snapshot.GetPartitionCount())
+	require.EqualValues(t, 1, snapshot.GetFailedPartitionCount())
+	require.EqualValues(t, 15, snapshot.GetStats().GetApproximateBacklogCount())
+	require.Equal(t, time.Hour, snapshot.GetStats().GetApproximateBacklogAge().AsDuration())
+	require.InDelta(t, 6, snapshot.GetStats().GetTasksAddRate(), 0.001)
+	require.InDelta(t, 3, snapshot.GetStats().GetTasksDispatchRate(), 0.001)
+	// worker-b polled two partitions and is counted once.
+	require.Equal(t, map[string]int32{
+		worker_versioning.UnversionedVersionId: 3,
+		"v1":                                   1,
+	}, snapshot.GetPollerCountByBuildId())
+	require.True(t, snapshot.GetCollectTime().AsTime().Equal(now))
+}
+
+func TestStatsBuildID(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, worker_versioning.UnversionedVersionId, statsBuildID(PhysicalTaskQueueVersion{}))
+	require.Equal(t, "build-1", statsBuildID(PhysicalTaskQueueVersion{buildId: "build-1"}))
+	require.Equal(t, "set-1", statsBuildID(PhysicalTaskQueueVersion{versionSet: "set-1"}))
+	require.Equal(t,
+		"deployment"+worker_versioning.WorkerDeploymentVersionDelimiter+"build-1",
+		statsBuildID(PhysicalTaskQueueVersion{deploymentSeriesName: "deployment", buildId: "build-1"}))
+}
@@ -0,0 +1,512 @@
+package archival
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	VerifierWorkflowName = "archival-verifier"
+	VerifierActivityName = "verify-archived-histories"
+
+	VerifierWFID          = "temporal-sys-archival-verifier"
+	VerifierTaskQueueName = "temporal-sys-archival-verifier-taskqueue-0"
+
+	// verificationWindow is how far back, before the configured minimum data age, closed workflows are sampled.
+	// It matches the cron schedule so consecutive runs sample consecutive windows.
+	verificationWindow = 12 * time.Hour
+)
+
+var (
+	VerifierWFStartOptions = client.StartWorkflowOptions{
+		ID:                    VerifierWFID,
+		TaskQueue:             VerifierTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
+
+	errArchivedHistoryNotFound = errors.New("archived history not found")
+)
+
+type (
+	VerifierInput struct {
+		NamespaceListPageSize int
+		HistoryPageSize       int
+	}
+
+	Activities struct {
+		logger             log.Logger
+		metricsHandler     metrics.Handler
+		metadataManager    persistence.MetadataManager
+		visibilityManager  manager.VisibilityManager
+		namespaceRegistry  namespace.Registry
+		adminClient        adminservice.AdminServiceClient
+		archivalMetadata   archiver.ArchivalMetadata
+		archiverProvider   provider.ArchiverProvider
+		serializer         serialization.Serializer
+		currentClusterName string
+		// Maximum number of recently closed workflows per namespace to verify on each run.
+		sampleSize dynamicconfig.IntPropertyFn
+		// Minimum duration since a workflow closed for it to be considered for verification.
+		// Archival happens asynchronously after close, so recently closed workflows may not be archived yet.
+		dataMinAge    dynamicconfig.DurationPropertyFn
+		rps           dynamicconfig.FloatPropertyFn
+		repairEnabled dynamicconfig.BoolPropertyFn
+	}
+
+	heartbeatDetails struct {
+		NamespaceIdx           int
+		NamespaceNextPageToken []byte
+	}
+
+	// expectedHistory is what an archived history is verified against. Zero and nil fields are unknown.
+	expectedHistory struct {
+		lastEventID          int64
+		closeFailoverVersion *int64
+		checksum             *uint32
+	}
+
+	// archivedHistory accumulates the events read back from the archive.
+	archivedHistory struct {
+		nextEventID      int64
+		lastEventVersion int64
+		checksum         uint32
+	}
+
+	// archivedHistoryCorruptedError describes why an archived history failed verification.
+	archivedHistoryCorruptedError struct {
+		reason string
+	}
+)
+
+func NewActivities(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	metadataManager persistence.MetadataManager,
+	visibilityManager manager.VisibilityManager,
+	namespaceRegistry namespace.Registry,
+	adminClient adminservice.AdminServiceClient,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
+	serializer serialization.Serializer,
+	currentClusterName string,
+	sampleSize dynamicconfig.IntPropertyFn,
+	dataMinAge dynamicconfig.DurationPropertyFn,
+	rps dynamicconfig.FloatPropertyFn,
+	repairEnabled dynamicconfig.BoolPropertyFn,
+) *Activities {
+	return &Activities{
+		logger:             logger,
+		metricsHandler:     metricsHandler.WithTags(metrics.OperationTag(metrics.ArchivalVerifierScope)),
+		metadataManager:    metadataManager,
+		visibilityManager:  visibilityManager,
+		namespaceRegistry:  namespaceRegistry,
+		adminClient:        adminClient,
+		archivalMetadata:   archivalMetadata,
+		archiverProvider:   archiverProvider,
+		serializer:         serializer,
+		currentClusterName: currentClusterName,
+		sampleSize:         sampleSize,
+		dataMinAge:         dataMinAge,
+		rps:                rps,
+		repairEnabled:      repairEnabled,
+	}
+}
+
+func (e *archivedHistoryCorruptedError) Error() string {
+	return "archived history is corrupted: " + e.reason
+}
+
+// VerifierWorkflow samples recently closed workflows in all namespaces with history archival enabled and verifies
+// that their archived history is complete and readable.
+// This workflow is a wrapper around the long running VerifyArchivedHistories activity.
+func VerifierWorkflow(ctx workflow.Context, input VerifierInput) error {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 6 * time.Hour,
+		HeartbeatTimeout:    30 * time.Second,
+	})
+	return workflow.ExecuteActivity(activityCtx, VerifierActivityName, input).Get(ctx, nil)
+}
+
+func (a *Activities) setDefaults(input *VerifierInput) {
+	if input.NamespaceListPageSize == 0 {
+		input.NamespaceListPageSize = 100
+	}
+	if input.HistoryPageSize == 0 {
+		input.HistoryPageSize = 250
+	}
+}
+
+func (a *Activities) recordHeartbeat(ctx context.Context, heartbeat heartbeatDetails) {
+	activity.RecordHeartbeat(ctx, heartbeat)
+}
+
+// VerifyArchivedHistories reads back the archived history of a sample of recently closed workflows in every
+// namespace with history archival enabled, and requests re-archival of those that are missing or broken.
+func (a *Activities) VerifyArchivedHistories(ctx context.Context, input VerifierInput) error {
+	a.setDefaults(&input)
+
+	historyConfig := a.archivalMetadata.GetHistoryConfig()
+	if !historyConfig.ClusterConfiguredForArchival() || !historyConfig.ReadEnabled() {
+		a.logger.Info("Skipping archival verification because history archival is not enabled for read in this cluster")
+		return nil
+	}
+
+	var heartbeat heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &heartbeat); err != nil {
+			return temporal.NewNonRetryableApplicationError("failed to load previous heartbeat details", "TypeError", err)
+		}
+	}
+	rateLimiter := quotas.NewDefaultOutgoingRateLimiter(quotas.RateFn(a.rps))
+	for {
+		nsResponse, err := a.metadataManager.ListNamespaces(ctx, &persistence.ListNamespacesRequest{
+			PageSize:       input.NamespaceListPageSize,
+			NextPageToken:  heartbeat.NamespaceNextPageToken,
+			IncludeDeleted: false,
+		})
+		if err != nil {
+			return err
+		}
+		for heartbeat.NamespaceIdx < len(nsResponse.Namespaces) {
+			nsId := nsResponse.Namespaces[heartbeat.NamespaceIdx].Namespace.Info.Id
+			if err := a.processNamespace(ctx, rateLimiter, input, heartbeat, nsId); err != nil {
+				return err
+			}
+			heartbeat.NamespaceIdx++
+			a.recordHeartbeat(ctx, heartbeat)
+		}
+		heartbeat.NamespaceIdx = 0
+		heartbeat.NamespaceNextPageToken = nsResponse.NextPageToken
+		if len(heartbeat.NamespaceNextPageToken) == 0 {
+			break
+		}
+		a.recordHeartbeat(ctx, heartbeat)
+	}
+	return nil
+}
+
+func (a *Activities) processNamespace(
+	ctx context.Context,
+	rateLimiter quotas.RateLimiter,
+	input VerifierInput,
+	heartbeat heartbeatDetails,
+	nsId string,
+) error {
+	ns, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(nsId))
+	if err != nil {
+		return err
+	}
+	// Only the active cluster for this namespace archives its workflows.
+	if !ns.ActiveInCluster(a.currentClusterName) {
+		return nil
+	}
+	archivalState := ns.HistoryArchivalState()
+	if archivalState.State != enumspb.ARCHIVAL_STATE_ENABLED || archivalState.URI == "" {
+		return nil
+	}
+	URI, err := archiver.NewURI(archivalState.URI)
+	if err != nil {
+		a.logger.Error("Failed to parse history archival URI", tag.WorkflowNamespace(ns.Name().String()), tag.Error(err))
+		return nil
+	}
+	historyArchiver, err := a.archiverProvider.GetHistoryArchiver(URI.Scheme())
+	if err != nil {
+		a.logger.Error("Failed to get history archiver", tag.WorkflowNamespace(ns.Name().String()), tag.Error(err))
+		return nil
+	}
+
+	closedBefore := time.Now().UTC().Add(-a.dataMinAge())
+	resp, err := a.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		PageSize:    a.sampleSize(),
+		Query: fmt.Sprintf(
+			"%s != 'Running' AND %s BETWEEN '%s' AND '%s'",
+			sadefs.ExecutionStatus,
+			sadefs.CloseTime,
+			closedBefore.Add(-verificationWindow).Format(time.RFC3339Nano),
+			closedBefore.Format(time.RFC3339Nano),
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	metricsHandler := a.metricsHandler.WithTags(metrics.NamespaceTag(ns.Name().String()))
+	for _, executionInfo := range resp.Executions {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return err
+		}
+		err := a.verifyExecution(ctx, input, ns, historyArchiver, URI, executionInfo)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Intentionally don't fail the activity on single execution errors.
+			metrics.ArchivalVerifierErrorCount.With(metricsHandler).Record(1)
+			a.logger.Error("Failed to verify archived history",
+				tag.WorkflowNamespace(ns.Name().String()),
+				tag.WorkflowID(executionInfo.GetExecution().GetWorkflowId()),
+				tag.WorkflowRunID(executionInfo.GetExecution().GetRunId()),
+				tag.Error(err))
+		}
+		a.recordHeartbeat(ctx, heartbeat)
+	}
+	return nil
+}
+
+func (a *Activities) verifyExecution(
+	ctx context.Context,
+	input VerifierInput,
+	ns *namespace.Namespace,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	executionInfo *workflowpb.WorkflowExecutionInfo,
+) error {
+	metricsHandler := a.metricsHandler.WithTags(metrics.NamespaceTag(ns.Name().String()))
+	execution := executionInfo.GetExecution()
+
+	// The visibility record is written when the workflow closes and gives the length of the history that was
+	// archived. The history store adds the close failover version and a checksum of the events, as long as the
+	// workflow hasn't been deleted.
+	expected := expectedHistory{lastEventID: executionInfo.GetHistoryLength()}
+	sourceExists := true
+	closeFailoverVersion, checksum, err := a.readSourceHistory(ctx, input, ns, execution)
+	switch {
+	case err == nil:
+		expected.closeFailoverVersion = &closeFailoverVersion
+		expected.checksum = &checksum
+	case common.IsNotFoundError(err):
+		sourceExists = false
+	default:
+		return err
+	}
+	if expected.lastEventID == 0 && !sourceExists {
+		// Nothing is known about the history that was archived.
+		metrics.ArchivalVerifierSkipCount.With(metricsHandler).Record(1)
+		return nil
+	}
+
+	err = verifyArchivedHistory(
+		ctx,
+		historyArchiver,
+		URI,
+		&archiver.GetHistoryRequest{
+			NamespaceID:          ns.ID().String(),
+			WorkflowID:           execution.GetWorkflowId(),
+			RunID:                execution.GetRunId(),
+			CloseFailoverVersion: expected.closeFailoverVersion,
+			PageSize:             input.HistoryPageSize,
+		},
+		expected,
+	)
+	var corruptedErr *archivedHistoryCorruptedError
+	switch {
+	case err == nil:
+		metrics.ArchivalVerifierVerifiedCount.With(metricsHandler).Record(1)
+		return nil
+	case errors.Is(err, errArchivedHistoryNotFound), errors.As(err, &corruptedErr):
+		metrics.ArchivalVerifierCorruptedCount.With(metricsHandler).Record(1)
+		a.logger.Warn("Archived history failed verification",
+			tag.WorkflowNamespace(ns.Name().String()),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.ArchivalURI(URI.String()),
+			tag.Error(err))
+		if !sourceExists {
+			// The history to archive again is gone.
+			return nil
+		}
+		return a.requestRepair(ctx, metricsHandler, ns, execution)
+	default:
+		return err
+	}
+}
+
+// readSourceHistory reads the current branch of the workflow history from the history store and returns its close
+// failover version and checksum.
+func (a *Activities) readSourceHistory(
+	ctx context.Context,
+	input VerifierInput,
+	ns *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) (closeFailoverVersion int64, checksum uint32, err error) {
+	request := &adminservice.GetWorkflowExecutionRawHistoryRequest{
+		NamespaceId:     ns.ID().String(),
+		Execution:       execution,
+		StartEventId:    common.FirstEventID,
+		MaximumPageSize: int32(input.HistoryPageSize),
+	}
+	for {
+		resp, err := a.adminClient.GetWorkflowExecutionRawHistory(ctx, request)
+		if err != nil {
+			return 0, 0, err
+		}
+		if request.NextPageToken == nil {
+			lastItem, err := versionhistory.GetLastVersionHistoryItem(resp.GetVersionHistory())
+			if err != nil {
+				return 0, 0, err
+			}
+			closeFailoverVersion = lastItem.GetVersion()
+		}
+		for _, blob := range resp.GetHistoryBatches() {
+			events, err := a.serializer.DeserializeEvents(blob)
+			if err != nil {
+				return 0, 0, err
+			}
+			if checksum, err = updateHistoryChecksum(checksum, events); err != nil {
+				return 0, 0, err
+			}
+		}
+		request.NextPageToken = resp.GetNextPageToken()
+		if len(request.NextPageToken) == 0 {
+			return closeFailoverVersion, checksum, nil
+		}
+	}
+}
+
+// requestRepair regenerates the close tasks of the workflow, which includes its archival task.
+func (a *Activities) requestRepair(
+	ctx context.Context,
+	metricsHandler metrics.Handler,
+	ns *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) error {
+	if !a.repairEnabled() {
+		return nil
+	}
+	_, err := a.adminClient.RefreshWorkflowTasks(ctx, &adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: ns.ID().String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return err
+	}
+	metrics.ArchivalVerifierRepairRequestCount.With(metricsHandler).Record(1)
+	return nil
+}
+
+// verifyArchivedHistory reads back the whole archived history and checks that event IDs are contiguous from the
+// first event up to the expected last event ID and that event versions don't decrease. When the close failover
+// version and checksum of the history are known, it also checks that the last event has the close failover version
+// and that the checksum of the archived events matches.
+func verifyArchivedHistory(
+	ctx context.Context,
+	historyArchiver archiver.HistoryArchiver,
+	URI archiver.URI,
+	request *archiver.GetHistoryRequest,
+	expected expectedHistory,
+) error {
+	var actual archivedHistory
+	actual.nextEventID = common.FirstEventID
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return errArchivedHistoryNotFound
+			}
+			var invalidArgument *serviceerror.InvalidArgument
+			if errors.As(err, &invalidArgument) {
+				// Archivers return InvalidArgument when the stored blobs can't be decoded.
+				return &archivedHistoryCorruptedError{reason: invalidArgument.Error()}
+			}
+			return err
+		}
+		for _, batch := range resp.HistoryBatches {
+			if err := actual.add(batch.GetEvents()); err != nil {
+				return err
+			}
+		}
+		request.NextPageToken = resp.NextPageToken
+		if len(request.NextPageToken) == 0 {
+			break
+		}
+	}
+
+	if actual.nextEventID == common.FirstEventID {
+		return errArchivedHistoryNotFound
+	}
+	if expected.lastEventID != 0 && actual.nextEventID-1 != expected.lastEventID {
+		return &archivedHistoryCorruptedError{
+			reason: fmt.Sprintf("last event ID is %d, expected %d", actual.nextEventID-1, expected.lastEventID),
+		}
+	}
+	if expected.closeFailoverVersion != nil && actual.lastEventVersion != *expected.closeFailoverVersion {
+		return &archivedHistoryCorruptedError{
+			reason: fmt.Sprintf("last event version is %d, expected close failover version %d", actual.lastEventVersion, *expected.closeFailoverVersion),
+		}
+	}
+	if expected.checksum != nil && actual.checksum != *expected.checksum {
+		return &archivedHistoryCorruptedError{
+			reason: fmt.Sprintf("checksum is %08x, expected %08x", actual.checksum, *expected.checksum),
+		}
+	}
+	return nil
+}
+
+func (h *archivedHistory) add(events []*historypb.HistoryEvent) error {
+	for _, event := range events {
+		if event.GetEventId() != h.nextEventID {
+			return &archivedHistoryCorruptedError{
+				reason: fmt.Sprintf("found event ID %d, expected %d", event.GetEventId(), h.nextEventID),
+			}
+		}
+		if event.GetVersion() < h.lastEventVersion {
+			return &archivedHistoryCorruptedError{
+				reason: fmt.Sprintf("event %d has version %d lower than the version %d of the previous event", event.GetEventId(), event.GetVersion(), h.lastEventVersion),
+			}
+		}
+		h.nextEventID++
+		h.lastEventVersion = event.GetVersion()
+	}
+	var err error
+	h.checksum, err = updateHistoryChecksum(h.checksum, events)
+	return err
+}
+
+// updateHistoryChecksum adds events to a CRC32 checksum of a history. The checksum is computed over the
+// deterministic proto encoding of each event, so it doesn't depend on how the events are batched or on the
+// encoding used by the archiver.
+func updateHistoryChecksum(checksum uint32, events []*historypb.HistoryEvent) (uint32, error) {
+	var buf []byte
+	for _, event := range events {
+		var err error
+		buf, err = proto.MarshalOptions{Deterministic: true}.MarshalAppend(buf[:0], event)
+		if err != nil {
+			return 0, err
+		}
+		checksum = crc32.Update(checksum, crc32.IEEETable, buf)
+	}
+	return checksum, nil
+}
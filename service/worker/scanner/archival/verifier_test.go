@@ -0,0 +1,391 @@
+package archival
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+const (
+	testNamespaceID = "test-namespace-id"
+	testWorkflowID  = "test-workflow-id"
+	testRunID       = "test-run-id"
+)
+
+func newHistory(versions ...int64) []*historypb.History {
+	var events []*historypb.HistoryEvent
+	for i, version := range versions {
+		events = append(events, &historypb.HistoryEvent{EventId: int64(i + 1), Version: version})
+	}
+	// Split into two batches to exercise continuity across batches.
+	mid := len(events) / 2
+	return []*historypb.History{{Events: events[:mid]}, {Events: events[mid:]}}
+}
+
+func Test_verifyArchivedHistory(t *testing.T) {
+	URI, err := archiver.NewURI("test:///archival")
+	require.NoError(t, err)
+	checksum := func(batches []*historypb.History) *uint32 {
+		var checksum uint32
+		for _, batch := range batches {
+			checksum, err = updateHistoryChecksum(checksum, batch.GetEvents())
+			require.NoError(t, err)
+		}
+		return &checksum
+	}
+	version := func(version int64) *int64 {
+		return &version
+	}
+
+	testCases := []struct {
+		name          string
+		batches       []*historypb.History
+		getErr        error
+		expected      expectedHistory
+		expectedErr   error
+		expectCorrupt bool
+	}{
+		{
+			name:    "valid",
+			batches: newHistory(1, 1, 2, 2),
+			expected: expectedHistory{
+				lastEventID:          4,
+				closeFailoverVersion: version(2),
+				checksum:             checksum(newHistory(1, 1, 2, 2)),
+			},
+		},
+		{
+			name:     "valid without source history",
+			batches:  newHistory(1, 1, 2, 2),
+			expected: expectedHistory{lastEventID: 4},
+		},
+		{
+			name:          "event ID gap",
+			batches:       []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1}, {EventId: 3, Version: 1}}}},
+			expected:      expectedHistory{lastEventID: 3},
+			expectCorrupt: true,
+		},
+		{
+			name:          "truncated",
+			batches:       newHistory(1, 1, 1),
+			expected:      expectedHistory{lastEventID: 5},
+			expectCorrupt: true,
+		},
+		{
+			name:          "close failover version mismatch",
+			batches:       newHistory(1, 1, 1, 1),
+			expected:      expectedHistory{lastEventID: 4, closeFailoverVersion: version(2)},
+			expectCorrupt: true,
+		},
+		{
+			name:          "event version decreases",
+			batches:       newHistory(1, 3, 1, 1),
+			expected:      expectedHistory{lastEventID: 4},
+			expectCorrupt: true,
+		},
+		{
+			name:    "checksum mismatch",
+			batches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1, Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{}}}}},
+			expected: expectedHistory{
+				lastEventID:          1,
+				closeFailoverVersion: version(1),
+				checksum:             checksum([]*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1}}}}),
+			},
+			expectCorrupt: true,
+		},
+		{
+			name:        "not found",
+			getErr:      serviceerror.NewNotFound("not found"),
+			expected:    expectedHistory{lastEventID: 4},
+			expectedErr: errArchivedHistoryNotFound,
+		},
+		{
+			name:        "empty",
+			expected:    expectedHistory{lastEventID: 4},
+			expectedErr: errArchivedHistoryNotFound,
+		},
+		{
+			name:          "undecodable",
+			getErr:        serviceerror.NewInvalidArgument("failed to decode history"),
+			expected:      expectedHistory{lastEventID: 4},
+			expectCorrupt: true,
+		},
+		{
+			name:        "transient error",
+			getErr:      serviceerror.NewUnavailable("unavailable"),
+			expected:    expectedHistory{lastEventID: 4},
+			expectedErr: serviceerror.NewUnavailable("unavailable"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+			historyArchiver.EXPECT().Get(gomock.Any(), URI, gomock.Any()).Return(&archiver.GetHistoryResponse{
+				HistoryBatches: tc.batches,
+			}, tc.getErr)
+
+			err := verifyArchivedHistory(context.Background(), historyArchiver, URI, &archiver.GetHistoryRequest{
+				NamespaceID:          testNamespaceID,
+				WorkflowID:           testWorkflowID,
+				RunID:                testRunID,
+				CloseFailoverVersion: tc.expected.closeFailoverVersion,
+			}, tc.expected)
+
+			var corruptedErr *archivedHistoryCorruptedError
+			switch {
+			case tc.expectCorrupt:
+				require.ErrorAs(t, err, &corruptedErr)
+			case tc.expectedErr != nil:
+				require.Equal(t, tc.expectedErr, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_verifyArchivedHistory_FollowsPageToken(t *testing.T) {
+	URI, err := archiver.NewURI("test:///archival")
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+	gomock.InOrder(
+		historyArchiver.EXPECT().Get(gomock.Any(), URI, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+				require.Empty(t, request.NextPageToken)
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1, Version: 1}}}},
+					NextPageToken:  []byte("token"),
+				}, nil
+			}),
+		historyArchiver.EXPECT().Get(gomock.Any(), URI, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+				require.Equal(t, []byte("token"), request.NextPageToken)
+				return &archiver.GetHistoryResponse{
+					HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 2, Version: 1}}}},
+				}, nil
+			}),
+	)
+
+	err = verifyArchivedHistory(context.Background(), historyArchiver, URI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}, expectedHistory{lastEventID: 2})
+	require.NoError(t, err)
+}
+
+func Test_verifyExecution(t *testing.T) {
+	URI, err := archiver.NewURI("test:///archival")
+	require.NoError(t, err)
+	ns := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: "test-namespace"},
+		nil,
+		false,
+		nil,
+		0,
+	)
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID}
+	serializer := serialization.NewSerializer()
+	sourceHistory := func(batches []*historypb.History) []*adminservice.GetWorkflowExecutionRawHistoryResponse {
+		var pages []*adminservice.GetWorkflowExecutionRawHistoryResponse
+		for i, batch := range batches {
+			blob, err := serializer.SerializeEvents(batch.GetEvents())
+			require.NoError(t, err)
+			page := &adminservice.GetWorkflowExecutionRawHistoryResponse{
+				HistoryBatches: []*commonpb.DataBlob{blob},
+				VersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+					{EventId: 4, Version: 2},
+				}),
+			}
+			if i < len(batches)-1 {
+				page.NextPageToken = []byte("token")
+			}
+			pages = append(pages, page)
+		}
+		return pages
+	}
+	modified := newHistory(2, 2, 2, 2)
+	modified[1].Events[1].Attributes = &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{}
+
+	testCases := []struct {
+		name          string
+		historyLength int64
+		source        []*historypb.History
+		batches       []*historypb.History
+		repairEnabled bool
+		expectGet     bool
+		expectRepair  bool
+		expectedErr   error
+	}{
+		{
+			name:          "valid",
+			historyLength: 4,
+			source:        newHistory(2, 2, 2, 2),
+			batches:       newHistory(2, 2, 2, 2),
+			expectGet:     true,
+		},
+		{
+			name:          "broken with repair",
+			historyLength: 4,
+			source:        newHistory(2, 2, 2, 2),
+			batches:       newHistory(2, 2, 2),
+			repairEnabled: true,
+			expectGet:     true,
+			expectRepair:  true,
+		},
+		{
+			name:          "broken without repair",
+			historyLength: 4,
+			source:        newHistory(2, 2, 2, 2),
+			batches:       newHistory(2, 2, 2),
+			expectGet:     true,
+		},
+		{
+			name:          "checksum mismatch",
+			historyLength: 4,
+			source:        newHistory(2, 2, 2, 2),
+			batches:       modified,
+			repairEnabled: true,
+			expectGet:     true,
+			expectRepair:  true,
+		},
+		{
+			name:          "deleted and valid",
+			historyLength: 4,
+			batches:       newHistory(2, 2, 2, 2),
+			repairEnabled: true,
+			expectGet:     true,
+		},
+		{
+			name:          "deleted and broken",
+			historyLength: 4,
+			batches:       newHistory(2, 2, 2),
+			repairEnabled: true,
+			expectGet:     true,
+		},
+		{
+			name:          "deleted without history length",
+			repairEnabled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			adminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+			historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+			a := &Activities{
+				logger:         log.NewTestLogger(),
+				metricsHandler: metrics.NoopMetricsHandler,
+				adminClient:    adminClient,
+				serializer:     serializer,
+				repairEnabled:  dynamicconfig.GetBoolPropertyFn(tc.repairEnabled),
+			}
+
+			if tc.source == nil {
+				adminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).
+					Return(nil, serviceerror.NewNotFound("not found"))
+			} else {
+				var calls []any
+				for _, page := range sourceHistory(tc.source) {
+					calls = append(calls, adminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, request *adminservice.GetWorkflowExecutionRawHistoryRequest, _ ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
+							require.Equal(t, testNamespaceID, request.GetNamespaceId())
+							require.Equal(t, execution, request.GetExecution())
+							return page, nil
+						}))
+				}
+				gomock.InOrder(calls...)
+			}
+			if tc.expectGet {
+				historyArchiver.EXPECT().Get(gomock.Any(), URI, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+						if tc.source == nil {
+							require.Nil(t, request.CloseFailoverVersion)
+						} else {
+							require.Equal(t, int64(2), *request.CloseFailoverVersion)
+						}
+						return &archiver.GetHistoryResponse{HistoryBatches: tc.batches}, nil
+					})
+			}
+			if tc.expectRepair {
+				adminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &adminservice.RefreshWorkflowTasksRequest{
+					NamespaceId: testNamespaceID,
+					Execution:   execution,
+				}).Return(&adminservice.RefreshWorkflowTasksResponse{}, nil)
+			}
+
+			err := a.verifyExecution(context.Background(), VerifierInput{HistoryPageSize: 10}, ns, historyArchiver, URI, &workflowpb.WorkflowExecutionInfo{
+				Execution:     execution,
+				HistoryLength: tc.historyLength,
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_verifyExecution_RepairError(t *testing.T) {
+	URI, err := archiver.NewURI("test:///archival")
+	require.NoError(t, err)
+	ns := namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: "test-namespace"},
+		nil,
+		false,
+		nil,
+		0,
+	)
+	execution := &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID}
+	serializer := serialization.NewSerializer()
+
+	ctrl := gomock.NewController(t)
+	adminClient := adminservicemock.NewMockAdminServiceClient(ctrl)
+	historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+	a := &Activities{
+		logger:         log.NewTestLogger(),
+		metricsHandler: metrics.NoopMetricsHandler,
+		adminClient:    adminClient,
+		serializer:     serializer,
+		repairEnabled:  dynamicconfig.GetBoolPropertyFn(true),
+	}
+
+	blob, err := serializer.SerializeEvents(newHistory(2, 2, 2, 2)[0].GetEvents())
+	require.NoError(t, err)
+	adminClient.EXPECT().GetWorkflowExecutionRawHistory(gomock.Any(), gomock.Any()).Return(&adminservice.GetWorkflowExecutionRawHistoryResponse{
+		HistoryBatches: []*commonpb.DataBlob{blob},
+		VersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+			{EventId: 4, Version: 2},
+		}),
+	}, nil)
+	historyArchiver.EXPECT().Get(gomock.Any(), URI, gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	repairErr := errors.New("refresh failed")
+	adminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil, repairErr)
+
+	err = a.verifyExecution(context.Background(), VerifierInput{HistoryPageSize: 10}, ns, historyArchiver, URI, &workflowpb.WorkflowExecutionInfo{
+		Execution:     execution,
+		HistoryLength: 4,
+	})
+	require.ErrorIs(t, err, repairErr)
+}
@@ -14,6 +14,8 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -27,6 +29,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/sdk"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 )
 
@@ -71,6 +74,17 @@ type (
 		RemovableBuildIdDurationSinceDefault dynamicconfig.DurationPropertyFn
 		// BuildIdScavengerVisibilityRPS is the rate limit for visibility calls from the build ID scavenger
 		BuildIdScavengerVisibilityRPS dynamicconfig.FloatPropertyFn
+
+		// ArchivalVerifierEnabled indicates if the archival verifier should be started as part of scanner
+		ArchivalVerifierEnabled dynamicconfig.BoolPropertyFn
+		// ArchivalVerifierSampleSize is the max number of closed workflows per namespace verified on each run
+		ArchivalVerifierSampleSize dynamicconfig.IntPropertyFn
+		// ArchivalVerifierDataMinAge is the minimum time since a workflow closed before its archived history is verified
+		ArchivalVerifierDataMinAge dynamicconfig.DurationPropertyFn
+		// ArchivalVerifierRPS is the rate limit for workflows verified by the archival verifier
+		ArchivalVerifierRPS dynamicconfig.FloatPropertyFn
+		// ArchivalVerifierRepairEnabled indicates if the archival verifier should request re-archival of broken entries
+		ArchivalVerifierRepairEnabled dynamicconfig.BoolPropertyFn
 	}
 
 	// scannerContext is the context object that gets
@@ -91,6 +105,8 @@ type (
 		currentClusterName string
 		hostInfo           membership.HostInfo
 		serializer         serialization.Serializer
+		archivalMetadata   archiver.ArchivalMetadata
+		archiverProvider   provider.ArchiverProvider
 	}
 
 	// Scanner is the background sub-system that does full scans
@@ -124,6 +140,8 @@ func New(
 	currentClusterName string,
 	hostInfo membership.HostInfo,
 	serializer serialization.Serializer,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 ) *Scanner {
 	return &Scanner{
 		context: scannerContext{
@@ -142,6 +160,8 @@ func New(
 			currentClusterName: currentClusterName,
 			hostInfo:           hostInfo,
 			serializer:         serializer,
+			archivalMetadata:   archivalMetadata,
+			archiverProvider:   archiverProvider,
 		},
 	}
 }
@@ -209,6 +229,37 @@ func (s *Scanner) Start() error {
 		}
 	}
 
+	if s.context.cfg.ArchivalVerifierEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, archival.VerifierWFStartOptions, archival.VerifierWorkflowName)
+
+		archivalActivities := archival.NewActivities(
+			s.context.logger,
+			s.context.metricsHandler,
+			s.context.metadataManager,
+			s.context.visibilityManager,
+			s.context.namespaceRegistry,
+			s.context.adminClient,
+			s.context.archivalMetadata,
+			s.context.archiverProvider,
+			s.context.serializer,
+			s.context.currentClusterName,
+			s.context.cfg.ArchivalVerifierSampleSize,
+			s.context.cfg.ArchivalVerifierDataMinAge,
+			s.context.cfg.ArchivalVerifierRPS,
+			s.context.cfg.ArchivalVerifierRepairEnabled,
+		)
+
+		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), archival.VerifierTaskQueueName, workerOpts)
+		work.RegisterWorkflowWithOptions(archival.VerifierWorkflow, workflow.RegisterOptions{Name: archival.VerifierWorkflowName})
+		work.RegisterActivityWithOptions(archivalActivities.VerifyArchivedHistories, activity.RegisterOptions{Name: archival.VerifierActivityName})
+
+		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
+		if err := work.Start(); err != nil {
+			return err
+		}
+	}
+
 	// TODO: There's no reason to register all activities and workflows on every task queue.
 	for _, tl := range workerTaskQueueNames {
 		work := s.context.sdkClientFactory.NewWorker(s.context.sdkClientFactory.GetSystemClient(), tl, workerOpts)
@@ -19,6 +19,7 @@ import (
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/service/worker/scanner/archival"
 	"go.temporal.io/server/service/worker/scanner/build_ids"
 	"go.uber.org/mock/gomock"
 )
@@ -53,6 +54,10 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
 	}
+	archivalVerifier := expectedScanner{
+		WFTypeName:    archival.VerifierWorkflowName,
+		TaskQueueName: archival.VerifierTaskQueueName,
+	}
 
 	type testCase struct {
 		Name                     string
@@ -60,6 +65,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		TaskQueueScannerEnabled  bool
 		HistoryScannerEnabled    bool
 		BuildIdScavengerEnabled  bool
+		ArchivalVerifierEnabled  bool
 		DefaultStore             string
 		ExpectedScanners         []expectedScanner
 	}
@@ -155,14 +161,25 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                     "ArchivalVerifierNoSQL",
+			ExecutionsScannerEnabled: false,
+			TaskQueueScannerEnabled:  false,
+			HistoryScannerEnabled:    false,
+			BuildIdScavengerEnabled:  false,
+			ArchivalVerifierEnabled:  true,
+			DefaultStore:             config.StoreTypeNoSQL,
+			ExpectedScanners:         []expectedScanner{archivalVerifier},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
 			BuildIdScavengerEnabled:  true,
+			ArchivalVerifierEnabled:  true,
 			DefaultStore:             config.StoreTypeSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, buildIdScavenger}, // ExecutionsScanner is not supported for SQL store
+			ExpectedScanners:         []expectedScanner{historyScanner, taskQueueScanner, buildIdScavenger, archivalVerifier}, // ExecutionsScanner is not supported for SQL store
 		},
 		{
 			Name:                     "AllScannersNoSQL",
@@ -170,8 +187,9 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			TaskQueueScannerEnabled:  true,
 			HistoryScannerEnabled:    true,
 			BuildIdScavengerEnabled:  true,
+			ArchivalVerifierEnabled:  true,
 			DefaultStore:             config.StoreTypeNoSQL,
-			ExpectedScanners:         []expectedScanner{historyScanner, executionScanner, buildIdScavenger}, // TaskQueueScanner is only supported for SQL store
+			ExpectedScanners:         []expectedScanner{historyScanner, executionScanner, buildIdScavenger, archivalVerifier}, // TaskQueueScanner is only supported for SQL store
 		},
 	} {
 		s.Run(c.Name, func() {
@@ -191,6 +209,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					ArchivalVerifierEnabled:                dynamicconfig.GetBoolPropertyFn(c.ArchivalVerifierEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -215,6 +234,8 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				"active-cluster",
 				membership.NewHostInfoFromAddress("localhost"),
 				serialization.NewSerializer(),
+				nil,
+				nil,
 			)
 			var wg sync.WaitGroup
 			for _, sc := range c.ExpectedScanners {
@@ -271,6 +292,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			ArchivalVerifierEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -292,6 +314,8 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		"active-cluster",
 		membership.NewHostInfoFromAddress("localhost"),
 		serialization.NewSerializer(),
+		nil,
+		nil,
 	)
 	mockSdkClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
 	worker.EXPECT().RegisterActivityWithOptions(gomock.Any(), gomock.Any()).AnyTimes()
@@ -8,6 +8,8 @@ import (
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -53,6 +55,8 @@ type (
 		namespaceRegistry      namespace.Registry
 		workerServiceResolver  membership.ServiceResolver
 		visibilityManager      manager.VisibilityManager
+		archivalMetadata       archiver.ArchivalMetadata
+		archiverProvider       provider.ArchiverProvider
 
 		namespaceReplicationQueue persistence.NamespaceReplicationQueue
 
@@ -126,6 +130,8 @@ func NewService(
 	matchingClient resource.MatchingClient,
 	namespaceReplicationTaskExecutor nsreplication.TaskExecutor,
 	serializer serialization.Serializer,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
 	server *grpc.Server,
 	grpcListener net.Listener,
 	healthServer *health.Server,
@@ -153,6 +159,8 @@ func NewService(
 		taskManager:               taskManager,
 		historyClient:             historyClient,
 		visibilityManager:         visibilityManager,
+		archivalMetadata:          archivalMetadata,
+		archiverProvider:          archiverProvider,
 
 		workerManager:                    workerManager,
 		perNamespaceWorkerManager:        perNamespaceWorkerManager,
@@ -203,6 +211,11 @@ func NewConfig(
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
+			ArchivalVerifierEnabled:                 dynamicconfig.ArchivalVerifierEnabled.Get(dc),
+			ArchivalVerifierSampleSize:              dynamicconfig.ArchivalVerifierSampleSize.Get(dc),
+			ArchivalVerifierDataMinAge:              dynamicconfig.ArchivalVerifierDataMinAge.Get(dc),
+			ArchivalVerifierRPS:                     dynamicconfig.ArchivalVerifierRPS.Get(dc),
+			ArchivalVerifierRepairEnabled:           dynamicconfig.ArchivalVerifierRepairEnabled.Get(dc),
 		},
 		BatcherRPS:                           dynamicconfig.BatcherRPS.Get(dc),
 		BatcherConcurrency:                   dynamicconfig.BatcherConcurrency.Get(dc),
@@ -338,6 +351,8 @@ func (s *Service) initScanner(serializer serialization.Serializer) error {
 		currentCluster,
 		s.hostInfo,
 		serializer,
+		s.archivalMetadata,
+		s.archiverProvider,
 	)
 	return nil
 }
@@ -0,0 +1,371 @@
+package visibilityconsistency
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/searchattribute/sadefs"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// CheckPageRequest is the input of the activity that checks a single page of one of the visibility stores.
+	CheckPageRequest struct {
+		Namespace     string
+		Query         string
+		PageSize      int
+		RPS           float64
+		Backfill      bool
+		Phase         Phase
+		NextPageToken []byte
+	}
+
+	// CheckPageResponse is the output of the activity that checks a single page of one of the visibility stores.
+	CheckPageResponse struct {
+		NextPageToken    []byte
+		Checked          int64
+		Backfilled       int64
+		BackfillFailures int64
+		Divergences      []Divergence
+	}
+)
+
+// visibilityTimePrecision is the coarsest precision of the supported visibility stores. SQL stores keep timestamps
+// with microsecond precision while Elasticsearch keeps nanoseconds, so comparisons ignore anything finer.
+const visibilityTimePrecision = time.Microsecond
+
+func (c *workerComponent) checkPage(ctx context.Context, request CheckPageRequest) (CheckPageResponse, error) {
+	dualVisibilityManager, ok := c.visibilityManager.(*visibility.VisibilityManagerDual)
+	if !ok {
+		return CheckPageResponse{}, temporal.NewNonRetryableApplicationError(
+			ErrNotDualVisibility.Error(),
+			errorTypeInvalidRequest,
+			ErrNotDualVisibility,
+		)
+	}
+	nsEntry, err := c.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return CheckPageResponse{}, err
+	}
+
+	source := dualVisibilityManager.GetPrimaryVisibility()
+	target := dualVisibilityManager.GetSecondaryVisibility()
+	missingKind := DivergenceKindMissingInSecondary
+	if request.Phase == PhaseCompareSecondary {
+		source, target = target, source
+		missingKind = DivergenceKindMissingInPrimary
+	}
+
+	logger := log.With(c.logger, tag.WorkflowNamespace(request.Namespace))
+	metricsHandler := c.metricsHandler.WithTags(metrics.NamespaceTag(request.Namespace))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, max(1, int(request.RPS)))
+
+	if err := rateLimiter.Wait(ctx); err != nil {
+		return CheckPageResponse{}, err
+	}
+	resp, err := source.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   nsEntry.ID(),
+		Namespace:     nsEntry.Name(),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         sadefs.QueryWithAnyNamespaceDivision(request.Query),
+	})
+	if err != nil {
+		return CheckPageResponse{}, err
+	}
+	result := CheckPageResponse{
+		NextPageToken: resp.NextPageToken,
+		Checked:       int64(len(resp.Executions)),
+	}
+	metrics.VisibilityConsistencyCheckedCount.With(metricsHandler).Record(int64(len(resp.Executions)))
+	if len(resp.Executions) == 0 {
+		return result, nil
+	}
+
+	if err := rateLimiter.Wait(ctx); err != nil {
+		return CheckPageResponse{}, err
+	}
+	targetExecutions, err := c.lookupExecutions(ctx, target, nsEntry, resp.Executions)
+	if err != nil {
+		return CheckPageResponse{}, err
+	}
+
+	for _, execution := range resp.Executions {
+		targetExecution, found := targetExecutions[execution.GetExecution().GetRunId()]
+		// Records found in both stores are compared only once, while reading the primary store.
+		if found && (request.Phase != PhaseComparePrimary || len(diffExecutions(execution, targetExecution)) == 0) {
+			continue
+		}
+
+		// The record may have changed since the page was read, or the store that lags behind may have caught up, so
+		// the divergence is confirmed by reading both records again.
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return CheckPageResponse{}, err
+		}
+		divergence, err := c.confirmDivergence(ctx, source, target, nsEntry, execution.GetExecution(), missingKind)
+		if err != nil {
+			return CheckPageResponse{}, err
+		}
+		if divergence.Kind == "" {
+			continue
+		}
+		metrics.VisibilityConsistencyDivergenceCount.With(metricsHandler).Record(1)
+		logger.Info("Found divergent visibility record.",
+			tag.WorkflowID(divergence.WorkflowID),
+			tag.WorkflowRunID(divergence.RunID),
+			tag.NewStringTag("divergence-kind", string(divergence.Kind)),
+			tag.NewStringsTag("divergent-fields", divergence.Fields),
+		)
+
+		if request.Backfill {
+			if err := rateLimiter.Wait(ctx); err != nil {
+				return CheckPageResponse{}, err
+			}
+			if err := c.backfill(ctx, nsEntry, execution, divergence.Kind); err != nil {
+				result.BackfillFailures++
+				metrics.VisibilityConsistencyBackfillFailureCount.With(metricsHandler).Record(1)
+				logger.Warn("Unable to backfill visibility record.",
+					tag.WorkflowID(divergence.WorkflowID),
+					tag.WorkflowRunID(divergence.RunID),
+					tag.Error(err),
+				)
+			} else {
+				divergence.Backfilled = true
+				result.Backfilled++
+				metrics.VisibilityConsistencyBackfillCount.With(metricsHandler).Record(1)
+			}
+		}
+		result.Divergences = append(result.Divergences, divergence)
+	}
+	return result, nil
+}
+
+// lookupExecutions reads the given executions from a visibility store and returns them keyed by run ID.
+func (c *workerComponent) lookupExecutions(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	nsEntry *namespace.Namespace,
+	executions []*workflowpb.WorkflowExecutionInfo,
+) (map[string]*workflowpb.WorkflowExecutionInfo, error) {
+	runIDs := make([]string, 0, len(executions))
+	for _, execution := range executions {
+		runIDs = append(runIDs, fmt.Sprintf("'%s'", execution.GetExecution().GetRunId()))
+	}
+	query := fmt.Sprintf("%s IN (%s)", sadefs.RunID, strings.Join(runIDs, ", "))
+
+	result := make(map[string]*workflowpb.WorkflowExecutionInfo, len(executions))
+	var nextPageToken []byte
+	for {
+		resp, err := visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   nsEntry.ID(),
+			Namespace:     nsEntry.Name(),
+			PageSize:      len(executions),
+			NextPageToken: nextPageToken,
+			Query:         sadefs.QueryWithAnyNamespaceDivision(query),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range resp.Executions {
+			result[execution.GetExecution().GetRunId()] = execution
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// confirmDivergence reads the execution from both stores and returns the divergence between them, which has an empty
+// Kind if the records are now consistent.
+func (c *workerComponent) confirmDivergence(
+	ctx context.Context,
+	source manager.VisibilityManager,
+	target manager.VisibilityManager,
+	nsEntry *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+	missingKind DivergenceKind,
+) (Divergence, error) {
+	sourceExecution, err := getExecution(ctx, source, nsEntry, execution)
+	if err != nil {
+		return Divergence{}, err
+	}
+	targetExecution, err := getExecution(ctx, target, nsEntry, execution)
+	if err != nil {
+		return Divergence{}, err
+	}
+
+	divergence := Divergence{
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+	}
+	switch {
+	case sourceExecution == nil:
+		// The execution was deleted in the meantime.
+		return Divergence{}, nil
+	case targetExecution == nil:
+		divergence.Kind = missingKind
+		return divergence, nil
+	}
+
+	primary, secondary := sourceExecution, targetExecution
+	if missingKind == DivergenceKindMissingInPrimary {
+		primary, secondary = secondary, primary
+	}
+	divergence.Fields = diffExecutions(primary, secondary)
+	if len(divergence.Fields) == 0 {
+		return Divergence{}, nil
+	}
+	divergence.Kind = DivergenceKindMismatch
+	return divergence, nil
+}
+
+func getExecution(
+	ctx context.Context,
+	visibilityManager manager.VisibilityManager,
+	nsEntry *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	resp, err := visibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		WorkflowID:  execution.GetWorkflowId(),
+		RunID:       execution.GetRunId(),
+	})
+	switch err.(type) {
+	case nil:
+		return resp.Execution, nil
+	case *serviceerror.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// backfill rewrites a divergent record. Visibility tasks are regenerated from mutable state, which rewrites the record
+// in both stores. A record only found in the secondary store whose mutable state is gone is deleted instead.
+func (c *workerComponent) backfill(
+	ctx context.Context,
+	nsEntry *namespace.Namespace,
+	execution *workflowpb.WorkflowExecutionInfo,
+	kind DivergenceKind,
+) error {
+	archetypeID, err := workercommon.ArchetypeIDFromExecutionInfo(execution)
+	if err != nil {
+		return err
+	}
+	_, err = c.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: nsEntry.ID().String(),
+		ArchetypeId: archetypeID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: nsEntry.ID().String(),
+			Execution:   execution.GetExecution(),
+		},
+	})
+	if _, isNotFound := err.(*serviceerror.NotFound); !isNotFound || kind != DivergenceKindMissingInPrimary {
+		return err
+	}
+
+	_, err = c.historyClient.DeleteWorkflowVisibilityRecord(ctx, &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId:       nsEntry.ID().String(),
+		Execution:         execution.GetExecution(),
+		WorkflowStartTime: execution.GetStartTime(),
+		WorkflowCloseTime: execution.GetCloseTime(),
+	})
+	return err
+}
+
+// diffExecutions returns the names of the fields that differ between the primary and secondary record of an
+// execution.
+func diffExecutions(primary, secondary *workflowpb.WorkflowExecutionInfo) []string {
+	var fields []string
+	if primary.GetStatus() != secondary.GetStatus() {
+		fields = append(fields, sadefs.ExecutionStatus)
+	}
+	if !timestampsEqual(primary.GetCloseTime(), secondary.GetCloseTime()) {
+		fields = append(fields, sadefs.CloseTime)
+	}
+	return append(fields, diffSearchAttributes(primary.GetSearchAttributes(), secondary.GetSearchAttributes())...)
+}
+
+func timestampsEqual(a, b *timestamppb.Timestamp) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.AsTime().Truncate(visibilityTimePrecision).Equal(b.AsTime().Truncate(visibilityTimePrecision))
+}
+
+// diffSearchAttributes returns the sorted names of the search attributes that differ. A search attribute that is
+// missing is equal to one that is set to nil.
+func diffSearchAttributes(primary, secondary *commonpb.SearchAttributes) []string {
+	var fields []string
+	for name, value := range primary.GetIndexedFields() {
+		if !searchAttributeValuesEqual(value, secondary.GetIndexedFields()[name]) {
+			fields = append(fields, name)
+		}
+	}
+	for name, value := range secondary.GetIndexedFields() {
+		if _, ok := primary.GetIndexedFields()[name]; !ok && !searchAttributeValuesEqual(nil, value) {
+			fields = append(fields, name)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+func searchAttributeValuesEqual(a, b *commonpb.Payload) bool {
+	valueA, errA := decodeSearchAttributeValue(a)
+	valueB, errB := decodeSearchAttributeValue(b)
+	if errA != nil || errB != nil {
+		return proto.Equal(a, b)
+	}
+	return reflect.DeepEqual(valueA, valueB)
+}
+
+// decodeSearchAttributeValue decodes a search attribute value into a form where values that are stored differently by
+// the visibility stores, but mean the same thing, are equal.
+func decodeSearchAttributeValue(value *commonpb.Payload) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	decoded, err := searchattribute.DecodeValue(value, enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, true)
+	if err != nil {
+		return nil, err
+	}
+	switch decoded := decoded.(type) {
+	case time.Time:
+		return decoded.UTC().Truncate(visibilityTimePrecision), nil
+	case []time.Time:
+		normalized := make([]time.Time, len(decoded))
+		for i, t := range decoded {
+			normalized[i] = t.UTC().Truncate(visibilityTimePrecision)
+		}
+		return normalized, nil
+	case []string:
+		// Keyword lists are unordered.
+		return slices.Sorted(slices.Values(decoded)), nil
+	default:
+		return decoded, nil
+	}
+}
@@ -0,0 +1,277 @@
+package visibilityconsistency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testNamespace   = "test-namespace"
+	testNamespaceID = "test-namespace-id"
+)
+
+func newExecution(t *testing.T, runID string, status enumspb.WorkflowExecutionStatus, closeTime time.Time, searchAttributes map[string]any) *workflowpb.WorkflowExecutionInfo {
+	execution := &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "wid-" + runID, RunId: runID},
+		Status:    status,
+	}
+	if !closeTime.IsZero() {
+		execution.CloseTime = timestamppb.New(closeTime)
+	}
+	if searchAttributes != nil {
+		typeMap := searchattribute.NewNameTypeMap(map[string]enumspb.IndexedValueType{
+			"CustomKeywordField":     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"CustomKeywordListField": enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
+			"CustomDatetimeField":    enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			"CustomIntField":         enumspb.INDEXED_VALUE_TYPE_INT,
+		})
+		var err error
+		execution.SearchAttributes, err = searchattribute.Encode(searchAttributes, &typeMap)
+		require.NoError(t, err)
+	}
+	return execution
+}
+
+func Test_diffExecutions(t *testing.T) {
+	closeTime := time.Date(2024, 3, 1, 10, 0, 0, 123456789, time.UTC)
+	completed := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	running := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+	testCases := []struct {
+		name           string
+		primary        *workflowpb.WorkflowExecutionInfo
+		secondary      *workflowpb.WorkflowExecutionInfo
+		expectedFields []string
+	}{
+		{
+			name:      "equal",
+			primary:   newExecution(t, "rid", completed, closeTime, map[string]any{"CustomKeywordField": "a"}),
+			secondary: newExecution(t, "rid", completed, closeTime, map[string]any{"CustomKeywordField": "a"}),
+		},
+		{
+			name:      "close time with lower precision",
+			primary:   newExecution(t, "rid", completed, closeTime, nil),
+			secondary: newExecution(t, "rid", completed, closeTime.Truncate(time.Microsecond), nil),
+		},
+		{
+			name:           "status and close time",
+			primary:        newExecution(t, "rid", completed, closeTime, nil),
+			secondary:      newExecution(t, "rid", running, time.Time{}, nil),
+			expectedFields: []string{"ExecutionStatus", "CloseTime"},
+		},
+		{
+			name: "keyword list order and datetime precision",
+			primary: newExecution(t, "rid", running, time.Time{}, map[string]any{
+				"CustomKeywordListField": []string{"a", "b"},
+				"CustomDatetimeField":    closeTime,
+			}),
+			secondary: newExecution(t, "rid", running, time.Time{}, map[string]any{
+				"CustomKeywordListField": []string{"b", "a"},
+				"CustomDatetimeField":    closeTime.Truncate(time.Microsecond),
+			}),
+		},
+		{
+			name:           "search attribute values",
+			primary:        newExecution(t, "rid", running, time.Time{}, map[string]any{"CustomKeywordField": "a", "CustomIntField": int64(1)}),
+			secondary:      newExecution(t, "rid", running, time.Time{}, map[string]any{"CustomKeywordField": "b", "CustomIntField": int64(1)}),
+			expectedFields: []string{"CustomKeywordField"},
+		},
+		{
+			name:           "missing search attributes",
+			primary:        newExecution(t, "rid", running, time.Time{}, map[string]any{"CustomKeywordField": "a"}),
+			secondary:      newExecution(t, "rid", running, time.Time{}, map[string]any{"CustomIntField": int64(1)}),
+			expectedFields: []string{"CustomIntField", "CustomKeywordField"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedFields, diffExecutions(tc.primary, tc.secondary))
+		})
+	}
+}
+
+type checkPageTest struct {
+	component     *workerComponent
+	primary       *manager.MockVisibilityManager
+	secondary     *manager.MockVisibilityManager
+	historyClient *historyservicemock.MockHistoryServiceClient
+}
+
+func newCheckPageTest(t *testing.T) *checkPageTest {
+	ctrl := gomock.NewController(t)
+	primary := manager.NewMockVisibilityManager(ctrl)
+	secondary := manager.NewMockVisibilityManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name(testNamespace)).Return(namespace.NewNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: testNamespaceID, Name: testNamespace},
+		nil,
+		false,
+		nil,
+		0,
+	), nil).AnyTimes()
+	return &checkPageTest{
+		component: &workerComponent{
+			visibilityManager: visibility.NewVisibilityManagerDual(primary, secondary, nil, nil),
+			namespaceRegistry: namespaceRegistry,
+			historyClient:     historyClient,
+			metricsHandler:    metrics.NoopMetricsHandler,
+			logger:            log.NewTestLogger(),
+		},
+		primary:       primary,
+		secondary:     secondary,
+		historyClient: historyClient,
+	}
+}
+
+func expectGetExecution(visibilityManager *manager.MockVisibilityManager, execution *workflowpb.WorkflowExecutionInfo, runID string) {
+	call := visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		WorkflowID:  "wid-" + runID,
+		RunID:       runID,
+	})
+	if execution == nil {
+		call.Return(nil, serviceerror.NewNotFound("not found"))
+	} else {
+		call.Return(&manager.GetWorkflowExecutionResponse{Execution: execution}, nil)
+	}
+}
+
+func Test_checkPage_ComparePrimary(t *testing.T) {
+	test := newCheckPageTest(t)
+	closeTime := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	completed := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	running := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+	consistent := newExecution(t, "rid-1", completed, closeTime, nil)
+	missing := newExecution(t, "rid-2", running, time.Time{}, nil)
+	mismatched := newExecution(t, "rid-3", completed, closeTime, nil)
+	mismatchedSecondary := newExecution(t, "rid-3", running, time.Time{}, nil)
+	caughtUp := newExecution(t, "rid-4", completed, closeTime, nil)
+
+	test.primary.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   testNamespaceID,
+		Namespace:     testNamespace,
+		PageSize:      10,
+		NextPageToken: []byte("page-1"),
+		Query:         "(WorkflowType = 'test-type') AND (TemporalNamespaceDivision IS NULL OR TemporalNamespaceDivision IS NOT NULL)",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{consistent, missing, mismatched, caughtUp},
+		NextPageToken: []byte("page-2"),
+	}, nil)
+	test.secondary.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    4,
+		Query:       "(RunId IN ('rid-1', 'rid-2', 'rid-3', 'rid-4')) AND (TemporalNamespaceDivision IS NULL OR TemporalNamespaceDivision IS NOT NULL)",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{consistent, mismatchedSecondary},
+	}, nil)
+
+	expectGetExecution(test.primary, missing, "rid-2")
+	expectGetExecution(test.secondary, nil, "rid-2")
+	expectGetExecution(test.primary, mismatched, "rid-3")
+	expectGetExecution(test.secondary, mismatchedSecondary, "rid-3")
+	expectGetExecution(test.primary, caughtUp, "rid-4")
+	expectGetExecution(test.secondary, caughtUp, "rid-4")
+
+	test.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.RefreshWorkflowTasksRequest, _ ...any) (*historyservice.RefreshWorkflowTasksResponse, error) {
+			require.Equal(t, testNamespaceID, request.NamespaceId)
+			require.Equal(t, missing.Execution, request.Request.Execution)
+			return &historyservice.RefreshWorkflowTasksResponse{}, nil
+		})
+	test.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable"))
+
+	response, err := test.component.checkPage(context.Background(), CheckPageRequest{
+		Namespace:     testNamespace,
+		Query:         "WorkflowType = 'test-type'",
+		PageSize:      10,
+		RPS:           1000,
+		Backfill:      true,
+		Phase:         PhaseComparePrimary,
+		NextPageToken: []byte("page-1"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, CheckPageResponse{
+		NextPageToken:    []byte("page-2"),
+		Checked:          4,
+		Backfilled:       1,
+		BackfillFailures: 1,
+		Divergences: []Divergence{
+			{WorkflowID: "wid-rid-2", RunID: "rid-2", Kind: DivergenceKindMissingInSecondary, Backfilled: true},
+			{WorkflowID: "wid-rid-3", RunID: "rid-3", Kind: DivergenceKindMismatch, Fields: []string{"ExecutionStatus", "CloseTime"}},
+		},
+	}, response)
+}
+
+func Test_checkPage_CompareSecondary(t *testing.T) {
+	test := newCheckPageTest(t)
+	closeTime := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	completed := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+
+	existing := newExecution(t, "rid-1", completed, closeTime, nil)
+	deleted := newExecution(t, "rid-2", completed, closeTime, nil)
+
+	test.secondary.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{existing, deleted},
+	}, nil)
+	test.primary.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+
+	expectGetExecution(test.secondary, existing, "rid-1")
+	expectGetExecution(test.primary, nil, "rid-1")
+	expectGetExecution(test.secondary, deleted, "rid-2")
+	expectGetExecution(test.primary, nil, "rid-2")
+
+	test.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+	test.historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("not found"))
+	test.historyClient.EXPECT().DeleteWorkflowVisibilityRecord(gomock.Any(), &historyservice.DeleteWorkflowVisibilityRecordRequest{
+		NamespaceId:       testNamespaceID,
+		Execution:         deleted.Execution,
+		WorkflowCloseTime: deleted.CloseTime,
+	}).Return(&historyservice.DeleteWorkflowVisibilityRecordResponse{}, nil)
+
+	response, err := test.component.checkPage(context.Background(), CheckPageRequest{
+		Namespace: testNamespace,
+		PageSize:  10,
+		RPS:       1000,
+		Backfill:  true,
+		Phase:     PhaseCompareSecondary,
+	})
+	require.NoError(t, err)
+	require.Equal(t, CheckPageResponse{
+		Checked:    2,
+		Backfilled: 2,
+		Divergences: []Divergence{
+			{WorkflowID: "wid-rid-1", RunID: "rid-1", Kind: DivergenceKindMissingInPrimary, Backfilled: true},
+			{WorkflowID: "wid-rid-2", RunID: "rid-2", Kind: DivergenceKindMissingInPrimary, Backfilled: true},
+		},
+	}, response)
+}
+
+func Test_checkPage_NotDualVisibility(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := &workerComponent{visibilityManager: manager.NewMockVisibilityManager(ctrl)}
+	_, err := c.checkPage(context.Background(), CheckPageRequest{Namespace: testNamespace})
+	require.ErrorContains(t, err, ErrNotDualVisibility.Error())
+}
@@ -0,0 +1,65 @@
+package visibilityconsistency
+
+import (
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/resource"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	workerComponentParams struct {
+		fx.In
+		VisibilityManager manager.VisibilityManager
+		NamespaceRegistry namespace.Registry
+		HistoryClient     resource.HistoryClient
+		MetricsHandler    metrics.Handler
+		Logger            log.Logger
+	}
+
+	workerComponent struct {
+		visibilityManager manager.VisibilityManager
+		namespaceRegistry namespace.Registry
+		historyClient     historyservice.HistoryServiceClient
+		metricsHandler    metrics.Handler
+		logger            log.Logger
+	}
+)
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph.
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params workerComponentParams) workercommon.WorkerComponent {
+	return &workerComponent{
+		visibilityManager: params.VisibilityManager,
+		namespaceRegistry: params.NamespaceRegistry,
+		historyClient:     params.HistoryClient,
+		metricsHandler:    params.MetricsHandler,
+		logger:            params.Logger,
+	}
+}
+
+func (c *workerComponent) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(c.workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *workerComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// Use default worker
+	return nil
+}
+
+func (c *workerComponent) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.checkPage, activity.RegisterOptions{Name: checkPageActivityName})
+}
+
+func (c *workerComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// Use default worker
+	return nil
+}
@@ -0,0 +1,253 @@
+// Package visibilityconsistency contains the workflow that compares the primary and secondary visibility stores of a
+// dual-write visibility setup and optionally backfills the divergent records from mutable state.
+package visibilityconsistency
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+)
+
+type (
+	// WorkflowParams is the single argument to the visibility consistency check workflow.
+	WorkflowParams struct {
+		// Namespace is the name of the namespace to check.
+		Namespace string
+		// Query is an optional List filter that restricts the executions being checked.
+		Query string
+		// PageSize is the number of records read from a visibility store at a time. The maximum is MaxPageSize. The
+		// default is DefaultPageSize.
+		PageSize int
+		// RPS limits the number of visibility and history requests per second made by the check. The default is
+		// DefaultRPS.
+		RPS float64
+		// Backfill enables rewriting divergent records. Records that still have mutable state get their visibility
+		// tasks regenerated, which rewrites both stores. Records that only exist in the secondary store and no longer
+		// have mutable state are deleted.
+		Backfill bool
+
+		// Report carries the progress of the check across continue-as-new. It must be empty when starting the
+		// workflow.
+		Report Report
+		// NextPageToken carries the position in the current phase across continue-as-new. It must be empty when
+		// starting the workflow.
+		NextPageToken []byte
+	}
+
+	// Phase is the step of the check the workflow is in.
+	Phase string
+
+	// Report is the result of the workflow and the response to the progress query.
+	Report struct {
+		Namespace string
+		Phase     Phase
+		Backfill  bool
+		// Checked is the number of records read from either store.
+		Checked int64
+		// MissingInSecondary is the number of records found in the primary store but not in the secondary store.
+		MissingInSecondary int64
+		// MissingInPrimary is the number of records found in the secondary store but not in the primary store.
+		MissingInPrimary int64
+		// Mismatched is the number of records found in both stores with different values.
+		Mismatched int64
+		// Backfilled is the number of divergent records that were rewritten.
+		Backfilled int64
+		// BackfillFailures is the number of divergent records that could not be rewritten.
+		BackfillFailures int64
+		// Divergences holds up to MaxReportedDivergences of the divergent records, in the order they were found.
+		Divergences []Divergence
+	}
+
+	// DivergenceKind describes how a record differs between the two stores.
+	DivergenceKind string
+
+	// Divergence is a single record that is missing or differs between the two stores.
+	Divergence struct {
+		WorkflowID string
+		RunID      string
+		Kind       DivergenceKind
+		// Fields lists the fields that differ when Kind is DivergenceKindMismatch.
+		Fields []string
+		// Backfilled is true if the record was rewritten.
+		Backfilled bool
+	}
+)
+
+const (
+	// WorkflowName is the name of the visibility consistency check workflow.
+	WorkflowName = "temporal-sys-visibility-consistency-check-workflow"
+	// QueryTypeProgress is the query to get the progress of the visibility consistency check workflow.
+	QueryTypeProgress = "visibility-consistency-check-progress-query"
+
+	// PhaseComparePrimary pages through the primary store and compares every record with the secondary store.
+	PhaseComparePrimary Phase = "compare-primary"
+	// PhaseCompareSecondary pages through the secondary store looking for records missing in the primary store.
+	PhaseCompareSecondary Phase = "compare-secondary"
+	// PhaseCompleted is the phase after both stores have been fully read.
+	PhaseCompleted Phase = "completed"
+
+	// DivergenceKindMissingInSecondary is a record found in the primary store but not in the secondary store.
+	DivergenceKindMissingInSecondary DivergenceKind = "missing-in-secondary"
+	// DivergenceKindMissingInPrimary is a record found in the secondary store but not in the primary store.
+	DivergenceKindMissingInPrimary DivergenceKind = "missing-in-primary"
+	// DivergenceKindMismatch is a record found in both stores with different values.
+	DivergenceKindMismatch DivergenceKind = "mismatch"
+
+	// MaxPageSize is the maximum value for WorkflowParams.PageSize.
+	MaxPageSize = 1000
+	// DefaultPageSize is the default value for WorkflowParams.PageSize.
+	DefaultPageSize = 100
+	// DefaultRPS is the default value for WorkflowParams.RPS.
+	DefaultRPS = 50
+	// MaxReportedDivergences is the maximum number of divergent records kept in the report.
+	MaxReportedDivergences = 1000
+
+	errorTypeInvalidRequest = "visibility-consistency-error-type-invalid-request"
+	checkPageActivityName   = "visibility-consistency-check-page-activity"
+
+	// checkPageActivityTimeout is long enough to compare and backfill a single page at a low RPS.
+	checkPageActivityTimeout = 10 * time.Minute * debug.TimeoutMultiplier
+)
+
+var (
+	ErrNamespaceNotSet   = errors.New("Namespace is required")
+	ErrInvalidPageSize   = errors.New("PageSize must be positive or 0 to use the default")
+	ErrPageSizeTooLarge  = errors.New("PageSize too large")
+	ErrInvalidRPS        = errors.New("RPS must be positive or 0 to use the default")
+	ErrNotDualVisibility = errors.New("secondary visibility store is not configured")
+
+	checkPageActivityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+		MaximumAttempts:    10,
+	}
+)
+
+//revive:disable:import-shadowing this doesn't actually shadow imports because it's a method, not a function
+func (c *workerComponent) workflow(ctx workflow.Context, params WorkflowParams) (Report, error) {
+	params, err := parseWorkflowParams(params)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := params.Report
+	report.Namespace = params.Namespace
+	report.Backfill = params.Backfill
+	if report.Phase == "" {
+		report.Phase = PhaseComparePrimary
+	}
+	err = workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Report, error) {
+		return report, nil
+	})
+	if err != nil {
+		return Report{}, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: checkPageActivityTimeout,
+		RetryPolicy:         checkPageActivityRetryPolicy,
+	})
+	nextPageToken := params.NextPageToken
+	for report.Phase != PhaseCompleted {
+		var response CheckPageResponse
+		err := workflow.ExecuteActivity(ctx, checkPageActivityName, CheckPageRequest{
+			Namespace:     params.Namespace,
+			Query:         params.Query,
+			PageSize:      params.PageSize,
+			RPS:           params.RPS,
+			Backfill:      params.Backfill,
+			Phase:         report.Phase,
+			NextPageToken: nextPageToken,
+		}).Get(ctx, &response)
+		if err != nil {
+			return report, err
+		}
+		report.add(response)
+
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			report.Phase = report.Phase.next()
+		}
+
+		if report.Phase != PhaseCompleted && workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			params.Report = report
+			params.NextPageToken = nextPageToken
+			return report, workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+		}
+	}
+	return report, nil
+}
+
+func parseWorkflowParams(params WorkflowParams) (WorkflowParams, error) {
+	// Errors returned from the workflow are already non-retryable, but we're returning non-retryable errors here in
+	// case this code is ever used within an activity.
+	if params.Namespace == "" {
+		return params, temporal.NewNonRetryableApplicationError(
+			ErrNamespaceNotSet.Error(),
+			errorTypeInvalidRequest,
+			ErrNamespaceNotSet,
+		)
+	}
+	if params.PageSize < 0 {
+		return params, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Invalid page size of %v", params.PageSize),
+			errorTypeInvalidRequest,
+			ErrInvalidPageSize,
+		)
+	}
+	if params.PageSize > MaxPageSize {
+		return params, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Page size, %d, must be less than or equal to %d", params.PageSize, MaxPageSize),
+			errorTypeInvalidRequest,
+			ErrPageSizeTooLarge,
+		)
+	}
+	if params.RPS < 0 {
+		return params, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Invalid RPS of %v", params.RPS),
+			errorTypeInvalidRequest,
+			ErrInvalidRPS,
+		)
+	}
+
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.RPS == 0 {
+		params.RPS = DefaultRPS
+	}
+	return params, nil
+}
+
+func (p Phase) next() Phase {
+	switch p {
+	case PhaseComparePrimary:
+		return PhaseCompareSecondary
+	default:
+		return PhaseCompleted
+	}
+}
+
+func (r *Report) add(response CheckPageResponse) {
+	r.Checked += response.Checked
+	r.Backfilled += response.Backfilled
+	r.BackfillFailures += response.BackfillFailures
+	for _, divergence := range response.Divergences {
+		switch divergence.Kind {
+		case DivergenceKindMissingInSecondary:
+			r.MissingInSecondary++
+		case DivergenceKindMissingInPrimary:
+			r.MissingInPrimary++
+		case DivergenceKindMismatch:
+			r.Mismatched++
+		}
+		if len(r.Divergences) < MaxReportedDivergences {
+			r.Divergences = append(r.Divergences, divergence)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package visibilityconsistency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+)
+
+func TestWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(log.NewSdkLogger(log.NewTestLogger()))
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	c := &workerComponent{}
+	env.RegisterWorkflowWithOptions(c.workflow, workflow.RegisterOptions{Name: WorkflowName})
+
+	var requests []CheckPageRequest
+	env.RegisterActivityWithOptions(func(_ context.Context, request CheckPageRequest) (CheckPageResponse, error) {
+		requests = append(requests, request)
+		switch {
+		case request.Phase == PhaseComparePrimary && request.NextPageToken == nil:
+			return CheckPageResponse{
+				NextPageToken: []byte("token"),
+				Checked:       2,
+				Divergences: []Divergence{
+					{WorkflowID: "wid-1", RunID: "rid-1", Kind: DivergenceKindMissingInSecondary, Backfilled: true},
+				},
+				Backfilled: 1,
+			}, nil
+		case request.Phase == PhaseComparePrimary:
+			return CheckPageResponse{
+				Checked: 1,
+				Divergences: []Divergence{
+					{WorkflowID: "wid-2", RunID: "rid-2", Kind: DivergenceKindMismatch, Fields: []string{"CloseTime"}},
+				},
+				BackfillFailures: 1,
+			}, nil
+		default:
+			return CheckPageResponse{
+				Checked: 3,
+				Divergences: []Divergence{
+					{WorkflowID: "wid-3", RunID: "rid-3", Kind: DivergenceKindMissingInPrimary, Backfilled: true},
+				},
+				Backfilled: 1,
+			}, nil
+		}
+	}, activity.RegisterOptions{Name: checkPageActivityName})
+
+	env.ExecuteWorkflow(WorkflowName, WorkflowParams{
+		Namespace: "test-namespace",
+		Query:     "WorkflowType = 'test-type'",
+		Backfill:  true,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	require.Len(t, requests, 3)
+	for _, request := range requests {
+		require.Equal(t, "test-namespace", request.Namespace)
+		require.Equal(t, "WorkflowType = 'test-type'", request.Query)
+		require.Equal(t, DefaultPageSize, request.PageSize)
+		require.Equal(t, float64(DefaultRPS), request.RPS)
+		require.True(t, request.Backfill)
+	}
+	require.Equal(t, []byte("token"), requests[1].NextPageToken)
+	require.Equal(t, PhaseCompareSecondary, requests[2].Phase)
+	require.Nil(t, requests[2].NextPageToken)
+
+	var report Report
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.Equal(t, PhaseCompleted, report.Phase)
+	require.Equal(t, int64(6), report.Checked)
+	require.Equal(t, int64(1), report.MissingInSecondary)
+	require.Equal(t, int64(1), report.MissingInPrimary)
+	require.Equal(t, int64(1), report.Mismatched)
+	require.Equal(t, int64(2), report.Backfilled)
+	require.Equal(t, int64(1), report.BackfillFailures)
+	require.Len(t, report.Divergences, 3)
+
+	encodedReport, err := env.QueryWorkflow(QueryTypeProgress)
+	require.NoError(t, err)
+	var queriedReport Report
+	require.NoError(t, encodedReport.Get(&queriedReport))
+	require.Equal(t, report, queriedReport)
+}
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		params      WorkflowParams
+		expectedErr error
+	}{
+		{
+			name:        "namespace not set",
+			params:      WorkflowParams{},
+			expectedErr: ErrNamespaceNotSet,
+		},
+		{
+			name:        "negative page size",
+			params:      WorkflowParams{Namespace: "test-namespace", PageSize: -1},
+			expectedErr: ErrInvalidPageSize,
+		},
+		{
+			name:        "page size too large",
+			params:      WorkflowParams{Namespace: "test-namespace", PageSize: MaxPageSize + 1},
+			expectedErr: ErrPageSizeTooLarge,
+		},
+		{
+			name:        "negative rps",
+			params:      WorkflowParams{Namespace: "test-namespace", RPS: -1},
+			expectedErr: ErrInvalidRPS,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			c := &workerComponent{}
+			env.RegisterWorkflowWithOptions(c.workflow, workflow.RegisterOptions{Name: WorkflowName})
+
+			env.ExecuteWorkflow(WorkflowName, tc.params)
+			require.True(t, env.IsWorkflowCompleted())
+			err := env.GetWorkflowError()
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, err, &applicationErr)
+			require.Equal(t, errorTypeInvalidRequest, applicationErr.Type())
+			require.ErrorContains(t, err, tc.expectedErr.Error())
+		})
+	}
+}
+
+func TestReport_CapsDivergences(t *testing.T) {
+	var report Report
+	divergences := make([]Divergence, MaxReportedDivergences+1)
+	for i := range divergences {
+		divergences[i].Kind = DivergenceKindMismatch
+	}
+	report.add(CheckPageResponse{Divergences: divergences})
+	require.Equal(t, int64(MaxReportedDivergences+1), report.Mismatched)
+	require.Len(t, report.Divergences, MaxReportedDivergences)
+}
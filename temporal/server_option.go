@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -154,6 +155,30 @@ func WithCustomVisibilityStoreFactory(customFactory visibility.VisibilityStoreFa
 	})
 }
 
+// WithHistoryArchiver registers a custom history archiver for URIs with the given scheme, e.g. "myblob" for
+// "myblob://bucket/path". Built-in schemes (file, gs, s3, azblob) cannot be overridden.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithHistoryArchiver(scheme string, factory provider.HistoryArchiverFactory) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		if s.archiverFactories.History == nil {
+			s.archiverFactories.History = make(map[string]provider.HistoryArchiverFactory)
+		}
+		s.archiverFactories.History[scheme] = factory
+	})
+}
+
+// WithVisibilityArchiver registers a custom visibility archiver for URIs with the given scheme.
+// Built-in schemes (file, gs, s3, azblob) cannot be overridden.
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithVisibilityArchiver(scheme string, factory provider.VisibilityArchiverFactory) ServerOption {
+	return applyFunc(func(s *serverOptions) {
+		if s.archiverFactories.Visibility == nil {
+			s.archiverFactories.Visibility = make(map[string]provider.VisibilityArchiverFactory)
+		}
+		s.archiverFactories.Visibility[scheme] = factory
+	})
+}
+
 // WithClientFactoryProvider sets a custom ClientFactoryProvider
 // NOTE: this option is experimental and may be changed or removed in future release.
 func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) ServerOption {
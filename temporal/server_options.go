@@ -7,6 +7,7 @@ import (
 	"slices"
 
 	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -55,6 +56,7 @@ type (
 		searchAttributesMapper       searchattribute.Mapper
 		customFrontendInterceptors   []grpc.UnaryServerInterceptor
 		metricHandler                metrics.Handler
+		archiverFactories            provider.ArchiverFactories
 	}
 )
 
@@ -89,6 +91,10 @@ func (so *serverOptions) loadAndValidate() error {
 		return fmt.Errorf("config validation error: %w", err)
 	}
 
+	if err := so.archiverFactories.Validate(); err != nil {
+		return fmt.Errorf("invalid custom archiver: %w", err)
+	}
+
 	return nil
 }
 
@@ -986,6 +986,23 @@ func (s *AdvancedVisibilitySuite) TestCountGroupByWorkflow() {
 		resp.Groups[1],
 	)
 
+	if s.enableUnifiedQueryConverter {
+		query = fmt.Sprintf(`WorkflowType = %q GROUP BY ExecutionStatus, WorkflowType`, wt)
+		countRequest.Query = query
+		resp, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
+		s.NoError(err)
+		s.Equal(int64(numWorkflows), resp.GetCount())
+		s.Len(resp.Groups, 2)
+		s.Len(resp.Groups[0].GroupValues, 2)
+
+		query = `GROUP BY StartTime`
+		countRequest.Query = query
+		_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
+		s.Error(err)
+		s.Contains(strings.ToLower(err.Error()), "'group by' clause is only supported for")
+		return
+	}
+
 	query = `GROUP BY WorkflowType`
 	countRequest.Query = query
 	_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
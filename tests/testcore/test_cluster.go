@@ -469,7 +469,7 @@ func newArchiverBase(enabled bool, executionManager persistence.ExecutionManager
 	if !enabled {
 		return &ArchiverBase{
 			metadata: archiver.NewArchivalMetadata(dcCollection, "", false, "", false, &config.ArchivalNamespaceDefaults{}),
-			provider: provider.NewArchiverProvider(nil, nil, nil, logger, metrics.NoopMetricsHandler),
+			provider: provider.NewArchiverProvider(nil, nil, provider.ArchiverFactories{}, nil, logger, metrics.NoopMetricsHandler),
 		}
 	}
 
@@ -492,6 +492,7 @@ func newArchiverBase(enabled bool, executionManager persistence.ExecutionManager
 		&config.VisibilityArchiverProvider{
 			Filestore: cfg,
 		},
+		provider.ArchiverFactories{},
 		executionManager,
 		logger,
 		metrics.NoopMetricsHandler,
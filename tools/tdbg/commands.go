@@ -1,6 +1,7 @@
 package tdbg
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
@@ -34,6 +35,9 @@ import (
 const (
 	historyImportBlobSize = 16
 	historyImportPageSize = 256 * 1024 // 256K
+
+	// queueActionIsolateNamespace is the only queue action scoped to a namespace
+	queueActionIsolateNamespace = "isolate-namespace"
 )
 
 // AdminShowWorkflow shows history
@@ -240,6 +244,40 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminRestoreArchivedWorkflow restores a workflow whose history was archived back into the database
+func AdminRestoreArchivedWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.RestoreArchivedWorkflowExecution(ctx, &adminservice.RestoreArchivedWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to restore archived workflow: %s", err)
+	}
+	// nolint:errcheck // assuming that write will succeed.
+	fmt.Fprintf(c.App.Writer, "restored workflow with %d history events.\n", resp.GetHistoryLength())
+	return nil
+}
+
 // AdminDescribeExecution describes a Temporal execution (CHASM tree or workflow).
 func AdminDescribeExecution(c *cli.Context, clientFactory ClientFactory) error {
 	resp, err := describeMutableState(c, clientFactory)
@@ -548,6 +586,118 @@ func AdminDescribeShard(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminReshardHistory splits the history shards of the cluster into more shards
+func AdminReshardHistory(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	req := &adminservice.ReshardHistoryRequest{
+		TargetShardCount: int32(c.Int(FlagTargetShardCount)),
+		Cutover:          c.Bool(FlagCutover),
+		PageSize:         int32(c.Int(FlagPageSize)),
+	}
+	if c.IsSet(FlagPageToken) {
+		token, err := base64.StdEncoding.DecodeString(c.String(FlagPageToken))
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", FlagPageToken, err)
+		}
+		req.NextPageToken = token
+	}
+	if req.Cutover {
+		prompter.Prompt(fmt.Sprintf(
+			"Split history shards into %d shards and update the history shard count of the cluster? "+
+				"The history service must be stopped.", req.TargetShardCount))
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	var total, shard *adminservice.ReshardHistoryResponse
+	printShard := func() {
+		if shard == nil {
+			return
+		}
+		fmt.Fprintf(c.App.Writer, "%s shard %d: %d executions copied, %d unchanged, %d pruned, %d deleted, %d tasks copied, %d deleted\n",
+			shard.GetPhase(), shard.GetShardId(), shard.GetExecutionsCopied(), shard.GetExecutionsUnchanged(),
+			shard.GetExecutionsPruned(), shard.GetExecutionsDeleted(), shard.GetTasksCopied(), shard.GetTasksDeleted())
+	}
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ReshardHistory(ctx, req)
+		cancel()
+		if err != nil {
+			printShard()
+			if len(req.NextPageToken) > 0 {
+				return fmt.Errorf("unable to reshard history, resume with --%s %s: %w",
+					FlagPageToken, base64.StdEncoding.EncodeToString(req.NextPageToken), err)
+			}
+			return fmt.Errorf("unable to reshard history: %w", err)
+		}
+		if shard == nil || shard.GetPhase() != resp.GetPhase() || shard.GetShardId() != resp.GetShardId() {
+			printShard()
+			shard = &adminservice.ReshardHistoryResponse{Phase: resp.GetPhase(), ShardId: resp.GetShardId()}
+		}
+		if total == nil {
+			total = &adminservice.ReshardHistoryResponse{
+				SourceShardCount: resp.GetSourceShardCount(),
+				TargetShardCount: resp.GetTargetShardCount(),
+			}
+		}
+		for _, r := range []*adminservice.ReshardHistoryResponse{shard, total} {
+			r.ExecutionsCopied += resp.GetExecutionsCopied()
+			r.ExecutionsUnchanged += resp.GetExecutionsUnchanged()
+			r.ExecutionsPruned += resp.GetExecutionsPruned()
+			r.ExecutionsDeleted += resp.GetExecutionsDeleted()
+			r.TasksCopied += resp.GetTasksCopied()
+			r.TasksDeleted += resp.GetTasksDeleted()
+		}
+		req.NextPageToken = resp.GetNextPageToken()
+		if len(req.NextPageToken) == 0 {
+			break
+		}
+	}
+	printShard()
+
+	prettyPrintJSONObject(c, total)
+	if req.Cutover {
+		fmt.Fprintf(c.App.Writer, "History shard count updated from %d to %d, restart all services.\n",
+			total.GetSourceShardCount(), total.GetTargetShardCount())
+	}
+	return nil
+}
+
+// AdminRunQueueAction runs a mitigation action on a history queue of a shard
+func AdminRunQueueAction(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+) error {
+	category, err := getCategory(taskCategoryRegistry, c.String(FlagTaskCategory))
+	if err != nil {
+		return err
+	}
+	req := &adminservice.RunQueueActionRequest{
+		ShardId:   int32(c.Int(FlagShardID)),
+		Category:  int32(category.ID()),
+		Action:    c.String(FlagQueueAction),
+		ReaderId:  c.Int64(FlagReaderID),
+		Threshold: int32(c.Int(FlagThreshold)),
+	}
+	if req.Action == queueActionIsolateNamespace {
+		req.Namespace = c.String(FlagNamespace)
+	}
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RunQueueAction(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to run queue action: %w", err)
+	}
+	if !resp.GetActionTaken() {
+		fmt.Fprintf(c.App.Writer, "Queue action %s found nothing to change on shard %d.\n", req.Action, req.ShardId)
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Queue action %s ran on shard %d.\n", req.Action, req.ShardId)
+	return nil
+}
+
 // AdminShardManagement describes history host
 func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	adminClient := clientFactory.AdminClient(c)
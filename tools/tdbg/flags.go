@@ -75,4 +75,28 @@ var (
 	FlagMinPass                    = "min-pass"
 	FlagVisibilityQuery            = "query"
 	FlagJobID                      = "job-id"
+	FlagRPS                        = "rps"
+	FlagBackfill                   = "backfill"
+	FlagMaxInFlight                = "max-in-flight"
+	FlagFairnessKeyMaxInFlight     = "fairness-key-max-in-flight"
+	FlagWorkflowType               = "workflow-type"
+	FlagActivityType               = "activity-type"
+	FlagFairnessKey                = "fairness-key"
+	FlagPriorityKey                = "priority-key"
+	FlagBuildID                    = "build-id"
+	FlagMinAge                     = "min-age"
+	FlagMaxAge                     = "max-age"
+	FlagSummary                    = "summary"
+	FlagResolveTypes               = "resolve-types"
+	FlagCreatedBefore              = "created-before"
+	FlagDestinationTaskQueue       = "destination-task-queue"
+	FlagDuration                   = "duration"
+	FlagCancelPurgeID              = "cancel-purge-id"
+	FlagInterval                   = "interval"
+	FlagTargetShardCount           = "target-shard-count"
+	FlagCutover                    = "cutover"
+	FlagPageToken                  = "page-token"
+	FlagQueueAction                = "action"
+	FlagReaderID                   = "reader-id"
+	FlagThreshold                  = "threshold"
 )
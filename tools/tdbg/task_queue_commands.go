@@ -1,14 +1,20 @@
 package tdbg
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -91,6 +97,167 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// backlogSummaryPageSize is the number of tasks read at a time to count the backlog.
+const backlogSummaryPageSize = 1000
+
+type backlogTaskRow struct {
+	TaskID       int64
+	WorkflowID   string
+	RunID        string
+	WorkflowType string
+	ActivityType string
+	Attempt      int32
+	FairnessKey  string
+	PriorityKey  int32
+	BuildID      string
+	Age          time.Duration
+	LookupError  string
+}
+
+// AdminListTaskQueueBacklog displays decoded backlog tasks, or counts of them
+func AdminListTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("missing Task Queue type")
+	}
+	partition := tqid.UnsafeTaskQueueFamily("", tqName).TaskQueue(tqType).NormalPartition(c.Int(FlagPartitionID))
+	pageSize := c.Int(FlagPageSize)
+
+	filter := &adminservice.BacklogTaskFilter{
+		WorkflowType: c.String(FlagWorkflowType),
+		ActivityType: c.String(FlagActivityType),
+		FairnessKey:  c.String(FlagFairnessKey),
+		PriorityKey:  int32(c.Int(FlagPriorityKey)),
+		BuildId:      c.String(FlagBuildID),
+	}
+	if c.IsSet(FlagMinAge) {
+		filter.MinAge = durationpb.New(c.Duration(FlagMinAge))
+	}
+	if c.IsSet(FlagMaxAge) {
+		filter.MaxAge = durationpb.New(c.Duration(FlagMaxAge))
+	}
+	req := &adminservice.ListTaskQueueBacklogRequest{
+		Namespace:     namespace,
+		TaskQueue:     partition.RpcName(),
+		TaskQueueType: tqType,
+		Subqueue:      int32(c.Int(FlagSubqueue)),
+		Fairness:      c.Bool(FlagFair),
+		Filter:        filter,
+		ResolveTypes:  c.Bool(FlagResolveTypes),
+		PageSize:      int32(pageSize),
+	}
+	client := clientFactory.AdminClient(c)
+
+	if c.Bool(FlagSummary) {
+		if !c.IsSet(FlagPageSize) {
+			req.PageSize = backlogSummaryPageSize
+		}
+		summary := &adminservice.BacklogSummary{}
+		var scanned int64
+		for {
+			ctx, cancel := newContext(c)
+			response, err := client.ListTaskQueueBacklog(ctx, req)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("unable to list Task Queue backlog: %w", err)
+			}
+			scanned += response.GetScannedTasks()
+			mergeBacklogSummary(summary, response.GetSummary())
+			if len(response.GetNextPageToken()) == 0 {
+				break
+			}
+			req.NextPageToken = response.GetNextPageToken()
+		}
+		prettyPrintJSONObject(c, summary)
+		_, _ = fmt.Fprintf(c.App.Writer, "Scanned %d tasks\n", scanned)
+		return nil
+	}
+
+	printJSON := c.Bool(FlagPrintJSON)
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		ctx, cancel := newContext(c)
+		defer cancel()
+
+		req.NextPageToken = paginationToken
+		response, err := client.ListTaskQueueBacklog(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var items []interface{}
+		for _, task := range response.GetTasks() {
+			if printJSON {
+				items = append(items, task)
+				continue
+			}
+			items = append(items, &backlogTaskRow{
+				TaskID:       task.GetTaskId(),
+				WorkflowID:   task.GetWorkflowId(),
+				RunID:        task.GetRunId(),
+				WorkflowType: task.GetWorkflowType(),
+				ActivityType: task.GetActivityType(),
+				Attempt:      task.GetAttempt(),
+				FairnessKey:  task.GetFairnessKey(),
+				PriorityKey:  task.GetPriorityKey(),
+				BuildID:      task.GetBuildId(),
+				Age:          task.GetAge().AsDuration().Truncate(time.Second),
+				LookupError:  task.GetLookupError(),
+			})
+		}
+		return items, response.GetNextPageToken(), nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list Task Queue backlog: %v", err)
+	}
+	return nil
+}
+
+func mergeBacklogSummary(summary *adminservice.BacklogSummary, page *adminservice.BacklogSummary) {
+	if page.GetTotal() == 0 {
+		return
+	}
+	if summary.ByWorkflowType == nil {
+		summary.ByWorkflowType = make(map[string]int64)
+		summary.ByActivityType = make(map[string]int64)
+		summary.ByFairnessKey = make(map[string]int64)
+		summary.ByPriorityKey = make(map[int32]int64)
+		summary.ByBuildId = make(map[string]int64)
+	}
+	summary.Total += page.GetTotal()
+	if oldest := page.GetOldestCreateTime(); oldest != nil &&
+		(summary.OldestCreateTime == nil || oldest.AsTime().Before(summary.OldestCreateTime.AsTime())) {
+		summary.OldestCreateTime = oldest
+	}
+	for k, v := range page.GetByWorkflowType() {
+		summary.ByWorkflowType[k] += v
+	}
+	for k, v := range page.GetByActivityType() {
+		summary.ByActivityType[k] += v
+	}
+	for k, v := range page.GetByFairnessKey() {
+		summary.ByFairnessKey[k] += v
+	}
+	for k, v := range page.GetByPriorityKey() {
+		summary.ByPriorityKey[k] += v
+	}
+	for k, v := range page.GetByBuildId() {
+		summary.ByBuildId[k] += v
+	}
+}
+
 // AdminDescribeTaskQueuePartition displays task queue partition information
 func AdminDescribeTaskQueuePartition(c *cli.Context, clientFactory ClientFactory) error {
 	// extracting the namespace
@@ -249,3 +416,151 @@ func AdminForceUnloadTaskQueuePartition(c *cli.Context, clientFactory ClientFact
 	}
 	return nil
 }
+
+// AdminUpdateTaskQueueConcurrencyLimit sets the concurrency limit of an activity task queue
+func AdminUpdateTaskQueueConcurrencyLimit(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	maxInFlight := c.Int(FlagMaxInFlight)
+	fairnessKeyMaxInFlight := c.Int(FlagFairnessKeyMaxInFlight)
+	if maxInFlight < 0 || fairnessKeyMaxInFlight < 0 {
+		return errors.New("concurrency limits must not be negative") // nolint
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.UpdateTaskQueueConcurrencyLimitRequest{
+		Namespace:              namespace,
+		TaskQueue:              tqName,
+		TaskQueueType:          enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		MaxInFlight:            int32(maxInFlight),
+		FairnessKeyMaxInFlight: int32(fairnessKeyMaxInFlight),
+		Reason:                 c.String(FlagReason),
+		Identity:               getCurrentUserFromEnv(),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateTaskQueueConcurrencyLimit(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to update task queue concurrency limit: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminStreamTaskQueueStats prints the stats of a task queue every interval
+func AdminStreamTaskQueueStats(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	var tqTypes []enumspb.TaskQueueType
+	for _, tqTypeString := range c.StringSlice(FlagTaskQueueType) {
+		tqTypeInt, err := StringToEnum(tqTypeString, enumspb.TaskQueueType_value)
+		if err != nil {
+			return fmt.Errorf("invalid task queue type: %w", err)
+		}
+		tqTypes = append(tqTypes, enumspb.TaskQueueType(tqTypeInt))
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.StreamTaskQueueStatsRequest{
+		Namespace:      namespace,
+		TaskQueue:      tqName,
+		TaskQueueTypes: tqTypes,
+	}
+	if c.IsSet(FlagInterval) {
+		req.Interval = durationpb.New(c.Duration(FlagInterval))
+	}
+
+	ctx, cancel := context.WithCancel(c.Context)
+	defer cancel()
+	if c.IsSet(FlagDuration) {
+		ctx, cancel = context.WithTimeout(ctx, c.Duration(FlagDuration))
+		defer cancel()
+	}
+	stream, err := client.StreamTaskQueueStats(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to stream task queue stats: %w", err)
+	}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("unable to stream task queue stats: %w", err)
+		}
+		prettyPrintJSONObject(c, response)
+	}
+}
+
+// AdminPurgeTaskQueueBacklog drops or moves the backlog tasks of a task queue that match a filter
+func AdminPurgeTaskQueueBacklog(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("missing Task Queue type")
+	}
+
+	req := &adminservice.PurgeTaskQueueBacklogRequest{
+		Namespace:            namespace,
+		TaskQueue:            tqName,
+		TaskQueueType:        tqType,
+		WorkflowId:           c.String(FlagWorkflowID),
+		RunId:                c.String(FlagRunID),
+		FairnessKey:          c.String(FlagFairnessKey),
+		PriorityKey:          int32(c.Int(FlagPriorityKey)),
+		BuildId:              c.String(FlagBuildID),
+		DestinationTaskQueue: c.String(FlagDestinationTaskQueue),
+		CancelPurgeId:        c.String(FlagCancelPurgeID),
+		Reason:               c.String(FlagReason),
+		Identity:             getCurrentUserFromEnv(),
+	}
+	if createdBefore := c.Timestamp(FlagCreatedBefore); createdBefore != nil {
+		req.CreatedBefore = timestamppb.New(*createdBefore)
+	}
+	if c.IsSet(FlagDuration) {
+		req.Duration = durationpb.New(c.Duration(FlagDuration))
+	}
+
+	if req.CancelPurgeId == "" {
+		action := "Drop"
+		if req.DestinationTaskQueue != "" {
+			action = "Move to " + req.DestinationTaskQueue
+		}
+		prompter.Prompt(fmt.Sprintf("Namespace: %s TaskQueue: %s Type: %s\n%s all backlog tasks that match the filter?",
+			namespace, tqName, tqType, action))
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.PurgeTaskQueueBacklog(ctx, req)
+	if err != nil {
+		return fmt.Errorf("unable to purge task queue backlog: %w", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
@@ -1,17 +1,21 @@
 package tdbg
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
@@ -19,6 +23,7 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		listTaskQueueBacklogFn          func(request *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error)
 	}
 )
 
@@ -82,12 +87,16 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) ListTaskQueueBacklog(_ context.Context, request *adminservice.ListTaskQueueBacklogRequest, opts ...grpc.CallOption) (*adminservice.ListTaskQueueBacklogResponse, error) {
+	return t.listTaskQueueBacklogFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
 
 	// injecting a test admin client
-	client := &testClient{
+	s.client = &testClient{
 		describeTaskQueuePartitionFn: func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 			return &adminservice.DescribeTaskQueuePartitionResponse{}, nil
 		},
@@ -96,7 +105,7 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 		},
 	}
 	s.app = NewCliApp(func(params *Params) {
-		params.ClientFactory = client
+		params.ClientFactory = s.client
 	})
 	s.app.ExitErrHandler = func(context *cli.Context, err error) {}
 }
@@ -108,6 +117,7 @@ type taskQueueCommandTestSuite struct {
 	*require.Assertions
 	suite.Suite
 	controller *gomock.Controller
+	client     *testClient
 	app        *cli.App
 }
 
@@ -163,3 +173,36 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+func (s *taskQueueCommandTestSuite) TestListTaskQueueBacklogSummary() {
+	var requests []*adminservice.ListTaskQueueBacklogRequest
+	s.client.listTaskQueueBacklogFn = func(request *adminservice.ListTaskQueueBacklogRequest) (*adminservice.ListTaskQueueBacklogResponse, error) {
+		requests = append(requests, proto.Clone(request).(*adminservice.ListTaskQueueBacklogRequest))
+		resp := &adminservice.ListTaskQueueBacklogResponse{
+			ScannedTasks: 10,
+			Summary: &adminservice.BacklogSummary{
+				Total:          2,
+				ByActivityType: map[string]int64{"act": 2},
+			},
+		}
+		if request.GetNextPageToken() == nil {
+			resp.NextPageToken = []byte("page2")
+		}
+		return resp, nil
+	}
+
+	var out bytes.Buffer
+	s.app.Writer = &out
+	s.NoError(s.app.Run([]string{"tdbg", "taskqueue", "list-backlog",
+		"--task-queue", "test", "--partition-id", "2", "--activity-type", "act", "--min-age", "1h", "--summary"}))
+
+	s.Len(requests, 2)
+	s.Equal("/_sys/test/2", requests[0].GetTaskQueue())
+	s.Equal(enumspb.TASK_QUEUE_TYPE_ACTIVITY, requests[0].GetTaskQueueType())
+	s.Equal("act", requests[0].GetFilter().GetActivityType())
+	s.Equal(time.Hour, requests[0].GetFilter().GetMinAge().AsDuration())
+	s.Equal(int32(backlogSummaryPageSize), requests[0].GetPageSize())
+	s.Equal([]byte("page2"), requests[1].GetNextPageToken())
+	s.Regexp(`"act":\s+"4"`, out.String())
+	s.Contains(out.String(), "Scanned 20 tasks")
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -32,7 +33,7 @@ func getCommands(
 			Name:        "shard",
 			Aliases:     []string{"s"},
 			Usage:       "Run admin operation on specific shard",
-			Subcommands: newAdminShardManagementCommands(clientFactory, taskCategoryRegistry),
+			Subcommands: newAdminShardManagementCommands(clientFactory, taskCategoryRegistry, prompterFactory),
 		},
 		{
 			Name:        "history-host",
@@ -44,7 +45,7 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
 		},
 		{
 			Name:        "membership",
@@ -64,6 +65,12 @@ func getCommands(
 				},
 			},
 		},
+		{
+			Name:        "visibility",
+			Aliases:     []string{"vis"},
+			Usage:       "Run admin operation on visibility",
+			Subcommands: newAdminVisibilityCommands(clientFactory),
+		},
 		{
 			Name:        "decode",
 			Usage:       "Decode payload",
@@ -96,6 +103,27 @@ func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory Prom
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "restore",
+			Usage: "restore a workflow from history archival into database",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagRunID,
+					Aliases:  FlagRunIDAlias,
+					Usage:    "Run ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRestoreArchivedWorkflow(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
@@ -270,7 +298,11 @@ func newAdminExecutionCommands(clientFactory ClientFactory, prompterFactory Prom
 	}
 }
 
-func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRegistry tasks.TaskCategoryRegistry) []*cli.Command {
+func newAdminShardManagementCommands(
+	clientFactory ClientFactory,
+	taskCategoryRegistry tasks.TaskCategoryRegistry,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
 	// There are two different categories for the task type, and they have slightly
 	// different semantics. The first is the task category for the list-tasks command,
 	// which is required and does not have a default. The second is the task category
@@ -380,6 +412,71 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminRemoveTask(c, clientFactory, taskCategoryRegistry)
 			},
 		},
+		{
+			Name:  "reshard",
+			Usage: "Split the history shards of the cluster into more shards",
+			Description: "Copies executions to their new shard while the history service is running, and can be repeated to catch up. " +
+				"With --" + FlagCutover + ", also moves pending tasks (replication tasks included), " +
+				"updates the history shard count of the cluster and deletes moved executions and their history from their source shard. " +
+				"Resharding is an offline migration, no history host serves the source and new shards at the same time: " +
+				"cutover is refused until all history hosts are stopped, and all services must be restarted after it.",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagTargetShardCount,
+					Usage:    "Number of history shards after resharding, a multiple of the current number of shards",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagCutover,
+					Usage: "Move tasks, update the history shard count and clean up source shards once executions are copied",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of executions or tasks processed per request",
+				},
+				&cli.StringFlag{
+					Name:  FlagPageToken,
+					Usage: "Resume resharding from the token printed when it was interrupted",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminReshardHistory(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "run-queue-action",
+			Usage: "Run a mitigation action on the queue of a task category of a shard",
+			Description: "Runs one of the actions the queue otherwise only runs when it raises an alert. " +
+				"isolate-namespace moves the tasks of --" + FlagNamespace + " into their own queue reader, " +
+				"reader-stuck unblocks a queue reader, queue-pending-task and slice-count shrink the pending tasks " +
+				"and slices of the queue down to --" + FlagThreshold + ", move-group moves groups of at least --" +
+				FlagThreshold + " pending tasks to the next reader and slice-predicate compacts slice predicates.",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				taskCategoryFlag,
+				&cli.StringFlag{
+					Name:     FlagQueueAction,
+					Usage:    "Queue action: isolate-namespace, reader-stuck, queue-pending-task, slice-count, move-group, slice-predicate",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagReaderID,
+					Usage: "The queue reader to unblock for the reader-stuck action",
+				},
+				&cli.IntFlag{
+					Name:  FlagThreshold,
+					Usage: "Threshold for the queue-pending-task, slice-count and move-group actions",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRunQueueAction(c, clientFactory, taskCategoryRegistry)
+			},
+		},
 	}
 }
 
@@ -491,7 +588,54 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminVisibilityCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "check-consistency",
+			Usage: "Start a job comparing the primary and secondary visibility stores of a namespace",
+			Description: "This command starts a job that pages through both visibility stores of the namespace and " +
+				"reports the records that are missing or differ in status, close time or search attributes. " +
+				"With --backfill, divergent records are rewritten from mutable state.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagVisibilityQuery,
+					Usage: "List filter to restrict the executions to check",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of records to read from a visibility store at a time",
+				},
+				&cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Maximum number of visibility and history requests per second",
+				},
+				&cli.BoolFlag{
+					Name:  FlagBackfill,
+					Usage: "Rewrite the divergent records from mutable state",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartVisibilityConsistencyCheck(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-consistency-check",
+			Usage: "Get the progress and divergences of the visibility consistency check with provided job token",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobToken,
+					Usage:    "Token of the job. This token will be printed in the output of the check-consistency command",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityConsistencyCheck(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "list-tasks",
@@ -547,6 +691,86 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminListTaskQueueTasks(c, clientFactory)
 			},
 		},
+		{
+			Name:  "list-backlog",
+			Usage: "List decoded backlog tasks of a task queue partition that match a filter, or count them with --summary",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_ACTIVITY",
+					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to query",
+				},
+				&cli.BoolFlag{
+					Name:  FlagFair,
+					Usage: "Query fairness tasks",
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowType,
+					Usage: "Only list tasks of this workflow type",
+				},
+				&cli.StringFlag{
+					Name:  FlagActivityType,
+					Usage: "Only list tasks of this activity type",
+				},
+				&cli.StringFlag{
+					Name:  FlagFairnessKey,
+					Usage: "Only list tasks with this fairness key",
+				},
+				&cli.IntFlag{
+					Name:  FlagPriorityKey,
+					Usage: "Only list tasks with this priority key",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Only list tasks assigned to this build ID",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinAge,
+					Usage: "Only list tasks created at least this long ago",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMaxAge,
+					Usage: "Only list tasks created at most this long ago",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSummary,
+					Usage: "Read the whole backlog and print the counts of matching tasks instead of the tasks",
+				},
+				&cli.BoolFlag{
+					Name:  FlagResolveTypes,
+					Usage: "Look up workflow and activity types of tasks, one history call per run and at most 100 tasks per call. Implied by type filters",
+				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueBacklog(c, clientFactory)
+			},
+		},
 		{
 			Name:  "describe-task-queue-partition",
 			Usage: "Describe information related to a task queue partition",
@@ -630,6 +854,118 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "update-concurrency-limit",
+			Usage: "Limit the number of in-flight tasks of an activity task queue (requires the new matcher). Zero removes a limit. The limit is best-effort: tasks still running when a partition reloads stop counting against it.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxInFlight,
+					Usage: "Maximum number of in-flight tasks across all partitions",
+				},
+				&cli.IntFlag{
+					Name:  FlagFairnessKeyMaxInFlight,
+					Usage: "Maximum number of in-flight tasks with the same fairness key",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the change",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskQueueConcurrencyLimit(c, clientFactory)
+			},
+		},
+		{
+			Name:  "purge-backlog",
+			Usage: "Drop the backlog tasks of a task queue that match a filter, or move them to another task queue or partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_ACTIVITY",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowID,
+					Usage: "Only purge tasks of this workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagRunID,
+					Usage: "Only purge tasks of this run",
+				},
+				&cli.StringFlag{
+					Name:  FlagFairnessKey,
+					Usage: "Only purge tasks with this fairness key",
+				},
+				&cli.IntFlag{
+					Name:  FlagPriorityKey,
+					Usage: "Only purge tasks with this priority key",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Only purge tasks assigned to this build ID",
+				},
+				&cli.TimestampFlag{
+					Name:   FlagCreatedBefore,
+					Usage:  "Only purge tasks created before this time (RFC3339), default is now",
+					Layout: time.RFC3339,
+				},
+				&cli.StringFlag{
+					Name:  FlagDestinationTaskQueue,
+					Usage: "Move tasks to this task queue, or a partition of it like /_sys/<name>/<id>, instead of dropping them",
+				},
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Usage: "How long partitions keep purging tasks they read from their backlog, default is one hour",
+				},
+				&cli.StringFlag{
+					Name:  FlagCancelPurgeID,
+					Usage: "Stop the purge with this ID instead of starting a new one",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the purge",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPurgeTaskQueueBacklog(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "stream-stats",
+			Usage: "Print stats of a task queue aggregated across its partitions every interval",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue types: activity, workflow, nexus (experimental), default is workflow and activity",
+				},
+				&cli.DurationFlag{
+					Name:  FlagInterval,
+					Usage: "How often to print stats, default is the minimum interval configured on the server",
+				},
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Usage: "How long to print stats, default is until interrupted",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStreamTaskQueueStats(c, clientFactory)
+			},
+		},
 	}
 }
 
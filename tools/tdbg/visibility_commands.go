@@ -0,0 +1,59 @@
+package tdbg
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+)
+
+// AdminStartVisibilityConsistencyCheck starts a job comparing the primary and secondary visibility stores of a namespace
+func AdminStartVisibilityConsistencyCheck(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.StartVisibilityConsistencyCheck(ctx, &adminservice.StartVisibilityConsistencyCheckRequest{
+		Namespace: nsName,
+		Query:     c.String(FlagVisibilityQuery),
+		PageSize:  int32(c.Int(FlagPageSize)),
+		Rps:       c.Float64(FlagRPS),
+		Backfill:  c.Bool(FlagBackfill),
+	})
+	if err != nil {
+		return fmt.Errorf("call to StartVisibilityConsistencyCheck failed: %w", err)
+	}
+	if err := newEncoder(c.App.Writer).Encode(resp); err != nil {
+		return fmt.Errorf("unable to encode StartVisibilityConsistencyCheck response: %w", err)
+	}
+	return nil
+}
+
+// AdminDescribeVisibilityConsistencyCheck prints the progress and the divergences found by a visibility consistency check
+func AdminDescribeVisibilityConsistencyCheck(c *cli.Context, clientFactory ClientFactory) error {
+	jobTokenBytes, err := base64.StdEncoding.DecodeString(c.String(FlagJobToken))
+	if err != nil {
+		return fmt.Errorf("unable to parse job token: %w", err)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.DescribeVisibilityConsistencyCheck(ctx, &adminservice.DescribeVisibilityConsistencyCheckRequest{
+		JobToken: jobTokenBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("call to DescribeVisibilityConsistencyCheck failed: %w", err)
+	}
+	if err := newEncoder(c.App.Writer).Encode(resp); err != nil {
+		return fmt.Errorf("unable to encode DescribeVisibilityConsistencyCheck response: %w", err)
+	}
+	return nil
+}